@@ -0,0 +1,335 @@
+// Package bootstrap deploys the core contracts of the staking and locked tokens
+// systems, so that tests and local networks don't need to set them up by hand.
+//
+//    b, _ := emulator.NewBlockchain()
+//
+//    deployment, err := bootstrap.Deploy(bootstrap.NewEmulatorSender(b), bootstrap.EmulatorConfig())
+//
+//    tx := flow.NewTransaction().SetScript(templates.GenerateRegisterNodeScript(deployment.Environment))
+//
+// The contracts are deployed in dependency order: the fee contracts if they are not
+// deployed yet, FlowIDTableStaking, StakingProxy and finally LockedTokens.
+package bootstrap
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	sdktemplates "github.com/onflow/flow-go-sdk/templates"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// gasLimit is the maximum gas limit of a transaction
+const gasLimit = 9999
+
+const (
+	emulatorFungibleTokenAddress = "ee82856bf20e2aa6"
+	emulatorFlowTokenAddress     = "0ae53cb6e3f42a79"
+	emulatorFlowFeesAddress      = "e5a8b7f23e8b548f"
+	emulatorStorageFeesAddress   = "f8d6e0586b0a20c7"
+)
+
+// Config contains the addresses of the contracts that are already deployed
+// and the parameters of the contracts that are deployed.
+type Config struct {
+	FungibleTokenAddress flow.Address
+	FlowTokenAddress     flow.Address
+
+	// FlowFeesAddress is the address of the FlowFees contract.
+	// If it is empty, FlowFees is deployed to a new account.
+	FlowFeesAddress flow.Address
+
+	// StorageFeesAddress is the address of the FlowStorageFees contract.
+	// If it is empty, FlowStorageFees is deployed to a new account.
+	StorageFeesAddress flow.Address
+
+	// EpochTokenPayout and RewardCut are the initial parameters of FlowIDTableStaking.
+	EpochTokenPayout cadence.UFix64
+	RewardCut        cadence.UFix64
+}
+
+// EmulatorConfig returns the configuration of an emulator, where the token
+// and fee contracts are deployed when the blockchain is created.
+func EmulatorConfig() Config {
+	return Config{
+		FungibleTokenAddress: flow.HexToAddress(emulatorFungibleTokenAddress),
+		FlowTokenAddress:     flow.HexToAddress(emulatorFlowTokenAddress),
+		FlowFeesAddress:      flow.HexToAddress(emulatorFlowFeesAddress),
+		StorageFeesAddress:   flow.HexToAddress(emulatorStorageFeesAddress),
+		EpochTokenPayout:     1250000 * 100000000,
+		RewardCut:            8000000,
+	}
+}
+
+// Account is an account created by the bootstrap, with the key that controls it.
+type Account struct {
+	Address    flow.Address
+	Key        *flow.AccountKey
+	PrivateKey crypto.PrivateKey
+	Signer     crypto.Signer
+}
+
+// Deployment is the result of Deploy.
+type Deployment struct {
+	// Environment contains the addresses of all the deployed contracts,
+	// to be used with the transaction templates.
+	Environment templates.Environment
+
+	FlowFeesAddress flow.Address
+
+	// IDTable is the account of FlowIDTableStaking, which stores the staking Admin resource.
+	IDTable Account
+
+	// StakingProxy is the account of the StakingProxy contract.
+	StakingProxy Account
+
+	// LockedTokens is the account of the LockedTokens contract.
+	// The TokenAdminCollection is stored in the service account.
+	LockedTokens Account
+
+	// FlowFees is the account FlowFees was deployed to, which stores its Administrator.
+	// It is nil if FlowFees was already deployed.
+	FlowFees *Account
+
+	// StorageFees is the account FlowStorageFees was deployed to, which stores its Administrator.
+	// It is nil if FlowStorageFees was already deployed.
+	StorageFees *Account
+}
+
+// Deploy deploys the core contracts in dependency order with the given sender.
+func Deploy(sender Sender, conf Config) (*Deployment, error) {
+
+	d := &Deployment{
+		Environment: templates.Environment{
+			FungibleTokenAddress: conf.FungibleTokenAddress.Hex(),
+			FlowTokenAddress:     conf.FlowTokenAddress.Hex(),
+		},
+		FlowFeesAddress: conf.FlowFeesAddress,
+	}
+
+	if conf.FlowFeesAddress == flow.EmptyAddress {
+		account, err := deployFlowFees(sender, conf)
+		if err != nil {
+			return nil, fmt.Errorf("could not deploy FlowFees: %w", err)
+		}
+
+		d.FlowFees = &account
+		d.FlowFeesAddress = account.Address
+	}
+
+	storageFeesAddress := conf.StorageFeesAddress
+
+	if storageFeesAddress == flow.EmptyAddress {
+		code := contracts.FlowStorageFees(conf.FungibleTokenAddress.Hex(), conf.FlowTokenAddress.Hex())
+
+		account, err := createAccountWithContract(sender, "FlowStorageFees", code)
+		if err != nil {
+			return nil, fmt.Errorf("could not deploy FlowStorageFees: %w", err)
+		}
+
+		d.StorageFees = &account
+		storageFeesAddress = account.Address
+	}
+
+	d.Environment.StorageFeesAddress = storageFeesAddress.Hex()
+
+	var err error
+
+	d.IDTable, err = deployIDTableStaking(sender, conf, d.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("could not deploy FlowIDTableStaking: %w", err)
+	}
+
+	d.Environment.IDTableAddress = d.IDTable.Address.Hex()
+
+	d.StakingProxy, err = createAccountWithContract(sender, "StakingProxy", contracts.FlowStakingProxy())
+	if err != nil {
+		return nil, fmt.Errorf("could not deploy StakingProxy: %w", err)
+	}
+
+	d.Environment.StakingProxyAddress = d.StakingProxy.Address.Hex()
+
+	d.LockedTokens, err = deployLockedTokens(sender, d.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("could not deploy LockedTokens: %w", err)
+	}
+
+	d.Environment.LockedTokensAddress = d.LockedTokens.Address.Hex()
+
+	return d, nil
+}
+
+func deployFlowFees(sender Sender, conf Config) (Account, error) {
+	account, err := newAccount()
+	if err != nil {
+		return Account{}, err
+	}
+
+	code := contracts.FlowFees(conf.FungibleTokenAddress.Hex(), conf.FlowTokenAddress.Hex())
+
+	tx := newTransaction(sender, templates.GenerateDeployFlowFeesScript())
+
+	err = addArguments(tx,
+		cadence.NewString(hex.EncodeToString(code)),
+		cadence.NewArray([]cadence.Value{bytesToCadenceArray(account.Key.Encode())}),
+	)
+	if err != nil {
+		return Account{}, err
+	}
+
+	account.Address, err = sendAndGetNewAccount(sender, tx)
+	return account, err
+}
+
+func deployIDTableStaking(sender Sender, conf Config, env templates.Environment) (Account, error) {
+	account, err := newAccount()
+	if err != nil {
+		return Account{}, err
+	}
+
+	code := contracts.FlowIDTableStaking(env.FungibleTokenAddress, env.FlowTokenAddress)
+
+	tx := newTransaction(sender, templates.GenerateTransferMinterAndDeployHexScript(env))
+
+	err = addArguments(tx,
+		cadence.NewArray([]cadence.Value{bytesToCadenceArray(account.Key.Encode())}),
+		cadence.NewString("FlowIDTableStaking"),
+		cadence.NewString(hex.EncodeToString(code)),
+		conf.EpochTokenPayout,
+		conf.RewardCut,
+	)
+	if err != nil {
+		return Account{}, err
+	}
+
+	account.Address, err = sendAndGetNewAccount(sender, tx)
+	return account, err
+}
+
+func deployLockedTokens(sender Sender, env templates.Environment) (Account, error) {
+	account, err := newAccount()
+	if err != nil {
+		return Account{}, err
+	}
+
+	code := contracts.FlowLockedTokens(
+		env.FungibleTokenAddress,
+		env.FlowTokenAddress,
+		env.IDTableAddress,
+		env.StakingProxyAddress,
+		env.StorageFeesAddress,
+	)
+
+	tx := newTransaction(sender, templates.GenerateDeployLockedTokens())
+
+	err = addArguments(tx,
+		cadence.NewString("LockedTokens"),
+		cadence.NewString(hex.EncodeToString(code)),
+		cadence.NewArray([]cadence.Value{bytesToCadenceArray(account.Key.Encode())}),
+	)
+	if err != nil {
+		return Account{}, err
+	}
+
+	account.Address, err = sendAndGetNewAccount(sender, tx)
+	return account, err
+}
+
+func createAccountWithContract(sender Sender, name string, code []byte) (Account, error) {
+	account, err := newAccount()
+	if err != nil {
+		return Account{}, err
+	}
+
+	tx := sdktemplates.CreateAccount(
+		[]*flow.AccountKey{account.Key},
+		[]sdktemplates.Contract{{Name: name, Source: string(code)}},
+		sender.ServiceAddress(),
+	).SetGasLimit(gasLimit)
+
+	account.Address, err = sendAndGetNewAccount(sender, tx)
+	return account, err
+}
+
+func newTransaction(sender Sender, script []byte) *flow.Transaction {
+	return flow.NewTransaction().
+		SetScript(script).
+		SetGasLimit(gasLimit).
+		AddAuthorizer(sender.ServiceAddress())
+}
+
+func addArguments(tx *flow.Transaction, arguments ...cadence.Value) error {
+	for _, argument := range arguments {
+		err := tx.AddArgument(argument)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendAndGetNewAccount sends a transaction that creates exactly one account
+// and returns the address of the account from its events.
+func sendAndGetNewAccount(sender Sender, tx *flow.Transaction) (flow.Address, error) {
+	result, err := sender.Send(tx)
+	if err != nil {
+		return flow.EmptyAddress, err
+	}
+
+	var addresses []flow.Address
+
+	for _, event := range result.Events {
+		if event.Type == flow.EventAccountCreated {
+			addresses = append(addresses, flow.AccountCreatedEvent(event).Address())
+		}
+	}
+
+	if len(addresses) != 1 {
+		return flow.EmptyAddress, fmt.Errorf("expected the transaction to create one account, but it created %d", len(addresses))
+	}
+
+	return addresses[0], nil
+}
+
+// newAccount generates a new full weight key for an account that will be created.
+func newAccount() (Account, error) {
+	seed := make([]byte, crypto.MinSeedLength)
+
+	_, err := rand.Read(seed)
+	if err != nil {
+		return Account{}, err
+	}
+
+	privateKey, err := crypto.GeneratePrivateKey(crypto.ECDSA_P256, seed)
+	if err != nil {
+		return Account{}, err
+	}
+
+	key := flow.NewAccountKey().
+		FromPrivateKey(privateKey).
+		SetHashAlgo(crypto.SHA3_256).
+		SetWeight(flow.AccountKeyWeightThreshold)
+
+	return Account{
+		Key:        key,
+		PrivateKey: privateKey,
+		Signer:     crypto.NewInMemorySigner(privateKey, crypto.SHA3_256),
+	}, nil
+}
+
+func bytesToCadenceArray(b []byte) cadence.Array {
+	values := make([]cadence.Value, len(b))
+
+	for i, v := range b {
+		values[i] = cadence.NewUInt8(v)
+	}
+
+	return cadence.NewArray(values)
+}
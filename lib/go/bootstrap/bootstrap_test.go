@@ -0,0 +1,131 @@
+package bootstrap_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	emulator "github.com/onflow/flow-emulator"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/bootstrap"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+func executeScript(t *testing.T, b *emulator.Blockchain, script []byte, arguments ...cadence.Value) cadence.Value {
+	encoded := make([][]byte, len(arguments))
+	for i, argument := range arguments {
+		encoded[i] = jsoncdc.MustEncode(argument)
+	}
+
+	result, err := b.ExecuteScript(script, encoded)
+	require.NoError(t, err)
+	require.NoError(t, result.Error)
+
+	return result.Value
+}
+
+func TestDeploy(t *testing.T) {
+
+	t.Run("Should deploy the staking and locked tokens contracts to an emulator", func(t *testing.T) {
+		b, err := emulator.NewBlockchain()
+		require.NoError(t, err)
+
+		conf := bootstrap.EmulatorConfig()
+
+		deployment, err := bootstrap.Deploy(bootstrap.NewEmulatorSender(b), conf)
+		require.NoError(t, err)
+
+		env := deployment.Environment
+
+		assert.Equal(t, conf.FungibleTokenAddress.Hex(), env.FungibleTokenAddress)
+		assert.Equal(t, conf.FlowTokenAddress.Hex(), env.FlowTokenAddress)
+		assert.Equal(t, conf.StorageFeesAddress.Hex(), env.StorageFeesAddress)
+		assert.Equal(t, conf.FlowFeesAddress, deployment.FlowFeesAddress)
+		assert.Equal(t, deployment.IDTable.Address.Hex(), env.IDTableAddress)
+		assert.Equal(t, deployment.StakingProxy.Address.Hex(), env.StakingProxyAddress)
+		assert.Equal(t, deployment.LockedTokens.Address.Hex(), env.LockedTokensAddress)
+		assert.Nil(t, deployment.FlowFees)
+		assert.Nil(t, deployment.StorageFees)
+
+		result := executeScript(t, b, templates.GenerateGetWeeklyPayoutScript(env))
+		assert.Equal(t, conf.EpochTokenPayout, result)
+
+		result = executeScript(t, b, templates.GenerateGetCutPercentageScript(env))
+		assert.Equal(t, conf.RewardCut, result)
+
+		// The returned key of the staking account can use the staking admin
+		tx := flow.NewTransaction().
+			SetScript(templates.GenerateChangePayoutScript(env)).
+			SetGasLimit(9999).
+			SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
+			SetPayer(b.ServiceKey().Address).
+			AddAuthorizer(deployment.IDTable.Address)
+		require.NoError(t, tx.AddArgument(cadence.UFix64(100000000)))
+		require.NoError(t, tx.SignPayload(deployment.IDTable.Address, 0, deployment.IDTable.Signer))
+		require.NoError(t, tx.SignEnvelope(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().Signer()))
+		require.NoError(t, b.AddTransaction(*tx))
+
+		txResult, err := b.ExecuteNextTransaction()
+		require.NoError(t, err)
+		require.NoError(t, txResult.Error)
+		_, err = b.CommitBlock()
+		require.NoError(t, err)
+
+		result = executeScript(t, b, templates.GenerateGetWeeklyPayoutScript(env))
+		assert.Equal(t, cadence.UFix64(100000000), result)
+
+		// The service account is the locked tokens admin
+		tx = flow.NewTransaction().
+			SetScript(templates.GenerateCreateSharedAccountScript(env)).
+			AddAuthorizer(b.ServiceKey().Address)
+		key := deployment.LockedTokens.Key.Encode()
+		publicKey := make([]cadence.Value, len(key))
+		for i, v := range key {
+			publicKey[i] = cadence.NewUInt8(v)
+		}
+		for i := 0; i < 3; i++ {
+			require.NoError(t, tx.AddArgument(cadence.NewArray(publicKey)))
+		}
+
+		_, err = bootstrap.NewEmulatorSender(b).Send(tx.SetGasLimit(9999))
+		require.NoError(t, err)
+	})
+
+	t.Run("Should deploy the fee contracts if they are not deployed", func(t *testing.T) {
+		b, err := emulator.NewBlockchain()
+		require.NoError(t, err)
+
+		conf := bootstrap.EmulatorConfig()
+		conf.FlowFeesAddress = flow.EmptyAddress
+		conf.StorageFeesAddress = flow.EmptyAddress
+
+		deployment, err := bootstrap.Deploy(bootstrap.NewEmulatorSender(b), conf)
+		require.NoError(t, err)
+
+		require.NotNil(t, deployment.FlowFees)
+		require.NotNil(t, deployment.StorageFees)
+		assert.Equal(t, deployment.FlowFees.Address, deployment.FlowFeesAddress)
+		assert.Equal(t, deployment.StorageFees.Address.Hex(), deployment.Environment.StorageFeesAddress)
+
+		account, err := b.GetAccount(deployment.FlowFees.Address)
+		require.NoError(t, err)
+		assert.Contains(t, account.Contracts, "FlowFees")
+
+		result := executeScript(t, b, templates.GenerateGetStorageFeeMinimumScript(deployment.Environment))
+		assert.Equal(t, cadence.UFix64(0), result)
+	})
+
+	t.Run("Should return the error of a reverted transaction", func(t *testing.T) {
+		b, err := emulator.NewBlockchain()
+		require.NoError(t, err)
+
+		conf := bootstrap.EmulatorConfig()
+		conf.FlowTokenAddress = conf.FungibleTokenAddress
+
+		_, err = bootstrap.Deploy(bootstrap.NewEmulatorSender(b), conf)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,16 @@
+module github.com/onflow/flow-core-contracts/lib/go/bootstrap
+
+go 1.13
+
+require (
+	github.com/onflow/cadence v0.14.4
+	github.com/onflow/flow-core-contracts/lib/go/contracts v0.7.1
+	github.com/onflow/flow-core-contracts/lib/go/templates v0.0.0-00010101000000-000000000000
+	github.com/onflow/flow-emulator v0.17.1
+	github.com/onflow/flow-go-sdk v0.17.0
+	github.com/stretchr/testify v1.7.0
+)
+
+replace github.com/onflow/flow-core-contracts/lib/go/contracts => ../contracts
+
+replace github.com/onflow/flow-core-contracts/lib/go/templates => ../templates
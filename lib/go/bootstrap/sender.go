@@ -0,0 +1,141 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	emulator "github.com/onflow/flow-emulator"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/client"
+	"github.com/onflow/flow-go-sdk/crypto"
+)
+
+// Sender sends the bootstrap transactions on behalf of the service account.
+type Sender interface {
+	// ServiceAddress returns the address of the service account,
+	// which authorizes the bootstrap transactions.
+	ServiceAddress() flow.Address
+
+	// Send sets the service account as the proposer and payer of the transaction,
+	// signs it, sends it and waits until it is sealed.
+	// An error is returned if the transaction could not be sent or if it reverted.
+	Send(tx *flow.Transaction) (*flow.TransactionResult, error)
+}
+
+// EmulatorSender sends transactions to an emulator blockchain and commits
+// a block after each of them.
+type EmulatorSender struct {
+	blockchain *emulator.Blockchain
+}
+
+var _ Sender = &EmulatorSender{}
+
+// NewEmulatorSender returns a sender that signs with the service key of the emulator.
+func NewEmulatorSender(b *emulator.Blockchain) *EmulatorSender {
+	return &EmulatorSender{blockchain: b}
+}
+
+func (s *EmulatorSender) ServiceAddress() flow.Address {
+	return s.blockchain.ServiceKey().Address
+}
+
+func (s *EmulatorSender) Send(tx *flow.Transaction) (*flow.TransactionResult, error) {
+	serviceKey := s.blockchain.ServiceKey()
+
+	tx.SetProposalKey(serviceKey.Address, serviceKey.Index, serviceKey.SequenceNumber).
+		SetPayer(serviceKey.Address)
+
+	err := tx.SignEnvelope(serviceKey.Address, serviceKey.Index, serviceKey.Signer())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.blockchain.AddTransaction(*tx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, err = s.blockchain.ExecuteAndCommitBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.blockchain.GetTransactionResult(tx.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	return result, result.Error
+}
+
+// ClientSender sends transactions to an access node.
+type ClientSender struct {
+	client       *client.Client
+	address      flow.Address
+	keyIndex     int
+	signer       crypto.Signer
+	pollInterval time.Duration
+}
+
+var _ Sender = &ClientSender{}
+
+// NewClientSender returns a sender that signs with the given key of the service account.
+func NewClientSender(flowClient *client.Client, serviceAddress flow.Address, keyIndex int, signer crypto.Signer) *ClientSender {
+	return &ClientSender{
+		client:       flowClient,
+		address:      serviceAddress,
+		keyIndex:     keyIndex,
+		signer:       signer,
+		pollInterval: time.Second,
+	}
+}
+
+func (s *ClientSender) ServiceAddress() flow.Address {
+	return s.address
+}
+
+func (s *ClientSender) Send(tx *flow.Transaction) (*flow.TransactionResult, error) {
+	ctx := context.Background()
+
+	account, err := s.client.GetAccountAtLatestBlock(ctx, s.address)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.keyIndex >= len(account.Keys) {
+		return nil, fmt.Errorf("service account %s has no key %d", s.address, s.keyIndex)
+	}
+
+	block, err := s.client.GetLatestBlockHeader(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	tx.SetReferenceBlockID(block.ID).
+		SetProposalKey(s.address, s.keyIndex, account.Keys[s.keyIndex].SequenceNumber).
+		SetPayer(s.address)
+
+	err = tx.SignEnvelope(s.address, s.keyIndex, s.signer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.client.SendTransaction(ctx, *tx)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		result, err := s.client.GetTransactionResult(ctx, tx.ID())
+		if err != nil {
+			return nil, err
+		}
+
+		if result.Status == flow.TransactionStatusSealed {
+			return result, result.Error
+		}
+
+		time.Sleep(s.pollInterval)
+	}
+}
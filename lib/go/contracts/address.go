@@ -0,0 +1,81 @@
+package contracts
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/onflow/flow-go-sdk"
+)
+
+// AddressLength is the length of an account address in bytes.
+const AddressLength = 8
+
+// Address is the address of the account a contract is deployed to.
+//
+// It has the same representation as flow.Address in the Flow Go SDK,
+// so a flow.Address can be converted with contracts.Address(address).
+type Address [AddressLength]byte
+
+// ParseAddress parses a hex encoded address of the chain, with or without the 0x prefix.
+//
+// Shorter addresses are padded with leading zeros, like "0x01" for the address 0000000000000001.
+// An error is returned if the address is empty, too long, not valid hex or not
+// a valid account address of the chain, which catches most mistyped addresses.
+func ParseAddress(s string, chain flow.ChainID) (Address, error) {
+	digits := strings.TrimPrefix(s, "0x")
+
+	if digits == "" {
+		return Address{}, fmt.Errorf("invalid address %q: address is empty", s)
+	}
+
+	if len(digits) > 2*AddressLength {
+		return Address{}, fmt.Errorf("invalid address %q: address is longer than %d bytes", s, AddressLength)
+	}
+
+	if len(digits)%2 == 1 {
+		digits = "0" + digits
+	}
+
+	b, err := hex.DecodeString(digits)
+	if err != nil {
+		return Address{}, fmt.Errorf("invalid address %q: %w", s, err)
+	}
+
+	var address Address
+	copy(address[AddressLength-len(b):], b)
+
+	switch chain {
+	case flow.Mainnet, flow.Testnet, flow.Emulator:
+	default:
+		return Address{}, fmt.Errorf("invalid address %q: unknown chain %q", s, chain)
+	}
+
+	if !address.IsValid(chain) {
+		return Address{}, fmt.Errorf("invalid address %q: not an account address of %s", s, chain)
+	}
+
+	return address, nil
+}
+
+// IsValid returns true if the address is a valid account address of the chain.
+// It panics if the chain isn't flow.Mainnet, flow.Testnet or flow.Emulator.
+func (a Address) IsValid(chain flow.ChainID) bool {
+	address := flow.Address(a)
+	return address.IsValid(chain)
+}
+
+// Hex returns the hex encoding of the address without the 0x prefix.
+func (a Address) Hex() string {
+	return hex.EncodeToString(a[:])
+}
+
+// String returns the hex encoding of the address with the 0x prefix.
+func (a Address) String() string {
+	return "0x" + a.Hex()
+}
+
+// IsZero returns true if the address hasn't been set.
+func (a Address) IsZero() bool {
+	return a == Address{}
+}
@@ -189,3 +189,39 @@ func FlowLockedTokens(
 
 	return []byte(code)
 }
+
+// FlowEpoch returns the FlowEpoch contract.
+//
+// The returned contract will import the FlowIDTableStaking contract
+// from the specified address. FlowEpoch has to be deployed to the same account.
+func FlowEpoch(idTableAddress string) []byte {
+	code := assets.MustAssetString(flowEpochFilename)
+
+	code = strings.ReplaceAll(code, placeholderIDTableAddress, withHexPrefix(idTableAddress))
+
+	return []byte(code)
+}
+
+// FlowQC returns the FlowQuorumCertificate contract.
+//
+// The returned contract will import the FlowIDTableStaking contract
+// from the specified address.
+func FlowQC(idTableAddress string) []byte {
+	code := assets.MustAssetString(flowQCFilename)
+
+	code = strings.ReplaceAll(code, placeholderIDTableAddress, withHexPrefix(idTableAddress))
+
+	return []byte(code)
+}
+
+// FlowDKG returns the FlowDKG contract.
+//
+// The returned contract will import the FlowIDTableStaking contract
+// from the specified address.
+func FlowDKG(idTableAddress string) []byte {
+	code := assets.MustAssetString(flowDKGFilename)
+
+	code = strings.ReplaceAll(code, placeholderIDTableAddress, withHexPrefix(idTableAddress))
+
+	return []byte(code)
+}
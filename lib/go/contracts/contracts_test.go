@@ -1,9 +1,12 @@
 package contracts_test
 
 import (
+	"errors"
 	"testing"
 
+	"github.com/onflow/flow-go-sdk"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/onflow/flow-core-contracts/lib/go/contracts"
 )
@@ -36,3 +39,157 @@ func TestFlowIdentityTableContract(t *testing.T) {
 	contract := contracts.FlowIDTableStaking(fakeAddr, fakeAddr)
 	assert.NotNil(t, contract)
 }
+
+func TestFlowEpochContract(t *testing.T) {
+	contract := contracts.FlowEpoch(fakeAddr)
+	assert.NotNil(t, contract)
+}
+
+func TestFlowQCContract(t *testing.T) {
+	contract := contracts.FlowQC(fakeAddr)
+	assert.NotNil(t, contract)
+}
+
+func TestFlowDKGContract(t *testing.T) {
+	contract := contracts.FlowDKG(fakeAddr)
+	assert.NotNil(t, contract)
+}
+
+func TestParseAddress(t *testing.T) {
+	t.Run("Should parse addresses with and without prefix", func(t *testing.T) {
+		address, err := contracts.ParseAddress("0xf8d6e0586b0a20c7", flow.Emulator)
+		require.NoError(t, err)
+		assert.Equal(t, "f8d6e0586b0a20c7", address.Hex())
+
+		address, err = contracts.ParseAddress("f8d6e0586b0a20c7", flow.Emulator)
+		require.NoError(t, err)
+		assert.Equal(t, "0xf8d6e0586b0a20c7", address.String())
+	})
+
+	t.Run("Should pad short addresses", func(t *testing.T) {
+		address, err := contracts.ParseAddress("0xae53cb6e3f42a79", flow.Emulator)
+		require.NoError(t, err)
+		assert.Equal(t, "0ae53cb6e3f42a79", address.Hex())
+	})
+
+	t.Run("Should reject invalid addresses", func(t *testing.T) {
+		for _, s := range []string{"", "0x", "0xf8d6e0586b0a20c7a", "0xFLOWTOKENADDRESS", "f8d6e058 6b0a20c"} {
+			_, err := contracts.ParseAddress(s, flow.Emulator)
+			assert.Error(t, err, s)
+		}
+	})
+
+	t.Run("Should reject addresses of another chain", func(t *testing.T) {
+		_, err := contracts.ParseAddress("0xf8d6e0586b0a20c7", flow.Mainnet)
+		assert.Error(t, err)
+
+		_, err = contracts.ParseAddress("0x1654653399040a61", flow.Testnet)
+		assert.Error(t, err)
+
+		_, err = contracts.ParseAddress("0x1654653399040a61", "flow-localnet")
+		assert.Error(t, err)
+	})
+
+	t.Run("Should reject mistyped addresses", func(t *testing.T) {
+		_, err := contracts.ParseAddress("0x1654653399040a61", flow.Mainnet)
+		require.NoError(t, err)
+
+		_, err = contracts.ParseAddress("0x1654653399040a62", flow.Mainnet)
+		assert.Error(t, err)
+
+		_, err = contracts.ParseAddress("0x1654635399040a61", flow.Mainnet)
+		assert.Error(t, err)
+	})
+}
+
+func TestGet(t *testing.T) {
+	addresses := contracts.Addresses{
+		FungibleToken: contracts.Address{1},
+		FlowToken:     contracts.Address{2},
+		FlowFees:      contracts.Address{3},
+		StorageFees:   contracts.Address{4},
+		IDTable:       contracts.Address{5},
+		StakingProxy:  contracts.Address{6},
+	}
+
+	t.Run("Should return the same code as the contract functions", func(t *testing.T) {
+		ft := addresses.FungibleToken.String()
+		flowToken := addresses.FlowToken.String()
+		idTable := addresses.IDTable.String()
+
+		expected := map[string][]byte{
+			contracts.FungibleTokenName:         contracts.FungibleToken(),
+			contracts.FlowTokenName:             contracts.FlowToken(ft),
+			contracts.FlowFeesName:              contracts.FlowFees(ft, flowToken),
+			contracts.FlowStorageFeesName:       contracts.FlowStorageFees(ft, flowToken),
+			contracts.FlowServiceAccountName:    contracts.FlowServiceAccount(ft, flowToken, addresses.FlowFees.String(), addresses.StorageFees.String()),
+			contracts.FlowIDTableStakingName:    contracts.FlowIDTableStaking(ft, flowToken),
+			contracts.StakingProxyName:          contracts.FlowStakingProxy(),
+			contracts.LockedTokensName:          contracts.FlowLockedTokens(ft, flowToken, idTable, addresses.StakingProxy.String(), addresses.StorageFees.String()),
+			contracts.FlowEpochName:             contracts.FlowEpoch(idTable),
+			contracts.FlowQuorumCertificateName: contracts.FlowQC(idTable),
+			contracts.FlowDKGName:               contracts.FlowDKG(idTable),
+		}
+
+		require.Len(t, contracts.Names(), len(expected))
+
+		for _, name := range contracts.Names() {
+			code, err := contracts.Get(name, addresses)
+			require.NoError(t, err, name)
+			assert.Equal(t, string(expected[name]), string(code), name)
+			assert.NotContains(t, string(code), "ADDRESS", name)
+		}
+	})
+
+	t.Run("Should return the same code from the per-contract accessors", func(t *testing.T) {
+		accessors := map[string]func() ([]byte, error){
+			contracts.FungibleTokenName:         contracts.GetFungibleToken,
+			contracts.FlowTokenName:             func() ([]byte, error) { return contracts.GetFlowToken(addresses) },
+			contracts.FlowFeesName:              func() ([]byte, error) { return contracts.GetFlowFees(addresses) },
+			contracts.FlowStorageFeesName:       func() ([]byte, error) { return contracts.GetFlowStorageFees(addresses) },
+			contracts.FlowServiceAccountName:    func() ([]byte, error) { return contracts.GetFlowServiceAccount(addresses) },
+			contracts.FlowIDTableStakingName:    func() ([]byte, error) { return contracts.GetFlowIDTableStaking(addresses) },
+			contracts.StakingProxyName:          func() ([]byte, error) { return contracts.GetStakingProxy(addresses) },
+			contracts.LockedTokensName:          func() ([]byte, error) { return contracts.GetLockedTokens(addresses) },
+			contracts.FlowEpochName:             func() ([]byte, error) { return contracts.GetFlowEpoch(addresses) },
+			contracts.FlowQuorumCertificateName: func() ([]byte, error) { return contracts.GetFlowQC(addresses) },
+			contracts.FlowDKGName:               func() ([]byte, error) { return contracts.GetFlowDKG(addresses) },
+		}
+
+		require.Len(t, contracts.Names(), len(accessors))
+
+		for _, name := range contracts.Names() {
+			expected, err := contracts.Get(name, addresses)
+			require.NoError(t, err, name)
+
+			code, err := accessors[name]()
+			require.NoError(t, err, name)
+			assert.Equal(t, string(expected), string(code), name)
+		}
+
+		_, err := contracts.GetFlowEpoch(contracts.Addresses{})
+		assert.True(t, errors.Is(err, contracts.ErrMissingAddress))
+	})
+
+	t.Run("Should only require the addresses of imported contracts", func(t *testing.T) {
+		_, err := contracts.Get(contracts.FlowEpochName, contracts.Addresses{IDTable: addresses.IDTable})
+		assert.NoError(t, err)
+
+		_, err = contracts.Get(contracts.StakingProxyName, contracts.Addresses{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Should return an error for a missing address", func(t *testing.T) {
+		incomplete := addresses
+		incomplete.StorageFees = contracts.Address{}
+
+		_, err := contracts.Get(contracts.LockedTokensName, incomplete)
+		assert.True(t, errors.Is(err, contracts.ErrMissingAddress))
+		assert.Contains(t, err.Error(), "StorageFees")
+	})
+
+	t.Run("Should return an error for an unknown contract", func(t *testing.T) {
+		_, err := contracts.Get("FlowIdTableStaking", addresses)
+		assert.True(t, errors.Is(err, contracts.ErrUnknownContract))
+	})
+}
@@ -0,0 +1,193 @@
+package contracts
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	ftcontracts "github.com/onflow/flow-ft/lib/go/contracts"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts/internal/assets"
+)
+
+// The names of the contracts that Get returns, which are the names they are deployed with.
+const (
+	FungibleTokenName         = "FungibleToken"
+	FlowTokenName             = "FlowToken"
+	FlowFeesName              = "FlowFees"
+	FlowStorageFeesName       = "FlowStorageFees"
+	FlowServiceAccountName    = "FlowServiceAccount"
+	FlowIDTableStakingName    = "FlowIDTableStaking"
+	StakingProxyName          = "StakingProxy"
+	LockedTokensName          = "LockedTokens"
+	FlowEpochName             = "FlowEpoch"
+	FlowQuorumCertificateName = "FlowQuorumCertificate"
+	FlowDKGName               = "FlowDKG"
+)
+
+var (
+	// ErrUnknownContract is returned by Get for a name that isn't a core contract.
+	ErrUnknownContract = errors.New("unknown contract")
+
+	// ErrMissingAddress is returned by Get if the address of an imported contract isn't set.
+	ErrMissingAddress = errors.New("missing address")
+)
+
+var contractFilenames = map[string]string{
+	FlowTokenName:             flowTokenFilename,
+	FlowFeesName:              flowFeesFilename,
+	FlowStorageFeesName:       storageFeesFilename,
+	FlowServiceAccountName:    flowServiceAccountFilename,
+	FlowIDTableStakingName:    flowIdentityTableFilename,
+	StakingProxyName:          flowStakingProxyFilename,
+	LockedTokensName:          flowLockedTokensFilename,
+	FlowEpochName:             flowEpochFilename,
+	FlowQuorumCertificateName: flowQCFilename,
+	FlowDKGName:               flowDKGFilename,
+}
+
+// Addresses contains the addresses of the contracts that the core contracts import.
+// Only the addresses of the contracts imported by a contract have to be set.
+type Addresses struct {
+	FungibleToken Address
+	FlowToken     Address
+	FlowFees      Address
+	StorageFees   Address
+	IDTable       Address
+	StakingProxy  Address
+}
+
+// addressPlaceholder is the placeholder of an imported contract in the contract code,
+// with the field of Addresses that replaces it.
+type addressPlaceholder struct {
+	placeholder string
+	field       string
+	address     Address
+}
+
+func (a Addresses) placeholders() []addressPlaceholder {
+	return []addressPlaceholder{
+		{placeholderFungibleTokenAddress, "FungibleToken", a.FungibleToken},
+		{placeholderFlowTokenAddress, "FlowToken", a.FlowToken},
+		{placeholderFlowFeesAddress, "FlowFees", a.FlowFees},
+		{placeholderStorageFeesAddress, "StorageFees", a.StorageFees},
+		{placeholderIDTableAddress, "IDTable", a.IDTable},
+		{placeholderStakingProxyAddress, "StakingProxy", a.StakingProxy},
+	}
+}
+
+// Names returns the names of the contracts that Get returns, in alphabetical order.
+func Names() []string {
+	names := []string{FungibleTokenName}
+
+	for name := range contractFilenames {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Get returns the code of the named core contract with its imports replaced by the given addresses.
+//
+// Unlike the functions that return a single contract, Get doesn't panic:
+// an error wrapping ErrUnknownContract is returned for a name that isn't in Names,
+// and an error wrapping ErrMissingAddress if the address of a contract the named contract imports isn't set.
+func Get(name string, addresses Addresses) ([]byte, error) {
+	if name == FungibleTokenName {
+		return fungibleToken()
+	}
+
+	filename, ok := contractFilenames[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q, expected one of %s", ErrUnknownContract, name, strings.Join(Names(), ", "))
+	}
+
+	code, err := assets.AssetString(filename)
+	if err != nil {
+		return nil, fmt.Errorf("could not load contract %s: %w", name, err)
+	}
+
+	for _, p := range addresses.placeholders() {
+		if !strings.Contains(code, p.placeholder) {
+			continue
+		}
+
+		if p.address.IsZero() {
+			return nil, fmt.Errorf("%w: contract %s requires the %s address", ErrMissingAddress, name, p.field)
+		}
+
+		code = strings.ReplaceAll(code, p.placeholder, p.address.String())
+	}
+
+	return []byte(code), nil
+}
+
+// fungibleToken loads the FungibleToken contract interface from the flow-ft module,
+// which only exposes it through a function that panics if the asset is missing.
+func fungibleToken() (code []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("could not load contract %s: %v", FungibleTokenName, r)
+		}
+	}()
+
+	return ftcontracts.FungibleToken(), nil
+}
+
+// GetFungibleToken returns the FungibleToken contract interface, or an error if it can't be loaded.
+func GetFungibleToken() ([]byte, error) {
+	return fungibleToken()
+}
+
+// GetFlowToken returns the FlowToken contract like Get.
+func GetFlowToken(addresses Addresses) ([]byte, error) {
+	return Get(FlowTokenName, addresses)
+}
+
+// GetFlowFees returns the FlowFees contract like Get.
+func GetFlowFees(addresses Addresses) ([]byte, error) {
+	return Get(FlowFeesName, addresses)
+}
+
+// GetFlowStorageFees returns the FlowStorageFees contract like Get.
+func GetFlowStorageFees(addresses Addresses) ([]byte, error) {
+	return Get(FlowStorageFeesName, addresses)
+}
+
+// GetFlowServiceAccount returns the FlowServiceAccount contract like Get.
+func GetFlowServiceAccount(addresses Addresses) ([]byte, error) {
+	return Get(FlowServiceAccountName, addresses)
+}
+
+// GetFlowIDTableStaking returns the FlowIDTableStaking contract like Get.
+func GetFlowIDTableStaking(addresses Addresses) ([]byte, error) {
+	return Get(FlowIDTableStakingName, addresses)
+}
+
+// GetStakingProxy returns the StakingProxy contract like Get.
+func GetStakingProxy(addresses Addresses) ([]byte, error) {
+	return Get(StakingProxyName, addresses)
+}
+
+// GetLockedTokens returns the LockedTokens contract like Get.
+func GetLockedTokens(addresses Addresses) ([]byte, error) {
+	return Get(LockedTokensName, addresses)
+}
+
+// GetFlowEpoch returns the FlowEpoch contract like Get.
+func GetFlowEpoch(addresses Addresses) ([]byte, error) {
+	return Get(FlowEpochName, addresses)
+}
+
+// GetFlowQC returns the FlowQuorumCertificate contract like Get.
+func GetFlowQC(addresses Addresses) ([]byte, error) {
+	return Get(FlowQuorumCertificateName, addresses)
+}
+
+// GetFlowDKG returns the FlowDKG contract like Get.
+func GetFlowDKG(addresses Addresses) ([]byte, error) {
+	return Get(FlowDKGName, addresses)
+}
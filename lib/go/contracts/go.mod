@@ -3,9 +3,7 @@ module github.com/onflow/flow-core-contracts/lib/go/contracts
 go 1.14
 
 require (
-	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/kr/pretty v0.1.0 // indirect
 	github.com/onflow/flow-ft/lib/go/contracts v0.5.0
-	github.com/stretchr/testify v1.6.1
-	gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15 // indirect
+	github.com/onflow/flow-go-sdk v0.17.0
+	github.com/stretchr/testify v1.7.0
 )
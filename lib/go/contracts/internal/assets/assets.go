@@ -1,12 +1,15 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
 // ../../../contracts/FlowFees.cdc (1.345kB)
-// ../../../contracts/FlowIDTableStaking.cdc (48.125kB)
+// ../../../contracts/FlowIDTableStaking.cdc (59.981kB)
 // ../../../contracts/FlowServiceAccount.cdc (5.109kB)
 // ../../../contracts/FlowStorageFees.cdc (6.303kB)
 // ../../../contracts/FlowToken.cdc (7.087kB)
 // ../../../contracts/LockedTokens.cdc (27.581kB)
 // ../../../contracts/StakingProxy.cdc (5.392kB)
+// ../../../contracts/epochs/FlowDKG.cdc (9.811kB)
+// ../../../contracts/epochs/FlowEpoch.cdc (7.850kB)
+// ../../../contracts/epochs/FlowQuorumCertificate.cdc (7.698kB)
 // ../../../contracts/testContracts/TestFlowIDTableStaking.cdc (8.104kB)
 
 package assets
@@ -97,7 +100,7 @@ func flowfeesCdc() (*asset, error) {
 	return a, nil
 }
 
-var _flowidtablestakingCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xeb\x73\x1b\x37\xf2\xe0\x77\xff\x15\x6d\x7f\x70\xa8\x44\xd6\xc3\xaf\xcb\xb2\xac\xec\x3a\x92\x9d\x53\x65\xd7\x71\x49\xce\xf9\x83\xcb\x95\x82\x66\x40\x11\xa7\xe1\x0c\x77\x80\x91\xcc\x73\xfc\xbf\x5f\xe1\xfd\x9e\x19\x52\x54\xec\x54\xfd\x54\xa9\x58\x22\x81\x46\xa3\xd1\x68\xf4\x13\xd8\xff\xfe\xde\x3d\x00\x80\xd7\x55\x73\x73\x7a\xf2\x0e\x5d\x54\xf8\x9c\xa1\x2b\x52\x5f\xca\xcf\xdf\xcd\xb1\xf8\x0e\x4e\x4f\x40\x7c\x0b\xa8\x2e\x41\x35\x81\xa2\xa9\x59\x8b\x0a\x06\x0b\x54\xa3\x4b\x4c\x45\x97\xba\x29\x31\x34\x4b\xdc\x22\xd6\xb4\xf4\x3b\xd1\xa1\xc4\x15\xbe\x54\x7f\x93\x7a\xd6\xb4\x0b\xc4\x48\x53\x8b\xf6\xfc\x7b\x31\x04\x6b\xae\x70\x4d\x81\xcd\x11\x03\xd4\x62\xa0\x0c\x5d\xe1\x12\x10\x85\x25\x6a\x19\x34\x33\x60\x1a\x9b\xb7\x6d\xc3\x9a\xa2\xa9\xf6\x24\x96\x6f\x9a\x12\x53\xa0\xdd\xc5\x82\x30\xde\x88\xb4\xb2\x33\xb0\x46\xf4\x59\x76\x17\x15\x29\x00\x95\x25\x6f\x79\x5a\xcf\x1a\x98\x75\x75\x61\x50\x28\xbb\x96\x4f\x87\x37\xa5\x6a\x6a\xa8\x13\x5f\xc3\x72\x8e\x28\xde\xd3\xc4\x20\x14\x5a\x5c\x34\x6d\x49\xd5\x30\x7c\x32\x62\x06\x45\xb3\x58\x10\xc6\x70\xa9\xa6\xb1\xc7\x49\xb7\x02\x54\xd1\x06\x6e\x48\x55\xc1\x25\x66\x80\x04\xa6\x02\xd6\x6f\x17\xff\x17\x17\x4c\x4e\x96\xf1\x96\x05\xaa\xa1\xa3\x02\x65\x81\xfb\x2e\x74\xb5\xfa\x85\xc3\xbf\x21\x6c\x5e\xb6\xe8\x06\x5a\x7c\x83\xda\x92\x2a\x94\x5e\xa1\x62\x2e\x29\x3e\x47\x14\x16\x5d\xc5\xc8\xb2\xc2\x12\x07\xb8\xe8\x8a\x2b\xcc\x14\x45\xe7\x4d\x55\x2a\xa4\x25\x86\xa2\xff\x05\xa2\xb8\x84\xa6\xb6\x54\x63\x1d\x9d\xda\xd9\xec\xaa\x55\xd0\xd8\x90\xfa\xd2\x20\x56\x4a\xcc\x24\x42\xb8\xdc\xb3\x1c\xf3\xb2\x5c\x90\x5a\x60\xc4\x49\x8a\x3a\x36\x6f\x5a\xc2\x56\x7c\x6e\x2d\x5e\x34\xd7\x58\xa2\xac\x48\xb9\x2b\xfa\xb5\x78\xd6\xd5\x25\x90\x9a\x76\xb3\x19\x29\x08\xae\x59\xb5\xd2\x3c\xc0\x9b\xd3\x5d\x58\xa2\x95\x9e\xff\xae\xe1\x1d\x01\x4f\xf1\xce\x05\x66\x37\xd8\xce\x5c\x2c\x02\xc5\x72\x01\xe6\x68\xb9\xc4\x35\x34\x75\x81\x01\x5f\xe3\x76\x05\x78\xd9\x14\x73\x8e\xf6\xf7\xfb\xf7\xee\x91\xc5\xb2\x69\x19\xbc\xee\xea\x4b\x72\x51\xe1\x77\x82\x82\xb3\xb6\x59\xc0\xc1\xa7\xd7\xbf\xbf\xf9\xe5\xf4\xe7\x7f\xbf\x7a\xf7\xdb\xaf\xaf\xde\xbc\x3c\x39\x39\x7b\x75\x7e\x6e\x3a\x54\xcd\x8d\xdf\xf8\xdf\xbf\xbd\xf7\x1a\xde\x5b\x76\x17\x76\xab\xc4\x3b\x0d\x3e\x4b\xca\xed\x7f\x9f\xfa\x49\xef\xbc\x57\xd7\xb8\x66\x14\x92\x3d\xf8\x6c\x38\x3c\x3e\x2c\xe6\xed\xe0\x0d\xbe\x79\xc5\xe7\x3a\x61\x0d\x43\xd5\xb9\xa0\xe9\x14\x7e\x7f\x4d\x3e\x3d\x7f\xba\x0b\xe2\xc3\x33\x41\xd6\xb7\x68\xd5\x74\x4c\x7f\xb5\xa3\xf0\xda\xdf\x17\x7c\xab\x06\x8d\x61\xf3\x2f\x8f\x5b\x8c\x18\x2e\x27\x7c\xa5\x4e\x4f\xa6\x70\xce\x5a\xc1\x2b\x6d\x53\xe1\x29\xfc\x7e\x5a\xb3\x1f\x77\x01\x2d\x9a\xae\x66\xc7\x9a\xb9\xec\x38\x3e\x48\x41\x4e\x6a\x9a\x45\x30\x25\x98\xfe\xde\x72\x92\x1b\x75\xfd\x5d\x73\xfa\x2d\x7a\xaf\x3d\x34\xa7\xe1\x99\xd8\x1b\xe5\xcb\xba\x3c\x13\x9b\x61\x6d\x20\x72\x11\xe9\x5b\x44\xd6\xed\xa9\xb1\x96\x73\x78\xaf\x84\x4d\xbd\xd1\xf8\xeb\xc2\x30\x4c\x76\xa2\x4f\x89\x2c\xa7\x99\x16\x39\x76\x33\x07\x0d\xff\x90\x73\xdd\x93\xc7\x21\x92\x06\xc6\x10\x9b\x25\x60\x0d\x11\x20\x80\x9d\x61\xc2\xdb\x03\xce\xb3\xe8\xb6\x60\x6f\x19\xed\x3e\xce\xbc\x0d\xdc\xb1\x7c\x7b\x7b\xdc\xb7\x32\x82\x61\xf5\x63\x73\x1c\x10\x5c\x95\x70\x3c\x47\xf5\x65\x5e\xc0\x5a\xb6\xef\xd8\x5b\xdc\x16\xb8\x66\xe8\x12\x4f\x6a\x7c\xe3\x7d\x90\x95\x2d\xf8\xe6\x3d\xc6\x57\xd5\x4a\x8a\x77\xde\x2f\x14\xf4\x51\x07\x75\xd0\xfc\x87\xd4\x64\xd1\x2d\x28\xef\xa3\x7f\x9f\xc2\x67\x21\xcf\x75\xef\x2f\xce\xbc\xfe\x77\x53\x49\xdd\x08\x48\x89\x6b\x26\x0e\x7d\x71\x78\xcd\x9a\x16\x50\x55\x89\xaf\xc4\x69\x0e\xa4\x96\x7f\x60\x76\xd3\xb4\x57\x7b\x06\xc4\x69\x5d\x54\x1d\x6f\x20\x9b\x69\x75\xb0\xfe\x8e\x01\x2a\x18\xb9\xc6\xd5\x4a\x28\x84\xa4\x20\x4b\xc4\xb8\xa6\xaa\x7b\x5e\xe1\x15\x1c\x49\xd5\xe2\xf4\xc4\x7c\x7a\x8d\xaa\x0e\xc3\x91\x18\x4c\x6a\x1c\x52\x95\x44\x4c\x34\xfd\x8e\x0a\x25\x6e\x57\xa9\x10\xbb\x81\xbe\x2a\xd5\x8c\xa2\xc0\x94\x4e\xf4\x19\xbe\x03\xd7\xa8\x95\xe8\x4d\xe1\x5f\x9f\x25\x07\x4c\x95\x08\xe7\x03\x7c\xb1\x04\xe1\xea\xd0\x42\x12\x4e\x71\x83\x18\xdd\x51\x75\xb1\x51\xe1\xd8\x6a\x29\xf5\x38\xad\x03\x1a\x28\xa4\x86\xa6\x2d\x31\x57\xdd\xe0\x02\x73\x65\x82\x92\x12\xb7\xb8\xe4\xb3\x23\x65\x1e\x49\x35\xb4\x10\x44\x67\xf8\xbf\x1d\x69\xf9\xb1\x1b\xac\x9f\x8f\xad\x50\x07\x32\xb8\x3a\x6a\x79\xb4\xa0\x06\x48\x33\x0b\xe7\xe4\xe8\xd7\x45\xd7\xb6\x9c\xc5\x84\xee\x95\xc7\x5b\x20\xe1\x0a\xd1\x9f\x57\x9c\xbc\xef\x56\x4b\x7c\x4b\xf4\x97\x88\x08\x9b\x42\xe9\x91\xae\x2a\x68\xe0\x14\x4d\x57\x95\x9c\xd0\x0b\x54\x77\xa8\xaa\x56\x50\x88\x0d\x5a\xc2\xc5\x4a\xaa\xb4\x42\xc1\x6d\x31\x6d\xba\xb6\xc0\xf9\x69\x08\xb0\x62\x1a\xfe\x86\xf3\x51\x6e\xb9\x31\xa4\xed\x9b\x1b\xb1\x53\x01\x49\xec\x52\x1c\x72\x89\x19\x4d\xf1\x3c\x57\xb5\x22\xae\x2f\x71\x41\x16\xa8\x82\xba\x5b\x5c\xe0\xd6\x28\xc8\x07\x82\xcd\x0f\x81\xd4\x25\x29\xc4\x2e\x02\x04\x4b\x23\x49\xf2\x33\x92\x64\x3b\xe3\x18\xc7\x82\xc0\x9f\x96\x05\xc7\xe7\xa6\xe9\x2d\x67\x24\x88\xee\x59\x8a\xc0\x97\x99\x0a\x25\xda\x40\xe1\xf4\xe8\x28\x6e\x9d\xe5\x53\x3b\x53\xb0\x53\x03\x84\xf5\xef\xcf\x13\xd3\x5a\x0a\xf1\xe3\xd4\x12\xbc\x45\x6c\x4e\x05\x3f\x53\xd6\x08\x46\xd5\x46\xa0\x5e\x60\x2b\x92\x2b\x2c\x55\x35\xc1\x94\xed\x39\x6b\x5a\x74\x89\x39\x00\x7e\x10\x98\x3f\x32\xcd\xdf\x0a\x33\x54\xb6\xb6\xbf\x7b\x8d\x95\xd8\x15\x06\xd4\x28\xe8\xe6\x68\xc8\xb6\x8e\x6c\x8b\xb4\x45\x71\xdc\x2c\x96\x0d\x25\x0c\x03\xdf\x64\x70\x82\x67\xa4\x26\xdc\x04\x0e\xac\x8c\x7d\xff\x10\x43\xa4\xa6\xae\x49\x2f\x57\x8a\x50\xa0\x4b\x5c\x90\x19\x29\xf8\x32\x21\xb9\xd4\xa4\x16\xd6\x8f\xc1\x5f\x93\xd7\x91\x9c\xda\x16\x72\xf9\xa8\xab\xc9\x7f\x3b\x2e\xd2\xf5\x16\xa9\xb5\x11\xad\x5b\x9d\x63\x06\x37\x73\x5c\x9b\x6f\x39\x02\x85\x54\x11\x4d\x43\x4d\x31\x52\xea\x43\x3b\x1e\x4a\xec\xaf\x66\x26\x60\x4c\xbd\x6f\x0f\xe1\x08\x8a\xa6\xaa\xb0\xf5\x1a\xe8\xaf\x1e\x8b\xaf\x6a\x8a\x6b\xda\x51\xef\x9b\x27\x70\x04\xf8\x13\x2e\xba\xa8\xcf\x53\x38\x82\x6b\xdc\x72\x02\xa1\xe8\xcb\x67\x70\xa4\x58\xda\x43\x5e\x6c\x3f\x6b\x4e\xdd\x73\xbf\x9c\x50\xac\xd9\x5e\x9e\xa7\x82\x89\xca\x16\x53\x1a\xcf\x36\xd3\xfe\x57\xbc\x1a\x68\xab\xf6\x45\xb2\xa1\x2f\x7f\x5d\xa9\xdb\xd4\x15\x97\x9a\x44\x9e\xe8\xfa\x04\xa8\x56\xe2\xa8\xd3\x9e\x84\xba\x61\x40\xc4\xc9\xcf\x99\x31\x38\x7f\x2d\x7c\x42\x95\x64\x5b\x74\x94\x01\xaa\x6e\xd0\x8a\x5b\xfd\x80\x2e\x84\x17\xc0\x39\x6c\x5b\x79\xda\x2d\xf8\x61\x23\xcf\x53\xe3\x4d\xe0\x27\x57\x51\xe0\x25\x4b\x8d\xa3\x29\xcd\x9c\xc3\x67\x0a\xff\x32\x66\xfe\xde\xff\x41\x5d\xc5\x52\xf3\xb6\x33\xb6\x93\xe5\x53\x74\x5d\x43\xca\x2f\xc5\x85\x8d\x54\x7e\x3e\x31\xed\x86\x48\x23\xe0\x18\xcb\xb7\xc1\x41\xbb\x6c\xa4\x93\x42\xf8\xc4\x5a\x7c\x4d\x9a\x8e\x3a\x47\x9f\x06\xf5\x9f\xe6\x1a\x53\xed\x3b\x63\x9e\x51\xa0\x3c\x2b\x20\xbd\x56\x80\xeb\x52\x6f\xca\xde\x59\x18\x7b\x65\x70\x16\xc9\x19\x10\x0a\x42\x5e\xb1\xc6\xfa\xc1\xf8\x7e\xe7\xc7\x88\xf4\x9e\xdd\xa0\x9a\xf5\x8e\x3d\x4c\xc0\x73\x23\xf4\xe5\x41\x65\x94\x05\x41\x08\x42\xd5\xd4\xbd\x3e\xc7\xa8\xe6\xdc\xa7\x91\xaa\x0d\x56\x19\x5c\xce\x94\xaf\x6c\x08\x97\x8a\x50\xa1\xbc\x58\xfe\x54\x2c\xa3\x29\xa2\x4f\xcd\x48\xba\xd9\x1e\x5c\x35\x95\x76\xc8\xd4\xb5\x65\x1c\xfd\xd4\x65\x1d\x52\x17\x72\xb3\x70\x12\x9c\x9e\xf0\x63\xb7\x94\xfe\xba\x4b\x42\x19\xe6\x72\xe2\x26\xb3\x5d\xac\x7c\x70\x6c\xa0\x63\xae\x7f\xe1\x56\x9b\x42\xf1\x80\x69\x0d\xcd\xe7\x5a\xbe\x87\x31\x55\x3b\x47\xb1\x70\x62\xef\xa4\x91\xd1\x14\x57\x20\xde\x35\x8a\x11\x7c\x05\x40\xa3\x74\x83\xc9\xe5\x9c\x71\xc5\xb0\xc4\x0c\xb7\x0b\x52\x3b\xda\x9e\x44\x55\xfb\xa3\x67\x4c\x72\x5d\xe8\x2b\x4e\xa3\x21\x4e\x51\x54\xbd\x17\xf0\x25\x39\xdc\xb1\xf9\xd7\x13\xf3\x97\xf8\xc4\x9c\x4f\xbb\xde\xe7\xae\x27\xcd\xfb\x22\x2b\xf1\x73\xcd\x1c\xf9\xed\x37\x89\xe5\xbb\xff\x7d\x42\x24\xb9\xee\x52\xc5\xc9\xba\xf5\x0e\x7c\xf6\x7a\x2f\x5b\x1c\x7c\x22\xa7\xbb\x57\xe1\xfa\x92\xcd\xe1\xe8\x08\x9e\x3f\x9d\xc2\x83\x37\xd2\x80\x03\xf5\xb1\x90\xf4\x17\x18\x9e\x3c\x86\x8b\x15\xc3\x14\x26\xcf\x9f\xc2\x1c\x7f\xe2\x3a\x39\xd7\xf6\x70\x4b\x77\x1e\x44\x60\x63\x37\xeb\x9e\xb0\x52\x3e\x90\xf2\x23\x1f\xa9\x26\xd5\x14\x1e\x70\x46\x3c\x3d\x81\x02\xd5\xfc\xfc\x41\x55\x8b\x51\xb9\x02\xfc\x89\x6f\x3f\x65\x9f\x4a\x93\x31\x1e\x80\x2f\x07\xfc\x74\x24\x17\x64\x72\xb8\x03\x0f\x1f\xca\xcf\x5e\xe8\xcf\x9e\xed\xa8\x11\xc4\xc7\x7a\x1a\x87\xbb\xf0\x78\x17\x9e\xec\xc2\xd3\x5d\x7e\x10\x3d\x8b\x21\x47\xeb\xa9\x09\xf4\x13\x1c\xf0\x51\xb2\xdf\xbf\x38\x82\x67\x87\x07\x6a\xd0\xa8\x95\xc1\xa0\xe2\x7f\xb0\x39\xaa\xe1\xf1\xb3\x67\x9a\xa6\xcf\x0e\x0f\x86\x89\xea\xb1\x90\xb3\x6c\x87\x8f\x7f\x8c\x46\xfd\x15\xaf\xc2\x15\xc4\x9f\x50\xc1\xcf\xfd\xe7\x4f\xf5\xa8\x87\x8f\x7f\x1c\x1e\xd5\x72\xa5\x3b\xe4\x3f\x1e\xab\x21\xed\xd7\xb9\xf1\xfe\xf1\xdc\x8c\xf7\x8f\xc7\xfd\xe3\x39\x92\x11\x94\x64\x78\x49\x29\x6e\x6d\xa4\x06\x90\x24\x27\xa6\x42\x7d\xbe\xc2\x2b\x79\x54\xb8\x0c\x44\x44\x28\x27\x82\x24\x22\x42\x1a\x39\xa9\xd5\x7a\x6d\xb8\x64\x93\x6e\x25\xad\x2a\xa7\x38\x78\x4f\x0c\x19\x6f\x24\x24\xf1\x9c\x44\x5f\x24\x79\x0a\xee\x1f\xe5\xf7\x88\x44\xe2\xe3\x3f\xf7\xa2\x6e\xbb\x49\xe8\x0b\x4c\xa9\xf0\x3f\x3d\x78\x63\x3a\x80\x1e\x88\x1f\xde\x1e\x5d\xee\xc7\x4b\xbc\xb3\xe9\x6c\xf8\xaa\xaf\x39\x93\x5f\xf1\x6a\x8d\x59\xf0\x01\xee\x60\x06\x0e\xd3\x8e\x42\xdf\xb6\x1f\xc2\x5d\xab\x31\x9b\x21\x1e\xf0\x3f\xc5\xd5\x6c\x8f\x94\x70\x04\xa4\x8c\xbf\x10\x72\xed\xc8\xfa\x1d\xbc\x2f\x63\x8e\x3b\x8a\xb9\xb0\xaf\xdb\xaf\xd2\xb3\xe1\xfe\x1d\x37\x77\xe8\x78\xe4\x10\x35\x31\x09\xf7\x18\x86\x23\x38\x88\x9b\x38\xca\xd6\x8b\x47\xf0\xf9\x4b\x4f\x0b\xa3\xdf\x08\x48\x71\xc3\xe0\xa0\xe4\xf0\xc2\x8f\x10\xbd\x1f\x2b\x81\x19\x40\xd2\x0a\xe1\x50\x6c\x07\x69\xde\xbe\x5a\x2c\xd9\x4a\xf4\x9d\xec\xac\x05\xd2\x68\xe4\x77\x00\x75\xcb\xa8\x6a\xa5\x79\xcb\x40\x43\xbd\x90\x2f\xe5\x5e\xb0\x98\x78\x41\xb2\x11\x4e\xb5\x37\x74\x88\xd3\xec\x88\x44\x98\x33\xc5\x13\x7b\x17\xa8\x42\x75\x81\xed\xfe\x73\xf6\x5e\x89\x29\x6b\x9b\xd5\x24\x54\xa1\xb8\x86\x3f\xd3\x13\x3c\xc3\x33\x48\xca\x0e\x54\x14\x1c\x81\xbd\x8b\xa6\x6d\x9b\x9b\x17\x0f\x03\x92\xfc\x34\xe1\x46\xe0\x14\xf6\xa9\xf4\x12\xed\x1b\x88\xe2\xeb\x9d\xfb\xbe\x3a\x3a\x8b\x39\x51\xe3\xce\x35\x92\xbd\x83\xc4\x59\x94\x40\xaa\xc7\xa3\xfb\xc1\xd0\xee\x63\x7a\x42\xe3\xfa\xde\x87\x47\x79\x54\x23\x14\x5d\x32\xee\x95\x58\xf8\xbe\x14\x61\x5e\x3c\x8a\xc0\x04\x52\x12\x70\x45\x31\x7c\xd6\xeb\x94\xd8\xab\x5f\x7a\x88\x18\xf1\x40\x96\x8e\x63\x91\x34\x10\x07\xf0\x4c\x4b\xa7\x3e\x5c\x8d\x9c\xd8\x1a\xae\x06\xe2\x1a\xb8\x5a\x69\x35\x8c\xeb\x16\xc9\xaa\x01\xae\x8d\xe9\x00\x51\xb5\x44\xdb\x1a\xa2\x1a\xe0\x1a\x88\x1a\xa9\x1a\xa9\xbc\x70\x86\x59\xd7\xd6\x22\xba\xa3\x9c\x3b\x6e\xfe\xd6\xac\xab\x4b\x1a\xe9\xad\xa6\x05\xd7\x34\x82\xe3\x34\xa7\xb1\x2a\x7f\x85\xf2\xfc\x1e\xc9\x6e\x52\x68\x05\x0e\x8b\x89\x01\x16\xeb\x59\x64\x66\x81\xac\xbd\xc1\x06\x89\x9c\x07\xad\x9d\x3e\x13\x1d\xe4\x1d\xc4\x62\x27\x46\xfe\xcb\x98\xe9\x8c\x14\xba\x6b\xcf\x45\xc1\x1d\x9c\x88\x3f\xfe\xa6\xb3\x18\xbd\x3b\xd7\x9e\x87\x81\x3c\x38\x93\x10\x87\x4d\xe7\x32\x7a\x03\xaf\x3d\x17\x03\x79\x70\x2e\x21\x0e\xb7\x5b\x97\x31\x12\x7e\xc3\x85\xe1\xa0\x47\xae\x8c\x83\xc5\xe0\x74\x02\xb9\xe5\x1d\xc5\x09\x47\x65\xe0\xf3\xfc\x9d\x91\x8a\xb0\x15\xbc\x56\x79\xa0\xd2\xbe\x2f\xe6\xb8\xb8\xa2\x2a\x5e\xf5\x1d\x85\xe6\x1a\xb7\x5c\x0c\x5a\x17\xbe\xa6\x91\xf0\xa3\x13\x46\x41\xca\x2b\x5c\x2a\x47\x91\x19\x24\x8a\x49\xce\xba\x5a\x80\x7d\xdd\x55\x95\x11\x0d\x3f\x4b\x70\x93\x1d\xed\x95\x0c\xc8\x4e\x66\x30\xe9\xd5\x1c\x7e\xc8\xab\x3c\x3b\xf0\x62\x40\xe3\x8d\x97\xb8\x95\xa2\x9f\x2b\xc2\x1e\xad\xd5\x49\x92\x6b\xbf\x29\x8a\xbe\xc6\x16\x63\x78\x2f\xbd\xfc\xc1\x5a\xca\x25\x00\x04\x2d\x9e\xe1\x16\x73\xb8\xac\xe1\xff\x35\x35\x8e\x4f\x30\x99\xb9\x60\x22\x92\xd6\xc7\xd7\xbf\x74\xe9\x73\xe9\x8f\x64\xc6\xd9\x14\x1e\x06\x0d\x47\xb9\x42\x03\xd6\xfd\xe0\x80\xfe\x08\xf7\xa5\xcf\x32\xb9\x29\x1f\x9c\xcb\x60\x2b\x76\x32\x57\xe0\xf4\x04\xca\x46\xa4\xd1\xb0\x61\x8f\xa6\xbf\xb5\xd4\xaa\x3e\xec\x45\x88\x5b\x5b\xe1\x34\xef\xf9\xf0\x4c\x42\x00\x9c\xb3\xb6\x2b\x44\x20\x4e\xda\x6d\xfc\xb7\x4b\xcc\xa0\xc5\xa8\x7c\x24\x02\x84\x32\x93\xfa\xa2\xe9\x98\x5a\x1c\xd1\x75\xd9\x5d\x00\x95\x7d\x4d\xea\xf6\xe7\x28\xe8\xe1\x84\x74\xc3\xaf\xdc\x80\x69\xf8\x5d\x3e\x54\x9a\x6f\xe9\xc6\x3e\xc3\x56\x89\xf0\x68\xd8\xc4\x8f\x2a\xaa\x40\x44\xdc\x28\x30\x77\x7a\x5a\x06\x0e\xf9\xde\x76\x4e\x14\x6e\x44\xbb\x41\x70\x36\x98\x95\x0a\xa8\x44\x21\x2c\x38\x3d\xd9\x28\x8a\xf5\x41\x6e\xaa\x8f\xf9\x46\x71\xb4\x29\x87\x70\x36\x16\x14\x71\xd4\x88\xa8\x8d\x9f\x01\x98\x32\xd5\x6b\x9b\xd7\x90\xb4\x6b\xa5\x4c\xb1\xd9\x0f\x0a\xe4\x4e\xce\x09\x67\xe1\xed\xf5\xf8\xe3\x9c\x56\x6b\xb8\xe6\x6c\xa7\x4d\xbc\x74\xa9\xde\xc3\x0e\x3b\xa7\x5b\x9f\xef\xce\x33\xac\xbd\x5e\x83\x96\xbe\xea\x1f\xec\xa7\xf4\x6a\x5c\x62\x66\x72\x75\xf4\xc1\xfc\x9e\xb0\xb9\x91\x71\xd4\xac\x4f\x06\x45\x6b\x52\x27\xb0\x8c\x0e\xc6\x61\xd7\x5c\x02\x4a\xa4\x27\x0d\xba\xe2\xb2\x40\x86\x31\x31\x36\x62\x02\x46\xa8\x7e\xf6\xfa\x53\xbd\xfe\x81\x61\x38\xd2\xcd\x9a\x02\x60\xbe\x5f\xcb\xcb\x97\x98\x4a\xaf\xde\x91\xf4\x1f\xbb\x5b\xd1\xfd\x2a\x77\x02\x72\xab\xda\x14\x0e\x99\xc8\xb1\x3c\xf4\x28\x6d\x0a\x82\x38\xdb\x70\x35\x19\x90\x95\x6c\xa6\xf3\x39\x6b\x5a\x5c\x9a\x9c\x59\xa9\x9d\x5a\xc1\x61\xce\x4a\x93\x52\x95\xd7\x3f\xbc\x34\x0b\x42\x1d\x01\xed\x27\xab\xac\x17\x67\x1f\x9f\xa7\x92\x1d\xda\x49\x2c\x4d\x67\x8a\x66\x86\x1e\x99\xa3\x93\x1d\x37\x99\x65\x80\xea\x12\x08\x85\xaa\x29\x36\xc2\x69\xfd\x84\x97\x08\xad\x0b\x8c\x6b\x53\x5c\x25\x6b\xcc\x50\x6d\x52\x4d\x06\xcf\xe4\x35\xf3\x6c\xec\xd8\x26\x55\x68\xe4\x90\x63\xf3\x6b\xfc\x21\xf1\x88\x45\xe8\x23\xf0\x98\xbc\x0e\x71\x4a\x87\xe7\x72\x2e\x3e\xb3\xb5\xc8\xc2\xdf\x26\x58\x73\x27\x71\x95\x3b\x8a\x00\x65\x82\x35\x56\xd8\x86\xa1\x13\x88\x16\x3e\xe1\xab\x37\x0c\x30\xd4\x50\xae\xe9\x50\x2b\xb3\x4c\x43\x0d\x35\xe5\xc7\x01\xc4\xe5\x86\x4e\x0c\xb3\xc3\xee\xc0\x93\x61\x60\xff\x8f\x3b\x63\x0b\xee\x0c\xf0\x55\x05\x6d\x2d\x8b\xd5\x94\x29\x86\x12\x33\x95\x43\x3a\x6f\x6e\x4c\xed\x85\x67\x37\xfb\x8a\x83\x63\x3c\x1b\x75\xa0\xc7\x82\xce\xd8\x4e\xbe\x99\x73\x4b\x13\x74\x94\x3d\x3b\xd6\x4e\x8d\x0c\xd0\x3e\x70\x23\xcc\xd9\x91\x27\xca\x98\x9a\xc3\x3b\x33\x06\x3d\xc3\xd7\x80\x73\xb4\xd1\x81\xf8\x49\x8f\x75\xe9\xb4\x49\x58\x7c\x32\x2f\xe9\x48\xb1\xc3\x18\x0b\x28\x40\x73\x5d\x33\xc8\x98\x6a\x69\x38\xc3\x46\x5f\x68\x4b\xa5\xe1\x6c\x62\x50\xf5\x41\x5a\xcb\xaa\x4a\x03\x1a\x36\xad\x7a\x8e\xc5\x1c\xc8\xac\x2c\xf2\xa4\x8f\x67\x43\x10\x6e\x5a\xcd\x90\x2a\xd0\x70\x6b\x58\x86\x7d\x70\x9e\xe1\xa3\xe0\x19\xd5\xcf\x2f\xfc\x11\x07\x4b\x53\xe9\x12\x1c\x7b\x82\x46\x35\x22\x12\x85\x69\x0a\x1d\xff\x5c\x34\x15\x23\xc6\x88\xe9\x75\x37\xa5\x4a\x42\xc4\x56\xb7\x5f\x24\xd5\xc8\x30\x33\x2a\x38\x9e\x5f\x96\xa5\x48\x9b\xd6\x09\xce\x32\xa1\x9e\xae\x28\xc3\x0b\x5b\x0b\xe0\x14\xc7\xa5\x4d\x2d\x71\xde\x8a\xb6\x6f\xb0\xd4\x96\xe8\xe4\x0f\x05\x34\x9d\x82\xbb\x15\xf1\xa3\x66\xb8\x93\xc8\x88\xc9\x55\x4e\x7b\xfe\x29\x5b\xfd\xaa\x6e\xc4\x30\x09\x2f\x1e\x40\x4d\x28\x39\x7f\x8f\x58\xf2\xb7\x22\xa9\xa4\xf5\xb8\x57\xc2\xa8\x94\xfc\x3e\x99\x67\xa3\xf3\xfe\x71\x54\x26\xa8\x2c\xed\x64\xf5\x43\x7a\x71\xfc\x52\xe4\x49\x50\xfa\x7b\x77\x2b\x22\xab\xf2\x16\x88\xd4\xa4\xbe\x7c\x29\x53\xd5\x8f\x14\xed\xef\x85\xda\x57\xd8\xf0\xc5\x28\x77\x48\x42\x53\x1a\xd3\x6b\x1c\xec\x47\x21\x52\x09\xad\x2c\x9c\x5d\x46\xa1\x4b\x4c\xf0\xa7\x0d\xe7\x17\x8f\x19\x7e\xf2\x68\x6d\x47\xd2\x78\xba\x45\xf3\x1b\x5c\x48\x1d\xb3\x8d\x06\x74\xf7\x97\xbc\x7c\xa5\x1c\xbb\xc7\x92\xf8\xe6\xf7\x59\xde\xcd\x18\x45\x7f\x03\xec\x77\x76\x62\xbc\x37\x11\x34\x21\xd8\xac\xbe\xbd\xae\x00\x30\x47\x76\x9f\x00\xd0\x8d\xb2\x02\x20\x5c\x43\xe4\x2f\x5d\x48\x81\x2d\xca\x88\xaf\xcc\x08\xf9\xa4\x06\xb4\xd5\xf5\x47\xeb\x2c\xbb\xda\x78\x7a\x15\x2c\x29\x2e\x2c\x7d\x84\x6d\x6c\xca\x7c\xa2\x9a\xb7\x9e\xf3\x5a\xf9\xb3\xec\x45\x1d\x7f\xd9\x79\x90\xcd\x3b\x1f\x88\x9b\xc0\x0f\xeb\xac\x79\x2e\xad\xf2\x27\x7d\xf4\xc0\x0f\x63\x64\x5d\x9c\xcd\xee\x64\xe1\x37\x0c\x70\xdd\x74\x97\x73\x67\x71\x94\x6b\x30\x48\x64\xdf\x80\x04\x4e\x14\xc2\x56\x95\x1c\xc0\x9f\x7f\x46\x9d\x12\x4b\x41\xe8\x2f\xc2\xa3\xd5\xbe\x9b\xa3\x5a\xdd\x8b\xf1\xba\x69\xcf\x9a\x0a\x4f\xea\x6e\xf1\x4e\xe9\x66\xf9\x20\x53\xe8\x31\x79\x4f\xd8\xbc\xe9\x58\x10\x6a\x32\x2c\xbe\x03\x8f\x14\x59\x75\xba\x71\x10\xe4\xdb\xe9\x23\xe4\xb1\x2c\x6f\xd2\xae\xed\x0b\x2c\x6e\x5b\x73\x6a\x65\x89\x60\xe7\x16\xbb\x15\xf6\x4d\x4b\x7b\x69\xcc\xb7\xd0\x2f\x58\x6e\x09\xcd\x41\x6a\x5b\x84\xa2\xd4\x56\xfc\xa6\xc5\x0b\x67\xfe\x20\x67\x7a\x5c\x00\x2d\x42\xe8\x74\xa6\xc4\x9b\xdc\xda\x05\xaa\xf9\x90\xca\xc7\xe5\x5c\x6a\x66\xaa\x48\xfd\x2f\x1c\x06\x2b\xd3\xc2\xda\x22\x68\x19\x3d\x73\xe8\x9a\x31\x1c\x8c\xac\xc8\x0d\x06\xa6\x44\x64\xad\xcc\xf1\x0a\xe6\xe8\x5a\x56\x13\x89\x00\x80\x52\x3c\x57\x38\x56\x8b\xf2\xe7\xed\xa0\x40\xee\xc9\x62\x4c\x4b\xe4\xac\xc7\x6c\x8b\x6c\xb0\x3d\x56\xc8\x2d\x59\x4f\x5e\xdb\x5d\x12\xd3\x66\x67\xa7\x72\xda\x92\x24\xed\x96\x25\x62\x96\x91\xb5\xd3\x4f\xd0\xb2\xc2\x33\xd6\x5c\xe3\x56\xf3\x1f\x09\xa2\x27\xa2\xb8\x2c\xc1\xc4\xc9\x69\x6e\xac\xb6\xff\x00\x6a\x96\x46\x34\xe5\x92\xd0\x07\x0e\x60\xea\x85\xdd\x6c\xce\xb1\x7f\x8f\x24\xb8\x41\xa9\xe0\xae\xc5\x5d\x0f\x2c\xa2\x70\x83\xab\x8a\xff\xab\x7d\xa4\x3d\x7d\xf9\x68\x76\x50\xd2\xe6\xae\x04\xe0\x67\xba\x42\xf2\x65\x55\x25\x0b\x33\xb6\x74\x7e\x8b\xfb\x7e\xee\x4e\x8e\x0d\xef\xa5\x7e\x5b\xe2\x6f\x2a\xd6\x86\xa7\xbd\xd3\x5b\x09\x77\x57\x9b\x72\xc3\x0d\x99\xf1\x7e\x06\x3b\xec\xbd\x59\x2b\x67\x75\xc4\xa5\x3a\x03\x0e\x0d\x4d\xbe\x7e\x9f\x46\xda\xf9\x14\x32\xce\xb6\x9d\x4f\x43\x57\xbe\x0d\xd8\x06\x1e\x40\x15\xef\x79\x91\xb0\xe5\xf3\xc6\x6b\x68\x65\x8c\x25\x7a\x3b\x60\x44\xea\x81\xfa\xed\xc8\xaf\x43\xf4\xfe\x3b\xf0\xb6\x44\xf2\x41\x33\x71\x6d\x9f\xb5\xd1\xa7\x7b\xdd\xd6\xe3\x03\x5f\x29\xaf\x76\xe3\xdf\xe4\xeb\xe4\x35\x50\xd6\xb4\xe6\xa2\x3b\x22\x2e\x9d\x51\xa6\x26\x2c\x71\x3b\x6b\x5a\xc7\xb6\x14\xf1\x5b\x9a\x76\x7b\x9b\x59\x4c\x33\x93\xf2\xf8\x4f\x5c\x09\x6c\x91\x70\xb2\x9d\x8d\xc7\x7d\xce\xcf\x46\x7b\xaf\x52\x1f\x51\x3c\xd0\xea\xba\x84\xd4\x01\x9d\x4c\x25\x51\xbf\x89\xcb\x64\x86\xc8\x1b\x3b\xde\xf5\x25\x8a\xfd\x79\x96\xfd\x25\xca\xb9\xb0\x55\xb0\x67\x15\x49\x23\x0f\x74\x6f\xe4\xc0\x09\x83\x3b\x9e\x79\x79\x38\xdd\xad\x57\x3e\x95\x86\x18\x16\x53\x0d\x86\x04\x7b\x76\xfb\xe0\x15\xa9\x0e\x16\x41\xfc\xd3\x54\xc5\xea\xad\xcb\xe9\x91\x71\xfd\xe7\x0b\xa6\xc2\x93\x9e\xff\x9b\x15\xb8\x66\xf1\x06\x4e\xb9\xf5\x56\xf4\x2f\x72\xe7\xdf\xe1\x52\xde\x36\x30\x10\x17\x1c\x8d\xf0\x9b\x8f\xe8\x34\x0a\xf2\x5d\x47\x05\x36\x9a\xdc\x98\xa0\xc0\x30\xe0\xcd\x68\xf6\xed\x84\x04\xc6\x6f\xdb\xf1\x85\x7a\x63\x03\x02\xdb\x94\x4c\x6b\x79\x8b\xb3\x42\x46\x5f\x17\xb6\x89\x8c\xf9\x76\x22\x06\x29\x31\x04\xdb\x13\x45\xb7\x63\x9b\x8d\xc3\x07\x5f\x8d\x5b\xce\xac\x89\xa8\x3d\x1b\x7a\xcd\xcd\x56\x1b\x17\xf7\xff\xcb\xe2\x08\x77\x78\x10\x65\xdd\xf1\x03\x85\xce\x71\x40\x62\xb0\xc4\xba\x37\x1e\x31\x2c\x67\xef\x26\x1c\x71\xc7\x1e\x9d\x11\xd5\xef\x5f\xdd\x57\x9d\x3d\x09\x6e\x51\x6f\xff\x2d\x7a\xaa\x87\x19\xf4\xb6\x8e\xea\xbb\xa3\xe4\x37\xe4\xa6\xde\x96\x16\x79\x7b\x27\xf5\xd7\x72\xa1\x85\xac\xf6\xcd\x09\x75\xff\x80\x5d\xd3\x29\x37\xee\xa8\x8d\x84\xa8\x7a\x3c\xc8\xd9\xb2\x83\xcb\x01\x9e\xcb\x69\xbc\x42\xfa\x8d\x3a\xf9\xfe\x66\x6c\xb1\x96\xdb\x70\xfb\x4c\x91\x5b\x8c\x7e\xa6\xd8\xd0\x0d\x29\xad\x2a\xf7\x5d\x00\xf5\xf0\x96\x7e\x06\xeb\x42\x16\x6b\xd8\x1a\xf8\x1a\xdf\xc8\x33\xb3\x55\x4e\x44\xba\xab\x67\xd3\xf3\x0c\x96\x19\x2c\xce\x47\x09\x2e\xa4\x95\x6f\x59\x38\x8f\x66\xf1\xb1\x05\x8c\xa0\x2f\xaa\x1d\xed\xd3\x73\x3f\xca\x09\x7d\x0e\xb5\x5b\x81\xa3\xf2\x2a\x3a\xd4\xf6\xca\x46\xac\x06\xcb\x5b\x73\x16\x9c\xfc\x11\xfa\xf2\xa6\xf0\x2f\xef\xee\x77\x7f\x6d\xf5\x40\xc6\xa0\x32\x43\x89\x37\x45\x92\x9b\x41\xd7\x1d\x25\x6f\xce\x94\xa8\x4c\xae\xf0\x6a\x0a\x39\x8b\xe7\x9f\xff\x84\x25\xaa\x49\x31\x79\x70\x2c\x5e\x81\xe0\xda\xcd\x8c\xd4\xa5\x9e\xae\x28\xd1\x14\xc4\x36\x77\x2e\x9c\x9e\x3c\xc8\x39\xb9\x6b\xf7\xaa\xfa\x40\x86\x9c\x32\x6e\x1e\x8a\x27\x4e\x5a\xa1\x56\xea\x70\xa5\xbe\x73\x59\xaf\xb8\x2c\x46\x9c\x01\x91\x98\xf8\x77\xa2\x2b\xb4\x0c\xab\xb9\x9c\x13\xd9\xf2\x7d\x45\x9d\x42\x4b\x65\xe2\x39\x35\xfd\xba\x5d\x14\x2c\x95\xd6\x2d\x69\xb3\xe2\x76\x7f\x7f\xdf\x03\xf8\x16\xb5\x68\x81\x45\x69\x3e\x5a\x2e\xdb\xe6\x1a\xcb\x27\xf8\x4e\xe8\x14\x5e\x9a\x3b\x02\xe4\x5a\xe8\x87\xea\xb8\x4e\x29\xf4\x49\xdd\xc3\x83\xa8\xee\x64\x5e\xaa\x17\x00\x95\x26\x81\xf4\x46\x4a\xdc\xac\x2e\xdc\xd6\x82\x48\xb4\x99\xb1\x1b\xd4\xfa\x8f\x07\xa8\x72\x29\xe2\x38\xb5\x65\xe1\x4d\xd3\xb6\x98\x2e\x9b\x5a\xee\x1b\xf1\x08\xc7\xb2\x6d\x96\x0d\x15\x4b\x2e\xf4\x99\xae\xae\xf9\x7e\xe3\x1f\xe3\xb6\x5a\xf9\x0b\x53\x97\xea\x31\x04\xa6\xaf\x31\xaf\x67\x8d\x78\x99\x80\x43\x2e\xe2\xb3\x01\xd7\xa5\x7e\xff\x41\x6e\xe0\x49\x44\x33\xf3\xd8\xcc\xcf\x4d\x53\x7d\x89\x5c\x04\x42\x29\xad\x2a\xd5\xbc\xb7\xcc\xfd\xf4\x84\x4e\xf2\xd2\xb4\xb6\x6f\xef\x88\xf5\xf0\xb8\x2a\xf6\x62\xf9\x37\xd7\x3a\x18\x6c\xc9\x47\x91\x2c\x85\xd1\xd0\x9c\xf2\x7e\x57\x15\xbf\x6d\xf2\x55\x72\x3c\x27\xc9\xc8\x35\x1a\xac\x31\x34\x6a\xab\xb9\xd0\xca\x46\x88\x98\x05\x56\xa6\x49\xe2\xfd\x02\x9a\xb2\x19\xee\x6f\x9e\x96\x96\x22\x58\x2e\xaf\x2c\x95\x10\x07\x00\x21\x67\xea\x9b\x6a\xd5\xd5\xda\x11\x63\xea\x1f\x79\xa1\x66\xff\x5b\x77\x99\x68\xe2\x88\xc4\x85\x44\xca\x61\x50\x43\x98\xc6\x6a\xbd\xb4\x88\x14\x04\xbd\x9a\xfa\xbc\x4a\x09\xcf\x0b\x54\x5c\x25\x24\xa8\xfc\x36\x0b\xf5\x1b\x49\x87\xd0\x3f\x09\x43\x10\x9c\x57\x58\xe4\xdc\xda\xd8\xfd\x85\xff\xdb\x89\x67\x41\xf4\x21\xa7\x9f\x68\xed\x9d\x3f\x87\xea\x78\x1b\xb8\x69\x3e\x6b\xda\x22\x5d\x24\xde\x4b\xb4\x8d\xb2\x2a\x32\x38\xe9\x83\xdb\x3b\xb7\x9d\xcb\x2e\xec\xa3\xa8\x1c\xed\x85\x9e\xed\xdc\x3d\x78\x02\x88\xcd\xcc\xf3\x25\x6b\xd1\x61\x44\x8c\x96\x2e\xde\x15\x09\xea\x16\x9d\x24\xcc\xe8\x4a\xc6\x74\xe6\x6b\xe6\x62\x46\xfd\xb3\xb6\x4d\xe0\x5c\xd3\x98\x05\x7a\xeb\xfb\x1a\xf5\x4f\xca\xc5\x1b\x3d\x5e\xd8\x67\x63\x98\x3f\xac\x90\x19\xbe\xc3\x31\x3f\x31\xb8\x33\x19\x00\x77\xe4\xf7\xd9\xe4\xc6\x4a\xfd\x93\x11\x04\x29\x54\x47\x79\x6f\x06\xae\xeb\xed\x19\xb2\xce\x5c\xc5\x12\xdf\xcd\x0d\x7d\xfe\x41\x70\xc4\x98\x82\xa3\x5f\x05\x51\x39\x82\xe2\x06\x10\xb3\xa2\xd6\x18\x4b\x41\x79\xf7\xdb\xc9\x6f\x53\x78\x4d\x2e\xbb\x16\x43\xd3\x31\x10\x7e\xb1\x06\x0a\x54\x15\x5d\x25\xa5\x07\x0e\xc7\xe1\xbb\xd6\x3c\xf6\xb6\xee\x5c\x0f\x0f\x0e\x12\x6e\xbb\xf4\x5f\x81\x25\x72\x8c\xaa\x0a\x97\xb9\x47\x7d\x44\xbe\x4a\x6c\x44\xda\xdc\x4c\x5f\x3f\x77\x5e\x94\xce\xe8\x48\x81\xd3\x4f\xab\xbf\x4b\xb4\x52\xcf\x84\x26\xd3\x2b\x37\xd7\x6b\xbd\x5b\xb3\xff\x43\xd4\x85\x42\x6b\x5d\x9c\x2d\x7b\xe5\x6f\xce\x96\xdf\x8f\xb3\x26\xd5\x75\x85\x0b\x89\x88\xb9\xb3\x30\xb4\x21\xb9\x69\xe2\x31\x8b\x7c\x59\x4b\x3d\xe4\x67\xdf\xcd\x49\xb8\x50\xcd\x0b\x8a\x03\xd7\x5d\xbd\xb3\x6d\x42\x9a\x71\x73\xc8\x85\x70\x94\x11\xc8\xd2\xd2\xcd\xb0\x99\x87\x8b\x5c\xdb\xf3\x02\x89\x4b\xca\x12\x08\x85\x8f\x25\xc2\xbe\x8b\x42\x22\xa4\x92\x38\x86\xfd\xd7\x28\xf5\xcf\x57\x33\x4f\x52\x8a\x65\x10\xe4\x52\x94\xfd\xf3\xcf\x50\xf5\xe6\xdf\xe8\x87\x64\xe0\xb3\xa8\x92\x26\x75\x87\x53\x52\x50\x5c\x74\x28\xc8\x6b\x12\x13\x86\x86\xfd\x3e\x5a\x94\x24\xf6\x3e\x54\xc5\x04\xbd\xb8\x88\x07\xc4\x48\xcd\xbc\xfd\xdf\x38\x0a\x51\x1c\x16\x77\xa7\x21\x7a\x48\xac\xe0\xc5\xa3\x70\xdb\xee\xf1\x4d\x13\xb8\x4c\x5d\x14\x93\x16\xdb\x36\xf5\xb5\x6d\xeb\x6a\xb7\xd7\xd3\xb6\xae\xa3\x0d\x5f\x40\x3d\x8a\x0f\xc0\x9e\x2d\x8e\x0c\xeb\x79\xf5\xcb\x92\xa1\xc5\x05\x26\xd7\x99\xc3\x35\xb8\x00\xc3\x63\xfa\x01\xbc\xc7\xf0\xbc\xa5\x40\x6a\x84\xb1\x13\x4f\x20\x39\x92\x9b\x93\x03\x67\x56\x6a\x7f\x1f\x64\xe9\x6a\x98\x70\xf2\x1d\x85\xa2\x8b\x83\xb0\x6a\x6e\x93\x60\x0c\x87\x3e\x19\xcf\x69\xe2\x19\xd5\x9d\x7c\x7a\x91\xfe\x71\xb6\x72\x4a\x49\xf5\x50\x48\xa9\xa6\xb7\xc7\x71\x2d\x13\x36\x5e\xf5\xd1\xd6\x48\x2a\xe8\xe1\xbf\x75\xbe\xbe\x19\x92\x42\xa4\xc7\xf8\xc8\x06\x31\x06\x28\x9f\x21\x51\x9f\x92\x0c\xce\x65\x55\x01\xb4\x0c\xbd\x47\xac\x00\x99\x79\xfc\x32\x86\xf2\x4e\x62\x7a\x2f\xb1\xbd\x6b\x28\x46\x11\x54\x26\x7c\x09\xba\xd9\xcd\xa5\x46\x02\x52\x1b\xfb\xad\xbf\x38\xdc\xfd\xc9\xe7\xba\x27\x6f\xab\xc8\xad\x4d\xef\xba\xe8\x35\x71\x20\xdc\x9d\x41\x20\x8d\x5c\x65\xd4\xaa\x47\xa5\xe5\xfc\x7d\x73\x40\xdf\xe9\x82\xc3\xa7\x5c\x1d\xf1\x6f\x3d\xfe\xd6\xba\x42\x2d\x06\x9d\x99\xe8\xd6\x70\x25\x1e\xe1\x74\x41\xdd\xe0\x56\xc7\x82\xc5\x3b\xb2\x36\xc7\xaa\x42\x54\xb9\x61\xa5\x1f\xa9\xab\xaa\x55\x9c\x80\x20\x6f\x8f\xa1\xe6\x29\x50\x55\xa1\x26\x7a\x10\x41\x93\x8b\x15\xc7\x4b\x42\x6d\xa8\x1f\x73\x34\xd6\xbc\x45\x21\xb2\x72\xf8\xa4\x94\xcc\xdf\xb2\xfb\xfe\xab\x69\xb9\x9c\x66\x32\x11\xa4\xd7\x44\xe1\xa4\xf3\x74\x74\xf3\x52\x3c\x5b\x2d\x63\xd5\x68\xcd\x77\x80\x02\xed\x79\x83\x97\x84\x42\x08\xf7\x53\xfe\xe4\x71\x09\x3d\xb7\xf4\x26\x3b\x77\x1f\x9c\xf7\xba\xb3\xd6\x70\xe7\x8e\x13\x4b\xe7\x5f\xc9\xb5\x9c\x7c\xa9\x22\xe3\xfa\x1e\xfb\x54\x85\x43\xc5\x21\xb7\x60\x16\xf7\xf8\x59\x8a\x71\x74\x1c\xef\xa4\xef\x79\x23\x63\x04\x36\x9b\x52\x32\xe1\x92\x5b\x8b\x94\xa4\xbe\x5c\x9b\x96\xf1\x98\x6b\x11\x93\x4f\x7b\x90\x9a\xb9\x27\x6d\xc6\x20\x33\x32\xeb\x4d\x1e\x7d\x5a\x90\xb9\x2f\x23\x0d\x1c\x87\xfa\xe7\x1b\xb4\xf9\xbe\x09\x59\xbb\x41\xee\xa4\x5e\x11\xd4\x5e\xe5\x3c\xee\x28\xe9\x21\xd3\x3f\x5b\x09\x46\xa4\x02\x11\xfd\x72\x7b\x0b\x61\x88\x1c\x32\x19\x1b\xf8\x2b\x44\x09\x7a\xed\xac\x5b\xc8\xf5\x1c\xc9\xb7\x1d\xfb\x19\x29\xfb\x53\x44\x1f\x1d\x9c\xd9\xf2\x0b\x49\xeb\x91\x7d\xcd\x43\xa0\x9f\xee\x3d\x27\xc2\xba\x84\x1f\x7b\x50\xe4\x29\x9f\x3a\x2b\x36\x7d\xfd\x6c\xd4\x49\xd1\x43\xf8\xfd\x7d\xa0\xdd\x85\xb8\x3a\xd9\x0f\x8f\x07\x09\xf6\xd6\xc5\xef\x79\x0e\x79\x07\x99\x94\x94\x52\x92\xe1\xdb\x10\xde\xa3\xca\xde\x32\x46\xeb\x06\xb5\x6f\x19\x6a\xdf\x29\xa5\xbf\x09\x2a\x8f\x50\x63\x92\x64\x39\xc3\x14\x7b\x1e\x79\x4b\x98\x19\xc1\x55\x09\xb4\x01\x62\xee\x9a\xee\xe8\xb8\xf4\xa5\x71\x59\x16\x03\x85\x8b\xfa\x89\xdd\x57\x7c\x88\x89\x13\xf0\xc9\x5d\x9f\xe5\x05\xad\x76\x5d\x97\xae\x0c\x1b\x25\xfb\x85\xb1\xa5\x64\x62\xb7\xb9\xcd\x0f\x33\x95\x3c\x75\x2e\xef\xf5\xb3\x99\x58\x93\x3f\xa0\xc6\x37\xee\x27\x53\xf8\x2c\xa2\x34\x3a\x77\xfb\x4b\x18\xbb\x4c\xbf\xf5\x15\x40\x11\xda\x9e\x73\x11\xd9\xb3\x29\x3c\x38\xad\x55\xa2\xa0\x63\x50\x0b\xbb\xb9\xef\xe9\xae\xc4\xdc\x17\xf1\x5c\x84\xd2\xe8\x63\x90\x5e\x15\x05\x43\x91\x83\x4e\x6a\x7c\xa3\x7f\x9f\x86\x10\x32\xc9\xf2\x70\x3c\x47\xf5\xa5\xcc\x29\x55\xdb\xee\x06\xe3\xab\x6a\x05\x4b\x19\xe6\x63\x0d\x20\x91\x0b\x7d\x8d\xaa\x0e\xa7\x56\xe3\x55\xb0\x7a\x72\x15\xf4\x72\xa7\x2b\xe1\xc6\x04\x18\x8f\x2c\x98\xf4\xf4\xdf\x0b\x44\xd5\xa0\xce\x90\xe6\xd7\x6c\x81\x80\x4c\x9c\x2e\x50\x55\xa9\xc7\x3d\x58\x03\x85\x20\x84\x4c\x5f\xc5\x6d\x81\x6b\x86\x2e\xfd\x5c\x54\xef\xbd\x2c\x1d\x61\xc2\xd7\xb8\x5d\x85\xb7\x2d\xa0\x2b\x1c\x5f\x50\x44\x31\x3b\xee\xd8\x5b\x03\x5b\xd2\xc9\xfb\x28\x47\xae\x2c\x97\x7a\xbd\x95\x7e\xf0\xf0\x61\xfc\xcd\x0b\x38\xdc\x3b\xc8\x3c\x4f\x77\xdc\x31\x67\xc6\xb0\xe8\x28\xe3\x72\x46\x1b\x4a\x07\x22\x04\x77\x78\x3f\xe4\xeb\xa1\xf5\xcc\xb8\xfd\xe5\xb2\x7a\xd8\xa5\x57\xd7\xe8\x31\x3e\xd1\x62\x92\xad\x11\x72\x70\xf8\x01\x82\xda\x80\x5a\x2d\xa2\xa8\xfd\x93\xf6\x23\xa1\x7c\xdd\xd4\xdb\x0d\x8d\x49\xfc\x56\xfb\xe1\x8d\x8e\x18\xca\x6c\x71\x15\x6b\xa7\x2a\xfc\xa8\x32\xf4\xb5\xd7\xcf\xcd\xb2\xe0\x23\x88\xcb\x47\xe2\xbb\x47\x54\xe6\xc2\x3d\x97\x6d\x50\x59\x3a\x6e\x3e\x7b\xe7\xf5\xae\xfb\x7a\xde\x6e\xfe\xb9\xbc\xdd\xf4\xfb\x78\xbb\x89\x07\xf1\x76\x53\xcf\x05\x25\xae\xca\x50\x85\x01\xf2\x92\x6f\x87\x31\x9d\xb7\xd9\x7e\xd6\xd1\xc8\x10\x64\x6c\x2e\xca\x27\xfc\x84\x2f\x13\x5e\x3c\xd2\xe5\x17\xc1\x9c\xed\x53\xee\xf2\x15\xf7\xc4\x74\xa3\x8f\xa2\x99\x7b\x7f\xfa\x04\xb0\xbf\x27\x88\xa0\x6f\xa9\x76\xca\xd3\x5c\x11\x7a\x5a\x8b\x0a\x5a\x9b\xa0\xde\x24\xca\x20\x72\x65\x0f\x1f\x48\xf9\x11\x5e\x3c\xba\xaf\x49\xe0\x41\x56\x95\x0a\x96\xe1\xce\xdd\x82\x94\xdc\x95\xed\x99\x9b\x6d\x0c\x60\x53\xff\xe0\x90\x5a\x02\x56\xa4\xde\x09\x77\xc7\x99\x62\x7d\xaa\x50\xb1\x62\x50\xbd\x89\xd5\x45\x77\xba\xdb\x02\x8c\x3a\xaa\xb6\xd7\x09\xf8\x7a\xcb\x38\x4f\x63\x98\xa9\x49\x1a\xf2\x9d\x88\x5b\x6f\x3f\xe8\x6d\xe8\x8a\x88\xf0\xb5\x3f\xc5\xa0\xe6\xfb\x80\x47\x6f\xef\x5c\x4f\x16\x4d\x87\x29\x22\xf7\x6d\x8a\x88\x5f\xba\x1c\x5d\xfe\xe9\x88\x96\xe0\x6e\xa0\x5a\x3d\xf0\x22\x80\x5b\x11\x3c\x84\xca\xe6\xe9\xe7\xa2\xb4\x6b\xdd\x54\xfc\x71\xf7\x9e\x8e\x9b\x36\x49\xdd\x5f\x44\x68\xe2\x62\x54\xa1\xc3\x26\x69\x92\xf2\xd9\x8d\x7e\xae\x0e\x7e\x50\x97\x1b\x4d\x0e\x07\x40\xd2\x0f\xbd\x80\xe4\xbe\x56\x9b\x2c\x74\xfa\x39\xa0\xe3\x13\x4f\x74\x19\xe7\x2a\xe9\xc5\xc0\x19\x24\xb5\xe9\xed\xfe\xe1\xfb\xbe\x17\x92\xbd\xe8\xc9\x43\x26\x92\x14\x63\x5e\xfb\x95\x07\x62\xfc\xba\x6f\xcf\xcb\xbe\xce\x5e\x4c\x94\xa9\x3d\x4c\x96\xa9\xc5\x4a\x53\x56\x06\xeb\xfa\x88\xdc\x33\xbe\xce\x13\xbe\x82\x2f\x47\xbf\xde\xfb\x25\xa4\xff\xc3\x41\x1c\xc4\xcb\xbd\xc1\xe3\x81\x9a\xc0\xdf\x47\x3f\xf0\x0b\x66\x9c\x67\xf5\x23\x53\x36\xe5\x49\x87\x49\xc5\x9b\x42\x71\xc7\xef\xf7\xed\xa2\xfd\x82\x19\x15\xa2\xa6\x6d\xd1\xca\xbb\x40\xcc\xd4\x7d\xa1\x16\xdb\xfa\xaa\x8c\xf5\xc9\x41\xbd\xb1\xca\x8e\xd7\x85\xff\x11\x96\x2d\xf9\xf7\x35\x28\x6b\xff\x87\xa0\xaa\x5f\x87\xa9\x13\xe3\xe9\x13\xe1\x12\xb3\xb7\x6a\x1c\x13\x7c\x9d\xc2\x07\xc9\x1e\x1f\x1d\x1e\xb8\x46\xad\xc1\x48\x20\xea\xb4\x3a\x82\x0f\x1f\xed\x6e\xf1\xa3\xb5\x03\x81\xde\x3b\x7b\x4e\x48\x84\xcf\xb9\x2e\x5e\x34\x35\x25\xa5\xa8\x39\xd4\xf8\xef\xea\xda\x42\x51\xc1\xda\x48\x8a\x66\x49\xa9\x2a\x3c\xf8\x89\x72\xa1\x8b\x36\x95\x18\x0d\x47\x7c\x69\xcf\x1b\x2a\xa1\x22\x23\x71\x9b\x19\x1c\xec\x02\x6d\xa4\x22\x5b\x63\x73\x57\x00\x65\x2d\x29\x58\xb5\x82\x4b\x79\xc6\xf0\x55\xae\xe1\xff\xe1\xb6\x51\x0d\x12\x33\xf0\x06\x26\x33\x55\x54\xfc\x15\x4e\x29\x0f\x91\xd8\xcc\xf2\x38\x66\x0f\x2d\x97\xb8\x2e\x93\x6f\xcd\x26\x13\x36\xd4\xbe\xf7\x80\x84\x42\x33\xda\x7f\x6e\x20\xde\xdb\x82\xca\x71\x6d\x7a\xfe\x56\x57\x2b\x77\x5f\x89\x3d\x87\x5a\x46\x0a\xb2\x14\xb6\x8f\x16\x4c\xf1\xdb\x98\xa2\xba\x52\xba\x94\x24\x54\xb5\xb0\x84\xf2\xd3\x36\x85\x0d\x2a\x18\xb9\xb6\x36\x4f\x22\xcd\xdc\xd9\x91\xd2\x07\x34\xbc\x1f\xa9\x69\xf7\x77\xdc\x8d\x9a\x72\x83\x7b\xf1\x1b\xda\x7f\xe9\x7b\x6a\xd6\xd9\x7d\x03\xe9\xcb\x1b\xee\x33\x87\x13\x36\xdd\x65\x0e\x88\xb5\xf6\x18\xf8\xf5\xcd\x58\x5c\x8c\xb2\x5c\x56\x04\x97\x21\x5f\xf7\x73\xb4\xc2\x23\x5b\xe3\x6e\x9e\x54\x0e\x31\xb3\xee\xb7\x74\x12\xae\xcd\xc2\xb2\xd7\x65\xdb\x6d\xec\x95\xb5\xea\xca\xff\xc1\x43\x73\x9c\xf0\x4c\xe8\x5b\xd1\xeb\x91\xd1\x26\x13\xdc\x94\xdd\x56\x01\xb5\x1c\x3e\xe1\xbf\xa6\xdf\xac\xdc\x1a\xd5\x92\xd4\xb2\x12\xf5\x1d\x57\x9d\xba\x85\x8d\x45\xf8\x86\x08\x07\xc0\x39\x87\x30\x1a\x5e\x46\x3e\x82\xb2\x77\x4b\x56\x5f\xae\x76\x8b\xe0\xe9\xf7\x3c\x69\x3d\x41\xbb\x59\xf2\xc3\x6d\x92\x1e\x3c\x21\x20\xd0\xe6\xff\x77\x53\x0e\x06\xde\x33\xed\x93\x08\x4a\xc0\xde\x9a\x73\xee\x94\x57\xfa\x5e\xb5\xff\x0b\x18\xc5\x40\x5c\xa3\xea\xf6\x5b\x65\x95\xde\x7a\xc5\x11\xfc\x01\xc7\xf2\xc6\x09\xd6\xc0\x02\x5d\xf1\xf5\x6d\x9d\xb7\x11\x23\x66\x31\x6e\x2e\xdd\x9d\x50\xff\x0c\xbe\x11\x97\x4c\x50\x7d\x63\x80\x36\x9e\x90\xba\x10\x85\x1f\x8c\x1e\x47\x8c\x3c\x82\xe5\x82\x79\x3e\xe0\x1d\x79\xef\x84\x43\x6d\x32\x83\xb8\x56\xc9\xa3\xa1\x96\xc0\x1a\xb0\x0c\x21\x58\x7a\xa5\x32\x9a\xe3\x3e\x8a\xdf\x52\xa1\xac\x0f\x32\x3a\x9a\x77\xbc\x5b\xc3\x55\xb8\xd8\x05\x68\xed\x01\x90\xe1\x4f\x7a\x2f\xdc\x32\xd9\xf8\xdf\x4e\x14\xef\x8b\xcf\xe6\x2c\xaa\x2e\x66\xce\x60\xef\xf2\x51\xe0\xf1\xe3\xf5\x84\x92\xfb\x86\xd5\x81\xd4\xc4\x96\x0f\xaa\x08\x9d\x2d\xec\x2e\xa0\xd6\x9c\xf9\x40\x19\xdd\xb9\x07\xb5\xd4\xee\xdd\xdf\x87\x13\x79\x29\x86\x0c\x59\x38\xee\x49\x1a\xea\x94\x66\xe4\xfb\x59\x06\x04\x51\x8a\xe2\x16\x43\xba\x7f\xfd\xb0\x49\x58\x9e\xff\xe2\x70\x1c\x24\x5e\x73\x76\x96\xc7\x2d\x62\x4c\xaf\x44\x14\xdf\x4c\x2d\x87\xbb\xd8\x61\x24\x33\x03\xd7\x84\xa5\x9c\xe8\xd6\x10\xe8\x4c\x60\xab\x77\x84\x33\xc4\x48\xb3\xc6\xde\x68\x9d\x5e\x2e\x60\x71\xf5\xfb\x1f\x10\xce\xce\xca\xa2\x3f\x54\x50\xf4\x38\x1d\xf7\x35\x13\xa0\xf0\xe2\x11\x7c\x76\x64\xb1\xf8\xc6\x46\x21\xce\x65\x14\xec\x2d\x62\x73\x38\xf2\xcb\x79\x65\x83\x5c\x47\x79\xe5\xbe\xee\xb7\x14\x7f\x65\xbb\xe9\xcb\x7c\xca\x05\xa9\x07\x46\xd4\xcd\x7c\x00\xe6\x98\x1a\xee\x6d\x9a\x06\x73\xce\x04\xff\xe5\x3a\x4d\x0e\x77\xa6\xf0\xf8\xd9\xc1\xc1\xc1\xc1\xde\xc1\xae\xda\x40\x8f\x77\xa6\x20\x3e\x72\x3e\x7b\xb2\x33\x85\xc3\xb0\xe1\x53\xfe\xe1\x93\x67\xde\x67\xcf\x76\xa6\x5c\x3a\x84\x94\xef\xd9\x50\x3e\x32\x01\x1e\x01\x0a\xc1\xe0\xc3\xe3\x26\x22\xfe\xd1\xd6\x89\xb9\x27\x1d\x53\x36\x9c\x17\xf4\x70\x99\x39\x9c\xcc\xe1\xf3\x1f\xfd\xe9\x3c\x3b\xfc\x31\x98\xd0\xff\xfa\xd1\x9f\xd2\xe3\x27\xcf\x07\x26\xa5\xab\xd4\x29\xba\xc6\x13\xe3\x6d\x17\xec\x23\x13\x62\xa6\xbd\xec\xa7\xed\x9c\x2f\xf7\xfe\x7f\x00\x00\x00\xff\xff\x27\x55\x16\xf3\xfd\xbb\x00\x00"
+var _flowidtablestakingCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x5b\x73\x1b\x37\xd2\xe8\x3b\x7f\x05\xec\x07\x87\x8c\x65\x5d\x7c\xdb\x2c\xcb\x74\xd6\x2b\xd9\x7b\x54\xd9\x78\x5d\xb2\x72\xf2\xe0\x72\xa5\x46\x1c\x50\xc4\xf1\x70\x86\x3b\x98\x11\xcd\xcf\xab\xff\x7e\xaa\x81\xc6\x1d\x98\x19\xea\x72\xe2\xaf\xce\x67\xbb\x12\x89\x04\x1a\x8d\xbe\xa1\xbb\xd1\x00\x0e\x7e\x1c\x8d\x08\x21\xe4\x5d\x51\x6d\x4e\x4f\xce\xb3\x8b\x82\x7e\x6c\xb2\x2f\xac\xbc\x94\x9f\x9f\x2f\xa9\xf8\x8e\x9c\x9e\x10\xf1\x2d\xc9\xca\x9c\x60\x13\x32\xaf\xca\xa6\xce\xe6\x0d\x59\x65\x65\x76\x49\xb9\xe8\x52\x56\x39\x25\xd5\x9a\xd6\x59\x53\xd5\xfc\x07\xd1\x21\xa7\x05\xbd\xc4\xdf\x59\xb9\xa8\xea\x55\xd6\xb0\xaa\x14\xed\xe1\x7b\x31\x44\x53\x7d\xa1\x25\x27\xcd\x32\x6b\x48\x56\x53\xc2\x9b\xec\x0b\xcd\x49\xc6\xc9\x3a\xab\x1b\x52\x2d\x48\xa3\xb0\xf9\x50\x57\x4d\x35\xaf\x8a\x7d\x89\xe5\xfb\x2a\xa7\x9c\xf0\xf6\x62\xc5\x1a\x68\xc4\x6a\xd9\x99\x34\x15\xfc\x4a\xd6\xed\x45\xc1\xe6\x24\xcb\x73\x68\x79\x5a\x2e\x2a\xb2\x68\xcb\xb9\x46\x21\x6f\x6b\x56\x5e\x8a\xa6\x1c\xa7\x96\xb5\xe2\x6b\xb2\x5e\x66\x9c\xe2\x30\xe7\x4b\xc6\x49\x4d\xe7\x55\x9d\x73\x1c\x06\x26\x23\x66\x38\xaf\x56\x2b\xd6\x34\x34\xc7\x69\xec\x93\xf3\x25\xdd\x92\xac\xe0\x15\xd9\xb0\xa2\x20\x97\xb4\x21\x19\x81\xf1\x05\xac\x7f\x5d\xfc\x1f\x3a\x07\x64\x33\xf8\x0f\xdd\x92\x79\x56\x92\x96\x0b\x94\x01\x07\xba\x47\xda\x12\x7f\x00\xf8\x1b\xd6\x2c\xf3\x3a\xdb\x90\x9a\x6e\xb2\x3a\xe7\x88\xd2\xdb\x6c\xbe\x94\x14\x5f\x66\x9c\xac\xda\xa2\x61\xeb\x02\x80\x7c\xa1\x25\xb9\x68\xe7\x5f\x68\x83\x14\x5d\x56\x45\x8e\x48\x8b\x6f\x25\xb3\x2e\x32\x4e\x73\x52\x95\xf8\x0d\x6f\xb2\xa6\xe5\x53\x33\x9b\x3d\xe4\x82\xc2\x86\x95\x97\x1a\xb1\x7c\x4f\xcc\x5c\x22\x44\x73\x4d\x24\x4a\xde\xe4\x2b\x56\x0a\x8c\x80\xa4\x59\xdb\x2c\xab\x9a\x35\x5b\x98\x5b\x4d\x57\xd5\x15\x95\x28\x23\x29\xf7\x44\xbf\x9a\x2e\xda\x32\x27\xac\xe4\xed\x62\xc1\xe6\x8c\x96\x4d\xb1\x55\x32\x00\xcd\xf9\x1e\x59\x67\x5b\x35\xff\x3d\x2d\x3b\x02\x1e\xca\xce\x05\x6d\x36\xd4\xcc\x5c\x30\x81\x53\xc9\x80\x65\xb6\x5e\xd3\x92\x54\xe5\x9c\x12\x7a\x45\xeb\x2d\xa1\xeb\x6a\xbe\xdc\xf7\xb0\x06\x3e\x08\xae\xf1\x22\xe3\x4b\xa0\x0b\x72\x14\x04\x30\x93\x88\x0b\x82\xae\x18\xbf\xa0\xcb\xec\x8a\x86\xbc\x68\xb6\x6b\x0a\x0c\xe7\x84\x35\x9c\xd4\x20\xeb\x4a\x7c\xc5\x98\xc8\x9f\x75\xb6\xad\xda\x06\x04\x5c\x33\x55\xe1\xf2\x9e\x6e\xde\x8a\x96\xf4\x8a\x96\x8d\x26\xe5\xbc\x2a\x17\xec\xb2\xad\x69\x0e\xb4\xa8\xda\x46\xb2\x00\x00\x9f\x57\x4d\x56\x9c\x49\x38\x1f\x32\x96\xc3\x1c\xcb\x46\x4c\xce\x9a\x83\xc0\x7c\x43\x6b\x4a\xb2\x79\xd3\x66\x45\xb1\x25\x2b\x56\x0a\x46\x6f\x96\x6c\xbe\x14\x8a\x57\x50\x0e\x9c\xcb\x84\x54\xe0\x40\x02\x10\x33\x14\x80\x19\x02\x56\x25\x8a\xab\x92\x7b\x52\xd5\xa2\x17\xce\x48\xc0\x6b\xea\xb6\x9c\x67\x8d\x14\x91\x1f\x0f\x46\x23\xb6\x5a\x57\x75\x43\xde\xb5\xe5\x25\xbb\x28\xe8\xb9\xa0\xc6\xa2\xae\x56\xe4\xf0\xeb\xbb\xdf\xde\xff\xe3\xf4\xef\xff\x7c\x7b\xfe\xaf\x5f\xde\xbe\x7f\x73\x72\x72\xf6\xf6\xe3\x47\xdd\xa1\xa8\x36\x6e\xe3\x7f\xfe\xeb\x77\xa7\xe1\x68\xdd\x5e\x18\xb3\x14\x5a\x35\xf2\x4d\xf2\xea\xe0\xc7\xd8\x9f\xb8\x95\x7b\x0b\x84\xe4\x44\x35\x72\xff\x1c\x48\x78\x30\xac\x20\xb8\xe6\xdc\xb8\x01\x86\x00\x0c\x9a\x4f\xc9\x6f\xef\xd8\xd7\x97\xcf\xf7\x48\x63\xb8\xf4\x41\xd0\x55\x7d\x35\xf1\xc0\xf8\xec\x1c\x67\xab\xaa\x2d\xad\xe6\xa2\xfd\xc1\xc1\x81\x30\x29\x88\x63\x88\x0a\x7c\x79\x5c\x53\x20\xfe\x18\x18\x77\x7a\x32\x25\x1f\x1b\xb0\x76\x7b\xa4\xae\x0a\x3a\x25\xbf\x9d\x96\xcd\x4f\x7b\x44\x82\x3f\x56\x7a\x9f\x46\xeb\x0b\x2d\xb9\x6e\x16\xc0\xf4\xb1\x8c\xf5\x96\x34\xb9\x51\xd7\xdf\x94\x11\xba\x45\xef\x9d\x87\x06\x1a\x9e\x09\xb3\x95\xbf\x29\xf3\x33\x61\xa7\x76\x06\x62\xb3\x72\xb7\x9e\x0a\x6b\x49\xbc\xdf\x71\x1d\x28\x6f\x34\xfe\xed\x60\x20\xfb\xc0\x2e\xf6\xce\x7f\x0f\x16\x4a\xb6\x06\x33\x3e\x25\x6f\xf2\xbc\xa6\x9c\xff\x6c\x49\xed\x89\xf2\x08\x92\xa2\xab\x5b\xa4\xe4\x57\x3b\x15\xf0\x21\x88\xf1\xb3\xa7\x3e\xc6\x1a\x46\x9f\xdc\x46\x60\xf5\x51\xc3\x83\x9d\x90\xea\xdb\x03\x4e\xcb\xfc\x5d\xc1\xbe\x63\xb4\xbb\x44\xfd\x36\x70\x87\x2a\xc2\xed\x71\xbf\xcf\x11\xba\x95\x68\x00\xe4\x3e\xcd\x3a\xd6\xab\x1f\xa3\x45\x4e\x8e\x97\x59\x79\x49\x07\x68\x59\xdb\x7c\xa0\xf5\x9c\x96\x4d\x76\x49\xc7\x25\xdd\x38\x1f\xa4\xa6\xf5\x9e\x6e\x7e\xa7\xf4\x4b\xb1\x95\xab\x19\xf4\xeb\x5e\xd7\xde\xd3\x0d\xae\xab\xbf\xb2\x92\xad\xda\x15\x87\x3e\xea\xe7\x29\xf9\x06\xc2\xf6\x93\xea\x7d\x1d\xe9\x2e\x59\x74\x06\x4e\x95\xe8\x2b\x7f\x1a\xd2\xf3\xd7\xec\x2b\x8c\x78\x5a\xb2\x86\x65\xc5\xef\x94\x5d\x2e\x9b\x71\xa9\x3f\x97\x82\xfe\xf2\x79\xba\x27\x12\x8b\x55\xe5\x80\xe1\x35\x43\xfe\x57\x55\xc8\x78\x81\xb0\x9c\x96\x8d\x70\x84\x45\x28\xb5\xa8\x6a\x92\x15\x85\xf8\x0a\x04\x81\x13\x26\x5d\xae\x92\x36\x9b\xaa\xfe\xb2\xaf\x41\x9c\x96\xf3\xa2\x85\x06\xb2\x99\x0a\x91\xca\x1f\x1a\xf0\xe3\xd8\x15\x2d\xb6\x22\x48\x62\x73\xb6\xce\x1a\x88\xde\x54\xcf\x2f\x74\x4b\x66\xd2\x2b\x3d\x3d\xd1\x9f\x5e\x65\x45\x4b\xc9\x0c\x3d\x35\xf0\xc2\xa5\x7f\x9a\x35\xa2\xe9\x0f\x80\xc9\xa2\xda\x43\x9f\x6e\xcf\x8b\xe1\x04\x98\x6c\x3e\xa7\x9c\x8f\x95\xaf\x35\x21\x57\x59\x2d\x3a\xf3\x29\xf9\xdb\x37\x29\xcf\x53\xe1\x9c\x9c\x09\x37\xff\xda\x48\x28\x38\xb8\x2b\xc9\x71\x94\x6d\x31\xba\xe5\xa1\x52\xd7\x95\x06\x47\x53\xc5\x45\x1a\x0a\x2b\x49\x55\xe7\xb4\x86\xa8\xe2\x82\x82\xd3\xc7\x59\x4e\xc1\x35\xbe\xca\x0a\x96\xa7\x91\xc4\xa1\x41\x0c\xe9\x19\xfd\x77\xcb\x6a\x9a\x07\xfc\x73\xb1\x15\x6e\x5b\x02\x57\x2b\x54\x0d\x18\xaa\x81\x54\x0b\x7f\x4e\x56\xcc\x39\x6f\xeb\x1a\x04\x4d\xc4\x06\x69\xbc\x05\x12\xf6\x62\xf3\xf7\x2d\x90\xf7\x7c\xbb\xa6\xb7\x44\x7f\x0d\xf1\x82\x09\x43\xec\xf0\x48\xc3\x99\x57\x6d\x91\x93\x0b\x0a\xb1\xbe\x0c\x1c\xe6\xc2\xb2\xe4\xe4\x62\x2b\x66\x91\x89\xf0\xa9\xa6\xbc\x6a\xeb\x39\x4d\x4f\x43\xcc\x52\x4c\xc3\xb5\x14\x2e\xca\x4e\xd0\xb4\x11\x26\x86\x64\xa0\xf8\x71\x09\x81\x60\x2b\x26\xf3\xe0\xe3\x06\x52\x9f\xd3\x39\x5b\x65\x05\x29\xdb\xd5\x05\xad\x75\xd0\x78\x28\xc4\xfc\x88\xb0\x32\x67\x73\xa1\x45\x24\x23\x6b\x6d\x02\x43\xf4\x38\x88\xad\xcd\x6f\x81\x0b\x87\x3c\x03\x69\xd7\x20\x97\x47\x69\x32\xd4\x96\x19\xeb\x61\x9f\xc1\x01\x06\x54\x4c\x92\x64\x10\x9c\x72\x52\x2e\x04\xe4\x9a\x8b\x08\x49\x43\x01\x04\x5b\x4e\x6b\xec\x05\x22\x8b\xea\x0c\xb3\x6c\x2a\xc2\x9a\x34\xa6\x00\x5d\x59\xbe\xf2\x52\x9a\xdf\xe3\x18\xdf\x3e\x64\xcd\x92\x0b\x25\xe0\x4d\x25\xa4\x1b\x3d\x17\x2d\x15\x66\x01\x2a\x68\x23\x8c\x83\x90\xe4\xfa\x63\x53\xd5\xd9\x25\x05\x00\x53\x62\xfd\x92\x68\xfe\x41\xe4\x73\x64\x6b\xf3\xb3\xd3\x18\x17\x19\x91\x89\x18\x04\x1d\x67\x58\xa5\x71\x09\x02\xc7\x78\xb8\x78\x5c\xad\xd6\x15\x67\x0d\x25\xa0\x99\xe4\x84\x2e\x18\x2c\x38\x55\xc9\x49\x2c\x72\x54\x4b\x76\xc6\x4a\x6e\xe7\xc6\x24\xa7\x18\x27\x7c\x4d\xe7\x6c\xc1\xe6\x20\x4f\x18\x7d\xb3\x52\xa4\xc1\x34\xfe\x8a\xbc\x96\xb9\x55\x81\xae\x1a\x02\x74\xaa\x2d\xd9\xbf\x5b\x58\x07\x94\x5e\x95\x2a\x1b\xa5\x5a\x7d\xa4\x0d\xd9\x2c\x29\xae\x42\x20\x55\x8c\x93\xb9\xf4\xbf\x75\x43\x45\x31\x96\x2b\xbf\x25\x1c\x0a\x14\x01\x46\x81\x11\xa6\xce\xb7\x47\x64\x46\xe6\x55\x51\x50\x93\x7e\x53\x5f\x3d\x15\x5f\x95\x9c\x96\xbc\xe5\xce\x37\xcf\xc8\x8c\xd0\xaf\x74\xde\x06\x7d\x9e\x93\x19\xb9\xa2\x35\x10\x28\x0b\xbe\x7c\x41\x66\x28\xd2\xfa\x63\x40\x5e\xa8\x9f\x09\x7e\x0d\xf6\xeb\xf6\x62\xcc\xa9\x12\x7b\xb9\x08\x0b\x21\x12\xe1\x4b\x38\xdb\x44\xfb\x5f\xe8\xb6\xa7\x2d\xea\x45\xb4\xa1\x26\xa1\x30\xda\xb6\xa9\xae\xca\x02\x4c\x2d\x93\x6e\x80\x5a\x36\x8a\xad\x48\x0f\xa9\x94\x5c\x59\x35\x84\x09\x77\x81\x95\x97\xfe\xa2\x6d\xe0\x33\x8e\xe6\x70\xd5\xf2\x86\x64\xc5\x26\xdb\x72\xb0\xee\xd9\x85\x48\xa7\x59\x2b\x74\x2d\x97\xc8\x15\xac\x50\x72\x11\xd6\x69\x39\x58\xee\xe6\x73\xba\x6e\x62\xe3\x28\x4a\x37\xd6\x8a\x35\x25\x7f\xd3\x39\x9c\xfd\xff\x9d\xb5\x45\x13\x9b\xb7\x99\xb1\x99\x2c\x4c\xd1\xce\xb1\x62\x82\x77\x81\xe9\xa6\x92\x7e\xc5\xf5\x73\x3f\x81\x80\x8e\xfd\x6e\x87\x83\xca\x7d\x0a\xfb\x2a\xd4\x64\x5d\xd3\x2b\x56\xb5\xdc\x5a\x2f\x15\xa8\x5f\xab\x2b\xca\x55\x12\xba\x71\x22\x2e\x4c\x51\x12\x99\xfe\x25\xb4\xcc\x9d\x0c\x61\x6a\x16\x3a\x18\xec\x9d\x45\x74\x06\x8c\x13\xe1\x79\x36\x95\x49\x28\x83\xbe\xc3\x82\x0f\x83\x6f\xc9\x26\x2b\x9b\xce\xb1\xfb\x09\xa8\x0c\xa1\x5a\xa8\xb4\x87\x21\x08\xc1\x38\x4e\xdd\xe9\x73\x9c\x95\x20\x7d\x0a\xa9\x52\x63\x95\xc0\xe5\x0c\x93\xce\x7d\xb8\x14\x8c\x0b\x8f\xc7\xc8\xa7\x58\x9f\x0c\x45\xd4\xaa\xe9\x8c\x53\x50\x5b\xa2\xc1\x9f\x85\xc8\xe0\xd9\xd3\xa9\x1d\x28\x5a\x4e\xad\x1a\x0d\x44\x87\x95\x73\xa9\x2c\x40\x82\xd3\x13\xc8\xe9\x0b\x79\xad\xe9\x25\xe3\x0d\xad\x49\x49\x37\x09\x75\x31\xf6\x41\x7f\x7f\x7a\x72\x5c\xb5\x65\x43\x6b\x15\x0d\x86\x03\xc6\xdd\x3a\x33\x43\x90\x5a\xd0\x61\xca\x51\x73\x50\x84\x23\xba\x13\x47\x46\x51\x1c\x41\x9c\x57\x28\x08\xae\x03\xa0\x50\xda\x88\xb0\x0a\x92\xda\x39\x6d\x68\xbd\x62\xa5\xe5\x22\x4a\x54\x51\x03\xb2\x05\x90\x23\xb2\xe9\x12\x47\x83\xd9\x61\x9b\x8a\xd5\xcc\xd8\xf0\xf5\x58\xff\x06\xff\xcc\xfa\xb4\xe7\x7c\x6e\xe7\x3d\x9d\x2f\x92\x16\x3f\xd5\xcc\xb2\xdf\x6e\x93\xd0\xbe\xbb\xdf\x47\x4c\x92\x9d\x0b\x47\x49\x56\xad\x27\xe4\x9b\xd3\x7b\x5d\x53\xef\x13\xf8\xc7\xf2\xfd\x82\x96\x97\xcd\x92\xcc\x66\xe4\xe5\xf3\x29\x79\x08\xde\x00\xac\xf6\xf8\xb1\xb0\xf4\x17\x94\x3c\x7b\x4a\x2e\xb6\x0d\xe5\x64\xfc\xf2\x39\x59\xd2\xaf\x64\xbe\xcc\xc0\x2f\xa5\x35\x9f\x3c\x0c\xc0\x86\x39\xf4\x7d\x30\x25\xfc\x13\xcb\x3f\xc3\x48\x25\x2b\xa6\xe4\x21\x08\xe2\xe9\x09\x6c\x62\xc1\xfa\x93\x15\x35\xcd\xf2\x2d\xa1\x5f\x41\xfd\x30\xa8\x95\xbb\x3d\xe1\x00\xc0\x0e\xf2\x7a\x26\x18\xfa\xd3\xf8\x68\x42\x1e\x3d\x12\xa9\x69\xf2\x4a\x7d\xf6\x62\x82\x23\x88\x8f\xd5\x34\x8e\xf6\xc8\xd3\x3d\xf2\x6c\x8f\x3c\xdf\x83\x4d\x87\x17\x21\xe4\x80\x9f\x8a\x40\xaf\xc9\x21\x8c\x92\xfc\xfe\xd5\x8c\xbc\x38\x3a\xc4\x41\x83\x56\x1a\x03\xb3\x4f\xf2\xf4\xc5\x0b\x45\xd3\x17\x47\x87\xfd\x44\x35\x30\x7f\xa1\x5b\x35\xea\x6c\x46\x8e\x9e\xfe\x14\x8c\xfa\x0b\xdd\xfa\x1c\xa4\x5f\xb3\x39\xec\x8e\xbd\x7c\xae\x46\x3d\x7a\xfa\x53\xff\xa8\x46\x2a\xed\x21\xff\xfa\x14\x87\x34\x5f\xa7\xc6\xfb\xeb\x4b\x3d\xde\x5f\x9f\x76\x8f\x67\x59\x46\x65\x19\xde\x70\x4e\x6b\xb3\xe5\x09\x61\x12\xb8\x55\x94\x8b\xb8\xeb\x0b\xdd\xca\xa5\xc2\x16\x20\x26\xf6\x44\x03\x48\x62\x6b\x55\x21\x27\xbd\x5a\xa7\x0d\x58\x36\x10\xd2\xd3\x13\xc2\xca\xa4\x04\xef\x8b\x21\x43\x45\xca\x24\x9e\xae\x31\x09\x39\xa7\xa4\xe1\xc1\x2c\x39\xc2\x27\xf8\xef\xe9\xc9\xe7\x9f\xf7\x83\x6e\x7b\xa3\x08\x70\xb2\xa2\x9c\x8b\x6c\xdb\xc3\xf7\xba\x83\xca\xf1\x11\xc6\x3d\xba\x3c\x08\x59\x3c\xb9\xe9\x6c\x40\xca\x76\x9c\xc9\x2f\x74\xbb\xc3\x2c\x60\x80\x7b\x98\x81\x25\xb4\x83\xd0\x37\xed\xfb\x70\x47\x00\x37\x44\xdc\x93\x7f\x4e\x8b\xc5\x3e\xcb\xc9\x8c\xb0\x3c\xfc\x42\xd8\xb5\x99\x49\x56\xa8\x3f\xa2\x57\x20\x3a\x90\xdb\xf0\x3f\xeb\xea\x06\x13\xb0\xbb\xfc\x42\xb7\x61\x73\x8b\x8e\x33\xcb\x50\x84\x0d\x9d\x65\x98\xcc\xc8\x61\xd8\xc4\x78\x37\xe4\xd5\x13\xf2\xed\xba\xa3\x85\xf6\x6f\x04\xa4\xb0\xa1\xb7\x50\x02\x3c\xff\xa3\x8c\x3f\x08\x9d\xc0\x04\x20\xe0\xa9\x84\x62\x3a\xc8\xf0\xf6\xed\x6a\xdd\x6c\x45\xdf\xf1\x64\x27\x90\xda\x23\xbf\x07\xa8\x77\x8c\xaa\x72\x9a\xef\x18\xa8\xef\x17\x02\x2b\xf7\x3d\x66\xd2\x15\x4b\xee\x47\xa3\x6e\xa8\x0d\x69\xad\x11\x6a\xe3\x43\xb3\x7a\x1a\x95\x89\xfd\x8b\xac\xc8\xca\x39\x9d\x8c\x22\xba\x97\x53\xde\xd4\xd5\x76\xec\xbb\x50\xe0\xe1\x2f\xd4\x04\xcf\xe8\x82\x44\x6d\x47\x36\x9f\x03\x02\xfb\x17\x55\x5d\x57\x9b\x57\x8f\x3c\x92\xbc\x1e\x43\x10\x38\x25\x07\x90\xee\xca\x2e\xe9\x81\x86\x28\xbe\x9e\x3c\x70\x86\x64\x0b\x1b\x7d\x29\x89\x0a\x77\xf2\x1a\x48\x16\x71\xea\x22\x48\x75\xa4\x81\x3f\x69\xda\x7d\x26\xb3\x9b\xf7\x7d\x40\x9e\xa4\x51\x0d\x50\xb4\xc9\xb8\x9f\x53\x91\xfb\x42\xc2\xbc\x7a\x12\x80\xf1\xac\x24\xa1\x05\xa7\xe4\x9b\xe2\x53\x38\x2c\xb9\xee\x20\x62\x20\x03\x49\x3a\x0e\x45\x52\x43\xec\xc1\x33\x8a\x45\x27\xae\xda\x4e\xdc\x19\xae\x1a\xe2\x0e\xb8\xea\x3e\x03\x70\xbd\x43\xb2\x2a\x80\x3b\x63\xda\x43\x54\x65\xd1\xee\x0c\x51\x05\x70\x07\x44\x55\x17\x7f\xc9\x3f\x38\x20\x67\xb4\x69\x6b\x28\x27\x2b\x54\x72\xc7\x2c\x8d\x3f\x40\x09\x62\xee\x2e\xdc\xe0\xb7\xea\x16\xe0\x69\x78\xcb\x69\xca\x63\xc5\x7c\x05\x66\x7e\x67\x52\x38\xa5\xd1\xf2\x12\x16\x63\x0d\xcc\x9d\x21\x92\x56\x03\xf1\x45\xbb\x97\xc0\xbd\x44\x4e\x83\x56\x49\x1f\x5d\xe2\xd4\x8b\xc5\x24\x44\xfe\x7a\xc8\x74\x06\x1a\xdd\x9d\xe7\x82\x70\x7b\x27\xe2\x8e\x7f\xd3\x59\x28\xcd\xb8\xfb\x79\x68\xc8\xbd\x33\xf1\x71\xb8\xe9\x5c\x94\xf2\xdc\xfd\x5c\x34\xe4\xde\xb9\xf8\x38\xdc\x8e\x2f\x43\x2c\xfc\x0d\x19\x03\xa0\x07\x72\xc6\xc2\xa2\x77\x3a\x9e\xdd\x52\x36\xce\xb3\x3d\xa3\x48\x73\x88\xea\x7f\x6b\x58\x01\x25\x0d\xef\xb0\xa0\x5a\xc6\xf7\xf3\x25\x9d\x7f\xe1\xb8\x5f\xf5\x03\x27\xd5\x15\xad\xc1\x0c\x9a\x14\xbe\xa2\x11\xf0\x4f\x94\xc9\x4a\x7b\x45\x73\x2c\x48\xd0\x83\x04\x7b\x92\x8b\xb6\x14\x60\xdf\xb5\x45\xa1\x4d\xc3\xdf\x25\xb8\xf1\x44\x65\x25\x3d\xb2\xb3\x05\x19\x5b\x46\x3b\x30\x29\xe4\xb1\x6d\xd3\x3d\x45\x25\xaf\x7a\x3c\xde\x90\xc5\xb5\x34\xfd\x87\xfb\x87\xd1\x95\x24\xd5\xfe\xa6\x28\x92\x27\xdd\x18\xba\x48\xa4\x78\x29\x59\x40\x32\x52\xd3\x05\xad\x29\x90\x05\x72\xf6\x15\xa9\x4a\x1a\xae\x60\x22\x81\x6c\x76\x24\x4d\x8e\xaf\x9b\x75\xf1\x75\xe9\x8f\x58\xe9\xd3\x64\x4a\x1e\x79\x0d\x07\xa5\x42\x3d\xd1\xfd\x64\x81\xfe\x4c\x1e\xc8\x9c\x65\xd0\x09\xfe\x3d\xfc\x28\x37\x5b\xa9\x55\xee\x02\x19\xd4\xbc\x12\xb5\x37\x4d\x7f\x46\xf3\x7a\x14\xe1\xea\xa3\x4e\x84\x20\xda\xf4\xa7\xa9\xa1\x5c\x8f\x2c\x3e\x89\xcd\x95\xba\x85\x73\x03\x15\x6e\xcb\xc2\x4f\x70\xb4\x00\xf2\x65\x4f\xc4\x06\x21\xec\x21\x93\xec\x42\x54\x96\x9b\x1d\x5e\xd8\x46\xe2\xb2\xaf\x3e\x03\xf1\x2d\xd8\xf4\xb0\xb6\x74\xfd\xaf\xac\xac\x79\xd0\x2d\x48\x47\x24\xa1\x38\x59\x88\x64\xab\x30\x7d\x1e\x34\xb1\x95\x40\x6f\x44\x84\x8d\xbc\x70\xa7\xa3\xa5\xa3\x72\x3d\xed\xb4\x8d\x1d\xd4\xae\x17\x9c\x5a\x80\x74\xb3\xee\x2d\x2c\x72\x7a\x72\xa3\x5d\xac\x4f\xc0\xbc\x67\x4f\x3f\xa7\x1b\x85\xbb\x4d\x29\x84\x7d\xfb\x92\x9c\xe1\x90\x5d\x1b\x95\x08\x90\xac\x8e\x85\xea\xa5\xa9\x6b\x88\xc6\xb5\xd2\xa6\x98\xea\x07\x04\x39\x19\x39\x90\x4c\x12\xce\xc0\xdb\xef\xc8\xc7\x59\xad\x76\x48\xcd\x99\x4e\xc1\xd7\x03\xb2\x74\xb1\xde\xfd\x09\x3b\x0b\x53\xf3\x79\xd8\xc7\x56\x1a\xb7\x57\x6c\x4d\x89\xf5\xf7\xf4\x29\x9e\x65\xb8\xa4\x8d\xae\xd5\x51\x0b\x33\x14\xd3\x6a\x1b\xc7\x35\x7f\x22\x43\x38\x8a\x18\xc3\x52\x7f\xd9\x85\xa8\xa3\xa6\x31\x28\xfa\xcb\x61\x50\xe2\xa8\xa8\xef\xfa\x60\x28\x0d\x8f\xc1\x50\xdf\xa5\x61\x18\x25\x76\xfb\x9b\xcf\x45\x60\x38\x30\xcd\x1a\x03\xa0\xbf\x4f\x4f\xc0\xd7\xf8\xf8\x54\xfc\x56\xfd\xf9\x63\x0b\x88\xf3\x55\x6a\x05\x84\xa8\x5a\x9f\xc0\x53\x99\x6a\x3c\x87\xc7\x79\x35\x67\x90\x5b\x14\xd5\x0d\x24\x33\x96\x4d\x77\x86\x82\x2e\x9a\xab\x15\x1c\xbd\x53\x63\x38\xc2\x92\x2a\x6f\x61\x26\xdf\xe2\x65\x16\x8c\x9b\xc1\xbc\x62\x95\xdd\xf6\xd9\xb5\x74\xf7\x95\x36\xa4\x87\x46\x79\x55\xe3\x86\xe5\xa5\x89\xa1\xd5\x1a\x79\xd3\x71\xa3\x55\x06\x19\x1c\xea\xe3\xa4\xa8\xe6\x37\xc2\x49\xab\xe9\xcd\xd1\xba\x80\xe2\x4e\x75\x4a\x51\xec\x35\xc2\x19\x3f\x15\x48\x25\x97\xb8\x61\x05\x26\xe7\x7e\xcd\x85\x19\x1b\x29\x30\x78\x48\x54\x99\x1d\x87\xa4\x03\x98\xd0\x45\xe0\x8e\xb5\x5c\x77\x03\xc5\x0c\x52\xe8\x96\x75\xd0\x32\x7b\xa7\x3b\x0b\xb8\xc4\xdc\x25\x48\x2d\x4d\x77\xbc\x05\x82\xb2\x75\xf7\xa8\xde\x31\xd0\xe4\x66\x8d\x6a\x1b\x6e\x9d\x90\x80\xf1\x4e\x82\xc0\x13\x80\xbe\x86\x92\xa7\x7d\xad\x34\x9b\xfa\x1a\x2a\xca\x0f\x03\x48\xf3\xd8\x3c\x07\x24\x31\xb4\x86\xdd\x43\x26\x43\xc3\xfe\x9f\x74\xc6\x1d\xa4\x33\x2c\xde\x1e\x58\xd1\xb2\xe0\xa6\x2c\x31\x94\x98\x61\x0d\xe9\xb2\xda\xe8\x02\x7e\x27\x6e\xd6\x5c\xf1\x83\x67\xed\x0e\x74\x44\xd0\x89\xd8\xc9\x0d\x73\x82\xaf\x3d\x32\x69\x2b\x1c\x6f\x37\x28\x9e\xd5\x7a\xd4\xd3\x4e\xa9\xd1\x20\x70\xbd\xcd\x42\x76\xe9\x0e\x5d\x71\x5f\xf4\xf0\xdb\xfd\x05\x83\x4e\xe0\xab\xc1\x59\xde\x68\xcf\xfe\x49\x47\x74\x69\xb5\x09\x5b\x60\x5d\xd2\x0c\x0b\x94\xc2\x06\x9e\x1c\xd8\xf0\x10\xb3\x84\x42\xf5\xac\xa3\x29\x38\xae\xe6\xa5\x80\x68\x51\x4a\xe2\xa3\x5b\x0c\x03\xd5\x81\x91\x6a\xd0\x07\x48\x89\x6d\x12\x90\x6a\xd0\x0f\xc8\x97\xd8\x0e\x90\x7e\xd3\xb8\xf5\x71\x62\x08\xb8\x61\xa1\x5e\x64\x73\x1a\x9c\x61\x49\x58\x10\xcb\x44\x58\xd6\xec\x4c\xc1\xd3\xae\x9f\x93\xfc\x81\xb3\x13\x4d\x5d\x15\xea\x08\x8e\x59\x41\x1d\x64\x0c\x0a\xd3\x18\x3a\x1a\x1f\xb0\x9f\xbf\xe9\x13\x23\xca\x71\xef\x4e\x37\x59\xb8\xeb\xef\x84\xf3\x68\xbe\xf0\x35\x3a\x5a\x19\x75\xed\xa2\xf1\x26\xcf\x45\xd9\xb4\x2a\x70\x86\x3a\x72\x4a\xf8\x96\x37\x74\x65\xce\x02\x58\x27\xea\xe2\xa1\x96\x58\x6f\x45\xdb\xf7\x54\xba\x60\x7c\xfc\x07\x1a\xad\x78\x09\xee\x9d\x98\x1f\x9c\xa1\x67\x2e\x44\x45\x4c\xea\x58\xba\x65\x86\x58\x6e\x0e\x00\xe3\xd5\x32\x28\xcd\x1e\x40\x45\x28\x20\x8d\x47\x2c\xd1\xcd\x78\x2c\x4e\x37\x6b\x28\xdf\xae\xf8\xbb\x52\xf2\xfb\x49\x8a\x4b\x1f\xf1\xca\x1d\x13\x9d\x40\x39\x27\x46\xda\x9e\xea\x7b\x47\x00\x1c\xe6\xa8\x46\xc8\x21\x35\x79\x3c\x4f\x7c\x7f\x1c\x81\x98\xa8\xa6\xab\x8c\x95\x50\x57\x27\x46\x85\xf3\x43\xe2\x07\xb7\x25\x5b\x04\x0d\x5f\x39\x6b\x47\xd2\xac\xb8\xc8\x47\xe9\x1f\xe9\x35\x0c\xf6\x13\x1f\xa9\x60\x2c\x1f\xe9\x59\xca\xa1\x8b\x4c\xf0\xf5\x0d\xe7\x17\x8e\xe9\x7f\xf2\x64\x08\xe4\x00\xee\x10\x6c\x22\xf3\xeb\x65\xa4\xda\xb3\x75\xda\xf9\xfa\x25\x6f\x31\xca\x87\xea\xd8\x8e\x7a\x16\x34\x4d\xef\xcb\x7b\xd8\x4f\x3c\x91\xbe\xa9\xa1\xf1\xc1\x26\xfd\xed\x5d\x0d\x80\x5e\xb2\xbb\x0c\x80\x6a\x94\x34\x00\x3e\x0f\x33\x97\x75\xea\x9b\x7b\xb0\x11\x7f\xb2\x20\x28\xca\x84\x82\x90\xdd\x29\xff\x11\xda\x30\xb6\xa3\xe2\x61\x27\x8b\x14\x17\x86\x3e\x30\x23\xe5\x92\x44\xce\xbc\x75\xac\xd7\x98\xcf\xd2\xde\x65\x44\x1c\xee\x89\xd7\xc9\xba\x73\x8b\x68\x31\x17\x9a\x3c\xde\x85\xe7\xd8\x29\xe8\xf2\x5a\x2d\x3d\xe4\xf1\x10\x5b\xb7\x37\x4a\x57\xb2\xbf\xaf\x1a\x42\xcb\xaa\xbd\x5c\x5a\xcc\xc1\xd4\xa0\x57\xc8\x7e\x03\x12\x68\x3f\x99\x5b\xa7\x4a\x0e\xc9\x7f\xfe\x13\x74\x8a\xb0\x82\xf1\x7f\x88\x34\x59\x7d\xbe\xcc\x4a\xbc\x05\xe4\x5d\x55\x9f\x55\x05\x1d\x97\xed\xea\x1c\x7d\xb3\xf4\x26\x93\x9f\x31\x81\x7d\xa6\xaa\x6d\x74\xd8\xc6\xc7\x16\xaa\x2c\x9f\x90\x27\x48\x56\x55\x6e\x6c\x7d\x0d\x1f\x4c\xba\x08\x79\x2c\x8f\x37\x21\xe9\xc8\x05\x85\xbb\x08\xed\xb3\xb2\x4c\xd4\x48\xd4\xd4\x3e\x61\x5f\xd5\xbc\x93\xc6\xa0\x42\xff\x80\xe0\x79\x49\x75\x2a\x09\xd5\xc2\x37\xa5\x98\x35\x2f\xb6\x09\xf3\x02\xc2\xef\xd5\x4c\x93\xd9\x10\xe1\x0b\x10\x3a\x5d\xa0\x79\x13\x72\x26\xb2\xd7\xf3\x6a\x85\x39\x2e\x3d\xf8\x9e\x4e\x69\x7b\x5f\x58\x02\x96\xc7\x8d\xb5\x41\xd0\x08\x7a\x62\xd1\xd5\x63\x58\x18\x19\x93\xeb\x0d\xcc\x19\x64\xbf\xc4\x51\x56\xb8\x9b\x4f\x14\x6d\x88\x0d\x00\x74\x3c\xb7\xb4\x09\x06\x49\xaf\xb7\xbd\x06\xd9\x90\x72\xa0\x45\x4e\x66\xcc\xee\x50\x0c\xee\x4e\x14\x52\x2c\x4b\x2c\xb4\xf7\x4d\x4c\xdd\x20\x5a\xd3\x16\x7c\x04\x24\x6d\xd7\x79\xd6\x68\xb1\xd1\x49\x3f\x41\xcb\x82\x2e\x1a\x28\x4c\x43\xe8\xb0\x4d\x65\x8b\x17\x64\x0b\xa3\x42\x1c\x9d\x66\x68\x92\xc9\x6c\x50\xab\xc7\x04\x67\x49\x9e\x04\xd3\xdc\x69\x01\xb6\xcd\xba\x5d\x73\xec\x84\xec\x7a\x77\x30\x76\x69\xa9\xb1\x7d\x00\x36\xe3\x64\x43\x8b\x02\x8e\xf2\xaa\x1c\x69\x47\x5f\x75\x17\x8a\x94\x59\x66\x15\x32\xf3\x60\x4d\x47\x24\xdf\x14\xc5\xf8\x1e\xd7\x6f\x98\x03\xbb\x3f\x3b\x16\xf0\x36\xd0\x25\xad\x27\x4e\x57\x85\xda\x7f\x53\xb3\xd6\x3f\x6d\x4f\x39\x3d\xc5\xbc\x2f\xa5\x1c\xa2\x6a\xb3\x3e\xd7\x6d\x14\x41\x1a\x10\x56\x57\xc1\x39\xdc\x69\x96\x06\x15\xff\x4e\x03\x25\xe9\x8a\x7c\xdd\x39\x8d\x78\xf2\xc9\x17\x9c\x3b\x54\x0d\x11\x13\xb8\x38\x85\xb7\xdd\xf5\xc4\x06\xa3\xc8\x7e\xcf\xab\x48\x2c\xaf\x86\x49\x19\xf5\xc9\xce\x44\xaf\x7b\x82\x48\x35\x50\x77\x1c\xf9\xe7\x10\xbd\xfb\x82\xc1\x3b\x22\x79\x6f\x98\xb8\x73\xce\x5a\xfb\xd3\x9d\x69\xeb\xe1\x1b\x5f\xb1\xac\x76\xe5\x5e\x89\x6d\x16\x10\x02\xe7\xeb\xf4\xed\x78\xac\x26\x78\x2e\x0f\xcc\xc1\x9a\xd6\x70\x65\x92\x89\x2d\x45\x11\x3a\x0f\xe7\xe3\xcc\x62\x9a\x98\x94\x46\x1d\x94\x5e\xdc\xe7\x6c\x90\xb0\xaa\x9d\xd5\x62\x2a\x0a\x7d\x32\x73\xaf\x52\x17\x51\x1c\xd0\x78\x5d\x42\x6c\x81\x8e\x96\x92\xe0\x4f\xe2\x32\x99\x3e\xf2\x86\x89\x77\x75\x8f\xa4\xdb\xd0\x5f\x79\xa3\x89\xf8\xde\x6d\xab\x6b\x77\x62\x48\xd2\x20\x03\xed\xcf\x32\xd0\x59\x35\x43\x93\x99\x07\x1b\x1b\xd7\xd1\xbb\xf3\x19\x62\x65\x88\xb8\x31\xa8\x81\xf5\x6e\x09\xc6\xb5\x3d\x82\x83\x8c\xa6\xb1\x3b\xab\xca\xe3\x6c\x9d\xcd\x59\xb3\xb5\x71\x31\x4a\x0f\xf3\xd7\x4b\xaa\x0b\x5b\x98\x6f\x8d\x46\x2a\xb7\xe3\x40\x45\xfa\x56\xb5\xfe\x86\xe5\x83\xc6\xf2\xcf\x97\x98\xe5\xde\xf7\x22\xe0\xff\x49\x63\x8e\xd8\xd2\xc0\x98\x7b\x2b\xe8\x6e\xd2\xe2\x2e\x61\xbe\x85\xff\xff\x42\x4c\xa2\x6b\xc3\x6d\xb7\x32\x7c\xa6\x47\x7c\x29\x97\xb6\x31\x49\x89\x3a\x60\x03\x1a\xdd\xf7\x3e\xc6\x8d\x26\x17\x0e\xe9\x7f\xf2\x64\x00\xe0\x00\xec\x00\x5c\xbe\xa7\x4d\x8c\xe1\xc6\xc0\x6f\x99\xf6\x02\x3d\xdc\x93\x29\xec\xbb\xb4\x77\xbe\x1b\xd4\x19\x5e\x27\x4d\x97\xba\xe0\xec\x26\x96\xab\xdb\x37\xf5\x59\xa7\x73\x30\x9a\xb3\xea\x9b\xfb\x31\x6e\x77\x68\xe0\xee\xcf\xc8\xdd\x4e\x20\x7b\x7d\xe4\xef\x4e\x0e\xd1\x36\xd8\x59\x1e\x25\x4d\x5a\x89\x87\xd5\x40\xfc\x3f\xdb\x53\xb9\xc7\x85\x33\xb9\x35\xe1\x33\xba\x77\x73\x26\x2d\x43\xd8\xa7\x73\x6f\xa6\xdf\x82\xdf\xcf\xd6\xcc\x3d\x67\xb7\x7a\x89\xf2\x1d\xe4\xed\x93\x6b\x4c\x9f\xee\x9b\xc9\x0c\x54\xfe\x3f\x37\x6b\xdf\xcb\x8b\x5b\x27\xed\xef\x8f\x92\xdf\x51\xca\xbe\x5f\x53\x87\xf9\xa7\xb7\x4f\xd8\x27\x33\x5b\x5e\x30\x14\x18\x6e\x45\x64\x44\x65\xd7\xcc\x96\x2f\x6a\xdf\x9d\x51\x77\x17\x58\x77\x96\xbd\xd9\xb2\x61\x4b\xad\x33\x1e\x30\x03\x5f\x24\x6b\x96\xa1\x83\x97\x62\x87\x9b\x7e\xf3\x45\x46\xa1\x9d\x34\x2e\x3b\x8b\xc5\x3d\x27\x3c\x7d\x46\x7e\xe7\x62\xb1\x53\x0a\xf5\xee\x85\x22\xc5\x8c\x6e\xa1\xe8\x75\x37\xe3\x29\x59\x10\x8b\x37\xce\xc3\x0a\xf8\x9a\x9f\x7a\x5b\xef\x42\x1e\x5c\x31\xf7\x01\x40\xe2\x4d\xc8\x5f\x8d\x09\x55\xbe\xa7\x66\xd3\xf1\xb6\x9e\x1e\x2c\xac\xcd\xd1\x99\x55\xf9\x22\xa2\x7c\x0c\xc4\x7a\x89\x0f\xfc\x51\x01\xc3\xab\xeb\xc9\x4a\xcb\xfb\x74\x52\xb1\x72\x42\x96\xd7\x00\xb3\x94\x2f\x6c\xa9\x0c\xab\x45\x6d\xe7\x08\x8d\x92\x78\x39\x23\x10\xc1\xf1\x1f\x7e\x5e\x73\x4a\xfe\x66\x64\xd3\x13\x6f\x33\x90\x0e\xd5\xf4\x50\x0d\xc8\x78\x54\x19\xd4\x19\x2c\x4f\x0f\xa0\x3b\xdf\x97\xa8\x8c\xbf\xc0\x65\x05\x31\x1d\x80\xbf\x3f\xff\x4c\xd6\x59\xc9\xe6\xe3\x87\xc7\xe2\x19\x0d\xa8\x4a\x58\xb0\x32\x57\xd3\x05\x05\x16\x38\xe0\x65\xff\x34\x27\xa7\x27\x0f\x27\xa3\xb8\x70\x39\xd7\xf6\x7b\x36\x44\x3e\x2c\xc4\x9d\x97\x2b\x6c\x4e\x82\x3b\x68\xbc\x2e\x60\xa5\x7e\xd8\x31\x78\xe7\xd0\x01\x2c\x72\xed\x65\x56\xb0\xff\x82\x72\x17\xf9\xf4\x21\xab\xda\x5a\xca\x43\xb5\xb0\x75\xa5\x5a\x88\x1b\x5d\xb4\xee\x71\xc8\x51\x88\xf7\x14\xb5\x12\x73\x58\xba\x9b\xda\xba\xb8\xf5\xe0\xe0\xc0\x19\xf0\x7c\x49\x43\xdc\xc4\xd3\x85\x28\xb8\x56\xac\x83\xbe\x95\x14\xb8\x3d\xc2\x45\x24\xbe\xc5\x57\x44\xe1\xf1\xc7\x0c\x6f\x9d\x76\x46\x68\xcb\x86\x15\xa6\xa5\xb6\xf6\xa1\x0e\x08\xb8\xfb\xf6\xd5\xf0\x8e\xd7\xd1\x96\x21\x70\x18\xbb\xa6\x79\x3b\x37\xd7\x5f\xf3\x6c\x45\x2d\xb6\xb8\x78\x8a\xdb\xce\xc9\xaa\xaa\xa9\x79\x7a\x11\xf1\x91\x43\xee\x77\x52\x4a\x50\xd7\x78\xd7\x00\x11\x7d\x37\x8a\x17\xaf\x53\xf2\xee\x9f\xff\xfa\x1d\x14\x8a\x32\x18\x0a\xa7\xa6\x1f\x93\x72\x37\xff\xab\x9a\x5c\xc8\x73\x52\xcc\x6b\x07\x8c\x2b\x59\x11\xa8\xa4\xc0\x20\xaa\x91\x7b\xd6\xa4\xd5\x6a\xb4\xe7\xcb\xc3\x94\xfc\xbd\xaa\x8a\xf8\xe3\x56\x83\x2e\x7d\x31\x63\xa0\xab\xfb\xe8\x91\x35\x2e\x64\x35\x8f\xf6\xe1\x4e\x67\xa1\x21\xac\xbc\xb4\xbf\x54\x77\x09\x5f\x0a\x1b\x0a\x27\x1d\x32\xf5\x1e\x0c\xbc\xf4\x59\xf1\x86\x1c\xb9\xd1\xd8\xf5\x28\x6a\x29\x76\x5a\x36\x95\xb5\x08\x20\x21\x33\xf1\xa0\xef\x8d\x0e\x80\x86\x30\x85\x2c\x61\x7e\xb0\x77\x23\x9c\xfc\x68\x91\xc7\x81\xe5\xe0\x16\xc4\x07\x29\xb0\xc1\xaa\x67\xa3\x33\x99\xdc\x66\x04\xed\x7c\x87\x83\x74\xb4\x8d\xcd\xf3\x76\x78\xe8\x6c\xca\x00\x3c\x4c\xdb\x04\x1e\x0e\x22\x41\xf7\x68\xe4\x32\xa4\xd5\x93\x41\xad\x6c\x5c\x7a\xab\x5e\x22\xfd\x5f\x93\x94\x18\xa8\xd9\x86\xda\x7b\xc3\x39\xba\x70\xbb\x34\x34\xa2\x90\x5d\xb7\xa1\x5a\x23\xdd\xf0\x3e\x55\x1f\x82\xb8\x55\xd5\x92\x7a\x17\x3d\xb6\xf0\xe4\x4d\x91\x2a\x15\xb6\x5b\x65\xdd\xfe\x63\x82\xd6\xc8\xb6\xb3\x1b\x85\xef\x18\x5c\xfd\xe3\xa4\x8b\x92\x91\xb5\x3c\x44\x2f\xb8\xca\xd2\x42\xaa\xff\x42\xcb\x1b\xc5\x0e\x1a\xec\x64\xd4\x05\x10\x5f\x79\xb2\x89\x71\xb3\x33\xf6\x03\x06\xb1\xd8\x1d\xc9\x2d\x78\x4a\x91\x54\x3b\xf5\x37\x8e\x7c\x60\x99\x12\xc3\x04\x56\x29\x8a\xe6\x64\x72\x97\x43\x77\xd8\xe6\x74\xd3\x01\xa6\xf9\x96\x68\x75\x98\xea\x74\xd3\x04\x5a\x29\xbc\xfa\x8d\xd9\x80\x46\x4f\x86\x34\xb2\x11\x8a\x62\xc3\x16\x43\xc0\xbc\x26\x09\xc1\x51\x13\x8f\xab\xe9\x8d\xa7\xeb\x42\x8f\xc2\xbe\x1e\x45\x3f\xfe\x2e\x4c\x79\x54\x7b\xe2\xf8\xb2\x45\x4a\x50\xfb\x8c\x7c\x3c\x01\x32\xc8\xea\xeb\x11\xe1\x3b\xfd\x8b\x59\x0d\xba\x31\x1a\xb2\x2c\xf4\x70\x89\xf7\xa9\x64\x64\xf8\x70\x80\xeb\x51\xc7\x50\x6c\x21\xec\xad\xc6\x0f\x03\x06\x32\x33\x28\x47\x88\x8a\x5d\x44\x14\x24\xaf\x7e\xbf\xa4\xcd\x1b\x59\x52\x36\xf6\x61\xc5\xa7\x0c\xa7\x4f\xa0\x7a\x47\x66\x5f\xc6\x07\x6b\x51\x40\x66\xee\x7f\x3f\x43\xf0\x89\xde\xea\x3e\xf9\x6f\x6e\x3e\x4e\xf5\xba\x7e\x3d\x8e\x77\x8c\xa5\x11\x12\x77\x76\xf6\x86\x7b\x7e\x76\x01\xfe\x5a\x54\x09\x18\xe6\xb0\x73\x32\x7c\x77\xe4\x44\xde\xc6\xa2\x22\xf5\x2b\x58\x51\x45\x68\xc9\xad\x8c\x41\xd0\x53\xdf\xe1\x62\x8f\x3a\x8a\x0b\x86\x25\x14\x10\xb9\x9e\x36\x50\x62\x06\xa5\x72\xcb\x5a\x6c\xac\xa9\xe2\x75\xf5\x02\x97\xca\x79\x89\xc4\x05\x83\x54\x85\xc8\xc5\x18\xf0\x00\x05\x13\x33\x3a\xd9\x66\xe7\xce\x54\x90\x6d\xf2\x28\xfa\x74\x7c\xb8\xe7\x0a\xc0\x18\xc4\x90\xe2\x29\x38\x51\x19\x6f\xfa\x21\x20\x28\xbd\x10\xdf\xe8\x14\x84\x97\x5b\xf4\x23\xfe\x0f\x59\x9d\xad\xe0\x79\xad\x29\xc9\xd6\xeb\x1a\xca\x38\xc0\x58\x9d\x9e\xf0\x29\x79\xa3\x6f\x8c\x84\x19\xc0\x5d\x91\x38\x89\x2b\x2a\x77\xd4\x54\x0f\x07\x22\xa6\x28\xd6\x75\xd5\x54\xf3\xaa\xc0\xbd\x94\x4c\xa5\x12\xc3\x09\xca\x6c\x08\x0c\x41\x78\xb5\x68\x36\x59\x6d\x6c\x38\xa0\x88\x97\xe7\x60\xf6\xc0\x10\x73\x5e\xd5\x35\xe5\xeb\xaa\x84\x37\x91\x2b\xf9\x8e\xeb\xba\xae\xd6\x15\xbc\x93\x06\x0f\xf6\x70\x52\xb7\x25\x94\x23\x89\x8f\x69\x5d\x98\x8b\x0c\x01\x2e\x30\x4d\x4c\x08\x32\x4c\x78\xf7\xec\xa2\x82\x6e\x02\xf2\xbc\x09\x12\x13\xb4\xcc\xd1\xc8\xbf\x91\x29\xcc\x71\x40\x33\xfd\x5e\x31\xe4\x20\xae\x83\xf2\x0b\xb0\x18\x59\x51\x60\x73\x32\xeb\x38\x8f\x76\x7a\xc2\xc7\x9e\x66\x41\xef\x55\xe4\xe9\xe9\x24\x9c\x5f\x23\x8d\xc7\xe9\x24\xb5\xf5\x26\xf4\x32\xf3\xd2\xbc\x5e\xd6\x4b\x79\xe6\x58\xcd\xc9\x4a\x7b\x5a\x71\x4b\x69\x96\x95\xdb\x64\x35\x14\x34\x6b\x95\xd5\x0e\x1f\x99\xdd\xfa\x7c\x5f\x74\x3c\xeb\x1c\x9b\xd2\x33\x2c\x2d\xc6\x3d\xe6\x41\xfa\xab\xfe\x02\xb4\x1c\x32\xdd\x0d\x59\x51\xda\x38\xc7\xfe\xac\x27\x32\xf9\x28\xb2\xfa\x3f\xb8\xf9\xc9\xc7\x18\xc1\x52\x47\x17\x63\x67\x2e\x09\x21\xbe\xb8\xab\xc7\x90\xe0\x9c\x66\xc9\x8a\x40\xda\xd5\x5f\xe1\x74\x48\xc6\x82\x9c\xe5\x6f\xca\xfc\x8c\xc2\xa3\x05\xfd\xa1\xa6\xf5\x71\xca\xbb\x8f\x9c\x6a\x75\x3d\xc2\x08\x47\x91\x9c\x03\xa0\xf7\x39\x54\xc0\x4d\xb5\x0d\x10\xb3\xc8\x17\xd9\xfc\x4b\xc4\x2c\x27\x56\x2b\xf5\x37\x40\x2c\xb9\x8f\xde\x31\x85\x20\x24\xe9\x9f\x6e\x22\x3a\xba\x1e\x45\x3f\x56\x0f\xfd\xca\xb9\x61\x22\xdb\x4a\x63\x13\xfa\xef\x56\xbc\x3c\xab\x8e\x7d\xb1\x1a\x13\x17\x48\x9d\x24\x54\xab\x88\x03\x12\xd0\x8b\xaa\x9e\xc7\x13\xe4\xf6\xdf\x60\x7a\xd1\xe8\xa1\x47\x58\x52\x33\x55\xde\x80\xe3\x0c\x68\xdf\x53\xbe\x3c\x27\xf7\xb1\x60\xa2\x2b\x35\xdb\xa5\xbd\x9a\xd9\x7f\xe4\x53\xee\x66\x1b\x50\x5d\xc4\x6c\x99\x18\x55\xc1\x82\x24\x73\x2e\x6a\x8e\xc2\xbc\x7d\xaa\x44\x59\xd7\x3b\x4d\x97\xa0\xb2\xf9\x61\x5b\x20\x7c\xbd\xc1\x4b\x2a\x80\x51\xca\x91\x32\x26\x03\x22\x98\x6e\xcc\x3a\x26\x76\x7f\x36\x20\x16\x0f\x27\xcd\x40\x7a\x0a\xbd\x89\x89\xa1\x46\xa0\xc3\x10\xfc\x09\xa1\xbb\xcd\x62\xcf\x17\xf2\x5e\x0c\xeb\x0c\x2c\x14\xfb\xc0\x8c\x21\x1c\xf5\xf0\xac\xf5\x24\xbf\xe1\xa8\xd9\xe3\xf6\xff\x00\x14\xb3\x03\xec\xfb\xf5\xd2\x2b\x5e\xa1\xd3\x99\x38\xd5\x3a\x70\x7e\x11\x1f\x60\x9e\x15\xf3\xb6\xc8\x1a\xea\xba\x7a\xaa\x76\x14\x9d\xc6\x69\xd4\x7b\xec\x0f\x95\xcd\x4f\xfa\x47\x98\xed\x71\x56\x14\xe9\x4d\x4e\x10\xf8\xc8\xe6\xbe\x2e\x68\x36\xd3\x06\x58\x17\x19\x78\xed\x55\xd9\xe1\x64\x79\xc5\x58\xfa\x60\x15\x4c\x52\x3e\x13\x7f\x49\x1b\x2e\xf6\x8b\x6b\xa8\x04\x76\xb1\x59\x67\xdb\xaa\x6d\xf6\xc8\x66\xc9\xe6\x4b\x70\xf2\xf9\xba\x60\x4e\x5c\x44\x2e\x68\xb3\x81\xb0\x46\xc5\x03\x3c\xca\x2d\xb5\x07\x9b\x30\xd3\x0e\x44\x08\x6c\x34\x2e\x2a\x10\x04\x4c\x41\x29\xe1\x5d\x05\xc7\x12\x00\x56\xc2\x2b\x84\x5b\xde\xdc\x89\x02\xa0\x73\x70\xe0\x64\xf5\x07\xff\x90\xb1\x9c\xd0\x2b\xf0\xcb\x55\x01\x07\x6f\x57\x6a\xc6\x12\x00\x42\x0d\xa2\x98\x75\xb6\x45\x28\xd1\x33\xd3\x37\x0f\x4f\xe0\xac\x88\x1c\x1a\x93\xe1\x91\xe7\xfb\x9c\xe7\xf2\x7e\x65\x78\x93\xf8\x4e\x2f\xe6\xc9\x5e\xe9\x27\xf3\xe4\xf7\x93\xd1\x0e\x39\x0f\x81\x75\x6d\x12\x1f\x7e\x4a\x03\xa2\x50\xa5\x61\xd6\x9a\x0d\xe9\x52\x87\x85\xc0\x00\xea\xc8\xa4\x03\x06\x26\x2f\xd5\xe1\xe3\x3c\x2b\x28\x84\x8b\x70\xa2\xee\x27\xb5\x95\x7d\x4d\x66\xfe\x23\x97\xb0\xa4\x83\x8f\x9e\x78\x7e\x56\x42\x3b\x03\x11\x4b\xae\xeb\x3a\x5a\x02\x0e\xd2\x1c\x43\x83\x38\xd5\xbb\x72\x97\x80\xc6\x67\xf2\xf3\xcf\x21\x53\x71\x81\x8f\x0d\x22\x44\x80\x7c\x13\x37\x0a\xb2\xb2\xa5\x31\x73\x6e\x93\x04\x47\x89\x22\x27\x14\x59\x08\xce\x07\xa1\xcc\xe4\xc7\x3e\x92\x7c\xc2\x24\xeb\x41\x84\x00\x0e\x1a\x1e\x56\xa0\x72\x2c\xe2\xef\xa9\x04\x04\xff\xce\xe2\xe0\x33\x43\x41\x32\x73\xe9\x69\xad\x23\xbd\x0c\xec\x71\x8d\x15\x37\xff\xf3\x9f\xc8\xa8\x43\x18\x6d\xe4\x7f\x87\xea\x01\x7f\xa8\x28\xe6\x2e\xd4\x21\xb8\x00\x8b\xc1\x52\x38\xb6\xbc\x42\xf4\xc4\x87\xc1\xc9\x1b\xf5\x57\x5f\x50\x2b\x09\x00\x7b\x7e\x9e\xf1\xd9\x07\x83\xe2\xd5\x4e\xda\x28\x86\xc6\xc9\xb3\x9b\xce\xaf\x8f\x1d\xa2\x8d\x46\xf7\x1b\x9c\xdc\x75\x60\xf2\x1d\xee\xdf\x46\x82\x91\xb8\xa4\x77\x8b\x90\x12\xa3\x63\x67\x69\xc0\x8a\xee\x6a\x61\xe8\x15\x1e\xc3\xc6\xfc\x38\x1f\x45\x40\xaa\xf9\xaa\x29\x19\xd6\xf7\x3a\xd0\x83\xd4\xc5\x50\x20\x36\xc2\xd0\x89\x47\x90\x1c\xa8\x08\xd1\x81\x43\x8d\xe8\xd7\x8a\x28\xa0\x38\xb6\x50\x76\x08\x09\x89\xe0\x58\xdd\x0f\x9c\xcc\xdb\x66\xe4\x35\x57\x52\x62\x24\x49\x12\xc9\xa2\x73\xc4\x70\x97\xe6\x26\x00\x56\x5e\xca\x1e\xc7\x6d\x33\xd1\x71\x6d\x74\x18\xf8\x67\x59\x93\x58\x64\xe7\xa0\x10\x8b\xe7\x6e\x8f\x63\x9c\xfe\xd7\x43\xa5\x67\x70\x08\xef\x86\xef\x96\x37\x7b\x8b\xd8\x3d\x86\xc8\x64\x97\x40\x15\x0b\xaa\x7b\x28\x9f\x20\x51\x57\x64\x69\x6f\x40\x79\xd0\xe2\xc0\x46\x03\x38\xc0\xd0\xb2\xec\x40\x79\xeb\xc2\x90\x4e\x62\x2b\xa2\x46\xe0\x27\x08\x0a\xf6\xef\x44\xd2\xcd\x28\x17\x8e\x04\x37\x7f\xa8\xa4\x87\x4b\xea\x58\xc5\xbb\xfa\x63\xa1\xd4\xc3\x1f\x0b\xcb\xc9\x6e\x11\xbf\xe2\x89\x05\x21\x04\x30\xea\xe0\x03\xd6\x4c\x69\x53\x2b\xa9\xaa\xc8\x67\xdb\xa9\xc9\x28\x02\x62\x58\xf4\x2c\x53\x4a\x98\x42\xc2\xd8\x54\x12\xce\xac\x1b\x00\x49\x5d\xd2\x4d\x6b\x9e\x7c\xde\x45\xac\xd7\x62\xd3\xce\xe4\x24\x20\xb3\xaa\x0e\x6e\xdb\x55\xc2\xe1\x96\xa1\x03\x4a\x54\x4e\x9b\x7a\x6a\xab\x78\xba\xc8\x38\x6e\x7a\x88\x32\xe8\x45\x5b\x14\x5b\x9d\xee\x72\xe0\xe9\x62\x18\x95\x35\x96\x85\xc6\x0b\x26\x68\x72\xb1\x85\x5d\x4e\xf8\xb6\x59\x56\x5c\xd3\x40\x5f\xfd\x69\xa7\x82\xd5\x3d\x30\x76\x84\x0b\x93\xc2\x45\xe7\x8e\x77\xe0\xfe\x34\x57\x1f\x68\x26\x4f\xb3\x09\x7f\x98\x94\xed\xea\x02\xf7\xe2\xc5\x44\x35\xef\xb6\x6e\xa0\xa2\x23\xd2\x20\x18\xfd\x4e\xea\x5f\x22\xbb\x37\x41\x42\x72\x58\xb0\x12\x74\xeb\x37\x89\x36\x96\x7d\x36\xb1\x7f\xbc\xc9\x30\x7b\x86\x2e\x9b\x6f\xcd\x3a\xe0\x07\x4b\xfe\x00\x5c\x26\x3d\x06\x2d\x45\xc5\xb0\x4c\x6d\x20\x15\x55\x6a\x7a\x67\x3a\x06\x23\x0e\xa4\xa3\x1a\x30\x58\x17\x3a\x46\xe8\xa7\x64\x88\xcd\x4d\x29\x19\x49\x80\xef\x44\x4a\xb8\xa2\x78\x57\x5a\x86\x63\xee\x44\x4c\x98\x76\x2f\x35\x51\x7e\xfb\x49\x19\x41\x26\x46\xcb\xe0\x23\xb5\x9b\xa2\x0c\x99\xf6\x9c\xf8\x0f\xca\xda\xa5\x96\xc3\xef\x38\xe8\xfc\x2e\x6c\xad\xef\xfb\x06\x76\x23\x8e\x3b\x70\x24\xab\xbf\xa0\x2f\x67\x3c\x08\xe4\x86\x7e\x29\x70\x68\xb4\xbd\x9b\xa9\x0e\xe3\x06\x7b\xc6\x29\x1d\x19\x10\x38\x74\xe3\x34\x19\x1c\x41\x24\x2c\x7a\x7a\x84\x40\x77\xfa\x91\x89\x63\x73\x3d\x1a\x4a\x72\xa3\xe0\xb7\x21\x79\x9f\x91\xdf\x95\xe8\x01\x56\xc3\x89\xae\x50\xe9\x25\xbb\x19\xa3\x97\xec\x21\x3a\xb7\x25\x7b\x68\x03\x6f\x41\xf7\x8e\x15\x61\x57\xc2\x47\x6c\x73\x12\xa1\x34\x95\xfa\x48\x9f\x5a\x2a\x06\x60\xb3\x13\xe1\xa1\x62\xa4\xbd\x10\x6f\xe1\xb9\xc5\x28\xc6\x46\x59\x5b\xb3\xe0\x3f\xa3\xbb\x8c\xa9\x4d\x56\x27\x76\x6c\xbe\x23\xe3\x3d\xa4\x82\x3f\x4e\x9d\xfe\x7e\x91\x7b\xcf\x12\xd4\xbe\x57\x4a\x7f\x17\x54\xb6\x3e\x1b\x4e\x66\x71\x1a\x9c\x63\x31\x1f\xd2\xc1\x10\x66\xc1\x68\x91\xc3\x19\x5d\xa6\x1f\x0f\x6c\xf9\xb0\x62\xc1\x01\xd8\xc4\x2e\xad\x73\x31\x14\x16\xe5\x3d\xdd\xbc\x85\x21\xc6\xd6\x56\x58\xea\x3d\x84\x73\xd3\x64\x3c\xd9\xb3\x73\xca\x72\xe7\x2d\xda\xcf\xdf\x9e\x9b\x8c\x22\xe8\xa8\x10\x9d\xd3\x06\x4b\x15\x05\x53\xce\xac\xba\x47\x38\xf5\x4b\x37\xf6\x27\xc1\x3e\xe9\xb0\x73\xbc\x1e\x14\xe1\xed\x59\x2f\x4b\xbc\x98\x92\x87\xa7\x25\xd6\xfa\x5a\x01\x35\x90\xdc\x7b\x68\xc1\x75\xf8\x23\x73\x5f\x85\x73\x11\x4e\xa3\x8b\x41\x9c\x2b\x08\x03\xc9\xc1\xc7\x25\xdd\xa8\x9f\xa7\x3e\x84\x28\x4d\x21\x07\x74\xbc\xcc\xca\x4b\x59\x17\x9e\xae\x7e\x90\x65\xab\xee\x06\x35\xb9\xf4\xd3\x49\xba\x68\x81\xab\x12\x98\x05\xc3\xe2\x60\x61\x20\xb9\x3c\xe4\x9c\xe5\x39\x69\xd7\x90\x88\x39\x8a\x71\xf7\xcc\xda\x86\x45\x8e\x02\x5a\xb7\xe1\xa5\xd9\xe5\xbe\x29\x17\x9d\x5f\xa1\x5c\x41\x4a\x36\x00\x56\xf8\x44\xb4\x09\x1a\x82\x79\x98\x12\x81\x39\x99\x59\x33\x86\x7f\x9b\x25\x2b\xa8\xa8\xa4\x85\xb3\xe1\xa2\xcd\xf8\xc5\x24\x91\x12\x12\x94\x45\xd1\xb0\xf6\xa8\xad\xea\x84\x5f\x19\xe7\xac\xbc\x54\x1b\x76\xb2\x83\x75\x67\x30\xf0\xe0\xe1\x64\xe4\xc1\xb6\xa6\x42\x66\xf6\x2f\x8f\xa5\x3c\x04\xed\x61\x58\xd8\x2c\x86\xff\x3d\x46\xac\x8f\x26\x5d\x04\x93\x17\xa5\x8d\x3b\x47\x16\x67\xe3\xbb\xee\x29\xc3\xad\x22\x14\x30\x5f\x92\x5c\x86\x4d\x46\x7d\x7a\x67\x6f\xf6\xdb\x44\x8d\x6b\x9a\x23\x93\xba\xed\xd4\x74\x9b\x8c\x22\x53\xf7\xd5\x6b\xc9\x2e\x97\x94\x07\xe5\x62\x42\xb7\x90\x45\x60\xe4\x2f\xbd\x14\x6a\x98\xe8\xf5\xee\x43\x89\x69\x51\xb4\x80\x5f\x68\x13\x7e\x23\x65\xf2\xe5\xf3\xc1\x5a\x84\xfd\xc8\x6b\xec\x38\x3e\x9c\x4c\xc9\x43\xf5\xa9\x37\xa7\xe8\x6d\x06\xff\x45\xeb\xaa\x53\xb1\x3a\xaa\x79\x8a\x2a\xcb\x5f\xc9\x81\xa3\x05\x3c\xd8\x3e\x36\xed\xc9\xd0\x31\x78\x76\x45\xc7\x66\xa6\xb0\x7c\xed\x32\x4a\x54\x72\x62\x4d\xad\x31\xa6\x16\x65\x77\x92\x21\x6d\xaa\xd1\x65\x37\x5e\x14\xa6\xe4\xbd\x1a\xae\x03\xb3\x3b\xef\x6c\x63\x1a\x57\xc2\x58\x69\x7c\x2e\x2d\x67\x42\xba\xb2\xda\x3d\xfd\xf2\xde\x34\xd4\x17\xcb\x41\x45\x54\xd0\x0b\xee\x48\xa7\xeb\x86\x64\xa5\xde\xc3\xb2\x85\xd5\xba\xd7\xb4\xaa\x2d\x58\x59\x51\xd3\x2c\xdf\xc2\x3b\xc8\xea\x8e\x1c\xba\x41\x63\xa6\xc6\x52\x5b\x03\xee\x85\x21\x17\x2d\x56\xfd\xcd\xe5\x23\x49\x8a\x34\x78\xa5\x89\x43\x1e\xa7\xe2\x20\xd4\x1b\x44\x8c\x55\xe5\x0d\x16\x22\xbb\xf4\x4a\xf7\x4a\xa5\x8c\x52\xb6\x51\x5b\xda\xd7\x6a\x65\x38\x9a\x90\x47\x8f\x82\xd5\x22\x34\x99\xae\xd9\x3c\x2d\xaf\xb2\x82\xe5\xd6\x0a\x10\x74\x98\xdc\x46\x27\x3d\x5a\x0c\x50\x4e\x9f\xb6\x93\xa1\xe3\x29\xfd\x94\xdd\x7a\xd5\x33\x1c\xa7\x4b\x43\xfd\xd6\xbb\x98\x79\x47\x43\xc5\xa2\x46\x36\x94\x7e\x29\xb6\xda\x7d\xaa\xe0\x3e\x23\xba\x21\x57\x59\xd1\x46\xe5\xee\xad\xe7\x03\x4b\x81\x53\x4e\x33\x5e\x9f\x46\xbe\xf5\x91\xca\x77\xa5\xe5\xd2\x26\x7f\x8e\x4f\xff\x77\x81\x28\x0e\x6a\x0d\xa9\x7f\x4c\xcc\x59\x5d\x0a\x36\xcf\x8a\x02\xb4\x17\xee\x52\xaa\xc8\x5c\x10\x42\xd0\x21\x72\x22\x1c\x8d\x10\xea\x65\x55\xeb\x42\x21\xb8\x78\x68\xeb\x19\x26\xf0\x88\x79\x8c\x54\xc7\x6d\xf3\x41\xc3\x96\x74\x72\x3e\x4a\x91\x2b\xb9\xb2\x39\xbd\x31\xcb\xf2\xe8\x51\xf8\xcd\x2b\x70\x51\xa6\x01\x04\xf8\xf7\xf0\xb8\x6d\x62\xb7\xf9\xa8\x74\xb3\xbc\xc8\xe7\xc8\xbb\x4f\xf5\x7a\xd4\xc7\xcf\x44\xd5\x05\x99\x05\xd8\xc5\xb9\x8b\x7d\xab\xda\x69\x3b\xf6\x3b\x4f\x77\x18\xdb\x96\x07\x6b\x12\xc0\xdb\x37\x25\x32\x11\xdc\x18\x10\x0c\x29\x17\x8b\xb6\x14\x2b\x09\x08\x88\x3a\x01\x8b\xfa\xf0\x5e\x99\x61\xe8\x7e\xda\xe0\x05\x63\x18\x8d\xa8\xcb\xda\xd4\xde\x29\x2e\x12\xca\xc4\xcb\x37\x39\xc2\x27\x39\xd0\x22\xe8\x4b\xdf\x40\x6c\xb2\x3c\xb7\x36\x4b\xcd\x53\xd0\xea\x1c\x9b\x30\x62\x7b\xa4\xa4\xcd\xa6\xaa\x61\xf6\x78\xf6\xda\xb4\x33\x5f\xfd\x42\xb7\xe6\x63\x74\xc5\x9c\xcf\xbc\x4c\x6c\xfc\xd2\x43\x75\x47\x1c\x70\x9a\xd6\x96\x60\x16\xe6\x40\x01\x1e\x38\x24\x33\x1f\xa4\xca\x32\x1a\xa6\x43\xaf\x92\x8a\x1d\x61\xa8\xa6\xc2\x9b\xf8\xbc\x39\x33\x7d\x6e\x0f\xfe\x1b\x9d\x6e\xf0\x51\x30\x73\xe7\x57\x97\x00\xe6\xe7\x08\x11\x5e\x3d\xf1\x3e\xb2\xec\x31\x30\xbf\x84\x75\xd0\x72\x45\xaa\xc8\x8d\x78\x09\x29\xe5\x9f\x58\xfe\x99\xbc\x7a\xf2\x40\x91\xc0\x81\x8c\x97\xd6\x19\x81\x43\x92\xfb\x8f\xbd\xb8\xe6\x27\xf1\xe0\xcb\x28\xb8\x0a\xcf\x22\xb5\x04\x8c\xa4\x9e\xf8\xda\x71\x86\xa2\xcf\x51\xf6\x8d\x19\x84\xf4\xb2\xfd\x88\x8b\x76\xca\xcc\x5d\x7c\x0e\x72\x1a\x24\x18\x15\xa5\x32\x99\x05\x50\x4d\x4d\xd2\x10\x34\x91\xd6\x8e\x3e\x28\x35\xb4\x4d\xc4\x38\x7e\x89\xa1\xfe\xde\x93\x51\x93\x60\xba\x69\x89\x42\xf4\xfe\x6c\x2b\x6f\x05\x42\x4a\x1e\xa4\x5c\x9d\xe0\x4d\x4c\xcb\xb4\x18\x4a\x00\x25\xb3\x52\xb8\xa2\x9c\x0b\xc9\x32\x26\xb8\x0f\x95\x9b\x1f\x99\x15\x37\x8f\x63\x31\xc8\xe0\xe3\xc3\x4a\x37\xbb\x9f\x03\x1d\x36\x6d\xb6\x88\x88\x34\xe3\x91\xf7\x42\x41\x67\xe3\x34\xb1\xf0\xd0\x80\x4f\x4f\x8e\xc1\x15\xa3\x35\x99\xf5\x7c\xff\x18\xdf\xfc\x19\x1f\xf5\x80\xe4\x9f\x62\x9f\x6a\x40\x52\xaf\x51\xc9\x34\xd1\x50\xa4\x2c\xd0\xe1\x8a\x27\xba\x0c\xdb\x70\xea\xc4\x60\x32\xea\x54\x7a\x3d\xa0\xd0\xfb\x4e\x48\xe6\xfd\x23\xab\x59\xc4\x52\x24\x2e\xd4\xa8\x48\x55\xea\x9b\x34\x01\x82\x0e\xd5\xac\x2b\x4a\xa5\xa4\x8f\xa1\x0a\x17\x76\x35\x26\xc2\xc1\x0c\x74\x31\x72\x63\xe9\x23\xf3\xb5\xa5\xe9\xa1\xd3\x14\xd1\x0a\x80\x65\xce\x74\x3f\x10\x67\xba\x43\x3f\xe9\xe1\x47\xd7\x9a\x9d\x9e\x90\xbc\xa2\xa0\x94\x0d\xa1\x5f\x19\xd7\x21\xa4\x9c\xcd\xc3\x51\x98\x44\x45\xfa\x3f\xea\xc5\x01\x2e\x24\xb4\x26\xe4\x10\xf8\xc7\xe0\x0f\xdc\x9f\x0e\x32\xfb\x0e\x1d\x15\x53\xb9\x8e\x2b\x1a\x39\x2d\x17\x15\x51\xcd\xad\x3f\x07\x86\x69\xff\x80\x33\x64\x60\x6a\xea\x3a\xdb\x3a\xb1\xb6\xbe\x00\x03\xc2\x57\x7d\xd1\x44\x22\x87\xaf\x42\xec\x58\x97\xac\x0e\xaf\x5a\x70\xaf\xee\xc7\x3d\x93\xc7\xe6\x00\xa1\x06\x9a\x18\x4f\xad\x08\x97\xb4\xf9\x80\xe3\xe8\x12\xb6\x29\xf9\x24\xc5\xe3\xb3\x25\x03\x90\xd4\x54\x18\x41\x4b\x6e\xb5\x9a\x91\x4f\x9f\x47\xa3\x78\xcd\x5b\x4f\xb9\x9c\x27\x65\x77\x57\x04\x07\x89\x69\x71\x93\xc8\xbc\x2a\x39\xcb\xc5\xe5\x2b\x0a\xff\x3d\x95\x1a\x15\x67\xe1\x2a\x49\xd1\x24\x29\xf1\x54\x3a\xac\x28\x3a\x37\x81\x66\xd4\x1f\xf1\x8d\x59\x6f\xb8\x84\x9a\x69\x8b\x5b\x2d\xc8\xa1\xb9\x78\xb5\xa4\xfa\xda\x78\xde\xd4\x6c\x0e\xb7\xf3\x07\x59\x33\xd2\xa4\x66\xe0\x0c\x0c\xd7\xe2\xc1\xca\xf3\x67\xac\x52\x0e\x22\x2e\x2f\xe1\xaf\xc2\x17\xb8\xc5\xf7\xb3\xf5\x9a\x96\x86\x5f\xaa\x91\xab\xec\xd7\x23\x5f\xef\x1d\x20\xbe\xd1\x0c\xf4\xcf\x2e\x67\x74\x54\x10\xb7\xff\x75\xcf\x7f\x95\xc5\xd6\xd6\x2b\x50\xb3\x75\x56\x37\x6c\xce\xd6\x22\xf6\x51\x86\xc9\xb9\xe3\x57\x77\xc7\x8d\x39\x75\xb5\xb1\x60\x2c\x13\x97\x0b\xc7\xb0\xc9\xe6\x0d\xbb\x32\x31\x8f\xb3\x42\x73\x5f\x23\x41\xd2\x87\xe8\x23\xd7\xed\xfe\x3b\x6a\xa3\xa2\x5c\xaf\x2e\x7e\x47\xfa\x17\x29\x5b\xda\x51\xfb\x2c\x05\x72\x6a\x1e\x30\xb4\xbb\xa1\x9e\x59\x92\x70\x53\x2d\xb3\x40\xec\xa4\x63\x44\x2b\x99\x60\x1d\xe4\x74\xe0\x6a\xa8\x82\xd1\xdc\x97\xeb\x6e\x89\x46\x3c\x22\x32\x06\x53\x91\x1b\x79\x51\xcc\x4c\xfa\x2d\x7e\x96\xca\xd4\xb2\xa3\x40\x65\xa5\x59\x1f\x8d\x99\xb7\xb6\xcc\xfa\x17\xcd\x61\xc6\x33\xe2\x6f\xe1\xae\xe1\xb7\x51\x52\xc9\x44\x14\x91\x54\x2b\x8f\x5a\x96\x9c\xc0\x8f\xef\xda\xa2\xf0\xd1\x1a\x4f\xee\x8c\x6a\x51\x6a\xed\x6b\xb8\xe7\x78\xac\x5c\x57\x74\xb8\x81\x08\x00\x00\x7b\xe8\xde\xd0\x3e\x94\xb2\xf7\x4b\x56\xdd\x4f\xd8\xd5\x76\x45\x66\x03\x49\x3b\xba\x7d\x09\xe9\x6d\x4a\x47\x15\x0c\xf8\x0b\xa4\x9f\x89\xff\xda\x85\x9b\xba\x71\x87\x6c\x58\xf2\x61\xc9\x16\x6f\x57\x77\x23\x39\xf7\x2a\x2b\x58\xa4\xf3\x67\x09\x8a\x86\x38\xeb\x33\xed\xdf\xbf\xa8\x44\xb1\xde\x49\x3e\xf4\xd1\x56\x10\x05\xea\x6f\x0f\x57\x8b\x1e\x03\x0b\x82\xf1\x46\xb6\x80\x32\x13\x92\x59\x3d\x21\x08\x86\x6e\xb0\xc0\x6c\xc9\x66\x09\x59\x22\x81\xb0\xba\x94\x4f\xc8\x4c\x44\x5e\x00\xa8\x7b\xeb\x1a\xba\x01\x98\x00\x94\x43\x70\x13\x73\xd5\x90\xb5\x96\xf7\x50\xa9\x60\xd0\x8d\xbf\x35\xd8\x73\xdd\x1d\xfc\xbe\x39\xac\xbc\xfa\x7e\x13\xbc\x3a\xc5\xa3\x81\xba\x50\xc4\x24\xe8\x08\xec\x7b\x1a\x90\x55\x89\xa9\x6f\x7b\x8d\xe5\xf2\xad\x0b\xf0\x2d\xe1\x9d\x7a\x38\x36\x55\x95\xd4\xd1\x86\xc4\x8d\x2e\xbe\x06\x58\x77\xbb\x60\x21\x80\xfa\xc1\x53\x09\x9c\xd6\x4c\x6d\xfb\xf7\x45\x0d\x96\xd6\xa1\xaa\x58\xba\xc2\x16\x8a\x4c\xaf\xd5\xf8\x9e\x60\xa3\x48\xe1\x97\x31\xa1\x33\x30\x66\x1a\xa9\xc3\x49\x1c\xcc\x51\x0c\x00\x7e\x27\x11\x49\x1a\xb6\xb8\x49\x03\x67\x5f\xbe\xe7\x71\xe1\xbe\x7d\x88\x02\xad\xe1\x5c\xd0\x85\xd8\x75\x36\x65\x7d\x66\xc9\xbc\xd8\x7a\xe6\x8c\xd0\xaf\x73\x11\x07\x1a\x71\xd1\x80\xb0\x15\xec\x9f\xe8\x4d\x7f\xe8\x0d\xee\x21\x69\xd8\x8a\xf2\xe4\x20\x81\x35\xd5\x40\xcf\x30\x69\x5c\xb2\x42\xbf\xae\x51\x15\x8e\x70\x9b\xe4\x8c\xf2\x9f\x91\x5f\x3e\x42\x31\x5b\x7c\xa6\x5e\x54\x8d\x3c\xaf\x99\xb4\xc5\x3f\xdf\x81\x31\x66\x0b\xa7\x58\x4a\x09\x6b\x6a\x93\x77\x62\x27\x1f\x81\xa2\x9f\x3d\x39\x52\x78\x68\x49\x1f\xec\x0f\xf8\x50\x90\x6c\x55\x6d\x76\x40\x2c\x04\x87\x6b\x13\x56\x9a\xea\xc6\x01\xba\x8a\x4f\x33\x0f\xf1\x1f\x91\x5d\xa3\xd4\x89\x6c\x0b\xb1\xd7\x33\xcd\x6e\x97\x1e\x96\xfe\xf4\xd4\x8e\x62\x2b\x05\xe6\x49\x6c\x9c\x51\xa4\x2f\xf6\x53\xef\xbb\xe0\x37\xa0\x9a\xc7\xf2\x3e\x57\x61\xe5\x11\x98\x3a\x40\x1a\x2a\x6b\xa0\x90\x56\xae\x51\xeb\x9a\x7e\xf8\x57\x21\x07\x5a\x36\x47\x41\xb5\x95\x21\x9d\x5a\x4d\xbe\x22\x1b\x1a\x5c\x55\xc4\x1e\x6e\x4f\xa3\xd4\xea\x91\x5d\xb9\xe8\x52\x26\x25\x14\xdf\x46\x43\x8a\x4a\x90\x4c\xaf\x66\x7a\x92\xee\xe6\x82\xbb\xc1\x70\x62\x99\x37\x61\x5c\x36\xe2\xe5\xa8\xd0\x56\x45\x6d\x94\x22\xdd\xc3\x51\xbc\xd6\xc4\xdb\x3d\xd6\xdc\xad\xc8\x0a\x96\x59\xde\xd6\x78\x5d\x6f\x94\xbf\x7a\x67\x4c\x75\x67\xdc\x0d\xdb\x37\xcb\x4c\xbf\xcd\x28\x8a\x6a\x61\x29\x15\xa2\x03\x48\x89\xe0\xda\x31\x5c\x03\xa3\x76\xf5\x74\x90\xb5\x6d\x3c\x91\xef\x06\xb9\xec\x84\xef\xd5\xfa\x14\x29\xea\x54\x42\xae\x00\xcb\xaa\x83\x51\xe7\x19\xf4\xb0\x0f\x8a\x49\xac\x86\x18\x2f\x30\x4a\x51\xfb\xc0\xca\x75\x8b\x5d\x79\x01\x5a\x49\xb6\xac\x3b\xe7\x23\x87\x40\x97\x1d\x85\xd7\x93\xa0\x26\xca\xc2\x1d\x81\x27\x51\xb5\x31\xb3\x06\x3b\x4f\x97\xdf\x0f\x1f\xaf\xa3\x86\xbf\x6b\x58\x81\x5f\x3e\x9e\x68\x9f\xfe\xdb\x28\x28\xfe\x55\x85\xf0\xda\xeb\xb7\x19\xa8\x92\x6d\x70\x58\x20\x91\x6e\xeb\x40\x2d\xe6\xf0\x43\xb5\x9c\xbc\xfb\x57\xec\x43\xdb\x3b\x9a\xa6\x4a\x06\x85\x4f\x8f\x6c\x6d\x9e\x92\x6f\xf1\xf2\x5b\x39\x0d\x32\x73\x7e\x7b\xbc\x23\xc2\x9f\xd4\x88\x96\xc4\x19\xa9\x73\x7f\x42\xf6\x58\xc3\x25\x38\x11\x94\x44\xc5\xd8\x61\x33\xdb\x2f\x7e\x4a\xc0\xd5\x95\x2c\x56\x41\x4c\x1f\xe8\x44\x2d\x4c\xe7\x08\xca\xc5\x18\x2a\xab\x76\x49\x72\xd2\x2b\x1d\x5e\x43\x4c\xd0\x74\x52\x2b\xc7\x55\x2d\x9c\x5d\x2d\xac\x1f\x86\x6d\x49\xb2\xca\x1a\x48\x79\xc2\x33\xb7\x2b\xa8\xd8\x44\x3b\xcb\xc0\xfd\xb3\xdc\x4a\x0d\xea\x37\xf5\x72\x1d\xc9\xc4\x5b\x8a\x5c\x86\x69\xb8\x22\xec\xc1\x95\xf6\x50\x12\x26\x2e\x77\xa8\x2f\x01\x65\x30\x85\x2f\x9f\xef\x69\x08\xbc\x82\x54\xa2\x37\x11\xc6\x49\x05\xe9\xf8\x82\xad\x98\xe5\xc5\x1a\xb7\xd6\xaa\x6b\xb5\x28\x1e\x2d\xb3\x8d\x45\x34\x36\xc1\xb1\xa4\x63\x7f\x5e\xad\xb7\x37\xad\x2d\x86\xf2\x7b\x8c\x41\x8e\x7e\x7a\xfe\xfc\xe5\x5f\x9e\x3f\x3f\xfc\xcb\xb3\xbf\x1c\xfe\xf5\xc5\x8b\xa3\x97\x47\x2f\x26\xbd\x8c\x74\xd6\x4b\x24\x7a\x6e\x2d\xbc\xbe\x1b\xd3\x54\x76\xcb\x6e\x77\x1f\xa2\x0c\x5a\x2c\xf6\x44\x4a\xca\x9c\xde\xd0\x6b\x1f\xc0\xfb\x42\xb7\x64\x16\xf9\x5c\x54\x2a\x92\x99\xb3\xcc\x1b\x77\x5f\xc5\xbc\xab\x6c\x0d\x01\x2f\x85\xc7\xe0\x48\x1b\x13\x09\x7d\xae\x84\x1b\xce\x43\x08\xab\x2f\x99\x80\x51\xb9\xbe\xd7\x32\x43\x82\xa4\x6b\x87\x43\xae\x2b\xc5\xac\xca\x5d\x55\xce\x91\x80\x3b\xa8\x64\x05\x69\xf8\x76\x6d\xf3\x1c\xc4\x7b\xfc\x07\xf1\x4d\x93\x1a\x65\x8f\xfc\x81\x45\x90\xc7\xe6\x43\xdb\x59\xd0\xd6\x87\x43\x59\xd7\xb7\xeb\x91\xfb\x8d\xa9\x3a\xfa\x28\xab\xde\x3e\x64\xcd\x92\xcc\x42\xa4\x69\x9d\xea\xf8\x41\x3c\x1c\xa2\xfa\x59\xcf\x88\xc4\xba\xe1\xfc\x45\xe9\x67\xcf\x88\xaa\x99\x0b\x00\x49\x56\xf5\xe1\x6b\xbc\xdd\xaa\xf6\xe6\x1c\xf3\x21\xe0\xfe\x4b\x55\x25\x3d\x25\x4f\x5f\x1c\x1e\x1e\x1e\xee\x1f\xee\xe1\xea\xf7\x74\x32\x25\x2f\x0e\xdd\xcf\x9e\x4d\xa6\xe4\xc8\x6f\x08\x2f\x14\x1f\x3d\x7b\xe1\x7c\xf6\x62\x32\x05\xdf\xcc\xa7\x7c\xc7\x6a\xe8\x22\x63\x81\x7a\xea\xfe\xfa\xcc\xfd\xf5\xb9\xfb\x6b\x62\x5c\x5f\x92\xc8\x2c\x10\x2e\xaf\x47\x62\xed\xd2\xf7\x3d\x1e\x07\x3d\xbc\xc3\x31\xce\x64\x8e\x5e\xfe\xa4\x30\x94\xd3\x79\x71\xf4\x93\x37\xa1\xbf\xe8\x0f\xe4\x94\x9e\x3e\x7b\xd9\x33\x29\xa5\x87\xa2\xc2\x5b\x57\xd7\x08\x29\x93\xc7\x08\x71\xb3\x3a\x21\x7e\x93\x11\x21\x84\x5c\x8f\xae\x47\xff\x77\x00\x21\xca\xdd\xa1\x4d\xea\x00\x00"
 
 func flowidtablestakingCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -113,7 +116,7 @@ func flowidtablestakingCdc() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "FlowIDTableStaking.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x68, 0x80, 0xc6, 0x6b, 0xd1, 0x7e, 0x30, 0x97, 0x5a, 0x8f, 0x74, 0x87, 0x86, 0xc7, 0x6f, 0x76, 0x64, 0xb7, 0xdc, 0x65, 0xc9, 0x93, 0x13, 0xaf, 0x97, 0xa7, 0x5, 0x1b, 0x76, 0xd7, 0x4a, 0x9c}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x13, 0xd5, 0x33, 0x30, 0x12, 0xca, 0x63, 0xd0, 0xea, 0x18, 0x2c, 0x19, 0x1e, 0xfa, 0x1b, 0x1, 0x27, 0x21, 0x16, 0xf2, 0x38, 0xe8, 0x7f, 0xb5, 0x65, 0x96, 0x67, 0x23, 0xbc, 0x6c, 0xe3, 0xb7}}
 	return a, nil
 }
 
@@ -217,6 +220,66 @@ func stakingproxyCdc() (*asset, error) {
 	return a, nil
 }
 
+var _epochsFlowdkgCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x1a\x4d\x6f\xdb\x46\xf6\xae\x5f\xf1\xe2\x43\x21\xb5\x5a\xc9\xed\x69\x21\x94\xcd\x3a\x91\x13\x08\x69\xd3\x20\xd6\xa2\x07\x23\x30\x46\xe4\x93\x34\x30\x35\xa3\x9d\x19\xda\x31\x0c\xff\xf7\xc5\x9b\x0f\x72\x38\x24\x65\x7b\xb1\xd8\xc3\xaa\x05\x2c\x0d\xe7\x7d\x7f\x3f\x66\xfe\xe3\x68\x04\x00\xf0\xa1\x94\xf7\xcb\x4f\x1f\xdd\x8f\xf5\x1e\xed\x01\x2c\x3f\x7d\x84\x5c\x0a\xa3\x58\x6e\x80\x6b\x30\x7b\x84\xfb\x3d\x37\xb8\x91\x4c\x15\x20\xb7\xf6\xa4\xe0\xda\x28\xbe\xa9\x0c\x16\x70\x8b\x0f\xb0\x43\x81\x8a\x19\x2e\x85\xc5\x66\xf6\xcc\xd8\x7b\xb9\x14\x1a\x85\xae\x34\x08\x59\xa0\x0e\xe0\x02\xbf\x1b\xc0\xa3\xcc\xf7\xa0\x2a\x01\x46\x42\xae\x90\x19\x24\x18\xae\x40\x31\x51\xc8\x03\x6c\x90\xe5\x52\x10\x7e\x3d\x6b\xb8\x64\xc5\x81\x0b\xd0\x86\x29\xe3\xb8\x23\x8e\xef\xb9\xd9\xdb\x1f\xab\x65\x4d\xe4\xc8\x94\xe1\x39\x3f\x32\xc3\xc5\x2e\xe5\x64\x66\xf1\xbd\x6f\x1f\x06\x2e\x18\x7c\x09\xb0\xc2\xd4\xb8\xb9\xb2\x1a\x5a\x2d\xd7\x6c\x53\xe2\x95\x61\xb7\x5c\xec\x2c\x9a\xcf\xb2\xb0\xbf\x51\x81\x42\x2d\x2b\x95\xe3\x94\x94\x96\xef\xe1\xa8\xe4\x1d\x12\x9f\x4e\x21\x0f\x20\xef\x05\x7d\xb1\x04\x61\xb5\x9c\x5a\x04\x47\xa9\x8d\x27\xb1\x51\x92\x15\x39\xd3\x06\x0e\xa8\x35\xdb\x11\xb0\x4c\xad\xc0\x44\x01\x5b\x2e\x58\x59\x3e\x58\x78\x5d\x6d\x0e\xdc\x62\x20\x06\xaa\xd2\xd3\xca\xe5\xe1\x48\x26\x8a\xb4\x47\xca\xe2\xda\x3e\x29\xd1\x20\x48\x91\x23\x1c\xa4\x22\xd5\x33\x01\x7b\x56\x6e\x3b\xfa\x13\x46\x5b\xf8\x3d\xbb\x43\x4f\x8a\xec\x4e\x77\x34\x3b\x04\x92\x44\xe4\xc7\xf9\x68\xc4\x0f\x47\xa9\x4c\x8f\xaa\x60\xab\xe4\x01\xce\xbf\x7f\xf8\xfd\xcf\xbf\x56\xcb\xf5\xc5\xbb\xdf\x2f\xaf\xd6\x17\x9f\x56\x9f\x3f\x5e\x2c\x97\x5f\x2f\xaf\xae\x46\xa3\x63\xb5\x69\x9c\xcf\xfb\x27\x3c\x3a\xee\xe7\x3f\x9e\xfc\x58\xc7\xbd\xbc\x43\x61\x34\x84\xb3\xde\xcf\xdc\xa1\x23\x52\x48\xd7\xe1\x8a\x5c\x69\xf9\xe9\xe3\x78\x92\x3c\xb8\x14\x05\x1d\x5b\x45\x5f\x91\xd8\x5a\x73\x29\x16\x70\x7d\x65\x14\x17\xbb\x6f\xe9\xfd\xc8\x69\xde\x5b\x47\x2a\xc6\x64\xe5\xd5\x72\x01\x0e\x22\x05\x78\x17\x6c\xfd\x87\x33\x75\x72\x7d\x6a\x75\x81\xc2\x0c\xc1\x7f\x68\x73\xd6\xa1\x66\xc9\xcd\xe7\x73\x6b\x79\x25\x4b\x24\xd3\xa6\x21\xc9\xc5\x90\x5b\x13\xa1\x12\x4d\x13\x25\x5f\x65\x89\x0b\xf8\xe7\x4a\x98\xbf\x7b\x9b\xcc\xe7\x70\xd1\x75\x59\xf2\x25\x03\x2c\xf6\x20\xeb\xe2\x58\x74\x7d\xb9\xa6\xa4\x8d\xaa\x72\x03\x5e\x15\xc1\xea\xb1\x04\xab\x65\x70\x4d\xe2\xdc\x11\x09\x68\xf7\x18\x88\xd7\x60\x81\xfd\xb6\x56\xba\x68\xbd\x92\x03\xee\x21\x34\x89\x2d\x1a\x3c\x5c\x70\xf3\xac\xe5\xe0\xb1\xbe\x4f\xff\x6b\x2c\xb7\x33\x07\x03\x99\xe7\xb0\x7b\xc1\x23\x81\x2c\xa0\xab\xaf\x3c\xd9\x6f\x4f\x8d\x15\x56\xa2\xe0\x39\x33\xa8\x81\x6f\xeb\xac\xc8\x35\xb0\x3c\xc7\xa3\x4d\x80\x5e\x2e\x6d\x93\x87\xae\x9d\x46\xd7\x06\xb8\x63\x0a\x8a\xdb\xdd\xa5\x20\x3f\x28\x16\xf0\x4e\xca\xb2\x21\xb0\x6e\x72\x56\x37\xc3\x0a\x53\x9f\xe5\x95\x52\xc4\x33\x55\x16\x82\x25\x06\xb4\x1e\x87\xa8\x9e\x58\x32\xb5\x0f\x52\xe6\x5c\x2d\xf5\x02\x1e\x9d\x9e\x1c\xd5\x48\xae\x75\x63\x11\x9f\x45\x6d\x16\xda\x20\x8a\x60\x7b\x2e\x52\xc2\x53\xe0\xee\x29\xc9\x2d\x55\x81\x6a\x98\x95\xc6\x13\xbd\xe7\xe9\x05\x5c\xfb\xaf\xdf\xda\x7c\xd8\x44\x10\xab\x6e\x48\x66\x02\xa0\xaa\x98\x05\x8d\xd5\xa7\x77\xac\xac\x10\x32\xcb\xf0\x4e\xc9\xea\x48\xae\x5f\xf2\x9c\x8a\x1c\x6c\x65\x59\xca\x7b\x2c\x60\xf3\x60\x2f\x44\x8f\xe4\x96\x72\x92\x7a\x88\x55\x3e\x2c\x53\x92\xb1\x62\xf5\x86\xdc\xf5\x34\x60\xd9\x46\xc5\xad\x22\x38\x05\xe6\xee\xe4\x4c\x80\x14\xe5\x83\x65\xc7\x5d\x94\x02\x87\x59\x89\xf8\x7d\x5f\x32\x7e\xc0\x62\xd8\xd6\x5f\x98\xd9\x6b\xd8\x4a\x05\xda\x48\x62\xb7\x76\xe4\x50\x55\x1b\x67\x2d\xb1\x95\x6f\xaf\x8c\x54\x6c\x87\x84\x61\x01\xd1\x8f\xd6\xfd\x0b\xea\x1c\x06\x6f\xd6\x6c\x7c\xf5\xc4\x42\x16\x6b\x27\x4c\xa8\xb4\x2f\xc9\xec\xd6\xd5\xc8\xe0\x80\xc1\xfa\xc4\x5e\x60\x38\xe6\xf1\x74\x4a\x4b\xa8\x58\xda\xf2\x5e\xe8\x34\xd0\x6a\x14\x41\xaa\x76\xe6\x39\x99\x96\x5e\x95\x85\x9e\xda\xdc\x7e\x91\xda\x68\x60\x7d\xc9\xbe\x37\xa5\x07\x16\xb7\x95\x0b\x45\x1f\x52\xe3\x9b\xe7\x52\xe3\x51\x61\x72\x12\x35\xab\xb3\x38\x41\x9d\x45\x0d\x8d\x90\xd6\x0e\x47\x25\x77\x0a\xb5\x3e\x1b\x44\x90\xa6\x9e\xeb\x48\x09\xdf\xe0\x4d\x06\x82\x97\x1e\x35\x69\x26\xe0\x6e\x17\xb3\x6e\xdc\x77\x09\x7a\x31\x67\x25\x8a\x9d\xd9\xc3\x6f\x70\xee\xd1\x06\xbd\xe5\x4c\x10\xe6\x0d\x02\x1e\x8e\xe6\xa1\x8d\x21\xd2\x7e\xcc\x7d\x37\x5b\xcd\xd8\xf1\x88\xa2\x18\x07\xfd\x06\x8b\x47\x62\x45\xd5\xc8\x7f\x99\x4c\xda\xe8\xf1\xc0\x4f\x74\x23\xa7\x51\x8d\x7a\x58\x26\xf7\xb6\x7d\x89\xd1\x71\x53\xea\xd5\x46\x26\xab\x27\x04\xe2\x37\x6a\x54\x23\x0c\x2d\x6c\x5f\x98\x62\x07\x34\xa8\x16\x51\x02\x5e\xc0\xfa\xbf\x95\x47\x63\x77\xd5\x28\x8a\xb4\xb5\xba\x01\xdd\xd7\x01\xfe\x9f\xfa\x6e\x20\x98\x16\x92\x36\xc1\xac\x43\x70\xcf\x34\xb0\x52\x21\x2b\x1e\xa2\x41\x81\x79\xfb\x77\xe9\x34\x3a\x0d\x61\x92\x65\x83\xd2\xce\xec\x20\xe8\xef\xfd\x04\x3f\x7b\xc2\x0d\x0e\x38\x54\xda\xf5\x69\xcc\xa7\x64\x57\x62\xc9\x27\xa8\xe9\x61\xde\x3b\x54\xd7\x07\x5e\x14\x7c\xcf\x68\x23\x92\xa6\x0d\x6f\xa3\x6b\xa8\x57\x8f\x70\xc4\xa1\x14\xb1\x41\xc1\x64\x4b\x57\x53\x55\x6c\xf4\xf8\x11\x98\x24\x43\x51\xe8\xe1\x1a\xe4\x80\x93\xea\x73\xe5\xa1\x41\xe0\x3d\x25\xb0\x29\x14\x5c\xe7\x4c\x15\xa1\xee\x0e\xf5\x8b\xc1\x81\x8e\x0a\xef\xb8\xac\x34\x48\x81\x2f\x8a\x5b\x11\x7a\xbd\xf5\xc0\x9c\x2e\x8c\x0e\x63\xb3\x6d\x2c\x8c\x84\x0d\xd2\xa4\x7f\x8b\x45\x52\x1f\x75\x37\x68\xc3\x14\x57\x93\x79\x5d\x90\xbe\x79\x2e\x4a\x83\x5f\x9f\x8c\x54\x4f\xbc\x9b\xf4\x29\xd4\xad\x7b\x5a\xc9\x98\x81\x12\x69\xc6\x97\x02\x5f\xec\x86\x7e\x08\x69\xc5\x44\xda\x4c\x41\x06\x8f\x09\x18\xf5\x53\xbe\xc6\x73\xe1\xbf\xe9\x1e\x05\x30\xad\x51\x99\x71\x4a\xe1\xba\x1d\xed\xd3\xe0\x17\x0b\x38\xbb\x48\x5a\xc2\x5a\x10\xbf\x57\x38\x9b\x8c\x12\x1a\x81\x48\xd0\x35\xd7\x34\x6f\x62\x51\x4f\x98\x44\xd4\x5b\x70\x12\x93\xfa\x93\x5a\xce\x7e\x4f\xb0\xf4\x63\xd2\x4d\x3b\x76\x36\x19\xf5\x95\xe5\x01\xf9\xc0\xa8\x0a\x4f\x19\x20\x70\x9d\xa2\x80\xac\x83\xf5\x85\xc5\x1b\x32\xb8\xfe\xf6\xa2\x5c\xe3\x0c\xdb\x77\xb3\xf1\xd7\x20\x42\xeb\x9a\x4d\x3e\xc9\x8a\x23\x91\x8d\xe2\xf4\x32\xca\x20\xd6\x78\x80\x42\x56\xbb\x7d\xec\x9d\xfa\xd9\x0d\x50\x8c\x71\x7d\x2a\x87\x68\xc3\x1e\x80\xe2\x89\xca\x21\x54\xc2\xf0\xb2\x59\x0c\x12\x0b\x2e\xb9\x75\xa2\x1c\xdd\x4a\xe6\x7f\x50\x77\x13\xdb\x53\xf0\x25\x36\x81\xa6\x54\x15\xb7\xbb\xf7\x7e\xa3\x56\x44\x3a\x0e\xff\xbd\x7d\x0b\x47\x26\x78\x3e\x3e\xfb\x2c\xcd\x7f\xa0\xd8\xd4\x8f\xbb\x82\x41\x06\x5b\x56\xea\x3e\xdb\x0f\x6d\xb1\x92\x83\xd8\x33\x22\xf9\xc9\x92\x6e\x99\xe5\xdc\x23\x9e\x67\xe4\xb6\x33\x1d\xcd\x6a\xa0\xba\x27\xb0\x51\xab\xd2\x45\x28\xe4\xac\x2c\x51\x35\x23\x4e\x3c\x29\x07\x6b\xbb\x75\x6c\x44\xd2\xa6\x06\x9b\x32\xd4\x02\x7e\xe8\xae\xad\x66\xcd\x26\x76\xb2\x80\x7f\xc4\xcc\x3e\x8e\x92\x1c\x64\x0b\xef\x70\x02\xb2\xe7\x6a\xc6\x8b\x57\xe5\xa1\x7a\x81\x4c\x3e\x1c\x59\xf8\x6c\xd2\x4b\xbe\x3b\x1d\x5f\xb7\x68\xf7\x67\xdd\x08\xaa\xd5\x71\xd9\xa5\x88\xe3\xa0\xb0\x6d\x8e\xd9\xdb\x0e\xb0\xc0\xd8\x81\x5e\x4a\x38\xc9\x25\x36\x8f\x9c\xd8\x70\xb6\xc0\x23\x72\x0a\x4d\xa5\x04\xfc\xfa\x37\xaf\x9a\x08\xc5\x10\x6c\xe2\x7e\x5f\x2d\x06\x6d\xf9\x09\xdb\xad\xd0\xde\x76\xa6\x73\xbb\xac\xf7\x11\x66\xe4\x2d\xba\x5c\x73\xdb\x5d\x13\xd9\xb7\x0f\x2d\x6f\xeb\xf7\x85\x1b\x48\x66\x68\xb7\xa2\x8a\xfc\x89\x6f\xe1\x4d\x8f\x2b\xee\xd0\xf8\x62\x30\x9e\xcc\x7c\x53\xaa\xbd\xc8\x69\xfe\xf2\x4a\x72\x21\x1c\x0e\xbd\x0a\x42\xf6\xb1\x90\x62\x2b\x7d\xda\xe9\x71\xfc\x95\xd8\xca\x96\x4e\x57\xcb\xae\x21\x02\x9a\x99\xdd\x07\x67\x99\x5b\x02\xd4\x32\x7f\x95\x65\xbb\x06\xfe\xf0\x43\x03\xe2\x14\x4a\x02\x62\x41\x03\xed\xec\x3c\xbd\xda\xc3\x19\xd7\x1f\xad\xe5\xd5\x7a\xcf\xc4\x1f\x5c\xf0\x43\x75\xf8\x20\x15\x11\x1a\x8b\xea\xb0\xb6\x38\x17\xfd\x44\xa6\x40\x5c\x46\x0f\xe9\xe7\xb0\x83\x34\x69\xa4\xb7\xb9\xec\x19\x7d\x5a\x0e\xb0\x43\xd3\xb2\xbd\x35\x5d\xd3\x47\xc2\x63\xaa\xcb\xb0\xa4\x6d\x81\xd8\x31\xe5\x24\x8b\xd5\x61\x43\x99\x6f\x1b\x82\x7a\x68\xb1\x39\xbc\x2b\xf7\xdc\xfe\x95\x36\x14\xef\x65\x25\xcc\x78\xb2\x80\x95\x30\x03\xec\x76\x9b\x10\xdf\xac\x9e\x62\xf9\xb5\x8c\x4e\x6b\x2c\xb6\x8e\x73\xb1\x03\x9f\xf6\x77\xfc\x0e\x05\x70\x51\xe0\xf7\x29\x68\x82\x63\xc6\xe7\x4f\xdb\x43\x52\x26\xf3\x9d\xc0\x3d\x4d\x16\xfa\x59\x99\xf5\x07\x25\x0f\xe3\x1b\x87\xd3\x4a\x3e\x89\x36\xb8\x91\x16\xba\x4d\x82\x05\x81\xdf\x32\x38\x27\xdf\x75\xbf\x7e\xcd\x9e\xd1\x93\xef\x21\xdc\x6d\xae\x41\x56\xb6\x12\x2a\x26\x76\xd8\xb4\x10\x49\xf0\x1e\xba\xbb\x65\xd7\xfb\xd5\xb7\x68\x6f\xcb\x21\x73\x62\xd4\xa7\xf7\x7b\x5e\x22\x70\xf8\xf5\x19\xa6\x12\xc1\x0e\xc9\x72\x68\x00\xf8\x9a\x7f\x9b\xb4\xe0\x2c\x03\xf0\x13\xfc\xdc\x27\x87\xf7\xa2\x80\xfb\x94\xbf\xbc\x78\x53\x1e\x19\x36\x19\x90\x6d\xe8\xd5\xc3\x4d\x88\xc1\x27\x78\x4c\xf9\xb1\xb2\x25\xcd\xcc\xab\x98\x23\xeb\x31\xeb\x83\x53\x2a\x2f\xdc\x44\x8d\x58\xd8\x6d\x47\x7c\x52\x98\xa7\xc3\x7c\x4f\x91\x08\x1c\xbf\x7d\x21\xc7\x61\x0e\x39\xc5\x79\x93\x39\x78\x81\xc2\xf0\x3c\x51\xb2\x5f\x58\xfb\x6d\x1b\xb5\x06\x46\x02\x7e\xcf\x11\x8b\x1a\x9f\xeb\x0b\xfc\x12\xce\x0e\xd8\xe1\x5d\x70\x98\xbd\xb9\x3e\xfd\x1a\x38\xd2\x45\xc3\xff\x7a\xaf\x50\xef\x65\x59\x9c\xce\x3e\xbd\xc9\x32\xf8\xf0\x1c\x7e\x39\x25\xbd\x17\xcb\x0a\xf9\xfc\x1b\xeb\xa4\xa5\x6e\x32\x12\x8d\xc3\xbc\xf4\x8d\x84\xf2\x4b\x32\xd0\x15\xfd\x5b\x04\x47\xe1\x01\x4d\x4b\xd0\x76\x77\xdf\x6f\x5a\xbe\x7d\x81\x80\x19\x65\x9a\x06\x26\x52\x8e\xe0\x65\x7d\x9c\xa4\x0e\x13\x34\x0b\x3e\x33\x0d\xe9\xbd\x01\x23\x1b\x47\xfe\xcd\x07\x5c\x6e\x66\xdf\x46\xa5\xbb\x00\x4a\x44\x39\xd5\x11\xc8\xe0\xbc\xbb\x4a\x90\xa4\xb5\x57\xa2\x8c\x15\x14\xf9\xeb\xe5\xbf\x2a\x56\x8e\x9b\x83\xa9\x43\x9e\x36\x47\xe1\x13\x98\x72\x7f\xe3\x24\x15\x3e\xed\x01\x39\xd2\xa3\x67\xc0\x41\xfe\x16\xa9\xb4\x4b\x29\x78\x6b\xcd\xd5\xa8\x9f\x42\x84\x3d\xb1\xe1\xd3\xa8\xff\x75\xd8\xb6\x8a\xd1\x7a\xe9\x6f\x80\x35\x0e\x35\x85\x1b\xd8\x34\x3f\x7b\x5b\x4d\x16\x9c\xe9\x4d\x06\x9b\xf0\xfd\x71\xd4\x23\xc2\x60\x37\x19\x4a\xcd\x79\x4f\x99\x61\xfd\x28\x89\xf0\x35\xb7\x3b\xe6\x0d\xfd\x6d\x3f\x1d\x24\xda\x35\xca\x4b\x2a\x4c\xbd\x87\xf1\x8f\xec\x9b\xac\xd8\x2b\xba\x2d\x2b\x64\xf0\x4b\x83\xc7\x3e\x8f\x06\x8d\xe8\x35\x1f\x64\x30\xd7\xee\xe7\x7c\xeb\xc6\xe8\xe8\x62\x1b\x43\xfa\xbe\xb0\x07\xd6\x5e\x49\x08\xf7\x4c\xe5\xad\xe7\x69\x8a\x68\xaf\x76\x06\x4a\x75\x7b\x53\xd4\x1b\x7b\x3d\x78\xba\x63\x5e\xb2\x20\xb4\xb7\x58\x6e\x03\x63\xa6\xd9\x1d\x8e\xeb\x41\xcd\x8a\x36\x9e\x4c\xc1\xc8\x45\xbf\x3e\x26\x23\x00\x80\xa7\xd1\xd3\xe8\xdf\x03\x00\xbe\x14\x2d\xba\x53\x26\x00\x00"
+
+func epochsFlowdkgCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_epochsFlowdkgCdc,
+		"epochs/FlowDKG.cdc",
+	)
+}
+
+func epochsFlowdkgCdc() (*asset, error) {
+	bytes, err := epochsFlowdkgCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "epochs/FlowDKG.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x65, 0x9c, 0x8c, 0xba, 0xb, 0xcc, 0xb2, 0x5c, 0xeb, 0x63, 0x69, 0x8a, 0xd3, 0x7f, 0x99, 0x34, 0x35, 0x8, 0xb6, 0x7e, 0x98, 0xc8, 0x6a, 0x63, 0x90, 0x8a, 0xd8, 0x4e, 0x69, 0xc7, 0xdd, 0x6a}}
+	return a, nil
+}
+
+var _epochsFlowepochCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x5f\x6f\xe3\xb8\x11\x7f\xf7\xa7\x98\xee\x43\x6b\x6f\x7d\x76\xae\x2d\x8a\xc2\x38\xdf\x22\x9b\x78\x5b\xa3\xb7\x49\xb0\xf6\xf6\x1e\x0e\x8b\x03\x2d\x8d\x6c\x22\x32\x29\x90\x94\xbd\xc6\x22\xdf\xbd\x20\x45\x8a\xa4\x44\x3b\x6e\x7b\xdd\x0b\x70\x91\xc4\xf9\xc3\x99\xdf\xfc\xcd\xf4\xed\x60\x00\x00\xf0\xa1\xe4\xc7\x45\xc5\xb3\x5d\xf3\xb8\xde\xa1\x79\x05\xe6\x1d\x64\x9c\x29\x41\x32\x05\x7b\xc2\xc8\x16\x25\xa8\x1d\x42\x49\x0b\xcc\x4e\x59\x89\xc0\x0b\xf3\x02\xf5\x59\x69\xe8\xed\x1b\xc3\xe2\x49\x70\xc5\x33\x5e\x02\x61\x39\xe4\x82\x1e\x2c\xbd\x54\xe4\x99\xb2\xad\x67\xae\x76\x82\xd7\xdb\x1d\x50\x35\x69\xb4\x58\x1c\x50\x9c\x1a\xb6\xb0\xe5\x86\xac\x39\xa1\x76\x02\x11\xaa\x1d\x91\x28\x67\xcd\xd9\xef\x27\xb0\xb2\x0c\x6f\xeb\x4c\x51\xce\x66\xc0\x78\x8e\xb2\x11\x8b\x25\x6e\x89\xe2\x42\x42\xc6\xf7\x7b\xaa\xc6\x46\x3c\x8e\xcd\xd7\x9a\x99\x07\xc3\x07\x00\x14\x7f\x46\x26\xe1\x48\xd5\xae\xbd\xc5\xf2\x7e\x4d\x36\x25\xae\x3a\x3a\x4f\x0c\xcd\x9f\x26\xd6\x4e\x2b\x54\x75\x35\x8b\x6e\x47\x1a\x65\x60\x47\x24\x20\xcb\x31\x37\x12\xf5\x09\x9a\x23\x53\x54\x9d\x40\x69\xce\x4e\xb8\xb5\x1c\xc3\xaf\xca\xde\x9c\x4a\x28\xe8\x57\xcc\x27\xc6\x2b\x46\x90\x91\x03\x78\x40\xa6\x40\x61\x59\x6a\xcb\x60\x73\xdd\xf3\x7c\x14\x87\x4a\x60\x45\x04\x42\xc1\x85\xb1\xb2\x3e\xf8\x67\xa7\xfc\x9d\x31\x8c\xc2\x7c\xe6\xb9\x25\xd8\x68\x7a\x81\x24\x3f\x59\x27\xfd\xbc\x43\xd6\x3d\x23\x15\x11\x4a\x8e\xcd\x6b\x81\x47\x22\x72\x69\x64\xea\xe7\xac\x16\x02\x59\xc8\xad\x22\x34\x37\xac\x9c\x65\x7a\xc8\xd8\x73\x07\x1a\xeb\x9b\x0d\xaa\x23\x5a\xb9\x9b\x3a\x7b\x46\x25\xad\x3a\xda\x46\x2d\x9d\x36\xba\xe2\xb0\x41\xc8\xb1\x2a\xf9\x09\x73\x50\xdc\x10\x49\xb2\x47\x20\x59\xc6\x6b\xa6\x80\xc8\x84\x8f\xc7\x86\xdd\x06\x33\x52\x4b\x04\xaa\xa0\x96\x1d\xe0\xde\xe6\x7b\xca\x40\xa0\xe4\xb5\xc8\x10\xd4\x8e\x28\xa0\x12\xa4\xe2\x02\x73\xa0\x5a\x3b\xa2\x9c\x10\xad\xde\xdb\xe9\x60\x40\xf7\x15\x17\x2a\x85\xa9\x42\xf0\x3d\xdc\x7c\xfd\xf0\xd3\xe3\xcf\xcb\xfb\xf5\xed\xfb\x9f\x16\xab\xf5\xed\x3f\x97\x0f\x7f\xbf\xbd\xbf\xff\xb4\x58\xad\x06\x83\xaa\xde\xf8\xbb\xb5\x11\x0b\xdf\x9a\x9b\x4f\xdf\x5e\xfc\x67\xbd\xbc\xd0\xa0\x91\xe0\xde\x26\xff\x4d\x2d\xc3\xe9\x14\xd6\xc1\x7d\x1d\x94\xfb\x98\x68\xc1\x3d\x89\x08\x35\x84\x60\x79\x2f\x8d\x97\x3d\xa6\x8c\x59\x2a\x22\x14\xcd\x68\x45\x14\x02\xed\x02\xc8\x70\xd1\xb7\x6d\x20\xee\x31\x3f\x34\x0e\x43\x31\x83\xcf\x4b\xa6\xfe\xfa\x97\xb1\x01\xfd\xf2\x5e\xce\xe0\x97\x95\x12\x94\x6d\xbf\x8c\xa1\xa0\x42\xaa\x7f\x51\x3c\xfa\x53\x05\x65\xa4\x0c\x5f\x8d\xe2\x2b\xbe\x0a\x76\x1b\x32\x29\xc5\xda\xb8\xe9\x2a\x17\xc8\xb8\x05\x86\xc7\xc0\x58\x26\x40\x82\x5c\xe0\x82\xc4\x6a\x50\x09\x3c\x50\x5e\xcb\x96\xe2\x80\xb0\xd1\x78\x6f\x43\xa5\x6b\x1c\xcd\xaf\x6f\x9c\xff\xc0\x12\x9e\xe1\x03\x1e\x1f\xea\xbd\x3e\x22\x97\xcc\x70\x1f\x32\xfb\x7c\xc6\x7a\x4d\x22\xd6\xb9\x82\xb0\x46\xe5\xb1\xf3\x29\x17\x39\x0a\x7d\xa7\x13\xec\x48\x55\x21\x03\xca\xbc\x3c\x56\xef\x1b\x1b\x3e\x69\x0e\x0d\xf7\xbf\xc1\x37\x97\xc3\xcc\xa1\x8c\x48\x04\x17\x09\x9f\xef\xd6\xcb\xc7\x87\xfe\xf7\xc5\xd3\xe3\xdd\x3f\x56\x8b\xf5\xe7\xa7\x33\xdf\xee\x1e\x3f\x7e\x5c\xae\xd7\x8b\x7b\xf3\xfd\xc5\xeb\xbf\x64\x05\x17\x7b\x62\x72\x34\xd9\xf0\x5a\xb5\x77\x68\xd5\x94\x4a\xd4\x99\x35\xf4\x47\x54\x24\x27\x8a\xb8\xa0\x0b\xcd\xc0\xea\xfd\x06\x85\xf3\xa1\xb5\x83\xf1\xb4\x0e\x6f\xa2\xe0\x26\x52\xae\x44\x05\x1d\x8f\xf5\x79\x1a\x0f\x1a\x98\x94\x44\x2a\x38\x50\x3c\x46\x02\x7a\x1c\x7b\x2e\x4f\x9c\xe8\x20\xa0\x2f\x55\xc7\x2c\x2f\xae\x08\xd9\xb4\x0e\xbd\x98\xf4\x12\x28\xa3\xff\x25\x4e\x13\xa1\x3e\x0a\xa0\xa2\x7f\x24\x96\xc5\xc4\x32\x87\xb9\x33\x6e\xff\x48\x2b\x0e\xe6\x5e\x74\xea\x98\x55\x01\xe6\x5e\x9d\xfe\x31\xab\x16\xcc\x9d\x82\xed\x91\x97\x2e\xd8\xb4\x6d\x9d\x7e\xa1\x17\xdb\xda\x61\x8b\x63\x79\x02\x51\x33\x46\xd9\xb6\x05\xe1\x81\x08\x57\x3a\x6d\xd6\x49\x20\xc7\xc9\x30\x01\x99\xa8\xb6\x54\x86\xe1\xd7\x65\x69\x83\xd0\xff\x3e\x18\xa4\xe1\xad\x51\xa8\x39\x01\x06\x0d\x1a\x29\x14\xc6\x15\x9e\x33\x8c\x64\xb1\x38\xab\xa4\x55\xdf\xbb\x00\xe3\x45\xc8\x6c\x1c\xe5\x45\xd3\xb6\x8d\x5b\x3a\xce\x32\x8c\x2a\x73\xaf\xe9\x1a\xa7\x0a\x8c\x16\xf9\x8c\x27\x98\xdb\x1b\x84\x78\x21\x59\x86\x52\x0e\x5d\xb5\x1d\x99\x0b\x60\x98\x01\x66\xf0\xad\xd1\x7f\x16\x67\x86\xc0\xdd\x4f\x44\xed\x4c\xe1\xd0\x2d\x81\xce\x01\xde\xe1\x24\xea\x1d\x5a\x33\xe9\xe8\x31\x6d\xc5\x4a\x71\x41\xb6\xa8\x39\xcc\x20\x78\xf0\xcc\x53\xdd\x87\xef\x93\x1a\x29\xa6\xa5\xb0\x60\xe0\x4d\xbf\xdc\x8a\x6a\x29\x1b\x46\x9d\x7c\xb6\x60\xb9\x4c\x9a\x94\x17\x1d\x53\x6a\x57\x98\x06\x55\x02\x55\xb2\xd3\xd5\x4e\x42\xa6\x91\x80\x27\x22\xc8\x1e\x0d\x82\x49\x55\x09\x7e\xc0\xfc\xc1\xc5\xf7\x2d\x94\x54\x2a\x8d\x34\x17\x5b\x26\x3e\x7c\x19\x74\x14\x11\xc7\xcd\xc9\x62\xc4\x8e\x1b\x4d\xe3\x47\x0c\x28\x50\xb4\xfd\xa7\xd7\x3c\x52\x0e\x56\x98\xea\xf4\x27\xc6\x3a\x13\x64\xb9\x7d\x61\x87\x8b\x96\x54\x07\x52\x51\x33\xe8\x9d\x18\xf6\xae\xf5\xad\x49\x5b\x33\x78\xcf\x79\xf9\xd2\x4d\x5e\x95\xc0\xce\x9b\x68\x2e\x9b\xf4\x22\x15\xe6\xf3\xa0\x78\x4e\xe2\x12\x39\x83\x37\x77\x84\x01\x67\xe5\x49\xab\x96\x74\x65\x5e\xb7\xa0\xec\x7c\x7a\x13\xe9\xf1\x32\x88\x1e\xbd\x4a\x1b\x2e\x04\x3f\xba\x6b\x6b\x43\x0d\x47\x93\x2b\x0c\xd1\x79\x31\x8a\xf9\xeb\x18\x08\x2f\x0b\xf3\xc0\x0a\x5b\x54\x51\xb4\x0d\xd3\x06\xb2\xd9\x71\xf4\xbb\x3e\x6b\xed\x7f\xc7\x37\x66\x15\x1d\xd5\x3f\x36\x21\xcc\x22\x75\xda\x02\xf3\x47\x9b\xbf\x86\xdf\x8f\xc6\x3d\xd2\xb6\xac\x74\x88\x7d\x4d\x79\x85\xdc\x1e\xbb\x40\xee\x6f\xde\x49\xac\x7d\x76\x36\x8c\x66\x29\x80\x6f\x51\x3d\x09\x5e\x71\xd9\xfa\x63\x38\x8a\x18\x74\xdc\xe3\xc5\x46\xe9\xf0\x97\xd6\xae\xce\x40\x5f\x60\xee\x8d\x7d\x86\x45\x02\xd4\x21\xa6\x83\xb6\x2e\x62\x80\x7b\x9a\x9e\x0a\x7a\x4a\x04\x5d\x83\xff\x66\x5f\x45\x7d\x87\xff\xda\xbe\x8c\x9a\x90\xf0\xbb\x7d\x39\x1a\x24\x22\x44\xa7\x92\x4f\x98\x71\xdd\xcd\x9b\xac\xe5\x9b\xa8\x2b\xc7\x8b\x30\xab\x34\xcb\x0a\x23\x77\xf8\x5b\x27\x0c\x6f\xdc\x30\x59\x34\x12\x83\x1a\x12\x64\x09\x3b\xdc\x6b\x93\x37\xc5\xe4\xca\x3c\x71\x8d\x97\x7d\x83\x7e\xc6\xd3\x89\x31\xeb\x62\xec\x87\x01\x76\xd6\x53\x6d\x99\xb3\x0c\x9c\x5f\x58\x6e\x77\x18\xde\x63\x9c\x61\x5c\x30\x9e\xc8\x49\x5e\xb3\xe1\x60\x79\x50\x97\x5d\xa2\x6d\xf6\x18\x11\xbf\xc4\x4e\x63\x0c\x92\x7b\x14\x65\xce\x02\x6e\x0b\xa2\xe1\xa3\x19\x62\x9e\xa8\x6e\x3d\x2c\x21\xcb\xff\x8f\x40\x6a\xfd\x97\xaa\x3c\x46\x21\xdf\xa6\x05\x11\x40\xdd\x42\x4e\x61\x7e\x11\x4d\x3a\x75\x5b\xe3\x99\x92\x0c\xf3\x57\x0a\x51\x4c\x1e\x92\x4e\x2a\x72\xfa\xd4\x0c\xdc\xc3\xd1\xf9\x53\xda\x69\x6b\x63\xe8\x2e\xb3\xe9\x14\x1e\xf5\xf5\x9e\x11\x2b\x50\x89\xa6\x35\x9e\xdf\x23\x5a\x5a\xbc\x02\xdb\x1f\x1d\x6c\x6f\x46\x17\xbd\x12\x65\xdf\x89\x40\xad\xee\xf0\x19\x4f\xaf\x85\xc5\x77\xa9\xb0\x48\x18\xfc\x32\x97\xf9\x2b\xdf\x83\xe0\x1b\x5c\x8d\xaa\xf3\xed\x4c\xac\x9a\xc6\x02\x43\xbb\xfd\xfa\xed\xba\x83\xa0\xaa\xc4\xeb\x14\x27\xcb\x17\x95\xa8\x70\x1c\x2f\xd7\x8d\xe3\xb5\x65\xe3\x6e\x47\x98\x5b\xae\x77\x87\x2d\x0b\x2c\xe3\x74\x09\xc7\x1d\x97\xbd\xa6\x4d\x2f\x96\xd8\x1f\x94\xdd\x32\x9f\xb0\x5f\x4d\x24\xaa\xee\x66\xe7\x57\x70\xcd\x83\x1b\xc7\xae\xcb\x0e\x8e\x2a\x84\xeb\x0c\xde\xdc\xda\xdd\x09\xec\x6b\x69\x1b\x76\xa2\xa0\x44\xbd\xbb\xe0\x0c\xcd\xfe\xe2\xca\xa2\xc1\x62\x4d\x61\xde\xca\x8c\x29\x8c\xd7\x2e\xee\xac\xdc\x6f\xa1\xe1\x03\xd1\x3a\x97\xbf\x37\x09\x24\x4e\xd1\x26\x0b\x24\xb7\xba\x7e\x73\xcc\x0b\xd8\x70\xb5\x6b\xb7\xb2\x32\x3d\x3c\x6a\xe3\xa7\x72\xd4\x0c\x7e\x7f\x6e\xe4\x08\x6c\x2e\x50\xd5\x42\x7b\xaf\x2c\x26\x6e\x9b\xdc\x70\xfb\xe1\x2c\xf9\x8f\x43\x3d\xfb\x25\x1b\x3e\xfb\xff\xee\x98\xe9\xad\xa3\xff\x7b\xf7\x0e\x2a\xc2\x68\x36\x7c\x73\xc7\xeb\x32\x07\xc6\x95\xbd\x41\x64\x23\x33\xc6\x8e\xbd\xd7\x2e\x6c\xdc\xfb\x9a\x38\x2b\xbe\x19\x75\xdd\xf1\xc9\xdc\x58\x26\x73\xab\x0d\x64\xb7\x5f\xeb\xa7\xdb\x60\x2f\xd0\xad\x89\x4d\x09\xd2\x63\x6a\x22\x76\x9a\xc0\x69\xe7\x63\xed\xb3\x5e\x3e\xf9\xd5\xcf\x04\x36\x5a\x3a\xd3\xff\xbb\x33\x7e\x8b\x7b\x65\xcb\xe4\x4b\x78\x6f\xb3\x19\x63\x31\x88\x53\x31\xd9\x8f\xc7\x0e\xd1\xff\x14\x92\x56\x99\x76\xb9\xd5\x45\x09\xcc\x61\xaa\xb7\x19\x64\x8b\xd3\xc2\x79\xdd\x1c\xea\x10\x26\xf2\x2d\xcc\xe1\xe6\xfc\xa1\xd7\x6b\x40\x44\x7a\x3e\x3d\xd8\x37\x1d\x7d\x22\x07\xc0\xbc\x63\xc2\xc0\x60\xb1\xc3\xcf\xcf\x84\x37\xa9\xa9\xad\xad\x0a\xc9\xaf\xbe\x28\x74\x94\x0f\x6a\x72\x9f\xae\x1d\x60\x12\xb1\xbc\x45\xa5\xc3\xf9\xfc\xe8\x96\xf0\xeb\x74\x0a\x1f\x08\x2d\x01\x89\x28\x4f\x40\x6d\x54\xd9\x5c\xa5\xff\x80\xa5\x63\xbd\xf7\xf7\xb2\x38\x64\x5b\x66\xc6\xb6\x97\xdb\xaf\x28\x6f\x49\x72\xc0\xe1\x0f\xdf\x65\x02\xf5\x16\xd9\x9e\x1d\x83\xe2\xb3\x34\xde\x46\x03\x00\x80\x97\xc1\xcb\xe0\xdf\x03\x00\x29\x72\x27\xa7\xaa\x1e\x00\x00"
+
+func epochsFlowepochCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_epochsFlowepochCdc,
+		"epochs/FlowEpoch.cdc",
+	)
+}
+
+func epochsFlowepochCdc() (*asset, error) {
+	bytes, err := epochsFlowepochCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "epochs/FlowEpoch.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x21, 0x79, 0x1a, 0x17, 0x52, 0xb9, 0x54, 0x70, 0x4f, 0x2e, 0x99, 0xe1, 0xdf, 0x64, 0xb0, 0xad, 0xc, 0x12, 0x8f, 0xd7, 0x70, 0x10, 0x7a, 0xe5, 0x2, 0xb4, 0x6d, 0x1e, 0xd7, 0x70, 0x3d, 0x20}}
+	return a, nil
+}
+
+var _epochsFlowquorumcertificateCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x59\x6d\x6f\x1b\xb9\x11\xfe\xae\x5f\x31\xf1\x87\x42\x4a\x55\xd9\x69\x81\xa2\x10\x4e\x87\xfa\xe4\x5c\x2b\xf4\x90\x8b\x23\x37\xfd\x60\x18\x01\xbd\x4b\x49\x44\x56\xa4\x4a\x72\xe5\x08\x86\xff\x7b\x31\xc3\x97\x25\xf7\xc5\x76\xda\xe2\x7c\x07\xd8\x12\xc9\x79\x79\x38\xf3\xcc\x70\x72\xfe\x76\x34\x02\x00\xf8\xb9\x52\x0f\xd7\xb5\xd2\xf5\x7e\xc9\xb5\x15\x1b\x51\x30\xcb\xdd\xd2\xcd\x8e\xd3\x32\xb8\x75\x48\x36\x40\xa1\xa4\xd5\xac\xb0\x50\xa8\xaa\xe2\x85\x35\x60\x77\x1c\x8e\xca\x72\x03\x6a\x83\x1f\x48\x84\x5f\x15\x4a\x82\x54\x25\x37\xb0\x51\x1a\x17\x41\x2b\x65\xe1\xdf\x4e\x6e\x91\xc8\x55\x1b\xe0\xac\xd8\x41\x51\xd5\xc6\x72\x4d\x42\x9c\x3c\x90\xfc\x9b\x05\x7e\x50\xc5\x6e\xd6\xd8\xc7\xca\xbd\x90\x60\x2c\xd3\x8d\x09\x42\x6e\xe1\x41\xd8\x1d\x7d\x46\xb5\xb0\xba\x22\xab\xf8\x91\xeb\x53\x10\x3d\x23\x19\xcb\xb6\x81\x85\xe6\xe8\x1f\x83\xcf\xca\x72\x1d\xe5\x08\x4d\x50\xac\xae\x6e\xd8\x7d\xc5\xd7\x96\x7d\x15\x72\x4b\x12\x3e\xa8\x92\x3e\x73\x0d\x9a\x1b\x55\xeb\x82\x4f\xe1\x61\x27\x8a\x1d\x1c\xb4\x3a\x72\x34\x8b\x59\xb4\xe5\x04\xea\x41\xa6\x46\x4d\x49\x00\x93\x25\x98\xfa\x7e\x2f\x2c\x28\xe9\x30\x8c\x30\x79\x5b\xf1\xef\x13\x30\xcd\x81\x19\x23\xb6\x92\x97\x60\x95\x47\xe1\x32\x38\x04\xc2\x40\xa1\xf6\x87\x8a\x23\x8e\xb2\xe0\xb0\x57\x9a\xa3\x76\x09\xf6\x41\x81\xdd\x09\x5d\x12\x0e\xc2\x1a\xef\xed\x8e\x1d\x9d\xc6\x12\xa5\xbd\x3d\x1f\x8d\xc4\xfe\xa0\xb4\xed\x71\x16\x36\x5a\xed\xe1\xe2\xdb\xcf\xbf\xfc\xfa\xaf\xd5\xd5\xcd\xe5\x4f\xbf\xbc\x5f\xdf\x5c\xfe\x63\xf5\xe1\x6f\x97\x57\x57\x9f\xde\xaf\xd7\xa3\xd1\xa1\xbe\x6f\x02\xa3\x37\xb0\xe0\xd1\x19\x7d\xfe\xf6\xd9\x1f\xb8\x5e\xc2\xfb\x23\x97\xd6\x40\xf8\xaa\xf7\xe7\xdc\x49\x43\xbd\x1c\xb7\xe3\xa5\x09\xb9\x5d\x63\x38\xf0\x72\x2c\xeb\xfd\xd2\x61\x63\xe6\xf0\xcf\x95\xb4\xef\xfe\x3c\x19\x38\xa0\x0e\x07\x5e\x8e\x7b\x56\xb9\x5e\x52\x44\x94\x63\x44\x6c\x75\x35\x87\xb5\xd5\x42\x6e\xfb\xb6\xae\xe9\x16\xbb\x7b\xa7\xe1\x8a\x56\xb2\xe4\xdf\x1a\x53\x48\xc4\xf9\xf9\x39\x45\xb2\x56\x15\xc7\xcb\xe9\xa4\x8c\x90\x43\xa1\x87\xda\x2b\x6e\x43\x10\x2b\xfd\x49\x55\xdc\x89\xff\x4b\x23\xfc\x32\x15\x19\x62\xa5\x93\x53\x51\xa0\xb1\xba\x2e\x2c\x78\xe0\xc2\x85\xa5\x96\x0a\xf4\x22\x48\xf0\x02\xa7\x2e\x07\x31\x4e\x98\x85\x8b\x78\x26\x98\x28\x52\xcf\xbb\x22\x7d\x82\x92\xc0\x1e\xff\x13\x45\x1d\xc9\xb8\x69\x75\x65\xe6\x70\xeb\xc0\xbe\x6b\xa4\x0b\x29\xec\x38\xd3\x3c\xed\x6e\x9f\xc0\x63\x3c\x80\xff\x1b\x5e\x6d\x66\x74\x08\x16\x40\xbf\xbb\xcb\x5e\x08\x2c\x82\xb8\xb8\xe5\x89\xfe\x7a\x6a\xd0\x5f\xc9\x92\x22\xdf\x80\xd8\x78\x7e\xa4\x3c\x2e\x0a\x7e\xb0\xbc\x8c\xb0\x1f\x99\x06\x21\x3f\x6a\xb5\xd5\xdc\x98\x39\xfc\xa4\x54\xd5\x48\xb9\x69\x00\x68\x80\xaa\xb5\xc6\xc8\x3b\x52\x08\xd3\x56\x56\x14\xdc\x98\x71\xc8\xc1\x09\x49\x0d\xe7\xe6\x70\xeb\x2f\xf5\xae\x57\x70\x73\xaf\x8e\x27\xd1\x35\x64\x0f\x0b\x05\x93\x64\x7a\x3c\xf5\x95\x9f\xbc\xef\xb0\xba\x1a\xd6\x8c\x12\xbc\xca\x39\x3c\xba\xeb\x09\x37\x91\x40\x74\xd3\xaa\x1c\x7d\x9e\xf5\x6b\xc5\x6f\x8f\xac\xaa\x39\x2c\x62\xfd\x01\x24\x48\x66\x6b\xcd\x83\x38\x3c\x31\x6c\x24\x69\x4e\xcc\x73\x66\xb6\xcc\xf3\x4a\x3d\x97\x13\x6b\xfa\x0a\x31\x05\xe6\x80\x40\x90\x94\xac\x4e\x48\x2c\xda\x6d\x51\xf2\x05\xbd\x7a\x59\x31\xb1\xe7\x65\xa2\x1e\xef\x3d\x51\xfe\x91\xd9\x9d\xab\x9a\xc6\x2a\x34\x8c\x3c\xba\x5e\xc6\x5a\x63\x62\x04\x61\x9a\x91\x49\x6b\xab\x34\xdb\x72\x3c\x3a\x87\xe4\x43\xb6\xf3\x12\x0b\xe7\xe0\xce\xa8\xff\x93\x57\xe3\x1c\x67\xed\xec\x84\xda\x60\x81\x53\xa1\x80\x61\x65\x21\x40\xa3\xae\x60\xa7\xaf\xa7\x3d\x74\xb2\xba\x1a\x48\x7d\xa7\x53\x3d\xc8\xa6\xb9\xd0\xf1\x74\xf0\x23\x67\xda\x56\xee\xe7\x8b\xbd\x99\xee\xb6\xf8\xb8\xf2\x61\x95\xa4\x70\x30\xd4\x51\x7b\x63\x48\x1a\x5b\x79\x53\x73\xbd\x0c\x25\xd6\xe7\x55\x94\x83\x26\x6f\x6a\x97\x4a\xe3\x2f\xf4\x6b\x1d\x62\x75\xc8\xc6\x83\xe6\xad\x6f\x06\x9b\xb6\x59\x4a\x1f\x67\xae\x12\x62\x4f\x20\x15\xd2\x2f\x36\x23\x44\x2d\x67\x1d\x69\x99\x21\xb3\x8a\xcb\xad\xdd\xc1\x8f\x70\x31\x87\xb3\x9b\x6e\x52\x15\x4c\xa2\xc4\x7b\x0e\x7c\x7f\xb0\xa7\xb3\x57\x1a\x97\x70\xc1\x6d\x02\xfc\x1d\xbc\x59\x80\x14\x95\xd7\x85\xbb\x12\x9b\x99\x8c\xed\x5a\x3f\x37\xbc\x56\x3b\xfa\x60\x72\xbd\x8b\x8e\xde\x1d\x33\xc0\x2a\xcd\x59\x79\xa2\xcb\x29\x73\xe9\x49\x44\x7c\x9f\xa2\xfc\xa6\x73\x29\x7c\x2f\x86\x7a\x87\x44\x48\xbb\x81\xf8\x3e\x88\xdf\x4c\x86\xcb\x13\xd1\x40\x24\x13\x97\x71\xbe\x9b\xa6\xbe\xd4\xaa\x43\x0c\xfa\xc0\xc5\x59\x5a\x3b\x01\xad\xb4\x5e\x77\xfa\x71\x4c\x11\xc9\x1f\x82\x1f\x66\x0a\xa5\x30\x05\xd3\x65\xa0\xb4\xac\x00\x1c\x34\x3f\x0a\x55\x1b\x7f\x7a\x96\x0a\xcf\x14\x7d\x64\x9a\xed\x39\xd5\x17\x2f\xf9\x43\xa8\xef\x37\xcf\x35\xff\x53\xcc\x88\x56\xdd\xd3\x25\xd7\x99\x26\x78\xdf\xd4\x41\x8c\x0d\xab\xe0\x9e\xf7\x70\x20\x02\x15\xd9\xff\x1e\xfb\x23\x6a\xe4\xbd\xf8\x59\x27\xff\x09\x5f\x97\x9f\xe3\xb6\xd5\xb7\xa1\x2d\xb9\x7b\x1d\x13\xbc\xf9\x2e\x2a\x08\xd1\xfd\x2c\x1d\xe4\x26\x75\xf8\x40\x73\xd8\xd7\x86\x08\x80\x59\xa8\x38\x33\x36\x75\xf7\xd9\x9c\x41\xc2\xee\x69\x47\x60\x01\xb7\x77\x9d\x8d\x49\x38\x77\xbb\x07\x58\xc0\x63\x4b\x38\x16\xf3\xac\xd9\x83\x05\x5c\xe4\x5b\x28\x0a\x7d\xef\x26\x64\xcb\xd3\x1e\x74\x99\x31\x5c\x87\x32\x92\x42\x31\x85\x3d\x37\x86\x6d\xf9\x1c\xce\x9a\x07\x18\xe1\x42\x95\x3f\x43\x06\x8f\x9f\xf9\x6e\xbf\xdf\x1a\x0c\x19\x39\x68\x45\xcb\x12\x8f\xc9\x6d\xe4\x17\x22\xb2\xdc\xa2\xbc\x23\xe9\xc4\x64\x9f\x35\x29\xec\x2b\xb9\x51\xb0\xe8\x79\x76\xcc\x3e\xf8\xd5\xc8\x52\xee\xf7\x64\xd4\x92\xd4\x81\x4f\x6e\xd4\x8c\x9e\x38\x8b\xc5\x00\x7d\x65\xaf\x98\xd4\x9d\x5f\xd1\x87\x56\xd2\x19\xf2\xce\x39\xc6\x5e\x72\xab\x17\xb5\x9e\xde\xbe\x27\x62\x93\x84\x30\x33\x76\x38\x70\x59\x8e\xbd\xa8\xf0\xb2\xa0\x5f\xc9\xc3\xc2\xff\x31\xe9\x31\x26\x7b\x56\xc0\xef\x7d\x9c\x8e\xdf\x4d\x46\xcf\x98\xd0\x8f\x56\x30\x0a\x16\xd1\xbe\x57\x9c\x4a\x90\xf0\x2d\x8f\xff\xf4\x8a\xb3\x8e\xa1\x29\xef\x5e\xde\xdc\x10\x10\xb6\xe6\xba\x1e\x28\x7b\x43\x8f\x75\x02\x68\x32\xea\x41\x04\x79\x79\x4d\x55\x09\xbb\xea\x03\x92\x28\x56\x09\x6e\x66\xc9\x3b\xc2\x58\x76\x02\xa4\x3a\x0c\x5d\xa8\xa5\x15\x55\xf3\xdc\xf5\x15\x89\x58\xd8\xf4\x90\xb3\x3a\x78\x6e\xfe\xad\x7b\xb1\xa7\xd1\xf7\x02\xbb\x61\x95\x79\x0e\xd9\x74\xaa\xd1\x5b\xfe\xdd\x6c\xc3\x55\x78\xec\x42\x68\x30\xd0\xa9\x70\xb3\xb8\x3f\x16\x55\xe3\x26\x5e\xad\x09\x17\x14\xac\xaa\xb8\x6e\x7a\x76\x5f\x80\x63\xdb\x80\x08\xbb\x09\x1b\x69\x23\x16\x41\x6a\xc1\x02\xfe\xbb\x01\xba\x71\xeb\x93\x39\xfc\x35\x3c\x21\xfe\x47\xba\xc2\x45\xae\x67\xa2\x4c\x32\x74\x88\xaa\xa8\x8f\xfa\x2f\x98\x29\x8e\x11\xaf\x97\x14\x93\xfa\x6c\xd2\xd6\x45\xa2\xd3\x57\xe0\x6d\x66\x5c\x3f\xb1\xd3\xfe\xac\x53\xbd\xe7\x3c\xa8\x2b\xfd\x4b\x44\x98\x4e\xcd\x79\x59\x59\x2b\x4b\x43\x1c\x75\xe7\x5f\x43\x10\x6a\x6e\x6b\x2d\xe1\x87\x3f\x78\xdf\x9b\x1b\xee\x39\xd5\x8a\xc3\x4f\x74\xd6\xa4\x13\xa5\xe7\xa6\x01\x21\x94\xb6\xdc\x06\xce\x18\x4f\xd2\x86\xe2\xb1\x6d\x15\xf9\x9f\x11\xe5\x80\x76\x04\xd8\x07\x34\x55\x72\x82\xd7\x84\xe6\x9c\x00\x8e\xf5\xe6\xff\x37\x99\xe8\x3a\x84\xe8\x99\xf1\x97\x56\xe3\xef\x27\x87\xdd\x71\x45\xe2\x71\x97\xa6\x56\xd2\x86\x46\x93\xe4\x4c\xe0\x87\x1c\x10\xdf\xd9\xc4\x06\x8f\x02\x28\xa8\x0e\x33\x70\x81\xa3\xb8\x92\x7f\x6b\x48\xeb\x69\x94\xa5\xe2\xc0\x24\xa5\xd5\xaa\xe5\x5d\x4f\x66\xc6\xad\xff\x83\x8c\xbc\x9b\xf5\x77\x44\x62\x13\x95\x81\xcf\x4f\x52\x1c\x2b\xfb\x63\xef\x03\xd7\x24\x95\x3f\xce\xb2\xc2\x7f\x4f\x7d\x2e\xf9\xc8\x69\xc6\x19\x7d\x11\xa3\xeb\x17\x66\xed\xe1\x9a\x8d\x67\x56\x8f\x69\x33\x78\xcf\xee\x5f\x18\x7f\xfd\x4b\x3f\xc8\x1f\x8e\x00\x1c\x14\x25\xd0\x20\x26\xb2\xde\x7f\xf6\x45\x9a\x70\x69\x47\x53\x2a\x69\x32\xfb\xca\x4f\xe1\xe2\xdb\x52\x90\x36\xa3\x14\x7f\x6a\xe0\x76\x82\x84\x36\x6c\xd1\x96\xb7\xf0\x27\xf8\xb1\x11\xfa\x16\xfe\xf8\x32\x98\xd9\x5b\x2d\xfd\x77\x8d\x0c\x2c\x57\xc8\x03\x52\xe5\xb8\x0b\x8a\xd8\xe4\x2e\x78\x63\x91\x59\x2f\x92\x6d\x89\xd9\xae\xa2\x86\x2f\x5b\x61\x1b\x0d\x6a\xc5\x6d\x4b\x94\xd8\xc0\x1b\x5a\xef\xde\xa6\x3f\xe1\xe6\xcb\xed\xfe\x62\xd0\x8c\x97\x42\x14\x81\x7b\x16\x54\xd1\xc4\x21\xd5\x0e\x8c\xe9\x32\x0e\xd6\x87\xf9\x15\x0f\xfc\x9d\x19\x8c\x9e\x72\xfc\xc5\xa7\x6d\x48\xec\x2e\xdc\xde\x9c\x9e\x9c\x74\x43\x9e\xd4\x46\x9a\xcf\xa5\x08\x74\x2b\x2d\x2c\xe0\x5d\xe3\x2b\xad\xb7\xe7\x9b\xb0\x80\x73\xe3\x3e\x9e\x6f\xfa\xfa\xa5\xcf\xd9\xd0\x90\x64\xb4\x27\x9f\x2f\xca\xa0\x03\x2d\x43\x7a\xda\xb0\x6c\x3d\x86\x46\xf6\xb4\x8d\x53\x19\x1f\x17\x79\x37\xdd\xe0\x36\xf0\x7d\xa8\xdd\x2d\x46\xa5\x75\x56\x14\xaa\x96\x76\x66\xd8\x91\x8f\x63\x0d\x26\xd3\xc7\x93\x29\x58\x35\xef\xf7\x7e\x32\x02\x00\x78\x1a\x3d\x8d\xfe\x33\x00\x70\x76\x04\xf5\x12\x1e\x00\x00"
+
+func epochsFlowquorumcertificateCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_epochsFlowquorumcertificateCdc,
+		"epochs/FlowQuorumCertificate.cdc",
+	)
+}
+
+func epochsFlowquorumcertificateCdc() (*asset, error) {
+	bytes, err := epochsFlowquorumcertificateCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "epochs/FlowQuorumCertificate.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4a, 0x12, 0x7, 0xf9, 0xec, 0x37, 0x3b, 0xa9, 0xb6, 0x8a, 0xbf, 0x1c, 0x59, 0x60, 0x77, 0xc3, 0x2e, 0x41, 0xde, 0xe7, 0xe7, 0x2b, 0xbe, 0x2f, 0xb7, 0xe5, 0xd4, 0x60, 0x2c, 0x6, 0xae, 0x48}}
+	return a, nil
+}
+
 var _testcontractsTestflowidtablestakingCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x4b\x6f\x1b\xbf\x11\xbf\xeb\x53\x0c\x7c\x68\x2d\xc3\x7f\x29\x71\xd2\xa2\x30\xa2\xa6\xae\x65\x17\x82\x53\x27\xb0\xe5\xe6\x10\x04\x01\xb5\x3b\x92\x58\x53\xa4\x42\x72\x2d\x0b\x41\xbe\x7b\xc1\xc7\x72\x97\xfb\x92\x94\xb4\xfd\x5f\xa2\x43\x14\x8b\xc3\xe1\xbc\xe7\x37\xe4\xf0\xa4\xd7\x03\x00\x98\xa2\xd2\xd7\x4c\x6c\x26\xe3\x29\x99\x31\xbc\xd7\xe4\x91\xf2\x85\x5f\x5b\x52\x05\x54\x01\x01\x8d\x4a\x43\x22\xb8\x96\x24\xd1\xa0\x05\x98\x2f\xa2\x80\x70\xb8\xf8\x30\x81\xb9\x90\x76\x83\x21\xa3\x7c\x01\x7a\x89\xc0\x44\xf2\x38\x13\xcf\x40\x78\x0a\xca\x71\x85\xb5\x14\xcf\xdb\xc0\x47\x0d\x7a\x3d\x38\x19\xf6\x7a\x74\xb5\x16\x52\xc3\x75\xc6\x17\x74\xc6\x70\x2a\x1e\x91\xc3\x5c\x8a\x15\xbc\x78\xbe\x7e\xb8\xfd\xc7\xe4\xef\xef\xae\xa6\xef\x6f\xae\x6e\x2f\xc6\xe3\xbb\xab\xfb\xfb\xb0\x81\x89\x4d\x4c\xfc\xee\xfd\xc7\x88\xb0\xb7\xce\x66\x85\xdc\x75\x3d\xe1\x9b\xd3\x74\x78\x52\x7c\x60\x32\x06\x4b\x63\x45\xcf\x09\x2f\xc5\x6a\x2d\x14\xd5\x08\xd3\xed\x1a\x61\x8c\x73\xca\xa9\xa6\x82\x2b\x38\x29\x7f\x86\x9e\xe1\x70\x08\x97\x82\x6b\x42\xb9\x02\xca\xe7\x42\xae\x88\xa1\x06\xbd\x24\xda\xd8\x54\xad\x31\xa1\x73\x9a\x58\x63\x02\x17\x29\x02\xe5\x56\xc2\xb0\x5f\x70\xb6\x05\x46\x9f\xd0\x70\x00\x6d\x9c\x91\xab\x62\x69\x8c\x6e\x12\x95\xc8\x64\x82\x70\x2b\x52\xbc\xc3\x44\xc8\x34\xd7\x29\x67\x33\x5d\x22\x64\x9c\x7e\xcd\xd0\x68\x26\xe6\xd6\x3b\xe6\xc0\x88\xea\x1e\x35\x6c\x96\xc8\xc3\xaa\x11\x32\x91\x48\x34\xa6\x81\xd0\x9c\xc8\x50\x03\x4d\xcf\xe1\x5e\xcb\x10\x28\xe5\xa3\xb4\x31\x8f\x98\x5b\x1e\xe7\xd1\xea\x4b\x18\x41\x22\x18\xc3\xc4\x58\x22\x5a\x3a\xb3\x4b\x5c\x21\x57\x99\x8a\x56\x5e\xc1\x08\xf0\x19\x93\xac\xb6\xe7\x35\x8c\xe0\x09\xa5\x31\x22\xa9\x2d\xfe\x09\x46\x40\x92\x04\x95\x8a\x84\x7f\x22\x12\xa4\x60\x78\x0e\x0f\x13\xae\xff\x52\x97\x9e\xa4\xa9\x44\xa5\x20\x53\x98\x9a\xb0\x06\x8e\x7a\x23\xa4\xcd\x89\x12\xa3\x63\x85\xba\x6f\xb9\x15\xeb\x17\x6e\x6b\xb3\x65\x8c\x55\xd7\xd9\x8c\xd1\x04\x1e\x71\x7b\x20\xe7\x1b\xdc\xee\xcd\xd5\xa7\x5a\x33\x4b\xbf\xd8\xc8\xcf\x84\xf3\x71\xf8\xcb\xfe\x12\xbc\x7c\x1a\xfd\x5e\x32\xe0\xa9\x93\xc3\xfc\xe2\x22\x3b\x44\xcf\x86\x32\x06\x4b\xf2\x84\x56\xaa\x79\xa6\x33\x89\x80\x6b\x91\x2c\x55\xc4\xac\xd5\x7e\xa7\x2d\x64\x25\xe1\x63\x92\xba\x72\xf1\xba\x36\x95\x42\x5d\x8a\xd5\x8a\x6a\x8d\xe9\x39\xfc\x2d\x2a\x38\x83\x7f\x91\x8c\xe9\xb0\xa3\x5f\x4e\x23\xcb\x1e\xd9\x7c\x40\x53\x18\x01\x4d\xeb\x0b\xd6\x02\x23\x6b\x88\xfa\x62\x4d\x47\x18\xd5\xf5\xee\xda\x76\x83\xdb\x68\xcb\x0d\x6e\xeb\xe4\x85\xfe\x30\x2a\x19\x23\xd6\x22\x45\xa5\xa5\xd8\x56\x8d\x11\x68\xbe\xf7\xdc\xbf\xa5\x30\x33\xc6\xcc\x5c\xd5\x77\xf5\xc0\xfc\x6f\x81\x1a\x24\x92\xf4\x37\x5b\xa4\x4c\x81\x03\x32\x13\x99\xf6\xb5\x2c\xd4\x27\xe5\xf6\x9a\xea\x34\x31\x44\xdf\xba\x6a\x49\x75\xa9\x9c\xa9\xd5\xb5\xf6\xbc\x6b\xa7\x2c\xc7\x7d\x95\xaa\x21\x35\xaa\x24\xce\x66\xa6\x21\x98\xe8\x79\xb8\xa6\xcf\x7f\x7e\xdd\x40\xa4\x09\x9b\xee\x47\x59\x89\xc7\x4e\xba\x07\xee\x25\xdc\x8b\x6e\x27\xbb\x3b\xdc\x10\x99\x96\xc8\xa2\xba\xc2\xa8\xd2\xa6\x86\xa7\xc8\x70\x41\xb4\x90\x30\x19\x2b\x9b\xc8\xb6\x0b\xd9\x04\x17\x6b\x94\x66\xa9\x76\x40\xd8\xa4\xce\xe1\x93\x71\xde\xab\xb3\xcf\xed\x44\x93\xf1\xa5\xc8\xb8\x46\xe9\x1c\xfd\xea\xac\x55\xe0\xaf\x19\x2a\x8d\xe9\x54\x78\x15\x5b\x35\xb4\x8d\x99\xb0\x8f\x48\x17\x4b\xed\xd8\x96\x15\xb4\x85\xce\xa8\x30\x19\xe7\xae\xee\x48\x76\x47\xd8\x9a\xf0\x67\xfb\x65\xfb\x91\xef\x2b\x47\xbb\xb3\xfc\xe8\x11\xb7\x0d\x64\x51\x76\xb7\xd0\x94\x03\x14\x46\xf0\x62\xf0\xa2\x89\xa6\x12\x9f\xed\x84\x51\x78\x76\x93\x85\xe8\xdc\x87\x6c\x17\xb3\x3c\x36\xdb\xa8\x8a\x00\x83\x11\x7c\xfa\xdc\x41\x10\x82\xcb\xb0\x6a\x3f\xae\x1a\x59\x6d\x07\x47\x81\x15\xb1\x8c\xca\xa6\xc9\xa0\xbb\x1c\x97\xc5\x5d\x31\x4f\x1a\x07\xe4\x04\x53\xb5\x9e\x5d\x03\x75\xd6\x4b\xb2\x0a\xea\x1e\x02\xa0\x73\x59\x89\x3b\x92\xb2\x09\xb1\xd9\x44\x28\x16\xfa\xa5\xe2\x0c\x6d\x0d\xef\x7b\x2c\xc6\x45\x9a\x02\xc7\x8d\xcf\x51\xd3\x18\x8c\x28\x6a\xab\x34\xae\xcc\x5f\xce\x9c\x69\x26\xf3\xa9\x80\xe3\xb3\x76\x30\x20\x12\x71\x9e\x71\x47\x7b\x8b\x0e\xd1\xab\xe3\x2f\x9e\x69\x73\x9f\xae\x65\x6c\xdc\xd8\xda\xe4\xb5\xc6\x0c\xd2\x1a\xd7\x10\x89\x0e\x5d\x63\xa5\x80\xc2\x2c\x4b\x1e\x51\x47\xdb\x67\x99\xb6\x98\x86\xff\x51\xc3\x0c\x91\x83\x98\xcf\x69\x42\x09\x63\x5b\x27\x7e\xda\xac\x55\xce\xd3\xab\x46\x56\x26\x2a\xf3\xfa\x15\xa9\x72\xb8\xbc\x21\x5b\xfe\x8b\xf2\xe6\x3c\x0f\x96\xd7\xe7\x12\xb8\x1d\xa5\xb0\x98\x21\x48\x5c\x89\x27\x83\xaa\xcd\xac\x56\x85\xa9\x66\xb3\x4f\x14\xe4\x69\x98\x52\xda\xa3\x45\xba\x93\x42\xe1\x39\x5c\x48\x2b\x57\xe6\x33\x9e\x30\x56\x1e\x8d\x42\x22\x29\x6f\x27\x3b\x0b\x26\xa1\x18\x3a\xc5\x4e\x63\xf1\x15\x6c\x90\x31\x3b\x16\x33\xe6\x12\xa1\x7d\xaf\x39\xad\x38\x94\xca\x52\xdb\xac\xe9\xea\x85\xbc\x60\xec\xb8\x4b\xaf\x8f\x54\x2f\x53\x49\x42\x36\x5a\x43\x6b\x3b\xfd\x35\x87\x74\xce\x7f\xe3\x37\x76\x87\x69\x73\x22\x56\x8a\x86\xa9\x35\xf3\x7c\x2c\xff\x27\xf5\xb5\xb7\x3e\x78\x0f\x48\x92\x18\xee\x83\x99\x90\x52\x6c\xde\xfc\x21\xcc\xf2\x03\xb7\xeb\xaf\xc7\x46\xfc\x73\x18\x2a\x2d\x24\x59\xe0\xb0\xc2\xb5\x1f\x1d\x6b\x3e\x6f\xdf\xc2\x9a\x70\x9a\x1c\x1f\x5d\x8a\x8c\xa5\xc0\x85\x06\xc7\x1d\x56\x4e\x10\x89\x73\x94\xc8\x13\x3c\xea\xc7\xc5\x43\xa2\xce\x24\x87\x37\xbf\x55\x65\x1f\x98\x9d\x15\x73\xb8\xef\xfe\xe1\x6e\x90\x2d\x99\x5a\x75\x43\x77\xf6\xfd\x72\x43\xec\x86\x8a\x17\xfc\x57\x69\xf6\x18\x07\x34\xe0\x06\x90\xc6\xde\xd8\x82\x3d\x63\x98\xf8\x93\x10\x7e\xaf\x79\x60\x5f\x9c\x5f\x03\xf0\x5d\xec\xf6\x18\x07\x5a\xd1\x75\x17\x6e\x3e\x2d\xe3\xf8\xdc\x88\x1d\x60\xba\x44\xdd\x00\x84\x2d\xeb\x0e\xc8\x7d\x10\x22\xdd\x07\xdc\xfe\x5f\x51\xeb\x9e\x60\x33\x0e\xe3\x08\x4a\x8a\xd9\xbf\xd1\xcc\xe1\x39\xa2\x2c\xa6\x33\x93\x9c\xf9\x2d\xa1\xe9\x25\x3e\xab\x03\x0b\x2d\x60\x8d\x72\x2e\x64\x68\xbc\x40\xec\x25\x9c\x6a\xc6\x9c\x21\x65\xaa\xb0\xf3\x8a\x24\xcb\xd2\xb9\x06\x7b\x92\x0a\xb2\x5d\x9a\xfe\x57\x5c\x38\x76\x65\x5b\xed\xf6\x2d\xbe\x9f\x2c\x78\x7a\x95\xa9\x2a\x9d\xed\xff\x87\xa6\x8d\xef\xca\xdb\x3a\xe4\x75\x12\x9c\x42\x7d\x0e\x6c\x8e\xdc\xa6\x3b\x9f\xb6\x80\xad\xf4\x03\x6f\x4c\x6c\x00\xca\xed\x98\xdd\xf4\x83\x5c\xc3\x02\x13\xbb\x5a\x7c\x10\x1e\x36\x5b\x76\x4a\xb6\x03\x30\x1c\x26\xee\x0f\x83\xdd\x56\x71\x5c\xe3\x54\x3f\x24\xcd\x4f\x43\xd9\x12\x48\xcc\x79\x06\x0c\xb7\xdf\x60\xf3\x13\x50\xf5\x17\xa4\xfb\x3d\xb1\xc4\x4e\x2f\xfc\x42\x74\xff\x5b\x2f\x40\xdc\x09\x2f\xf8\xd6\x65\x7d\x42\x38\x24\x6e\xc8\xa2\xca\x58\x3a\x71\x6f\x6b\x02\x24\x2e\xa8\x32\x22\x11\x5b\x6e\x6f\xf3\xbb\x68\xb3\x7d\xa2\xbd\x3c\xca\x3b\xcf\x77\x3c\xfb\x18\x23\x52\x54\xa1\xb5\x6e\xed\x09\xb6\xab\x02\xe5\x45\x1b\x2d\x37\x57\xf0\xf6\xeb\x95\x1d\x4e\xd2\xb4\x78\x88\x2b\x5e\x53\x9a\x5e\x52\xca\xaf\x28\xe1\xc7\x3d\x5e\x43\x76\xbc\x84\x74\xbd\x82\xec\xff\x02\x62\x62\x32\xba\x7c\xca\x59\xb4\xbd\x1f\x94\xf2\x25\x77\x7a\xe1\x01\xcf\xa5\x8a\x5f\xe2\x1e\xdf\x85\x61\xa2\x50\xf2\x8f\x10\x05\x63\xdb\xd0\x69\xda\xaf\x0d\x00\xae\xf8\xba\x80\xb8\xc5\x4d\x40\x35\xd5\xdb\x5f\xaf\x6c\x23\xea\x69\x3a\xb6\x60\x64\x4e\x7e\x59\xa0\x08\xf7\xdd\x2f\xcb\x61\x11\xc7\x17\xd7\x19\xac\x95\x3f\x90\xad\xc8\x42\xf2\x9f\xc2\x17\x5f\x45\x2e\xb3\xa8\x23\x38\x16\xdf\x7b\xff\x09\x00\x00\xff\xff\x48\x87\x4a\x14\xa8\x1f\x00\x00"
 
 func testcontractsTestflowidtablestakingCdcBytes() ([]byte, error) {
@@ -335,6 +398,9 @@ var _bindata = map[string]func() (*asset, error){
 	"FlowToken.cdc":                            flowtokenCdc,
 	"LockedTokens.cdc":                         lockedtokensCdc,
 	"StakingProxy.cdc":                         stakingproxyCdc,
+	"epochs/FlowDKG.cdc":                       epochsFlowdkgCdc,
+	"epochs/FlowEpoch.cdc":                     epochsFlowepochCdc,
+	"epochs/FlowQuorumCertificate.cdc":         epochsFlowquorumcertificateCdc,
 	"testContracts/TestFlowIDTableStaking.cdc": testcontractsTestflowidtablestakingCdc,
 }
 
@@ -389,6 +455,11 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"FlowToken.cdc": {flowtokenCdc, map[string]*bintree{}},
 	"LockedTokens.cdc": {lockedtokensCdc, map[string]*bintree{}},
 	"StakingProxy.cdc": {stakingproxyCdc, map[string]*bintree{}},
+	"epochs": {nil, map[string]*bintree{
+		"FlowDKG.cdc": {epochsFlowdkgCdc, map[string]*bintree{}},
+		"FlowEpoch.cdc": {epochsFlowepochCdc, map[string]*bintree{}},
+		"FlowQuorumCertificate.cdc": {epochsFlowquorumcertificateCdc, map[string]*bintree{}},
+	}},
 	"testContracts": {nil, map[string]*bintree{
 		"TestFlowIDTableStaking.cdc": {testcontractsTestflowidtablestakingCdc, map[string]*bintree{}},
 	}},
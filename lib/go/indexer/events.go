@@ -0,0 +1,186 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+const (
+	idTableContract      = "FlowIDTableStaking"
+	lockedTokensContract = "LockedTokens"
+)
+
+// Kind is what an indexed event is about, which decides the timeline it is part of.
+type Kind string
+
+const (
+	// KindNode is an event about the tokens of a node.
+	KindNode Kind = "node"
+	// KindDelegator is an event about the tokens of a delegator.
+	KindDelegator Kind = "delegator"
+	// KindAccount is an event about a locked token account.
+	KindAccount Kind = "account"
+	// KindContract is an event about the whole staking contract, like the start of an epoch.
+	KindContract Kind = "contract"
+)
+
+// newEpochEvent is the event that FlowIDTableStaking emits when an epoch starts.
+const newEpochEvent = idTableContract + ".NewEpoch"
+
+type eventType struct {
+	contract string
+	name     string
+	kind     Kind
+}
+
+// eventTypes are the events that are indexed.
+var eventTypes = []eventType{
+	{idTableContract, "NewEpoch", KindContract},
+	{idTableContract, "NewDelegatorCutPercentage", KindContract},
+	{idTableContract, "NewWeeklyPayout", KindContract},
+	{idTableContract, "NewStakingMinimums", KindContract},
+	{idTableContract, "NewRewardRatios", KindContract},
+	{idTableContract, "NewMaximumInitialWeight", KindContract},
+	{idTableContract, "NewMaximumDelegationRatios", KindContract},
+
+	{idTableContract, "NewNodeCreated", KindNode},
+	{idTableContract, "TokensCommitted", KindNode},
+	{idTableContract, "TokensStaked", KindNode},
+	{idTableContract, "TokensUnstaking", KindNode},
+	{idTableContract, "TokensUnstaked", KindNode},
+	{idTableContract, "NodeRemovedAndRefunded", KindNode},
+	{idTableContract, "RewardsPaid", KindNode},
+	{idTableContract, "UnstakedTokensWithdrawn", KindNode},
+	{idTableContract, "RewardTokensWithdrawn", KindNode},
+	{idTableContract, "TokensSlashed", KindNode},
+
+	{idTableContract, "NewDelegatorCreated", KindDelegator},
+	{idTableContract, "DelegatorTokensCommitted", KindDelegator},
+	{idTableContract, "DelegatorTokensStaked", KindDelegator},
+	{idTableContract, "DelegatorTokensUnstaking", KindDelegator},
+	{idTableContract, "DelegatorTokensUnstaked", KindDelegator},
+	{idTableContract, "DelegatorRewardsPaid", KindDelegator},
+	{idTableContract, "DelegatorUnstakedTokensWithdrawn", KindDelegator},
+	{idTableContract, "DelegatorRewardTokensWithdrawn", KindDelegator},
+	{idTableContract, "DelegatorTokensSlashed", KindDelegator},
+
+	{lockedTokensContract, "SharedAccountRegistered", KindAccount},
+	{lockedTokensContract, "UnlockedAccountRegistered", KindAccount},
+	{lockedTokensContract, "UnlockLimitIncreased", KindAccount},
+	{lockedTokensContract, "LockedAccountRegisteredAsNode", KindAccount},
+	{lockedTokensContract, "LockedAccountRegisteredAsDelegator", KindAccount},
+	{lockedTokensContract, "LockedTokensDeposited", KindAccount},
+}
+
+// amountFields are the fields that contain the amount of an event, the first one that exists is used.
+var amountFields = []string{"amount", "amountCommitted", "increaseAmount", "totalStaked", "newPayout"}
+
+// Event is an indexed event.
+type Event struct {
+	Height           uint64
+	BlockID          flow.Identifier
+	TransactionID    flow.Identifier
+	TransactionIndex int
+	EventIndex       int
+
+	// Epoch is the counter of the epoch the event was emitted in.
+	// The rewards of an epoch are paid and the tokens are moved at its end, so those events
+	// are part of the epoch, and the NewEpoch event is the first event of the epoch it starts.
+	Epoch uint64
+
+	// Type is the contract and name of the event, like FlowIDTableStaking.RewardsPaid.
+	Type string
+	Kind Kind
+
+	// NodeID, DelegatorID, Address and Amount are copied from the fields of the event
+	// with the same names, if it has them. The amount of events without an amount is zero.
+	NodeID      string
+	DelegatorID uint32
+	Address     flow.Address
+	Amount      ufix64.UFix64
+
+	// Payload is the JSON-Cadence encoding of the event, which contains all its fields.
+	Payload []byte
+}
+
+// eventTypeIDs returns the types of the indexed events for the addresses of a configuration,
+// like A.8624b52f9ddcd04a.FlowIDTableStaking.RewardsPaid.
+func eventTypeIDs(conf Config) map[string]eventType {
+	addresses := map[string]flow.Address{
+		idTableContract:      conf.IDTableAddress,
+		lockedTokensContract: conf.LockedTokensAddress,
+	}
+
+	ids := make(map[string]eventType, len(eventTypes))
+	for _, t := range eventTypes {
+		ids[fmt.Sprintf("A.%s.%s.%s", addresses[t.contract].Hex(), t.contract, t.name)] = t
+	}
+
+	return ids
+}
+
+// decodeEvent copies the fields of an event that the indexer stores in columns.
+func decodeEvent(t eventType, event flow.Event) (Event, error) {
+	decoded := Event{
+		TransactionID:    event.TransactionID,
+		TransactionIndex: event.TransactionIndex,
+		EventIndex:       event.EventIndex,
+		Type:             t.contract + "." + t.name,
+		Kind:             t.kind,
+	}
+
+	fields := make(map[string]cadence.Value, len(event.Value.Fields))
+	for i, field := range event.Value.EventType.Fields {
+		if i < len(event.Value.Fields) {
+			fields[field.Identifier] = event.Value.Fields[i]
+		}
+	}
+
+	if value, ok := fields["nodeID"]; ok {
+		nodeID, ok := value.(cadence.String)
+		if !ok {
+			return Event{}, fmt.Errorf("%s: unexpected type %T for field nodeID", decoded.Type, value)
+		}
+
+		decoded.NodeID = string(nodeID)
+	}
+
+	if value, ok := fields["delegatorID"]; ok {
+		delegatorID, ok := value.(cadence.UInt32)
+		if !ok {
+			return Event{}, fmt.Errorf("%s: unexpected type %T for field delegatorID", decoded.Type, value)
+		}
+
+		decoded.DelegatorID = uint32(delegatorID)
+	}
+
+	if value, ok := fields["address"]; ok {
+		address, ok := value.(cadence.Address)
+		if !ok {
+			return Event{}, fmt.Errorf("%s: unexpected type %T for field address", decoded.Type, value)
+		}
+
+		decoded.Address = flow.Address(address)
+	}
+
+	for _, name := range amountFields {
+		value, ok := fields[name]
+		if !ok {
+			continue
+		}
+
+		amount, ok := value.(cadence.UFix64)
+		if !ok {
+			return Event{}, fmt.Errorf("%s: unexpected type %T for field %s", decoded.Type, value, name)
+		}
+
+		decoded.Amount = ufix64.UFix64(amount)
+		break
+	}
+
+	return decoded, nil
+}
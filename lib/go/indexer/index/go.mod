@@ -0,0 +1,29 @@
+module github.com/onflow/flow-core-contracts/lib/go/indexer/index
+
+go 1.13
+
+replace github.com/onflow/flow-core-contracts/lib/go/bootstrap => ../../bootstrap
+
+replace github.com/onflow/flow-core-contracts/lib/go/contracts => ../../contracts
+
+replace github.com/onflow/flow-core-contracts/lib/go/indexer => ../
+
+replace github.com/onflow/flow-core-contracts/lib/go/model => ../../model
+
+replace github.com/onflow/flow-core-contracts/lib/go/templates => ../../templates
+
+replace github.com/onflow/flow-core-contracts/lib/go/testkit => ../../testkit
+
+replace github.com/onflow/flow-core-contracts/lib/go/ufix64 => ../../ufix64
+
+replace github.com/onflow/flow-core-contracts/lib/go/verify => ../../verify
+
+require (
+	github.com/onflow/flow-core-contracts/lib/go/contracts v0.7.1
+	github.com/onflow/flow-core-contracts/lib/go/indexer v0.0.0-00010101000000-000000000000
+	github.com/onflow/flow-core-contracts/lib/go/ufix64 v0.0.0-00010101000000-000000000000
+	github.com/onflow/flow-core-contracts/lib/go/verify v0.0.0-00010101000000-000000000000
+	github.com/onflow/flow-go-sdk v0.17.0
+	github.com/spf13/cobra v1.1.1
+	google.golang.org/grpc v1.31.1
+)
@@ -0,0 +1,314 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/client"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+	"github.com/onflow/flow-core-contracts/lib/go/indexer"
+	"github.com/onflow/flow-core-contracts/lib/go/indexer/statement"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+	"github.com/onflow/flow-core-contracts/lib/go/verify"
+)
+
+type Config struct {
+	Network     string
+	AccessNode  string
+	DB          string
+	StartHeight uint64
+	StartEpoch  uint64
+	Follow      time.Duration
+
+	Format     string
+	Delegator  int64
+	InitialCut string
+}
+
+var accessNodes = map[string]string{
+	verify.Mainnet: "access.mainnet.nodes.onflow.org:9000",
+	verify.Testnet: "access.devnet.nodes.onflow.org:9000",
+}
+
+var conf Config
+
+var cmd = &cobra.Command{
+	Use:   "index",
+	Short: "Index the staking events of a network into a SQLite database",
+	Long: `Index the staking events of a network into a SQLite database.
+
+The FlowIDTableStaking and LockedTokens events of every sealed block after the
+last indexed block are read from an access node and stored in the database.
+A new database starts at --start-height, which is in the epoch --start-epoch.
+
+With --follow, new blocks are indexed at the given interval until the command is stopped.
+The node, delegator, account, epochs and statement commands query the database.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := index(conf); err != nil {
+			exit(err)
+		}
+	},
+}
+
+var nodeCmd = &cobra.Command{
+	Use:   "node <node ID>",
+	Short: "Show the events of a node",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		query(func(ctx context.Context, db *indexer.DB) ([]indexer.Event, error) {
+			return db.NodeTimeline(ctx, args[0])
+		})
+	},
+}
+
+var delegatorCmd = &cobra.Command{
+	Use:   "delegator <node ID> <delegator ID>",
+	Short: "Show the events of a delegator",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		delegatorID, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			exit(fmt.Errorf("invalid delegator ID %s", args[1]))
+		}
+
+		query(func(ctx context.Context, db *indexer.DB) ([]indexer.Event, error) {
+			return db.DelegatorTimeline(ctx, args[0], uint32(delegatorID))
+		})
+	},
+}
+
+var accountCmd = &cobra.Command{
+	Use:   "account <address>",
+	Short: "Show the LockedTokens events of an account",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		network, err := verify.GetNetwork(conf.Network)
+		if err != nil {
+			exit(err)
+		}
+
+		address, err := contracts.ParseAddress(args[0], network.ChainID)
+		if err != nil {
+			exit(err)
+		}
+
+		query(func(ctx context.Context, db *indexer.DB) ([]indexer.Event, error) {
+			return db.AccountTimeline(ctx, flow.Address(address))
+		})
+	},
+}
+
+var epochsCmd = &cobra.Command{
+	Use:   "epochs",
+	Short: "Show the totals of the node and delegator events of every epoch",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		db, err := indexer.Open(conf.DB)
+		if err != nil {
+			exit(err)
+		}
+		defer db.Close()
+
+		totals, err := db.EpochTotals(context.Background())
+		if err != nil {
+			exit(err)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "EPOCH\tEVENT\tCOUNT\tAMOUNT")
+
+		for _, total := range totals {
+			fmt.Fprintf(w, "%d\t%s\t%d\t%s\n", total.Epoch, total.Type, total.Count, total.Amount)
+		}
+
+		w.Flush()
+	},
+}
+
+var statementCmd = &cobra.Command{
+	Use:   "statement <node ID>",
+	Short: "Write the reward statements of a node operator and its delegators",
+	Long: `Write the reward statements of a node operator and its delegators as CSV or JSON.
+
+Every statement has a line for each epoch with the rewards paid at its end, the cut
+that the operator took from the rewards of its delegators, the cumulative rewards and
+the rewards that were withdrawn. The rewards of the operator include the cut.
+
+The cut is derived from the rewards paid to a delegator and the cut percentage at the time,
+which starts at --initial-cut and is changed by the indexed NewDelegatorCutPercentage events.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := writeStatements(conf, args[0]); err != nil {
+			exit(err)
+		}
+	},
+}
+
+func writeStatements(conf Config, nodeID string) error {
+	initialCut, err := ufix64.Parse(conf.InitialCut)
+	if err != nil {
+		return fmt.Errorf("invalid initial cut %s: %w", conf.InitialCut, err)
+	}
+
+	write := statement.WriteCSV
+	switch conf.Format {
+	case "csv":
+	case "json":
+		write = statement.WriteJSON
+	default:
+		return fmt.Errorf("unknown format %s, expected csv or json", conf.Format)
+	}
+
+	db, err := indexer.Open(conf.DB)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	statements, err := statement.Statements(context.Background(), db, nodeID, initialCut)
+	if err != nil {
+		return err
+	}
+
+	if conf.Delegator >= 0 {
+		var selected []statement.Statement
+		for _, s := range statements {
+			if s.Kind == indexer.KindDelegator && int64(s.DelegatorID) == conf.Delegator {
+				selected = append(selected, s)
+			}
+		}
+
+		if len(selected) == 0 {
+			return fmt.Errorf("no rewards of delegator %d of node %s are indexed", conf.Delegator, nodeID)
+		}
+
+		statements = selected
+	}
+
+	return write(os.Stdout, statements)
+}
+
+func index(conf Config) error {
+	network, err := verify.GetNetwork(conf.Network)
+	if err != nil {
+		return err
+	}
+
+	indexerConf := indexer.Config{
+		IDTableAddress: flow.Address(network.Addresses.IDTable),
+		StartHeight:    conf.StartHeight,
+		StartEpoch:     conf.StartEpoch,
+	}
+
+	for _, contract := range network.Contracts {
+		if contract.Name == contracts.LockedTokensName {
+			indexerConf.LockedTokensAddress = flow.Address(contract.Address)
+		}
+	}
+
+	accessNode := conf.AccessNode
+	if accessNode == "" {
+		accessNode = accessNodes[network.Name]
+	}
+
+	flowClient, err := client.New(accessNode, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer flowClient.Close()
+
+	db, err := indexer.Open(conf.DB)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	i := indexer.New(db, indexer.NewClientSource(flowClient), indexerConf)
+
+	for {
+		height, err := i.Index(context.Background())
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("indexed up to block %d\n", height)
+
+		if conf.Follow == 0 {
+			return nil
+		}
+
+		time.Sleep(conf.Follow)
+	}
+}
+
+func query(events func(ctx context.Context, db *indexer.DB) ([]indexer.Event, error)) {
+	db, err := indexer.Open(conf.DB)
+	if err != nil {
+		exit(err)
+	}
+	defer db.Close()
+
+	timeline, err := events(context.Background(), db)
+	if err != nil {
+		exit(err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "HEIGHT\tEPOCH\tEVENT\tAMOUNT\tTRANSACTION")
+
+	for _, event := range timeline {
+		fmt.Fprintf(w, "%d\t%d\t%s\t%s\t%s\n", event.Height, event.Epoch, event.Type, event.Amount, event.TransactionID)
+	}
+
+	w.Flush()
+}
+
+func init() {
+	initConfig()
+}
+
+func initConfig() {
+	flags := cmd.PersistentFlags()
+
+	flags.StringVar(&conf.DB, "db", "staking.db", "Path of the SQLite database")
+
+	indexFlags := cmd.Flags()
+
+	indexFlags.StringVar(&conf.Network, "network", verify.Mainnet, "Flow network to index")
+	indexFlags.StringVar(&conf.AccessNode, "access-node", "", "Access node to read the blocks from, defaults to the public access node of the network")
+	indexFlags.Uint64Var(&conf.StartHeight, "start-height", 0, "First block to index into a new database")
+	indexFlags.Uint64Var(&conf.StartEpoch, "start-epoch", 0, "Counter of the epoch of the start height")
+	indexFlags.DurationVar(&conf.Follow, "follow", 0, "Keep indexing new blocks at this interval")
+
+	accountFlags := accountCmd.Flags()
+
+	accountFlags.StringVar(&conf.Network, "network", verify.Mainnet, "Flow network of the address")
+
+	statementFlags := statementCmd.Flags()
+
+	statementFlags.StringVar(&conf.Format, "format", "csv", "Format of the statements, csv or json")
+	statementFlags.Int64Var(&conf.Delegator, "delegator", -1, "Only write the statement of this delegator of the node")
+	statementFlags.StringVar(&conf.InitialCut, "initial-cut", "0.08", "Cut percentage before the first indexed NewDelegatorCutPercentage event")
+
+	cmd.AddCommand(nodeCmd, delegatorCmd, accountCmd, epochsCmd, statementCmd)
+}
+
+func main() {
+	if err := cmd.Execute(); err != nil {
+		exit(err)
+	}
+}
+
+func exit(err error) {
+	fmt.Println(err)
+	os.Exit(1)
+}
@@ -0,0 +1,165 @@
+// Package indexer stores the history of the FlowIDTableStaking and LockedTokens events in SQLite.
+//
+// The contracts only keep the current token buckets of nodes and delegators. The indexer reads
+// the events of every sealed block from an EventSource, like an access node or an emulator,
+// and stores them with the epoch they were emitted in, so the timeline of a node or delegator
+// and the totals of every epoch can be queried.
+//
+//	db, err := indexer.Open("staking.db")
+//	...
+//	i := indexer.New(db, indexer.NewClientSource(flowClient), indexer.Config{
+//	    IDTableAddress:      flow.HexToAddress("8624b52f9ddcd04a"),
+//	    LockedTokensAddress: flow.HexToAddress("8d0e87b65159ae63"),
+//	    StartHeight:         startHeight,
+//	})
+//
+//	height, err := i.Index(ctx)
+//	...
+//	timeline, err := db.NodeTimeline(ctx, nodeID)
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow-go-sdk"
+)
+
+// DefaultBatchSize is the largest range of blocks that access nodes return events for.
+const DefaultBatchSize = 250
+
+// Config contains the addresses of the contracts whose events are indexed
+// and where a new database starts.
+type Config struct {
+	IDTableAddress      flow.Address
+	LockedTokensAddress flow.Address
+
+	// StartHeight is the first block that is indexed into an empty database.
+	StartHeight uint64
+
+	// StartEpoch is the counter of the epoch that the start height is part of.
+	// The epoch is incremented by every NewEpoch event.
+	StartEpoch uint64
+
+	// BatchSize is the number of blocks that are fetched from the source at once.
+	// If it is zero, DefaultBatchSize is used.
+	BatchSize uint64
+}
+
+// Indexer indexes the events of an event source into a database.
+type Indexer struct {
+	db     *DB
+	source EventSource
+	conf   Config
+	types  map[string]eventType
+}
+
+// New returns an indexer that indexes the events of the source into the database.
+func New(db *DB, source EventSource, conf Config) *Indexer {
+	if conf.BatchSize == 0 {
+		conf.BatchSize = DefaultBatchSize
+	}
+
+	return &Indexer{
+		db:     db,
+		source: source,
+		conf:   conf,
+		types:  eventTypeIDs(conf),
+	}
+}
+
+// Index indexes the blocks after the last indexed block, or from the start height,
+// up to the latest sealed block, and returns the height of the last indexed block.
+//
+// The blocks are indexed in batches, and every batch is stored in one transaction,
+// so Index can be interrupted and called again to resume. Blocks are never indexed twice.
+func (i *Indexer) Index(ctx context.Context) (uint64, error) {
+	height, epoch, ok, err := i.db.progress(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	next := height + 1
+	if !ok {
+		next = i.conf.StartHeight
+		epoch = i.conf.StartEpoch
+	}
+
+	latest, err := i.source.LatestHeight(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("could not get the latest block: %w", err)
+	}
+
+	for start := next; start <= latest; start += i.conf.BatchSize {
+		end := start + i.conf.BatchSize - 1
+		if end > latest {
+			end = latest
+		}
+
+		events, err := i.fetch(ctx, start, end)
+		if err != nil {
+			return 0, err
+		}
+
+		for j := range events {
+			if events[j].Type == newEpochEvent {
+				epoch++
+			}
+
+			events[j].Epoch = epoch
+		}
+
+		if err := i.db.store(ctx, events, end, epoch); err != nil {
+			return 0, fmt.Errorf("could not store blocks %d to %d: %w", start, end, err)
+		}
+
+		height = end
+	}
+
+	return height, nil
+}
+
+// fetch returns the indexed events of a range of blocks, in the order they were emitted.
+func (i *Indexer) fetch(ctx context.Context, start, end uint64) ([]Event, error) {
+	var events []Event
+
+	for id, t := range i.types {
+		blocks, err := i.source.GetEvents(ctx, id, start, end)
+		if err != nil {
+			return nil, fmt.Errorf("could not get %s events of blocks %d to %d: %w", id, start, end, err)
+		}
+
+		for _, block := range blocks {
+			for _, event := range block.Events {
+				decoded, err := decodeEvent(t, event)
+				if err != nil {
+					return nil, fmt.Errorf("block %d: %w", block.Height, err)
+				}
+
+				decoded.Height = block.Height
+				decoded.BlockID = block.BlockID
+
+				decoded.Payload, err = jsoncdc.Encode(event.Value)
+				if err != nil {
+					return nil, fmt.Errorf("block %d: could not encode %s event: %w", block.Height, decoded.Type, err)
+				}
+
+				events = append(events, decoded)
+			}
+		}
+	}
+
+	sort.Slice(events, func(a, b int) bool {
+		if events[a].Height != events[b].Height {
+			return events[a].Height < events[b].Height
+		}
+		if events[a].TransactionIndex != events[b].TransactionIndex {
+			return events[a].TransactionIndex < events[b].TransactionIndex
+		}
+		return events[a].EventIndex < events[b].EventIndex
+	})
+
+	return events, nil
+}
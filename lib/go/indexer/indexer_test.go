@@ -0,0 +1,273 @@
+package indexer_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/bootstrap"
+	"github.com/onflow/flow-core-contracts/lib/go/indexer"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/testkit"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+func types(events []indexer.Event) []string {
+	types := make([]string, len(events))
+	for i, event := range events {
+		types[i] = event.Type
+	}
+
+	return types
+}
+
+func TestIndexer(t *testing.T) {
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "indexer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "staking.db")
+
+	f := testkit.New(t)
+
+	conf := indexer.Config{
+		IDTableAddress:      f.Deployment.IDTable.Address,
+		LockedTokensAddress: f.Deployment.LockedTokens.Address,
+		BatchSize:           5,
+	}
+
+	index := func() uint64 {
+		db, err := indexer.Open(path)
+		require.NoError(t, err)
+		defer db.Close()
+
+		height, err := indexer.New(db, indexer.NewEmulatorSource(f.Blockchain), conf).Index(ctx)
+		require.NoError(t, err)
+
+		return height
+	}
+
+	node := f.NewNode(testkit.NodeID(1), testkit.RoleCollection, ufix64.MustParse("500000.0"))
+	delegator := f.NewDelegator(node.ID, ufix64.MustParse("100000.0"))
+
+	f.AdvanceEpoch()
+
+	height := index()
+
+	latest, err := f.Blockchain.GetLatestBlock()
+	require.NoError(t, err)
+	assert.Equal(t, latest.Header.Height, height)
+
+	assert.Equal(t, height, index(), "indexing again without new blocks should not index anything")
+
+	f.AdvanceEpoch()
+	f.RequestUnstaking(node, ufix64.MustParse("100000.0"))
+	f.AdvanceEpoch()
+
+	f.Send(templates.GenerateWithdrawRewardedTokensScript(f.Env), node.Owner, false, cadence.UFix64(ufix64.MustParse("1000.0")))
+
+	assert.Greater(t, index(), height)
+
+	db, err := indexer.Open(path)
+	require.NoError(t, err)
+	defer db.Close()
+
+	t.Run("Node timeline", func(t *testing.T) {
+		timeline, err := db.NodeTimeline(ctx, node.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"FlowIDTableStaking.NewNodeCreated",
+			"FlowIDTableStaking.TokensStaked",
+			"FlowIDTableStaking.RewardsPaid",
+			"FlowIDTableStaking.RewardsPaid",
+			"FlowIDTableStaking.TokensUnstaking",
+			"FlowIDTableStaking.RewardTokensWithdrawn",
+		}, types(timeline))
+
+		for _, event := range timeline {
+			assert.Equal(t, indexer.KindNode, event.Kind)
+			assert.Equal(t, node.ID, event.NodeID)
+			assert.NotEqual(t, flow.EmptyID, event.TransactionID)
+			assert.NotEmpty(t, event.Payload)
+		}
+
+		// The committed tokens are staked at the end of the epoch the node registered in
+		assert.Equal(t, ufix64.MustParse("500000.0"), timeline[1].Amount)
+		assert.Equal(t, uint64(0), timeline[1].Epoch)
+		assert.Equal(t, uint64(1), timeline[2].Epoch)
+		assert.Equal(t, uint64(2), timeline[3].Epoch)
+		assert.Equal(t, ufix64.MustParse("100000.0"), timeline[4].Amount)
+		assert.Equal(t, ufix64.MustParse("1000.0"), timeline[5].Amount)
+		assert.Equal(t, uint64(3), timeline[5].Epoch)
+	})
+
+	t.Run("Delegator timeline", func(t *testing.T) {
+		timeline, err := db.DelegatorTimeline(ctx, node.ID, delegator.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"FlowIDTableStaking.NewDelegatorCreated",
+			"FlowIDTableStaking.DelegatorTokensCommitted",
+			"FlowIDTableStaking.DelegatorTokensStaked",
+			"FlowIDTableStaking.DelegatorRewardsPaid",
+			"FlowIDTableStaking.DelegatorRewardsPaid",
+		}, types(timeline))
+
+		assert.Equal(t, ufix64.MustParse("100000.0"), timeline[1].Amount)
+		assert.Equal(t, delegator.ID, timeline[1].DelegatorID)
+	})
+
+	t.Run("Epochs", func(t *testing.T) {
+		epochs, err := db.Epochs(ctx)
+		require.NoError(t, err)
+		require.Len(t, epochs, 3)
+
+		for i, epoch := range epochs {
+			assert.Equal(t, uint64(i+1), epoch.Epoch)
+			assert.Equal(t, indexer.KindContract, epoch.Kind)
+		}
+
+		assert.Equal(t, ufix64.MustParse("600000.0"), epochs[0].Amount)
+	})
+
+	t.Run("Epoch totals", func(t *testing.T) {
+		totals, err := db.EpochTotals(ctx)
+		require.NoError(t, err)
+
+		rewards := map[uint64]ufix64.UFix64{}
+		for _, total := range totals {
+			if total.Type == "FlowIDTableStaking.RewardsPaid" || total.Type == "FlowIDTableStaking.DelegatorRewardsPaid" {
+				rewards[total.Epoch], err = rewards[total.Epoch].Add(total.Amount)
+				require.NoError(t, err)
+			}
+		}
+
+		conf := bootstrap.EmulatorConfig()
+		payout := f.RolePayout(testkit.RoleCollection)
+		totalStaked := ufix64.MustParse("600000.0")
+
+		nodeRewards, err := testkit.Rewards(payout, totalStaked, ufix64.MustParse("500000.0"))
+		require.NoError(t, err)
+
+		delegatorRewards, nodeCut, err := testkit.DelegatorRewards(payout, totalStaked, ufix64.MustParse("100000.0"), ufix64.UFix64(conf.RewardCut))
+		require.NoError(t, err)
+
+		// The rewards of the node include the cut of the rewards of its delegator
+		paid := nodeRewards + nodeCut + delegatorRewards
+		assert.Equal(t, map[uint64]ufix64.UFix64{1: paid, 2: paid}, rewards)
+
+		assert.Contains(t, totals, indexer.EpochTotal{
+			Epoch:  0,
+			Type:   "FlowIDTableStaking.NewNodeCreated",
+			Count:  1,
+			Amount: ufix64.MustParse("500000.0"),
+		})
+	})
+}
+
+// staticSource is an event source with events that the test creates.
+type staticSource struct {
+	latest uint64
+	events map[string][]indexer.BlockEvents
+}
+
+func (s staticSource) LatestHeight(_ context.Context) (uint64, error) {
+	return s.latest, nil
+}
+
+func (s staticSource) GetEvents(_ context.Context, eventType string, start, end uint64) ([]indexer.BlockEvents, error) {
+	var blocks []indexer.BlockEvents
+	for _, block := range s.events[eventType] {
+		if block.Height >= start && block.Height <= end {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return blocks, nil
+}
+
+func lockedTokensEvent(address flow.Address, name string, transaction byte, fields ...cadence.Field) func(values ...cadence.Value) flow.Event {
+	return func(values ...cadence.Value) flow.Event {
+		return flow.Event{
+			Type:          fmt.Sprintf("A.%s.LockedTokens.%s", address.Hex(), name),
+			TransactionID: flow.Identifier{transaction},
+			Value: cadence.NewEvent(values).WithType(&cadence.EventType{
+				QualifiedIdentifier: "LockedTokens." + name,
+				Fields:              fields,
+			}),
+		}
+	}
+}
+
+func TestIndexerLockedTokens(t *testing.T) {
+	ctx := context.Background()
+
+	lockedTokensAddress := flow.HexToAddress("8d0e87b65159ae63")
+	account := flow.HexToAddress("0x01")
+
+	addressField := cadence.Field{Identifier: "address", Type: cadence.AddressType{}}
+
+	registered := lockedTokensEvent(lockedTokensAddress, "LockedAccountRegisteredAsDelegator", 1,
+		addressField,
+		cadence.Field{Identifier: "nodeID", Type: cadence.StringType{}},
+	)(cadence.NewAddress(account), cadence.NewString(testkit.NodeID(1)))
+
+	increased := lockedTokensEvent(lockedTokensAddress, "UnlockLimitIncreased", 2,
+		addressField,
+		cadence.Field{Identifier: "increaseAmount", Type: cadence.UFix64Type{}},
+		cadence.Field{Identifier: "newLimit", Type: cadence.UFix64Type{}},
+	)(cadence.NewAddress(account), cadence.UFix64(ufix64.MustParse("10.0")), cadence.UFix64(ufix64.MustParse("30.0")))
+
+	source := staticSource{
+		latest: 20,
+		events: map[string][]indexer.BlockEvents{
+			registered.Type: {{Height: 12, Events: []flow.Event{registered}}},
+			increased.Type:  {{Height: 15, Events: []flow.Event{increased}}},
+		},
+	}
+
+	db, err := indexer.Open(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	height, err := indexer.New(db, source, indexer.Config{
+		LockedTokensAddress: lockedTokensAddress,
+		StartHeight:         10,
+		StartEpoch:          7,
+		BatchSize:           4,
+	}).Index(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(20), height)
+
+	timeline, err := db.AccountTimeline(ctx, account)
+	require.NoError(t, err)
+	require.Len(t, timeline, 2)
+
+	assert.Equal(t, "LockedTokens.LockedAccountRegisteredAsDelegator", timeline[0].Type)
+	assert.Equal(t, testkit.NodeID(1), timeline[0].NodeID)
+	assert.Equal(t, uint64(12), timeline[0].Height)
+
+	assert.Equal(t, "LockedTokens.UnlockLimitIncreased", timeline[1].Type)
+	assert.Equal(t, ufix64.MustParse("10.0"), timeline[1].Amount)
+	assert.Equal(t, uint64(7), timeline[1].Epoch)
+
+	for _, event := range timeline {
+		assert.Equal(t, indexer.KindAccount, event.Kind)
+		assert.Equal(t, account, event.Address)
+	}
+
+	nodeTimeline, err := db.NodeTimeline(ctx, testkit.NodeID(1))
+	require.NoError(t, err)
+	assert.Empty(t, nodeTimeline, "account events are not part of the timeline of a node")
+}
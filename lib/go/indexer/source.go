@@ -0,0 +1,114 @@
+package indexer
+
+import (
+	"context"
+
+	emulator "github.com/onflow/flow-emulator"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/client"
+)
+
+// BlockEvents are the events of one type emitted in a block.
+type BlockEvents struct {
+	Height  uint64
+	BlockID flow.Identifier
+	Events  []flow.Event
+}
+
+// EventSource provides the sealed blocks of a chain and their events, like an access node.
+type EventSource interface {
+	// LatestHeight returns the height of the latest sealed block.
+	LatestHeight(ctx context.Context) (uint64, error)
+
+	// GetEvents returns the events of a type emitted in the blocks from start to end, inclusive.
+	GetEvents(ctx context.Context, eventType string, start, end uint64) ([]BlockEvents, error)
+}
+
+// ClientSource reads blocks and events from an access node.
+type ClientSource struct {
+	client *client.Client
+}
+
+var _ EventSource = &ClientSource{}
+
+// NewClientSource returns a source that reads sealed blocks from an access node.
+func NewClientSource(flowClient *client.Client) *ClientSource {
+	return &ClientSource{client: flowClient}
+}
+
+func (s *ClientSource) LatestHeight(ctx context.Context) (uint64, error) {
+	header, err := s.client.GetLatestBlockHeader(ctx, true)
+	if err != nil {
+		return 0, err
+	}
+
+	return header.Height, nil
+}
+
+func (s *ClientSource) GetEvents(ctx context.Context, eventType string, start, end uint64) ([]BlockEvents, error) {
+	blocks, err := s.client.GetEventsForHeightRange(ctx, client.EventRangeQuery{
+		Type:        eventType,
+		StartHeight: start,
+		EndHeight:   end,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]BlockEvents, len(blocks))
+	for i, block := range blocks {
+		events[i] = BlockEvents{
+			Height:  block.Height,
+			BlockID: block.BlockID,
+			Events:  block.Events,
+		}
+	}
+
+	return events, nil
+}
+
+// EmulatorSource reads blocks and events from an emulator blockchain,
+// where every committed block is sealed.
+type EmulatorSource struct {
+	blockchain *emulator.Blockchain
+}
+
+var _ EventSource = &EmulatorSource{}
+
+// NewEmulatorSource returns a source that reads the blocks of an emulator blockchain.
+func NewEmulatorSource(blockchain *emulator.Blockchain) *EmulatorSource {
+	return &EmulatorSource{blockchain: blockchain}
+}
+
+func (s *EmulatorSource) LatestHeight(_ context.Context) (uint64, error) {
+	block, err := s.blockchain.GetLatestBlock()
+	if err != nil {
+		return 0, err
+	}
+
+	return block.Header.Height, nil
+}
+
+func (s *EmulatorSource) GetEvents(_ context.Context, eventType string, start, end uint64) ([]BlockEvents, error) {
+	var blocks []BlockEvents
+
+	for height := start; height <= end; height++ {
+		block, err := s.blockchain.GetBlockByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+
+		events, err := s.blockchain.GetEventsByHeight(height, eventType)
+		if err != nil {
+			return nil, err
+		}
+
+		blocks = append(blocks, BlockEvents{
+			Height:  height,
+			BlockID: flow.Identifier(block.ID()),
+			Events:  events,
+		})
+	}
+
+	return blocks, nil
+}
@@ -0,0 +1,426 @@
+// Package statement creates per-epoch reward statements of node operators and delegators
+// from the events in an indexer database.
+//
+// The statement of a delegator shows the rewards it was paid in every epoch, the cut that
+// the node operator took from them, the cumulative rewards and the rewards it withdrew.
+// The statement of a node operator shows the rewards paid to the node, which include the
+// cut of the rewards of its delegators, that cut separately, the cumulative rewards and
+// the rewards it withdrew.
+//
+//	statements, err := statement.Statements(ctx, db, nodeID, ufix64.MustParse("0.08"))
+//	...
+//	err = statement.WriteCSV(os.Stdout, statements)
+package statement
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/flow-core-contracts/lib/go/indexer"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+const (
+	newEpoch                  = "FlowIDTableStaking.NewEpoch"
+	newCutPercentage          = "FlowIDTableStaking.NewDelegatorCutPercentage"
+	rewardsPaid               = "FlowIDTableStaking.RewardsPaid"
+	rewardTokensWithdrawn     = "FlowIDTableStaking.RewardTokensWithdrawn"
+	delegatorRewardsPaid      = "FlowIDTableStaking.DelegatorRewardsPaid"
+	delegatorRewardsWithdrawn = "FlowIDTableStaking.DelegatorRewardTokensWithdrawn"
+)
+
+// Line is the rewards of a node operator or delegator in one epoch.
+type Line struct {
+	Epoch uint64 `json:"epoch"`
+
+	// Rewards are the rewards paid at the end of the epoch.
+	Rewards ufix64.UFix64 `json:"rewards"`
+
+	// Cut is the cut of the rewards of the delegators that the node operator took.
+	// It is taken from the rewards of a delegator, and is part of the rewards of an operator.
+	Cut ufix64.UFix64 `json:"cut"`
+
+	// CumulativeRewards are the rewards paid up to and including the epoch.
+	CumulativeRewards ufix64.UFix64 `json:"cumulativeRewards"`
+
+	// Withdrawn are the rewards that were withdrawn during the epoch.
+	Withdrawn ufix64.UFix64 `json:"withdrawn"`
+}
+
+// Statement is the rewards of a node operator or delegator in every epoch from the first epoch
+// it was paid rewards or withdrew rewards in, up to the last epoch it did.
+type Statement struct {
+	Kind        indexer.Kind `json:"kind"`
+	NodeID      string       `json:"nodeID"`
+	DelegatorID uint32       `json:"delegatorID,omitempty"`
+	Lines       []Line       `json:"lines"`
+}
+
+// CutRates are the percentages of the rewards of delegators that node operators take.
+type CutRates struct {
+	initial ufix64.UFix64
+	changes []indexer.Event
+	rates   []ufix64.UFix64
+}
+
+// NewCutRates returns the cut rates that start at an initial rate and are changed
+// by NewDelegatorCutPercentage events.
+func NewCutRates(initial ufix64.UFix64, changes []indexer.Event) (CutRates, error) {
+	rates := CutRates{initial: initial}
+
+	for _, event := range changes {
+		if event.Type != newCutPercentage {
+			continue
+		}
+
+		rate, err := cutPercentage(event)
+		if err != nil {
+			return CutRates{}, err
+		}
+
+		rates.changes = append(rates.changes, event)
+		rates.rates = append(rates.rates, rate)
+	}
+
+	return rates, nil
+}
+
+// cutPercentage decodes the new rate of a NewDelegatorCutPercentage event.
+func cutPercentage(event indexer.Event) (ufix64.UFix64, error) {
+	value, err := jsoncdc.Decode(event.Payload)
+	if err != nil {
+		return 0, fmt.Errorf("could not decode %s event of transaction %s: %w", event.Type, event.TransactionID, err)
+	}
+
+	decoded, ok := value.(cadence.Event)
+	if !ok || len(decoded.Fields) != 1 {
+		return 0, fmt.Errorf("unexpected %s event in transaction %s", event.Type, event.TransactionID)
+	}
+
+	rate, ok := decoded.Fields[0].(cadence.UFix64)
+	if !ok {
+		return 0, fmt.Errorf("%s: unexpected type %T for field newCutPercentage", event.Type, decoded.Fields[0])
+	}
+
+	return ufix64.UFix64(rate), nil
+}
+
+// At returns the rate that was used for an event, which is the rate of the last change
+// in an earlier transaction.
+func (r CutRates) At(event indexer.Event) ufix64.UFix64 {
+	rate := r.initial
+
+	for i, change := range r.changes {
+		if change.Height > event.Height ||
+			change.Height == event.Height && change.TransactionIndex >= event.TransactionIndex {
+			break
+		}
+
+		rate = r.rates[i]
+	}
+
+	return rate
+}
+
+// maxCutSteps bounds the search for the rewards of a delegator before the cut,
+// which only takes more than a few steps for rates close to 100%.
+const maxCutSteps = 100
+
+// cut returns the cut that the node operator took from the rewards of a delegator,
+// given the rewards that were paid to the delegator.
+//
+// The contract pays rewards - rewards * rate, with the multiplication truncated, so the
+// rewards before the cut are at most paid / (1 - rate). The largest amount that results
+// in the paid rewards is used, so when two amounts result in the same paid rewards,
+// the cut is one smallest unit larger than the cut that was taken.
+func cut(paid, rate ufix64.UFix64) (ufix64.UFix64, error) {
+	if rate == 0 {
+		return 0, nil
+	}
+
+	if rate >= ufix64.One {
+		return 0, fmt.Errorf("cannot derive the cut of rewards of %s at a rate of %s", paid, rate)
+	}
+
+	gross, err := paid.Div(ufix64.One - rate)
+	if err != nil {
+		return 0, err
+	}
+
+	for step := 0; step < maxCutSteps && gross > paid; step++ {
+		operatorCut, err := gross.Mul(rate)
+		if err != nil {
+			return 0, err
+		}
+
+		if gross-operatorCut <= paid {
+			break
+		}
+
+		gross--
+	}
+
+	return gross - paid, nil
+}
+
+// Statements reads the events of a node and its delegators from a database and returns
+// the statement of the node operator followed by the statements of its delegators.
+// initialCut is the rate of the delegator cut before the first indexed NewDelegatorCutPercentage event.
+func Statements(ctx context.Context, db *indexer.DB, nodeID string, initialCut ufix64.UFix64) ([]Statement, error) {
+	nodeEvents, err := db.NodeTimeline(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	delegatorEvents, err := db.DelegatorsTimeline(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	epochs, err := db.Epochs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := db.EventsOfType(ctx, newCutPercentage)
+	if err != nil {
+		return nil, err
+	}
+
+	rates, err := NewCutRates(initialCut, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return Build(nodeID, append(nodeEvents, delegatorEvents...), epochs, rates)
+}
+
+// statementLines collects the lines of a statement by epoch.
+type statementLines struct {
+	statement Statement
+	lines     map[uint64]*Line
+}
+
+func (s *statementLines) line(epoch uint64) *Line {
+	line, ok := s.lines[epoch]
+	if !ok {
+		line = &Line{Epoch: epoch}
+		s.lines[epoch] = line
+	}
+
+	return line
+}
+
+// Build returns the statement of the operator of a node followed by the statements of its
+// delegators, ordered by delegator ID, from the events of the node and its delegators.
+//
+// The NewEpoch events are used to include the epochs without rewards or withdrawals
+// between the first and the last epoch of a statement.
+func Build(nodeID string, events []indexer.Event, epochs []indexer.Event, rates CutRates) ([]Statement, error) {
+	events = append([]indexer.Event(nil), events...)
+	sort.SliceStable(events, func(a, b int) bool {
+		if events[a].Height != events[b].Height {
+			return events[a].Height < events[b].Height
+		}
+		if events[a].TransactionIndex != events[b].TransactionIndex {
+			return events[a].TransactionIndex < events[b].TransactionIndex
+		}
+		return events[a].EventIndex < events[b].EventIndex
+	})
+
+	operator := &statementLines{
+		statement: Statement{Kind: indexer.KindNode, NodeID: nodeID},
+		lines:     map[uint64]*Line{},
+	}
+	delegators := map[uint32]*statementLines{}
+
+	delegator := func(id uint32) *statementLines {
+		d, ok := delegators[id]
+		if !ok {
+			d = &statementLines{
+				statement: Statement{Kind: indexer.KindDelegator, NodeID: nodeID, DelegatorID: id},
+				lines:     map[uint64]*Line{},
+			}
+			delegators[id] = d
+		}
+
+		return d
+	}
+
+	var err error
+
+	for _, event := range events {
+		if event.NodeID != nodeID {
+			continue
+		}
+
+		switch event.Type {
+		case rewardsPaid:
+			line := operator.line(event.Epoch)
+			line.Rewards, err = line.Rewards.Add(event.Amount)
+
+		case rewardTokensWithdrawn:
+			line := operator.line(event.Epoch)
+			line.Withdrawn, err = line.Withdrawn.Add(event.Amount)
+
+		case delegatorRewardsPaid:
+			var operatorCut ufix64.UFix64
+			operatorCut, err = cut(event.Amount, rates.At(event))
+			if err != nil {
+				break
+			}
+
+			line := delegator(event.DelegatorID).line(event.Epoch)
+			if line.Rewards, err = line.Rewards.Add(event.Amount); err != nil {
+				break
+			}
+			if line.Cut, err = line.Cut.Add(operatorCut); err != nil {
+				break
+			}
+
+			operatorLine := operator.line(event.Epoch)
+			operatorLine.Cut, err = operatorLine.Cut.Add(operatorCut)
+
+		case delegatorRewardsWithdrawn:
+			line := delegator(event.DelegatorID).line(event.Epoch)
+			line.Withdrawn, err = line.Withdrawn.Add(event.Amount)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s event of transaction %s: %w", event.Type, event.TransactionID, err)
+		}
+	}
+
+	known := map[uint64]bool{}
+	for _, epoch := range epochs {
+		if epoch.Type == newEpoch {
+			known[epoch.Epoch] = true
+		}
+	}
+
+	ids := make([]uint32, 0, len(delegators))
+	for id := range delegators {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(a, b int) bool { return ids[a] < ids[b] })
+
+	all := []*statementLines{operator}
+	for _, id := range ids {
+		all = append(all, delegators[id])
+	}
+
+	statements := []Statement{}
+
+	for _, s := range all {
+		statement, err := s.finish(known)
+		if err != nil {
+			return nil, err
+		}
+
+		statements = append(statements, statement)
+	}
+
+	return statements, nil
+}
+
+// finish orders the lines by epoch, adds empty lines for the known epochs between the
+// first and the last line, and sums the cumulative rewards.
+func (s *statementLines) finish(known map[uint64]bool) (Statement, error) {
+	statement := s.statement
+	statement.Lines = []Line{}
+
+	if len(s.lines) == 0 {
+		return statement, nil
+	}
+
+	var first, last uint64
+	started := false
+	for epoch := range s.lines {
+		if !started || epoch < first {
+			first = epoch
+		}
+		if !started || epoch > last {
+			last = epoch
+		}
+		started = true
+	}
+
+	var cumulative ufix64.UFix64
+
+	for epoch := first; epoch <= last; epoch++ {
+		line, ok := s.lines[epoch]
+		if !ok {
+			if !known[epoch] {
+				continue
+			}
+
+			line = &Line{Epoch: epoch}
+		}
+
+		var err error
+		cumulative, err = cumulative.Add(line.Rewards)
+		if err != nil {
+			return Statement{}, err
+		}
+
+		line.CumulativeRewards = cumulative
+		statement.Lines = append(statement.Lines, *line)
+	}
+
+	return statement, nil
+}
+
+// csvHeader is the header row of the CSV statements.
+var csvHeader = []string{"kind", "node_id", "delegator_id", "epoch", "rewards", "cut", "cumulative_rewards", "withdrawn"}
+
+// WriteCSV writes the lines of statements as CSV with a header row, one row per line.
+// The delegator ID of an operator statement is empty.
+func WriteCSV(w io.Writer, statements []Statement) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, statement := range statements {
+		delegatorID := ""
+		if statement.Kind == indexer.KindDelegator {
+			delegatorID = strconv.FormatUint(uint64(statement.DelegatorID), 10)
+		}
+
+		for _, line := range statement.Lines {
+			err := writer.Write([]string{
+				string(statement.Kind),
+				statement.NodeID,
+				delegatorID,
+				strconv.FormatUint(line.Epoch, 10),
+				line.Rewards.String(),
+				line.Cut.String(),
+				line.CumulativeRewards.String(),
+				line.Withdrawn.String(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteJSON writes statements as an indented JSON array.
+func WriteJSON(w io.Writer, statements []Statement) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(statements)
+}
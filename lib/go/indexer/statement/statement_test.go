@@ -0,0 +1,239 @@
+package statement_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/bootstrap"
+	"github.com/onflow/flow-core-contracts/lib/go/indexer"
+	"github.com/onflow/flow-core-contracts/lib/go/indexer/statement"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/testkit"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+func sum(t *testing.T, amounts ...ufix64.UFix64) ufix64.UFix64 {
+	var total ufix64.UFix64
+	for _, amount := range amounts {
+		var err error
+		total, err = total.Add(amount)
+		require.NoError(t, err)
+	}
+
+	return total
+}
+
+func TestStatements(t *testing.T) {
+	ctx := context.Background()
+
+	f := testkit.New(t)
+
+	db, err := indexer.Open(":memory:")
+	require.NoError(t, err)
+	defer db.Close()
+
+	node := f.NewNode(testkit.NodeID(1), testkit.RoleCollection, ufix64.MustParse("500000.0"))
+	delegator := f.NewDelegator(node.ID, ufix64.MustParse("100000.0"))
+
+	// The tokens are staked in epoch 0 and rewarded in epochs 1 and 2
+	f.AdvanceEpoch()
+	f.AdvanceEpoch()
+	f.Send(templates.GenerateChangeCutScript(f.Env), f.StakingAdmin, false, cadence.UFix64(ufix64.MustParse("0.2")))
+	f.AdvanceEpoch()
+
+	f.Send(templates.GenerateDelegatorWithdrawRewardsScript(f.Env), delegator.Owner, false,
+		cadence.UFix64(ufix64.MustParse("10.0")))
+	f.Send(templates.GenerateWithdrawRewardedTokensScript(f.Env), node.Owner, false,
+		cadence.UFix64(ufix64.MustParse("20.0")))
+
+	_, err = indexer.New(db, indexer.NewEmulatorSource(f.Blockchain), indexer.Config{
+		IDTableAddress:      f.Deployment.IDTable.Address,
+		LockedTokensAddress: f.Deployment.LockedTokens.Address,
+	}).Index(ctx)
+	require.NoError(t, err)
+
+	conf := bootstrap.EmulatorConfig()
+	payout := f.RolePayout(testkit.RoleCollection)
+	totalStaked := ufix64.MustParse("600000.0")
+
+	nodeRewards, err := testkit.Rewards(payout, totalStaked, ufix64.MustParse("500000.0"))
+	require.NoError(t, err)
+
+	rewards1, cut1, err := testkit.DelegatorRewards(payout, totalStaked, ufix64.MustParse("100000.0"), ufix64.UFix64(conf.RewardCut))
+	require.NoError(t, err)
+
+	rewards2, cut2, err := testkit.DelegatorRewards(payout, totalStaked, ufix64.MustParse("100000.0"), ufix64.MustParse("0.2"))
+	require.NoError(t, err)
+
+	statements, err := statement.Statements(ctx, db, node.ID, ufix64.UFix64(conf.RewardCut))
+	require.NoError(t, err)
+	require.Len(t, statements, 2)
+
+	assert.Equal(t, statement.Statement{
+		Kind:   indexer.KindNode,
+		NodeID: node.ID,
+		Lines: []statement.Line{
+			{
+				Epoch:             1,
+				Rewards:           nodeRewards + cut1,
+				Cut:               cut1,
+				CumulativeRewards: nodeRewards + cut1,
+			},
+			{
+				Epoch:             2,
+				Rewards:           nodeRewards + cut2,
+				Cut:               cut2,
+				CumulativeRewards: sum(t, nodeRewards, cut1, nodeRewards, cut2),
+			},
+			{
+				Epoch:             3,
+				CumulativeRewards: sum(t, nodeRewards, cut1, nodeRewards, cut2),
+				Withdrawn:         ufix64.MustParse("20.0"),
+			},
+		},
+	}, statements[0])
+
+	assert.Equal(t, statement.Statement{
+		Kind:        indexer.KindDelegator,
+		NodeID:      node.ID,
+		DelegatorID: delegator.ID,
+		Lines: []statement.Line{
+			{
+				Epoch:             1,
+				Rewards:           rewards1,
+				Cut:               cut1,
+				CumulativeRewards: rewards1,
+			},
+			{
+				Epoch:             2,
+				Rewards:           rewards2,
+				Cut:               cut2,
+				CumulativeRewards: rewards1 + rewards2,
+			},
+			{
+				Epoch:             3,
+				CumulativeRewards: rewards1 + rewards2,
+				Withdrawn:         ufix64.MustParse("10.0"),
+			},
+		},
+	}, statements[1])
+}
+
+func rewardsEvent(epoch uint64, height uint64, delegatorID uint32, amount ufix64.UFix64) indexer.Event {
+	eventType := "FlowIDTableStaking.RewardsPaid"
+	kind := indexer.KindNode
+	if delegatorID != 0 {
+		eventType = "FlowIDTableStaking.DelegatorRewardsPaid"
+		kind = indexer.KindDelegator
+	}
+
+	return indexer.Event{
+		Height:      height,
+		Epoch:       epoch,
+		Type:        eventType,
+		Kind:        kind,
+		NodeID:      testkit.NodeID(1),
+		DelegatorID: delegatorID,
+		Amount:      amount,
+	}
+}
+
+func TestBuild(t *testing.T) {
+	t.Run("Epochs without rewards", func(t *testing.T) {
+		epochs := []indexer.Event{
+			{Epoch: 5, Type: "FlowIDTableStaking.NewEpoch"},
+			{Epoch: 7, Type: "FlowIDTableStaking.NewEpoch"},
+		}
+
+		rates, err := statement.NewCutRates(0, nil)
+		require.NoError(t, err)
+
+		statements, err := statement.Build(testkit.NodeID(1), []indexer.Event{
+			rewardsEvent(7, 30, 0, ufix64.MustParse("3.0")),
+			rewardsEvent(4, 10, 0, ufix64.MustParse("1.0")),
+		}, epochs, rates)
+		require.NoError(t, err)
+		require.Len(t, statements, 1)
+
+		// The start of epoch 6 was not indexed, so it is not part of the statement
+		assert.Equal(t, []statement.Line{
+			{Epoch: 4, Rewards: ufix64.MustParse("1.0"), CumulativeRewards: ufix64.MustParse("1.0")},
+			{Epoch: 5, CumulativeRewards: ufix64.MustParse("1.0")},
+			{Epoch: 7, Rewards: ufix64.MustParse("3.0"), CumulativeRewards: ufix64.MustParse("4.0")},
+		}, statements[0].Lines)
+	})
+
+	t.Run("Cut", func(t *testing.T) {
+		payout := ufix64.MustParse("1250000.0")
+		totalStaked := ufix64.MustParse("123456789.12345678")
+
+		for _, rate := range []string{"0.0", "0.08", "0.1", "0.33333333", "0.5", "0.9"} {
+			for _, staked := range []string{"1.0", "135000.0", "777777.77777777", "1234567.1"} {
+				rewards, nodeCut, err := testkit.DelegatorRewards(payout, totalStaked, ufix64.MustParse(staked), ufix64.MustParse(rate))
+				require.NoError(t, err)
+
+				rates, err := statement.NewCutRates(ufix64.MustParse(rate), nil)
+				require.NoError(t, err)
+
+				statements, err := statement.Build(testkit.NodeID(1), []indexer.Event{
+					rewardsEvent(1, 10, 1, rewards),
+				}, nil, rates)
+				require.NoError(t, err)
+				require.Len(t, statements, 2)
+
+				cut := statements[1].Lines[0].Cut
+				assert.InDelta(t, uint64(nodeCut), uint64(cut), 1, "rate %s, staked %s", rate, staked)
+				assert.Equal(t, cut, statements[0].Lines[0].Cut)
+			}
+		}
+	})
+}
+
+func TestWrite(t *testing.T) {
+	statements := []statement.Statement{
+		{
+			Kind:   indexer.KindNode,
+			NodeID: "abc",
+			Lines: []statement.Line{
+				{Epoch: 1, Rewards: ufix64.MustParse("10.5"), Cut: ufix64.MustParse("0.5"), CumulativeRewards: ufix64.MustParse("10.5")},
+			},
+		},
+		{
+			Kind:        indexer.KindDelegator,
+			NodeID:      "abc",
+			DelegatorID: 2,
+			Lines: []statement.Line{
+				{Epoch: 1, Rewards: ufix64.MustParse("5.75"), Cut: ufix64.MustParse("0.5"), CumulativeRewards: ufix64.MustParse("5.75"), Withdrawn: ufix64.MustParse("1.0")},
+			},
+		},
+	}
+
+	t.Run("CSV", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, statement.WriteCSV(&buf, statements))
+
+		assert.Equal(t,
+			"kind,node_id,delegator_id,epoch,rewards,cut,cumulative_rewards,withdrawn\n"+
+				"node,abc,,1,10.50000000,0.50000000,10.50000000,0.00000000\n"+
+				"delegator,abc,2,1,5.75000000,0.50000000,5.75000000,1.00000000\n",
+			buf.String(),
+		)
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, statement.WriteJSON(&buf, statements))
+
+		var decoded []statement.Statement
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+		assert.Equal(t, statements, decoded)
+
+		assert.Contains(t, buf.String(), `"cumulativeRewards": "5.75000000"`)
+	})
+}
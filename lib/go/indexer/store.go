@@ -0,0 +1,276 @@
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	// The SQLite driver
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// Amounts are stored as integers in the smallest unit of UFix64, so they can be summed by SQLite.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS events (
+		height            INTEGER NOT NULL,
+		block_id          TEXT    NOT NULL,
+		transaction_id    TEXT    NOT NULL,
+		transaction_index INTEGER NOT NULL,
+		event_index       INTEGER NOT NULL,
+		epoch             INTEGER NOT NULL,
+		type              TEXT    NOT NULL,
+		kind              TEXT    NOT NULL,
+		node_id           TEXT    NOT NULL,
+		delegator_id      INTEGER NOT NULL,
+		address           TEXT    NOT NULL,
+		amount            INTEGER NOT NULL,
+		payload           TEXT    NOT NULL,
+		PRIMARY KEY (transaction_id, event_index)
+	)`,
+	`CREATE INDEX IF NOT EXISTS events_node ON events (node_id, delegator_id)`,
+	`CREATE INDEX IF NOT EXISTS events_address ON events (address)`,
+	`CREATE INDEX IF NOT EXISTS events_epoch ON events (epoch)`,
+	`CREATE TABLE IF NOT EXISTS progress (
+		id     INTEGER PRIMARY KEY CHECK (id = 0),
+		height INTEGER NOT NULL,
+		epoch  INTEGER NOT NULL
+	)`,
+}
+
+const eventColumns = `height, block_id, transaction_id, transaction_index, event_index,
+	epoch, type, kind, node_id, delegator_id, address, amount, payload`
+
+// DB is a SQLite database of indexed events.
+type DB struct {
+	db *sql.DB
+}
+
+// Open opens the SQLite database at a path and creates its tables if they don't exist.
+// The path ":memory:" opens a database in memory.
+func Open(path string) (*DB, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, err
+	}
+
+	// An in-memory database only exists for one connection
+	db.SetMaxOpenConns(1)
+
+	for _, statement := range schema {
+		if _, err := db.Exec(statement); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("could not create the tables: %w", err)
+		}
+	}
+
+	return &DB{db: db}, nil
+}
+
+// Close closes the database.
+func (d *DB) Close() error {
+	return d.db.Close()
+}
+
+// progress returns the height of the last indexed block and the epoch it is part of.
+// ok is false if no block is indexed yet.
+func (d *DB) progress(ctx context.Context) (height, epoch uint64, ok bool, err error) {
+	err = d.db.QueryRowContext(ctx, `SELECT height, epoch FROM progress WHERE id = 0`).Scan(&height, &epoch)
+	if err == sql.ErrNoRows {
+		return 0, 0, false, nil
+	}
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	return height, epoch, true, nil
+}
+
+// LastHeight returns the height of the last indexed block, or false if no block is indexed yet.
+func (d *DB) LastHeight(ctx context.Context) (uint64, bool, error) {
+	height, _, ok, err := d.progress(ctx)
+	return height, ok, err
+}
+
+// store stores the events of a range of blocks together with the new progress,
+// so the range is either indexed completely or not at all.
+// Events that are already stored are ignored.
+func (d *DB) store(ctx context.Context, events []Event, height, epoch uint64) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = storeEvents(ctx, tx, events, height, epoch)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func storeEvents(ctx context.Context, tx *sql.Tx, events []Event, height, epoch uint64) error {
+	insert, err := tx.PrepareContext(ctx, `INSERT OR IGNORE INTO events (`+eventColumns+`)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer insert.Close()
+
+	for _, event := range events {
+		_, err := insert.ExecContext(ctx,
+			event.Height,
+			event.BlockID.Hex(),
+			event.TransactionID.Hex(),
+			event.TransactionIndex,
+			event.EventIndex,
+			event.Epoch,
+			event.Type,
+			string(event.Kind),
+			event.NodeID,
+			event.DelegatorID,
+			addressColumn(event),
+			int64(event.Amount),
+			string(event.Payload),
+		)
+		if err != nil {
+			return fmt.Errorf("could not store %s event of transaction %s: %w", event.Type, event.TransactionID, err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT OR REPLACE INTO progress (id, height, epoch) VALUES (0, ?, ?)`, height, epoch)
+	return err
+}
+
+// addressColumn returns the address of an event, or the empty string for events without an address.
+func addressColumn(event Event) string {
+	if event.Address == flow.EmptyAddress {
+		return ""
+	}
+
+	return event.Address.Hex()
+}
+
+// NodeTimeline returns the events of the tokens of a node, excluding its delegators, in the order they were emitted.
+func (d *DB) NodeTimeline(ctx context.Context, nodeID string) ([]Event, error) {
+	return d.queryEvents(ctx, `WHERE kind = ? AND node_id = ?`, string(KindNode), nodeID)
+}
+
+// DelegatorTimeline returns the events of the tokens of a delegator in the order they were emitted.
+func (d *DB) DelegatorTimeline(ctx context.Context, nodeID string, delegatorID uint32) ([]Event, error) {
+	return d.queryEvents(ctx, `WHERE kind = ? AND node_id = ? AND delegator_id = ?`, string(KindDelegator), nodeID, delegatorID)
+}
+
+// DelegatorsTimeline returns the events of the tokens of all the delegators of a node in the order they were emitted.
+func (d *DB) DelegatorsTimeline(ctx context.Context, nodeID string) ([]Event, error) {
+	return d.queryEvents(ctx, `WHERE kind = ? AND node_id = ?`, string(KindDelegator), nodeID)
+}
+
+// AccountTimeline returns the LockedTokens events of an account in the order they were emitted.
+func (d *DB) AccountTimeline(ctx context.Context, address flow.Address) ([]Event, error) {
+	return d.queryEvents(ctx, `WHERE kind = ? AND address = ?`, string(KindAccount), address.Hex())
+}
+
+// Epochs returns the NewEpoch events in the order they were emitted.
+func (d *DB) Epochs(ctx context.Context) ([]Event, error) {
+	return d.EventsOfType(ctx, newEpochEvent)
+}
+
+// EventsOfType returns the events of a type, like FlowIDTableStaking.NewDelegatorCutPercentage,
+// in the order they were emitted.
+func (d *DB) EventsOfType(ctx context.Context, eventType string) ([]Event, error) {
+	return d.queryEvents(ctx, `WHERE type = ?`, eventType)
+}
+
+func (d *DB) queryEvents(ctx context.Context, where string, args ...interface{}) ([]Event, error) {
+	rows, err := d.db.QueryContext(ctx,
+		`SELECT `+eventColumns+` FROM events `+where+` ORDER BY height, transaction_index, event_index`,
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []Event
+
+	for rows.Next() {
+		var event Event
+		var blockID, transactionID, kind, address, payload string
+		var amount int64
+
+		err := rows.Scan(
+			&event.Height,
+			&blockID,
+			&transactionID,
+			&event.TransactionIndex,
+			&event.EventIndex,
+			&event.Epoch,
+			&event.Type,
+			&kind,
+			&event.NodeID,
+			&event.DelegatorID,
+			&address,
+			&amount,
+			&payload,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		event.BlockID = flow.HexToID(blockID)
+		event.TransactionID = flow.HexToID(transactionID)
+		event.Kind = Kind(kind)
+		if address != "" {
+			event.Address = flow.HexToAddress(address)
+		}
+		event.Amount = ufix64.UFix64(amount)
+		event.Payload = []byte(payload)
+
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// EpochTotal is the number and total amount of the events of a type in an epoch.
+type EpochTotal struct {
+	Epoch  uint64
+	Type   string
+	Count  int
+	Amount ufix64.UFix64
+}
+
+// EpochTotals returns the totals of the node and delegator events of every epoch, ordered by epoch and type.
+func (d *DB) EpochTotals(ctx context.Context) ([]EpochTotal, error) {
+	rows, err := d.db.QueryContext(ctx,
+		`SELECT epoch, type, COUNT(*), SUM(amount) FROM events
+		WHERE kind IN (?, ?)
+		GROUP BY epoch, type
+		ORDER BY epoch, type`,
+		string(KindNode), string(KindDelegator),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var totals []EpochTotal
+
+	for rows.Next() {
+		var total EpochTotal
+		var amount int64
+
+		if err := rows.Scan(&total.Epoch, &total.Type, &total.Count, &amount); err != nil {
+			return nil, err
+		}
+
+		total.Amount = ufix64.UFix64(amount)
+		totals = append(totals, total)
+	}
+
+	return totals, rows.Err()
+}
@@ -0,0 +1,376 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// RemoveNode mirrors Admin.removeNode. The tokens of the node and its
+// delegators are deposited into the staking account's vault.
+func (t *IDTable) RemoveNode(nodeID string) error {
+	node, err := t.borrowNodeRecord(nodeID)
+	if err != nil {
+		return err
+	}
+
+	if node.tokensStaked > 0 {
+		if err := t.unstakeFromNodeType(node.role, node.tokensStaked); err != nil {
+			return err
+		}
+	}
+
+	delete(t.nodes, nodeID)
+
+	return nil
+}
+
+// EndStakingAuction mirrors Admin.endStakingAuction.
+//
+// Nodes that are below the minimum stake for their role or are missing from
+// approvedNodeIDs have their committed tokens and those of their delegators
+// moved to unstaked, and are marked to unstake all of their staked tokens.
+//
+// Like the contract, a node counts as approved as soon as its ID
+// is a key of approvedNodeIDs, regardless of the value.
+func (t *IDTable) EndStakingAuction(approvedNodeIDs map[string]bool) error {
+	return t.atomically(func(c *IDTable) error {
+		return c.endStakingAuction(approvedNodeIDs)
+	})
+}
+
+func (t *IDTable) endStakingAuction(approvedNodeIDs map[string]bool) error {
+	for _, nodeID := range t.sortedNodeIDs() {
+		node := t.nodes[nodeID]
+
+		aboveMinimum, err := t.isGreaterThanMinimumForRole(node.nodeFullCommittedBalance(), node.role)
+		if err != nil {
+			return err
+		}
+
+		_, approved := approvedNodeIDs[nodeID]
+
+		if !aboveMinimum || !approved {
+
+			node.tokensUnstaked = add(node.tokensUnstaked, node.tokensCommitted)
+			node.tokensCommitted = 0
+
+			node.tokensRequestedToUnstake = node.tokensStaked
+
+			for _, delegator := range node.delegators {
+				delegator.tokensUnstaked = add(delegator.tokensUnstaked, delegator.tokensCommitted)
+				delegator.tokensCommitted = 0
+
+				delegator.tokensRequestedToUnstake = delegator.tokensStaked
+			}
+
+			node.initialWeight = 0
+
+		} else {
+			node.initialWeight, err = t.CalculateInitialWeight(nodeID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// PayRewards mirrors Admin.payRewards.
+//
+// Every role gets its ratio of the epoch payout, which is split between its
+// nodes and their delegators by the tokens they have staked. Roles without
+// staked tokens are skipped, so their ratio of the payout is not paid. Every
+// node operator receives its share plus the reward cut taken from its delegators.
+func (t *IDTable) PayRewards() error {
+	return t.atomically(func(c *IDTable) error {
+		return c.payRewards()
+	})
+}
+
+func (t *IDTable) payRewards() error {
+	rewardScales := make(map[uint8]ufix64.UFix64, len(t.rewardRatios))
+	for role, ratio := range t.rewardRatios {
+		totalStaked := t.totalTokensStakedByNodeType[role]
+
+		if totalStaked == 0 {
+			continue
+		}
+
+		rewardScales[role] = div(RolePayout(t.epochTokenPayout, ratio), totalStaked)
+	}
+
+	for _, nodeID := range t.sortedNodeIDs() {
+		node := t.nodes[nodeID]
+
+		totalRewardScale := rewardScales[node.role]
+
+		if totalRewardScale == 0 {
+			continue
+		}
+
+		delegatorIDs := node.sortedDelegatorIDs()
+
+		delegatorsStaked := make([]ufix64.UFix64, len(delegatorIDs))
+		for i, delegatorID := range delegatorIDs {
+			delegatorsStaked[i] = node.delegators[delegatorID].tokensStaked
+		}
+
+		rewards := nodeRewards(node.tokensStaked, delegatorsStaked, totalRewardScale, t.nodeDelegatingRewardCut)
+
+		for i, delegatorID := range delegatorIDs {
+			delegator := node.delegators[delegatorID]
+			delegator.tokensRewarded = add(delegator.tokensRewarded, rewards.Delegators[i])
+		}
+
+		node.tokensRewarded = add(node.tokensRewarded, rewards.Node)
+	}
+
+	return nil
+}
+
+// MoveTokens mirrors Admin.moveTokens.
+//
+// Committed tokens become staked, unstaking tokens become unstaked and
+// unstaking requests are filled by moving tokens from staked to unstaking.
+// If any request cannot be filled, the whole operation is reverted.
+func (t *IDTable) MoveTokens() error {
+	return t.atomically(func(c *IDTable) error {
+		for _, nodeID := range c.sortedNodeIDs() {
+			node := c.nodes[nodeID]
+
+			c.totalTokensStakedByNodeType[node.role] = add(c.totalTokensStakedByNodeType[node.role], node.tokensCommitted)
+
+			node.tokensStaked = add(node.tokensStaked, node.tokensCommitted)
+			node.tokensCommitted = 0
+
+			node.tokensUnstaked = add(node.tokensUnstaked, node.tokensUnstaking)
+			node.tokensUnstaking = 0
+
+			if node.tokensRequestedToUnstake > 0 {
+				if err := withdraw(&node.tokensStaked, node.tokensRequestedToUnstake); err != nil {
+					return fmt.Errorf("could not fill unstaking request of node %s: %w", nodeID, err)
+				}
+				node.tokensUnstaking = add(node.tokensUnstaking, node.tokensRequestedToUnstake)
+			}
+
+			for _, delegatorID := range node.sortedDelegatorIDs() {
+				delegator := node.delegators[delegatorID]
+
+				c.totalTokensStakedByNodeType[node.role] = add(c.totalTokensStakedByNodeType[node.role], delegator.tokensCommitted)
+
+				delegator.tokensStaked = add(delegator.tokensStaked, delegator.tokensCommitted)
+				delegator.tokensCommitted = 0
+
+				delegator.tokensUnstaked = add(delegator.tokensUnstaked, delegator.tokensUnstaking)
+				delegator.tokensUnstaking = 0
+
+				if delegator.tokensRequestedToUnstake > 0 {
+					if err := withdraw(&delegator.tokensStaked, delegator.tokensRequestedToUnstake); err != nil {
+						return fmt.Errorf("could not fill unstaking request of delegator %s.%d: %w", nodeID, delegatorID, err)
+					}
+					delegator.tokensUnstaking = add(delegator.tokensUnstaking, delegator.tokensRequestedToUnstake)
+				}
+
+				if err := c.unstakeFromNodeType(node.role, delegator.tokensRequestedToUnstake); err != nil {
+					return err
+				}
+
+				delegator.tokensRequestedToUnstake = 0
+			}
+
+			if err := c.unstakeFromNodeType(node.role, node.tokensRequestedToUnstake); err != nil {
+				return err
+			}
+
+			node.tokensRequestedToUnstake = 0
+		}
+
+		return nil
+	})
+}
+
+// Slash is an amount of tokens slashed from a node operator,
+// or from one of its delegators if DelegatorID is not zero.
+type Slash struct {
+	NodeID      string
+	DelegatorID uint32
+	Amount      ufix64.UFix64
+}
+
+// SlashNode mirrors Admin.slashNode and returns the slashed amounts,
+// in the order of the TokensSlashed and DelegatorTokensSlashed events.
+//
+// The model does not track accounts, so the slashed tokens are only
+// removed from the buckets, whether the contract burns or redirects them.
+func (t *IDTable) SlashNode(nodeID string, percentage ufix64.UFix64, slashDelegators bool) ([]Slash, error) {
+	if percentage == 0 || percentage > ufix64.One {
+		return nil, errors.New("slashing percentage must be greater than 0 and at most 1")
+	}
+
+	var slashes []Slash
+
+	err := t.atomically(func(c *IDTable) error {
+		node, err := c.borrowNodeRecord(nodeID)
+		if err != nil {
+			return err
+		}
+
+		stakedAmount := mul(node.tokensStaked, percentage)
+		amount := add(add(stakedAmount, mul(node.tokensCommitted, percentage)), mul(node.tokensUnstaking, percentage))
+
+		node.tokensStaked = sub(node.tokensStaked, stakedAmount)
+		node.tokensCommitted = sub(node.tokensCommitted, mul(node.tokensCommitted, percentage))
+		node.tokensUnstaking = sub(node.tokensUnstaking, mul(node.tokensUnstaking, percentage))
+		node.tokensRequestedToUnstake = slashRequest(node.tokensRequestedToUnstake, node.tokensStaked, percentage)
+
+		if err := c.unstakeFromNodeType(node.role, stakedAmount); err != nil {
+			return err
+		}
+
+		if amount > 0 {
+			slashes = append(slashes, Slash{NodeID: nodeID, Amount: amount})
+		}
+
+		if !slashDelegators {
+			return nil
+		}
+
+		// The contract iterates over the delegators in dictionary order,
+		// which does not change the slashed amounts
+		for _, delegatorID := range node.sortedDelegatorIDs() {
+			delegator := node.delegators[delegatorID]
+
+			stakedAmount := mul(delegator.tokensStaked, percentage)
+			amount := add(add(stakedAmount, mul(delegator.tokensCommitted, percentage)), mul(delegator.tokensUnstaking, percentage))
+
+			delegator.tokensStaked = sub(delegator.tokensStaked, stakedAmount)
+			delegator.tokensCommitted = sub(delegator.tokensCommitted, mul(delegator.tokensCommitted, percentage))
+			delegator.tokensUnstaking = sub(delegator.tokensUnstaking, mul(delegator.tokensUnstaking, percentage))
+			delegator.tokensRequestedToUnstake = slashRequest(delegator.tokensRequestedToUnstake, delegator.tokensStaked, percentage)
+
+			if err := c.unstakeFromNodeType(node.role, stakedAmount); err != nil {
+				return err
+			}
+
+			if amount > 0 {
+				slashes = append(slashes, Slash{NodeID: nodeID, DelegatorID: delegatorID, Amount: amount})
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return slashes, nil
+}
+
+// slashRequest reduces an unstaking request by the slashing percentage,
+// so that it can still be filled from the remaining staked tokens.
+func slashRequest(requested, staked, percentage ufix64.UFix64) ufix64.UFix64 {
+	requested = sub(requested, mul(requested, percentage))
+	if requested > staked {
+		return staked
+	}
+
+	return requested
+}
+
+// SetMinimumStakeRequirements mirrors Admin.setMinimumStakeRequirements.
+func (t *IDTable) SetMinimumStakeRequirements(newRequirements map[uint8]ufix64.UFix64) error {
+	if len(newRequirements) != 5 {
+		return errors.New("incorrect number of nodes")
+	}
+
+	t.minimumStakeRequired = make(map[uint8]ufix64.UFix64, len(newRequirements))
+	for role, amount := range newRequirements {
+		t.minimumStakeRequired[role] = amount
+	}
+
+	return nil
+}
+
+// SetRewardRatios mirrors Admin.setRewardRatios.
+func (t *IDTable) SetRewardRatios(newRatios map[uint8]ufix64.UFix64) (err error) {
+	defer recoverArithmeticError(&err)
+
+	if len(newRatios) != 5 {
+		return errors.New("incorrect number of nodes")
+	}
+
+	var totalRatio ufix64.UFix64
+	for role := RoleCollection; role <= RoleAccess; role++ {
+		ratio, ok := newRatios[role]
+		if !ok {
+			return errors.New("missing reward ratio for a node type")
+		}
+		totalRatio = add(totalRatio, ratio)
+	}
+
+	if totalRatio != ufix64.One {
+		return errors.New("reward ratios must add up to 1")
+	}
+
+	t.rewardRatios = copyRoleAmounts(newRatios)
+
+	return nil
+}
+
+// SetMaximumInitialWeight mirrors Admin.setMaximumInitialWeight.
+func (t *IDTable) SetMaximumInitialWeight(newMaximum uint64) error {
+	if newMaximum == 0 {
+		return errors.New("maximum initial weight must be greater than zero")
+	}
+
+	t.maximumInitialWeight = newMaximum
+
+	return nil
+}
+
+// SetMaximumDelegationRatios mirrors Admin.setMaximumDelegationRatios.
+func (t *IDTable) SetMaximumDelegationRatios(newRatios map[uint8]ufix64.UFix64) error {
+	for role := range newRatios {
+		if role < RoleCollection || role > RoleAccess {
+			return errors.New("invalid node type")
+		}
+	}
+
+	t.maximumDelegationRatios = copyRoleAmounts(newRatios)
+
+	return nil
+}
+
+// SetEpochTokenPayout mirrors Admin.setEpochTokenPayout.
+func (t *IDTable) SetEpochTokenPayout(newPayout ufix64.UFix64) error {
+	t.epochTokenPayout = newPayout
+
+	return nil
+}
+
+// SetCutPercentage mirrors Admin.setCutPercentage.
+func (t *IDTable) SetCutPercentage(newCutPercentage ufix64.UFix64) error {
+	if newCutPercentage == 0 || newCutPercentage >= ufix64.One {
+		return errors.New("cut percentage must be between 0 and 1")
+	}
+
+	t.nodeDelegatingRewardCut = newCutPercentage
+
+	return nil
+}
+
+// unstakeFromNodeType subtracts amount from the total staked for a role.
+func (t *IDTable) unstakeFromNodeType(role uint8, amount ufix64.UFix64) error {
+	total := t.totalTokensStakedByNodeType[role]
+
+	if err := withdraw(&total, amount); err != nil {
+		return fmt.Errorf("total tokens staked for role %d underflows", role)
+	}
+
+	t.totalTokensStakedByNodeType[role] = total
+
+	return nil
+}
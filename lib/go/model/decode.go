@@ -0,0 +1,344 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// DecodeNodeInfo decodes the FlowIDTableStaking.NodeInfo struct
+// returned by the get_node_info.cdc script.
+//
+// The delegator IDs are sorted, because the contract
+// returns them in dictionary order.
+func DecodeNodeInfo(value cadence.Value) (NodeInfo, error) {
+	fields, err := structFields(value, "NodeInfo")
+	if err != nil {
+		return NodeInfo{}, err
+	}
+
+	var info NodeInfo
+	d := fieldDecoder{fields: fields}
+
+	info.ID = d.string("id")
+	info.Role = d.uint8("role")
+	info.NetworkingAddress = d.string("networkingAddress")
+	info.NetworkingKey = d.string("networkingKey")
+	info.StakingKey = d.string("stakingKey")
+	info.TokensStaked = d.ufix64("tokensStaked")
+	info.TotalTokensStaked = d.ufix64("totalTokensStaked")
+	info.TokensCommitted = d.ufix64("tokensCommitted")
+	info.TokensUnstaking = d.ufix64("tokensUnstaking")
+	info.TokensUnstaked = d.ufix64("tokensUnstaked")
+	info.TokensRewarded = d.ufix64("tokensRewarded")
+	info.Delegators = d.uint32Array("delegators")
+	info.DelegatorIDCounter = d.uint32("delegatorIDCounter")
+	info.TokensRequestedToUnstake = d.ufix64("tokensRequestedToUnstake")
+	info.InitialWeight = d.uint64("initialWeight")
+
+	if d.err != nil {
+		return NodeInfo{}, d.err
+	}
+
+	sort.Slice(info.Delegators, func(i, j int) bool { return info.Delegators[i] < info.Delegators[j] })
+
+	return info, nil
+}
+
+// DecodeDelegatorInfo decodes the FlowIDTableStaking.DelegatorInfo struct
+// returned by the delegator/get_delegator_info.cdc script.
+func DecodeDelegatorInfo(value cadence.Value) (DelegatorInfo, error) {
+	fields, err := structFields(value, "DelegatorInfo")
+	if err != nil {
+		return DelegatorInfo{}, err
+	}
+
+	var info DelegatorInfo
+	d := fieldDecoder{fields: fields}
+
+	info.ID = d.uint32("id")
+	info.NodeID = d.string("nodeID")
+	info.TokensCommitted = d.ufix64("tokensCommitted")
+	info.TokensStaked = d.ufix64("tokensStaked")
+	info.TokensUnstaking = d.ufix64("tokensUnstaking")
+	info.TokensRewarded = d.ufix64("tokensRewarded")
+	info.TokensUnstaked = d.ufix64("tokensUnstaked")
+	info.TokensRequestedToUnstake = d.ufix64("tokensRequestedToUnstake")
+
+	if d.err != nil {
+		return DelegatorInfo{}, d.err
+	}
+
+	return info, nil
+}
+
+// SlashEvent is a decoded TokensSlashed or DelegatorTokensSlashed event.
+type SlashEvent struct {
+	Slash
+
+	// Recipient is the account that received the slashed tokens,
+	// or nil if they were burned.
+	Recipient *cadence.Address
+}
+
+// DecodeSlashEvent decodes the FlowIDTableStaking.TokensSlashed
+// and FlowIDTableStaking.DelegatorTokensSlashed events.
+func DecodeSlashEvent(event cadence.Event) (SlashEvent, error) {
+	if event.EventType == nil || len(event.EventType.Fields) != len(event.Fields) {
+		return SlashEvent{}, errors.New("slashing event is missing its type")
+	}
+
+	fields := make(map[string]cadence.Value, len(event.Fields))
+	for i, field := range event.EventType.Fields {
+		fields[field.Identifier] = event.Fields[i]
+	}
+
+	var slash SlashEvent
+	d := fieldDecoder{fields: fields}
+
+	slash.NodeID = d.string("nodeID")
+	if strings.HasSuffix(event.EventType.QualifiedIdentifier, ".DelegatorTokensSlashed") {
+		slash.DelegatorID = d.uint32("delegatorID")
+	}
+	slash.Amount = d.ufix64("amount")
+	slash.Recipient = d.optionalAddress("recipient")
+
+	if d.err != nil {
+		return SlashEvent{}, d.err
+	}
+
+	return slash, nil
+}
+
+// DecodeRoleAmounts decodes a {UInt8: UFix64} dictionary keyed by node role,
+// like the ones returned by the get_reward_ratios.cdc script
+// and by getMinimumStakeRequirements and getTotalTokensStakedByNodeType.
+func DecodeRoleAmounts(value cadence.Value) (map[uint8]ufix64.UFix64, error) {
+	dictionary, ok := value.(cadence.Dictionary)
+	if !ok {
+		return nil, fmt.Errorf("expected dictionary, got %T", value)
+	}
+
+	amounts := make(map[uint8]ufix64.UFix64, len(dictionary.Pairs))
+	for _, pair := range dictionary.Pairs {
+		role, ok := pair.Key.(cadence.UInt8)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type %T for role", pair.Key)
+		}
+
+		amount, ok := pair.Value.(cadence.UFix64)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type %T for the amount of role %d", pair.Value, role)
+		}
+
+		amounts[uint8(role)] = ufix64.UFix64(amount)
+	}
+
+	return amounts, nil
+}
+
+// DecodeInitialWeight decodes the UInt64 weight returned by the
+// get_node_initial_weight.cdc and get_maximum_initial_weight.cdc scripts.
+func DecodeInitialWeight(value cadence.Value) (uint64, error) {
+	weight, ok := value.(cadence.UInt64)
+	if !ok {
+		return 0, fmt.Errorf("expected UInt64 weight, got %T", value)
+	}
+
+	return uint64(weight), nil
+}
+
+// DecodeDelegationCapacity decodes the optional capacity returned by the
+// get_node_delegation_capacity.cdc script. limited is false if the delegation
+// to the node is not limited, in which case the capacity is zero.
+func DecodeDelegationCapacity(value cadence.Value) (capacity ufix64.UFix64, limited bool, err error) {
+	optional, ok := value.(cadence.Optional)
+	if !ok {
+		return 0, false, fmt.Errorf("expected optional capacity, got %T", value)
+	}
+
+	if optional.Value == nil {
+		return 0, false, nil
+	}
+
+	amount, ok := optional.Value.(cadence.UFix64)
+	if !ok {
+		return 0, false, fmt.Errorf("unexpected type %T for the capacity", optional.Value)
+	}
+
+	return ufix64.UFix64(amount), true, nil
+}
+
+// structFields returns the fields of a struct value keyed by their name,
+// so that decoding does not depend on the order of the fields.
+func structFields(value cadence.Value, name string) (map[string]cadence.Value, error) {
+	s, ok := value.(cadence.Struct)
+	if !ok {
+		return nil, fmt.Errorf("expected %s struct, got %T", name, value)
+	}
+
+	if s.StructType == nil || len(s.StructType.Fields) != len(s.Fields) {
+		return nil, fmt.Errorf("%s struct is missing its type", name)
+	}
+
+	fields := make(map[string]cadence.Value, len(s.Fields))
+	for i, field := range s.StructType.Fields {
+		fields[field.Identifier] = s.Fields[i]
+	}
+
+	return fields, nil
+}
+
+// fieldDecoder decodes struct fields and keeps the first error it encounters.
+type fieldDecoder struct {
+	fields map[string]cadence.Value
+	err    error
+}
+
+func (d *fieldDecoder) field(name string) cadence.Value {
+	if d.err != nil {
+		return nil
+	}
+
+	value, ok := d.fields[name]
+	if !ok {
+		d.err = fmt.Errorf("missing field %s", name)
+		return nil
+	}
+
+	return value
+}
+
+func (d *fieldDecoder) typeError(name string, value cadence.Value) {
+	d.err = fmt.Errorf("unexpected type %T for field %s", value, name)
+}
+
+func (d *fieldDecoder) string(name string) string {
+	value := d.field(name)
+	if d.err != nil {
+		return ""
+	}
+
+	s, ok := value.(cadence.String)
+	if !ok {
+		d.typeError(name, value)
+		return ""
+	}
+
+	return string(s)
+}
+
+func (d *fieldDecoder) uint8(name string) uint8 {
+	value := d.field(name)
+	if d.err != nil {
+		return 0
+	}
+
+	n, ok := value.(cadence.UInt8)
+	if !ok {
+		d.typeError(name, value)
+		return 0
+	}
+
+	return uint8(n)
+}
+
+func (d *fieldDecoder) uint32(name string) uint32 {
+	value := d.field(name)
+	if d.err != nil {
+		return 0
+	}
+
+	n, ok := value.(cadence.UInt32)
+	if !ok {
+		d.typeError(name, value)
+		return 0
+	}
+
+	return uint32(n)
+}
+
+func (d *fieldDecoder) uint64(name string) uint64 {
+	value := d.field(name)
+	if d.err != nil {
+		return 0
+	}
+
+	n, ok := value.(cadence.UInt64)
+	if !ok {
+		d.typeError(name, value)
+		return 0
+	}
+
+	return uint64(n)
+}
+
+func (d *fieldDecoder) ufix64(name string) ufix64.UFix64 {
+	value := d.field(name)
+	if d.err != nil {
+		return 0
+	}
+
+	n, ok := value.(cadence.UFix64)
+	if !ok {
+		d.typeError(name, value)
+		return 0
+	}
+
+	return ufix64.UFix64(n)
+}
+
+func (d *fieldDecoder) optionalAddress(name string) *cadence.Address {
+	value := d.field(name)
+	if d.err != nil {
+		return nil
+	}
+
+	optional, ok := value.(cadence.Optional)
+	if !ok {
+		d.typeError(name, value)
+		return nil
+	}
+
+	if optional.Value == nil {
+		return nil
+	}
+
+	address, ok := optional.Value.(cadence.Address)
+	if !ok {
+		d.typeError(name, optional.Value)
+		return nil
+	}
+
+	return &address
+}
+
+func (d *fieldDecoder) uint32Array(name string) []uint32 {
+	value := d.field(name)
+	if d.err != nil {
+		return nil
+	}
+
+	array, ok := value.(cadence.Array)
+	if !ok {
+		d.typeError(name, value)
+		return nil
+	}
+
+	ids := make([]uint32, 0, len(array.Values))
+	for _, element := range array.Values {
+		id, ok := element.(cadence.UInt32)
+		if !ok {
+			d.typeError(name, element)
+			return nil
+		}
+		ids = append(ids, uint32(id))
+	}
+
+	return ids
+}
@@ -0,0 +1,200 @@
+package model
+
+import (
+	"errors"
+
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// RegisterNewDelegator mirrors FlowIDTableStaking.registerNewDelegator
+// and returns the ID of the new delegator.
+func (t *IDTable) RegisterNewDelegator(nodeID string) (uint32, error) {
+	var delegatorID uint32
+
+	err := t.atomically(func(c *IDTable) error {
+		node, err := c.borrowNodeRecord(nodeID)
+		if err != nil {
+			return err
+		}
+
+		if node.role == RoleAccess {
+			return errors.New("cannot register a delegator for an access node")
+		}
+
+		aboveMinimum, err := c.isGreaterThanMinimumForRole(node.nodeFullCommittedBalance(), node.role)
+		if err != nil {
+			return err
+		}
+
+		if !aboveMinimum {
+			return errors.New("cannot register a delegator if the node operator is below the minimum stake")
+		}
+
+		node.delegatorIDCounter++
+
+		node.delegators[node.delegatorIDCounter] = &delegatorRecord{}
+
+		delegatorID = node.delegatorIDCounter
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return delegatorID, nil
+}
+
+func (t *IDTable) borrowDelegatorRecord(nodeID string, delegatorID uint32) (*delegatorRecord, error) {
+	node, err := t.borrowNodeRecord(nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return node.borrowDelegatorRecord(delegatorID)
+}
+
+// checkDelegationCapacity mirrors FlowIDTableStaking.assertDelegationCapacity.
+func (t *IDTable) checkDelegationCapacity(nodeID string, amount ufix64.UFix64) error {
+	capacity, limited, err := t.RemainingDelegationCapacity(nodeID)
+	if err != nil {
+		return err
+	}
+
+	if limited && amount > capacity {
+		return ErrDelegationCapacityExceeded
+	}
+
+	return nil
+}
+
+// DelegateNewTokens mirrors NodeDelegator.delegateNewTokens.
+func (t *IDTable) DelegateNewTokens(nodeID string, delegatorID uint32, amount ufix64.UFix64) error {
+	return t.atomically(func(c *IDTable) error {
+		delegator, err := c.borrowDelegatorRecord(nodeID, delegatorID)
+		if err != nil {
+			return err
+		}
+
+		if err := c.checkDelegationCapacity(nodeID, amount); err != nil {
+			return err
+		}
+
+		delegator.tokensCommitted = add(delegator.tokensCommitted, amount)
+
+		return nil
+	})
+}
+
+// DelegateUnstakedTokens mirrors NodeDelegator.delegateUnstakedTokens.
+func (t *IDTable) DelegateUnstakedTokens(nodeID string, delegatorID uint32, amount ufix64.UFix64) error {
+	return t.atomically(func(c *IDTable) error {
+		delegator, err := c.borrowDelegatorRecord(nodeID, delegatorID)
+		if err != nil {
+			return err
+		}
+
+		if err := c.checkDelegationCapacity(nodeID, amount); err != nil {
+			return err
+		}
+
+		requested, remainingAmount := cancelRequest(delegator.tokensRequestedToUnstake, amount)
+
+		if remainingAmount > delegator.tokensUnstaked {
+			return ErrInsufficientFunds
+		}
+
+		delegator.tokensRequestedToUnstake = requested
+		delegator.tokensUnstaked = sub(delegator.tokensUnstaked, remainingAmount)
+		delegator.tokensCommitted = add(delegator.tokensCommitted, remainingAmount)
+
+		return nil
+	})
+}
+
+// DelegateRewardedTokens mirrors NodeDelegator.delegateRewardedTokens.
+func (t *IDTable) DelegateRewardedTokens(nodeID string, delegatorID uint32, amount ufix64.UFix64) error {
+	return t.atomically(func(c *IDTable) error {
+		if amount == 0 {
+			return nil
+		}
+
+		delegator, err := c.borrowDelegatorRecord(nodeID, delegatorID)
+		if err != nil {
+			return err
+		}
+
+		if err := c.checkDelegationCapacity(nodeID, amount); err != nil {
+			return err
+		}
+
+		if err := withdraw(&delegator.tokensRewarded, amount); err != nil {
+			return err
+		}
+
+		delegator.tokensCommitted = add(delegator.tokensCommitted, amount)
+
+		return nil
+	})
+}
+
+// RequestDelegatorUnstaking mirrors NodeDelegator.requestUnstaking.
+func (t *IDTable) RequestDelegatorUnstaking(nodeID string, delegatorID uint32, amount ufix64.UFix64) error {
+	return t.atomically(func(c *IDTable) error {
+		delegator, err := c.borrowDelegatorRecord(nodeID, delegatorID)
+		if err != nil {
+			return err
+		}
+
+		if add(delegator.tokensStaked, delegator.tokensCommitted) < add(amount, delegator.tokensRequestedToUnstake) {
+			return errors.New("not enough tokens to unstake")
+		}
+
+		fromCommitted, requested := splitRequest(delegator.tokensCommitted, amount)
+
+		delegator.tokensCommitted = sub(delegator.tokensCommitted, fromCommitted)
+		delegator.tokensUnstaked = add(delegator.tokensUnstaked, fromCommitted)
+		delegator.tokensRequestedToUnstake = add(delegator.tokensRequestedToUnstake, requested)
+
+		return nil
+	})
+}
+
+// WithdrawDelegatorUnstakedTokens mirrors NodeDelegator.withdrawUnstakedTokens.
+func (t *IDTable) WithdrawDelegatorUnstakedTokens(nodeID string, delegatorID uint32, amount ufix64.UFix64) error {
+	delegator, err := t.borrowDelegatorRecord(nodeID, delegatorID)
+	if err != nil {
+		return err
+	}
+
+	return withdraw(&delegator.tokensUnstaked, amount)
+}
+
+// WithdrawDelegatorRewardedTokens mirrors NodeDelegator.withdrawRewardedTokens.
+func (t *IDTable) WithdrawDelegatorRewardedTokens(nodeID string, delegatorID uint32, amount ufix64.UFix64) error {
+	delegator, err := t.borrowDelegatorRecord(nodeID, delegatorID)
+	if err != nil {
+		return err
+	}
+
+	return withdraw(&delegator.tokensRewarded, amount)
+}
+
+// DelegatorInfo mirrors the FlowIDTableStaking.DelegatorInfo initializer.
+func (t *IDTable) DelegatorInfo(nodeID string, delegatorID uint32) (DelegatorInfo, error) {
+	delegator, err := t.borrowDelegatorRecord(nodeID, delegatorID)
+	if err != nil {
+		return DelegatorInfo{}, err
+	}
+
+	return DelegatorInfo{
+		ID:                       delegatorID,
+		NodeID:                   nodeID,
+		TokensCommitted:          delegator.tokensCommitted,
+		TokensStaked:             delegator.tokensStaked,
+		TokensUnstaking:          delegator.tokensUnstaking,
+		TokensRewarded:           delegator.tokensRewarded,
+		TokensUnstaked:           delegator.tokensUnstaked,
+		TokensRequestedToUnstake: delegator.tokensRequestedToUnstake,
+	}, nil
+}
@@ -0,0 +1,202 @@
+package model
+
+import (
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// NodeIDs returns the IDs of all the nodes in the record, sorted.
+func (t *IDTable) NodeIDs() []string {
+	return t.sortedNodeIDs()
+}
+
+// ProposedNodeIDs mirrors FlowIDTableStaking.getProposedNodeIDs.
+func (t *IDTable) ProposedNodeIDs() []string {
+	var proposedNodes []string
+
+	for _, nodeID := range t.sortedNodeIDs() {
+		node := t.nodes[nodeID]
+
+		if aboveMinimum, _ := t.isGreaterThanMinimumForRole(node.nodeFullCommittedBalance(), node.role); aboveMinimum {
+			proposedNodes = append(proposedNodes, nodeID)
+		}
+	}
+
+	return proposedNodes
+}
+
+// StakedNodeIDs mirrors FlowIDTableStaking.getStakedNodeIDs.
+func (t *IDTable) StakedNodeIDs() []string {
+	var stakedNodes []string
+
+	for _, nodeID := range t.sortedNodeIDs() {
+		node := t.nodes[nodeID]
+
+		if aboveMinimum, _ := t.isGreaterThanMinimumForRole(node.tokensStaked, node.role); aboveMinimum {
+			stakedNodes = append(stakedNodes, nodeID)
+		}
+	}
+
+	return stakedNodes
+}
+
+// NodeCommittedBalanceWithoutDelegators mirrors
+// FlowIDTableStaking.getNodeCommittedBalanceWithoutDelegators.
+func (t *IDTable) NodeCommittedBalanceWithoutDelegators(nodeID string) (ufix64.UFix64, error) {
+	node, err := t.borrowNodeRecord(nodeID)
+	if err != nil {
+		return 0, err
+	}
+
+	return node.nodeFullCommittedBalance(), nil
+}
+
+// NodeCommittedBalanceWithDelegators mirrors
+// FlowIDTableStaking.getNodeCommittedBalanceWithDelegators.
+func (t *IDTable) NodeCommittedBalanceWithDelegators(nodeID string) (sum ufix64.UFix64, err error) {
+	defer recoverArithmeticError(&err)
+
+	node, err := t.borrowNodeRecord(nodeID)
+	if err != nil {
+		return 0, err
+	}
+
+	sum = node.nodeFullCommittedBalance()
+
+	for _, delegator := range node.delegators {
+		sum = add(sum, delegator.delegatorFullCommittedBalance())
+	}
+
+	return sum, nil
+}
+
+// NodeStakedBalanceWithDelegators mirrors
+// FlowIDTableStaking.getNodeStakedBalanceWithDelegators.
+func (t *IDTable) NodeStakedBalanceWithDelegators(nodeID string) (sum ufix64.UFix64, err error) {
+	defer recoverArithmeticError(&err)
+
+	node, err := t.borrowNodeRecord(nodeID)
+	if err != nil {
+		return 0, err
+	}
+
+	sum = node.tokensStaked
+
+	for _, delegator := range node.delegators {
+		sum = add(sum, delegator.tokensStaked)
+	}
+
+	return sum, nil
+}
+
+// CalculateInitialWeight mirrors FlowIDTableStaking.calculateInitialWeight.
+//
+// The weight is the number of whole tokens committed by the node and its
+// delegators, capped at the maximum initial weight, and is at least one.
+func (t *IDTable) CalculateInitialWeight(nodeID string) (uint64, error) {
+	committed, err := t.NodeCommittedBalanceWithDelegators(nodeID)
+	if err != nil {
+		return 0, err
+	}
+
+	weight := uint64(committed) / ufix64.Factor
+
+	if weight > t.maximumInitialWeight {
+		return t.maximumInitialWeight, nil
+	}
+
+	if weight == 0 {
+		return 1, nil
+	}
+
+	return weight, nil
+}
+
+// RemainingDelegationCapacity mirrors
+// FlowIDTableStaking.getNodeRemainingDelegationCapacity.
+//
+// limited is false if the role of the node does not have
+// a maximum delegation ratio, in which case the capacity is zero.
+func (t *IDTable) RemainingDelegationCapacity(nodeID string) (capacity ufix64.UFix64, limited bool, err error) {
+	defer recoverArithmeticError(&err)
+
+	node, err := t.borrowNodeRecord(nodeID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	ratio, ok := t.maximumDelegationRatios[node.role]
+	if !ok {
+		return 0, false, nil
+	}
+
+	var delegatorsCommitted ufix64.UFix64
+	for _, delegator := range node.delegators {
+		delegatorsCommitted = add(delegatorsCommitted, delegator.delegatorFullCommittedBalance())
+	}
+
+	maximum := mul(node.nodeFullCommittedBalance(), ratio)
+
+	if delegatorsCommitted >= maximum {
+		return 0, true, nil
+	}
+
+	return sub(maximum, delegatorsCommitted), true, nil
+}
+
+// MinimumStakeRequirements mirrors FlowIDTableStaking.getMinimumStakeRequirements.
+func (t *IDTable) MinimumStakeRequirements() map[uint8]ufix64.UFix64 {
+	return copyRoleAmounts(t.minimumStakeRequired)
+}
+
+// TotalTokensStakedByNodeType mirrors FlowIDTableStaking.getTotalTokensStakedByNodeType.
+func (t *IDTable) TotalTokensStakedByNodeType() map[uint8]ufix64.UFix64 {
+	return copyRoleAmounts(t.totalTokensStakedByNodeType)
+}
+
+// TotalStaked mirrors FlowIDTableStaking.getTotalStaked,
+// which does not count the tokens staked for access nodes.
+func (t *IDTable) TotalStaked() ufix64.UFix64 {
+	var totalStaked ufix64.UFix64
+
+	for role, amount := range t.totalTokensStakedByNodeType {
+		if role != RoleAccess {
+			totalStaked = add(totalStaked, amount)
+		}
+	}
+
+	return totalStaked
+}
+
+// EpochTokenPayout mirrors FlowIDTableStaking.getEpochTokenPayout.
+func (t *IDTable) EpochTokenPayout() ufix64.UFix64 {
+	return t.epochTokenPayout
+}
+
+// RewardRatios mirrors FlowIDTableStaking.getRewardRatios.
+func (t *IDTable) RewardRatios() map[uint8]ufix64.UFix64 {
+	return copyRoleAmounts(t.rewardRatios)
+}
+
+// MaximumInitialWeight mirrors FlowIDTableStaking.getMaximumInitialWeight.
+func (t *IDTable) MaximumInitialWeight() uint64 {
+	return t.maximumInitialWeight
+}
+
+// MaximumDelegationRatios mirrors FlowIDTableStaking.getMaximumDelegationRatios.
+func (t *IDTable) MaximumDelegationRatios() map[uint8]ufix64.UFix64 {
+	return copyRoleAmounts(t.maximumDelegationRatios)
+}
+
+// RewardCutPercentage mirrors FlowIDTableStaking.getRewardCutPercentage.
+func (t *IDTable) RewardCutPercentage() ufix64.UFix64 {
+	return t.nodeDelegatingRewardCut
+}
+
+func copyRoleAmounts(amounts map[uint8]ufix64.UFix64) map[uint8]ufix64.UFix64 {
+	c := make(map[uint8]ufix64.UFix64, len(amounts))
+	for role, amount := range amounts {
+		c[role] = amount
+	}
+
+	return c
+}
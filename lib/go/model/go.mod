@@ -0,0 +1,11 @@
+module github.com/onflow/flow-core-contracts/lib/go/model
+
+go 1.13
+
+require (
+	github.com/onflow/cadence v0.14.4
+	github.com/onflow/flow-core-contracts/lib/go/ufix64 v0.0.0-00010101000000-000000000000
+	github.com/stretchr/testify v1.7.0
+)
+
+replace github.com/onflow/flow-core-contracts/lib/go/ufix64 => ../ufix64
@@ -0,0 +1,297 @@
+// Package model is a pure-Go model of the FlowIDTableStaking contract.
+//
+// It mirrors how tokens move between the committed, staked, unstaking,
+// unstaked and rewarded buckets of nodes and delegators, so that the outcome
+// of an epoch can be projected without running an emulator.
+//
+// Every operation either succeeds or returns an error and leaves the model
+// untouched, just like a reverted transaction leaves the contract untouched.
+// All arithmetic uses Cadence UFix64 semantics, including the truncation
+// of multiplication and division to 8 decimal places.
+package model
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// Node roles, as defined by the FlowIDTableStaking contract.
+const (
+	RoleCollection   uint8 = 1
+	RoleConsensus    uint8 = 2
+	RoleExecution    uint8 = 3
+	RoleVerification uint8 = 4
+	RoleAccess       uint8 = 5
+)
+
+// nodeIDLength is the required length of a node ID in hex characters.
+const nodeIDLength = 64
+
+var (
+	ErrNodeNotFound      = errors.New("specified node ID does not exist in the record")
+	ErrDelegatorNotFound = errors.New("specified delegator ID does not exist in the record")
+	ErrInsufficientFunds = errors.New("amount withdrawn must be less than or equal than the balance of the Vault")
+
+	ErrDelegationCapacityExceeded = errors.New("delegated tokens would exceed the maximum delegation ratio of the node")
+)
+
+// NodeInfo mirrors the FlowIDTableStaking.NodeInfo struct.
+//
+// The networking fields are not modelled and are always empty.
+type NodeInfo struct {
+	ID                       string
+	Role                     uint8
+	NetworkingAddress        string
+	NetworkingKey            string
+	StakingKey               string
+	TokensStaked             ufix64.UFix64
+	TotalTokensStaked        ufix64.UFix64
+	TokensCommitted          ufix64.UFix64
+	TokensUnstaking          ufix64.UFix64
+	TokensUnstaked           ufix64.UFix64
+	TokensRewarded           ufix64.UFix64
+	Delegators               []uint32
+	DelegatorIDCounter       uint32
+	TokensRequestedToUnstake ufix64.UFix64
+	InitialWeight            uint64
+}
+
+// DelegatorInfo mirrors the FlowIDTableStaking.DelegatorInfo struct.
+type DelegatorInfo struct {
+	ID                       uint32
+	NodeID                   string
+	TokensCommitted          ufix64.UFix64
+	TokensStaked             ufix64.UFix64
+	TokensUnstaking          ufix64.UFix64
+	TokensRewarded           ufix64.UFix64
+	TokensUnstaked           ufix64.UFix64
+	TokensRequestedToUnstake ufix64.UFix64
+}
+
+// nodeRecord mirrors the FlowIDTableStaking.NodeRecord resource.
+type nodeRecord struct {
+	id                       string
+	role                     uint8
+	tokensStaked             ufix64.UFix64
+	tokensCommitted          ufix64.UFix64
+	tokensUnstaking          ufix64.UFix64
+	tokensUnstaked           ufix64.UFix64
+	tokensRewarded           ufix64.UFix64
+	delegators               map[uint32]*delegatorRecord
+	delegatorIDCounter       uint32
+	tokensRequestedToUnstake ufix64.UFix64
+	initialWeight            uint64
+}
+
+// delegatorRecord mirrors the FlowIDTableStaking.DelegatorRecord resource.
+type delegatorRecord struct {
+	tokensCommitted          ufix64.UFix64
+	tokensStaked             ufix64.UFix64
+	tokensUnstaking          ufix64.UFix64
+	tokensRewarded           ufix64.UFix64
+	tokensUnstaked           ufix64.UFix64
+	tokensRequestedToUnstake ufix64.UFix64
+}
+
+// IDTable is the state of a FlowIDTableStaking contract.
+type IDTable struct {
+	nodes                       map[string]*nodeRecord
+	minimumStakeRequired        map[uint8]ufix64.UFix64
+	totalTokensStakedByNodeType map[uint8]ufix64.UFix64
+	epochTokenPayout            ufix64.UFix64
+	rewardRatios                map[uint8]ufix64.UFix64
+	nodeDelegatingRewardCut     ufix64.UFix64
+	maximumInitialWeight        uint64
+	maximumDelegationRatios     map[uint8]ufix64.UFix64
+}
+
+// NewIDTable returns a model in the state the contract is in right after
+// it is deployed with the given initializer arguments.
+func NewIDTable(epochTokenPayout, rewardCut ufix64.UFix64) *IDTable {
+	return &IDTable{
+		nodes: make(map[string]*nodeRecord),
+		minimumStakeRequired: map[uint8]ufix64.UFix64{
+			RoleCollection:   25000000000000,
+			RoleConsensus:    50000000000000,
+			RoleExecution:    125000000000000,
+			RoleVerification: 13500000000000,
+			RoleAccess:       0,
+		},
+		totalTokensStakedByNodeType: map[uint8]ufix64.UFix64{
+			RoleCollection:   0,
+			RoleConsensus:    0,
+			RoleExecution:    0,
+			RoleVerification: 0,
+			RoleAccess:       0,
+		},
+		epochTokenPayout: epochTokenPayout,
+		rewardRatios: map[uint8]ufix64.UFix64{
+			RoleCollection:   16800000,
+			RoleConsensus:    51800000,
+			RoleExecution:    7800000,
+			RoleVerification: 23600000,
+			RoleAccess:       0,
+		},
+		nodeDelegatingRewardCut: rewardCut,
+		maximumInitialWeight:    math.MaxUint64,
+		maximumDelegationRatios: make(map[uint8]ufix64.UFix64),
+	}
+}
+
+// Copy returns a deep copy of the model.
+func (t *IDTable) Copy() *IDTable {
+	c := &IDTable{
+		nodes:                       make(map[string]*nodeRecord, len(t.nodes)),
+		minimumStakeRequired:        make(map[uint8]ufix64.UFix64, len(t.minimumStakeRequired)),
+		totalTokensStakedByNodeType: make(map[uint8]ufix64.UFix64, len(t.totalTokensStakedByNodeType)),
+		epochTokenPayout:            t.epochTokenPayout,
+		rewardRatios:                copyRoleAmounts(t.rewardRatios),
+		nodeDelegatingRewardCut:     t.nodeDelegatingRewardCut,
+		maximumInitialWeight:        t.maximumInitialWeight,
+		maximumDelegationRatios:     copyRoleAmounts(t.maximumDelegationRatios),
+	}
+
+	for role, amount := range t.minimumStakeRequired {
+		c.minimumStakeRequired[role] = amount
+	}
+
+	for role, amount := range t.totalTokensStakedByNodeType {
+		c.totalTokensStakedByNodeType[role] = amount
+	}
+
+	for id, node := range t.nodes {
+		n := *node
+		n.delegators = make(map[uint32]*delegatorRecord, len(node.delegators))
+		for delegatorID, delegator := range node.delegators {
+			d := *delegator
+			n.delegators[delegatorID] = &d
+		}
+		c.nodes[id] = &n
+	}
+
+	return c
+}
+
+// atomically applies f to a copy of the model and only keeps the result
+// if f succeeds, the same way a reverted transaction discards its changes.
+// Arithmetic overflows and underflows in f are returned as errors.
+func (t *IDTable) atomically(f func(c *IDTable) error) (err error) {
+	defer recoverArithmeticError(&err)
+
+	c := t.Copy()
+
+	if err := f(c); err != nil {
+		return err
+	}
+
+	*t = *c
+
+	return nil
+}
+
+func (t *IDTable) borrowNodeRecord(nodeID string) (*nodeRecord, error) {
+	node, ok := t.nodes[nodeID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNodeNotFound, nodeID)
+	}
+
+	return node, nil
+}
+
+func (n *nodeRecord) borrowDelegatorRecord(delegatorID uint32) (*delegatorRecord, error) {
+	delegator, ok := n.delegators[delegatorID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s.%d", ErrDelegatorNotFound, n.id, delegatorID)
+	}
+
+	return delegator, nil
+}
+
+// nodeFullCommittedBalance mirrors NodeRecord.nodeFullCommittedBalance.
+func (n *nodeRecord) nodeFullCommittedBalance() ufix64.UFix64 {
+	total := add(n.tokensCommitted, n.tokensStaked)
+	if total < n.tokensRequestedToUnstake {
+		return 0
+	}
+
+	return sub(total, n.tokensRequestedToUnstake)
+}
+
+// delegatorFullCommittedBalance mirrors DelegatorRecord.delegatorFullCommittedBalance.
+func (d *delegatorRecord) delegatorFullCommittedBalance() ufix64.UFix64 {
+	total := add(d.tokensCommitted, d.tokensStaked)
+	if total < d.tokensRequestedToUnstake {
+		return 0
+	}
+
+	return sub(total, d.tokensRequestedToUnstake)
+}
+
+// sortedNodeIDs returns the node IDs in a deterministic order.
+func (t *IDTable) sortedNodeIDs() []string {
+	ids := make([]string, 0, len(t.nodes))
+	for id := range t.nodes {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	return ids
+}
+
+// sortedDelegatorIDs returns the delegator IDs of a node in a deterministic order.
+func (n *nodeRecord) sortedDelegatorIDs() []uint32 {
+	ids := make([]uint32, 0, len(n.delegators))
+	for id := range n.delegators {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	return ids
+}
+
+// The arithmetic helpers panic on overflow and underflow, like the Cadence
+// interpreter aborts the transaction. atomically and recoverArithmeticError
+// turn the panic into an error.
+
+func add(a, b ufix64.UFix64) ufix64.UFix64 {
+	return must(a.Add(b))
+}
+
+// sub subtracts b from a. Callers check for underflow beforehand.
+func sub(a, b ufix64.UFix64) ufix64.UFix64 {
+	return must(a.Sub(b))
+}
+
+func mul(a, b ufix64.UFix64) ufix64.UFix64 {
+	return must(a.Mul(b))
+}
+
+func div(a, b ufix64.UFix64) ufix64.UFix64 {
+	return must(a.Div(b))
+}
+
+func must(value ufix64.UFix64, err error) ufix64.UFix64 {
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// withdraw mirrors FlowToken.Vault.withdraw, which fails if the vault
+// holds less than the requested amount.
+func withdraw(balance *ufix64.UFix64, amount ufix64.UFix64) error {
+	if amount > *balance {
+		return ErrInsufficientFunds
+	}
+
+	*balance = sub(*balance, amount)
+
+	return nil
+}
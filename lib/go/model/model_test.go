@@ -0,0 +1,562 @@
+package model_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/model"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+var (
+	collectionID = strings.Repeat("1", 64)
+	consensusID  = strings.Repeat("2", 64)
+	accessID     = strings.Repeat("5", 64)
+)
+
+func tokens(t *testing.T, s string) ufix64.UFix64 {
+	value, err := ufix64.Parse(s)
+	require.NoError(t, err)
+	return value
+}
+
+func TestIDTableRewards(t *testing.T) {
+
+	table := model.NewIDTable(tokens(t, "1250000.0"), tokens(t, "0.08"))
+
+	require.NoError(t, table.AddNodeRecord(collectionID, model.RoleCollection, tokens(t, "250000.0")))
+	require.NoError(t, table.AddNodeRecord(accessID, model.RoleAccess, tokens(t, "1.0")))
+
+	t.Run("Shouldn't be able to register a delegator for an access node", func(t *testing.T) {
+		_, err := table.RegisterNewDelegator(accessID)
+		assert.Error(t, err)
+	})
+
+	delegatorID, err := table.RegisterNewDelegator(collectionID)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), delegatorID)
+
+	require.NoError(t, table.DelegateNewTokens(collectionID, delegatorID, tokens(t, "250000.0")))
+
+	require.NoError(t, table.EndStakingAuction(map[string]bool{collectionID: true, accessID: true}))
+	require.NoError(t, table.MoveTokens())
+
+	t.Run("Should not count access nodes in the total staked", func(t *testing.T) {
+		assert.Equal(t, tokens(t, "500000.0"), table.TotalStaked())
+		assert.Equal(t, tokens(t, "1.0"), table.TotalTokensStakedByNodeType()[model.RoleAccess])
+	})
+
+	t.Run("Should pay rewards and take the node operator's cut", func(t *testing.T) {
+		require.NoError(t, table.PayRewards())
+
+		// Collection nodes get 0.168 of the payout, 210000.0 for 500000.0 staked tokens
+		node, err := table.NodeInfo(collectionID)
+		require.NoError(t, err)
+		assert.Equal(t, tokens(t, "113400.0"), node.TokensRewarded)
+		assert.Equal(t, tokens(t, "500000.0"), node.TotalTokensStaked)
+
+		delegator, err := table.DelegatorInfo(collectionID, delegatorID)
+		require.NoError(t, err)
+		assert.Equal(t, tokens(t, "96600.0"), delegator.TokensRewarded)
+
+		access, err := table.NodeInfo(accessID)
+		require.NoError(t, err)
+		assert.Equal(t, ufix64.UFix64(0), access.TokensRewarded)
+	})
+}
+
+func TestIDTableRewardTruncation(t *testing.T) {
+
+	table := model.NewIDTable(tokens(t, "1250000.0"), tokens(t, "0.08"))
+
+	require.NoError(t, table.AddNodeRecord(collectionID, model.RoleCollection, tokens(t, "900000.0")))
+	require.NoError(t, table.AddNodeRecord(consensusID, model.RoleConsensus, tokens(t, "750000.0")))
+
+	require.NoError(t, table.EndStakingAuction(map[string]bool{collectionID: true, consensusID: true}))
+	require.NoError(t, table.MoveTokens())
+	require.NoError(t, table.PayRewards())
+
+	// 210000.0 / 900000.0 and 647500.0 / 750000.0 truncate to 0.23333333 and 0.86333333,
+	// so the rewards add up to less than the payout of each role
+	node, err := table.NodeInfo(collectionID)
+	require.NoError(t, err)
+	assert.Equal(t, tokens(t, "209999.997"), node.TokensRewarded)
+
+	node, err = table.NodeInfo(consensusID)
+	require.NoError(t, err)
+	assert.Equal(t, tokens(t, "647499.9975"), node.TokensRewarded)
+}
+
+func TestIDTableArithmeticOverflow(t *testing.T) {
+
+	table := model.NewIDTable(tokens(t, "100000.0"), tokens(t, "0.08"))
+
+	require.NoError(t, table.SetMinimumStakeRequirements(map[uint8]ufix64.UFix64{
+		model.RoleCollection:   0,
+		model.RoleConsensus:    0,
+		model.RoleExecution:    0,
+		model.RoleVerification: 0,
+		model.RoleAccess:       0,
+	}))
+
+	require.NoError(t, table.AddNodeRecord(collectionID, model.RoleCollection, tokens(t, "0.00000001")))
+
+	require.NoError(t, table.EndStakingAuction(map[string]bool{collectionID: true}))
+	require.NoError(t, table.MoveTokens())
+
+	t.Run("Should return an error and not pay rewards if the reward scale overflows", func(t *testing.T) {
+		before := table.Copy()
+
+		// 16800.0 / 0.00000001 does not fit in a UFix64
+		assert.Equal(t, ufix64.ErrOverflow, table.PayRewards())
+		assert.Equal(t, before, table)
+	})
+
+	t.Run("Should return an error and not commit tokens if the committed tokens overflow", func(t *testing.T) {
+		require.NoError(t, table.StakeNewTokens(collectionID, tokens(t, "100000000000.0")))
+
+		before := table.Copy()
+
+		assert.Equal(t, ufix64.ErrOverflow, table.StakeNewTokens(collectionID, tokens(t, "100000000000.0")))
+		assert.Equal(t, before, table)
+	})
+}
+
+func TestIDTableEndStakingAuction(t *testing.T) {
+
+	table := model.NewIDTable(tokens(t, "1250000.0"), tokens(t, "0.08"))
+
+	require.NoError(t, table.AddNodeRecord(collectionID, model.RoleCollection, tokens(t, "250000.0")))
+	require.NoError(t, table.AddNodeRecord(consensusID, model.RoleConsensus, tokens(t, "500000.0")))
+
+	delegatorID, err := table.RegisterNewDelegator(consensusID)
+	require.NoError(t, err)
+	require.NoError(t, table.DelegateNewTokens(consensusID, delegatorID, tokens(t, "100.0")))
+
+	require.NoError(t, table.EndStakingAuction(map[string]bool{collectionID: true, consensusID: true}))
+	require.NoError(t, table.MoveTokens())
+
+	t.Run("Should force unstaking of nodes that are not approved", func(t *testing.T) {
+		require.NoError(t, table.StakeNewTokens(consensusID, tokens(t, "10.0")))
+		require.NoError(t, table.EndStakingAuction(map[string]bool{collectionID: true}))
+
+		node, err := table.NodeInfo(consensusID)
+		require.NoError(t, err)
+		assert.Equal(t, tokens(t, "10.0"), node.TokensUnstaked)
+		assert.Equal(t, tokens(t, "500000.0"), node.TokensRequestedToUnstake)
+		assert.Equal(t, uint64(0), node.InitialWeight)
+
+		delegator, err := table.DelegatorInfo(consensusID, delegatorID)
+		require.NoError(t, err)
+		assert.Equal(t, tokens(t, "100.0"), delegator.TokensRequestedToUnstake)
+
+		require.NoError(t, table.MoveTokens())
+
+		node, err = table.NodeInfo(consensusID)
+		require.NoError(t, err)
+		assert.Equal(t, ufix64.UFix64(0), node.TokensStaked)
+		assert.Equal(t, tokens(t, "500000.0"), node.TokensUnstaking)
+		assert.Equal(t, tokens(t, "250000.0"), table.TotalStaked())
+	})
+
+	t.Run("Should leave the table untouched if an operation fails", func(t *testing.T) {
+		before := table.Copy()
+
+		assert.Error(t, table.RequestUnstaking(collectionID, tokens(t, "250000.1")))
+		assert.Error(t, table.StakeUnstakedTokens(collectionID, tokens(t, "1.0")))
+		assert.Error(t, table.WithdrawRewardedTokens(collectionID, tokens(t, "1.0")))
+		assert.Error(t, table.SetCutPercentage(tokens(t, "1.0")))
+
+		assert.Equal(t, before, table)
+	})
+}
+
+func TestIDTableInitialWeight(t *testing.T) {
+
+	table := model.NewIDTable(tokens(t, "1250000.0"), tokens(t, "0.08"))
+
+	require.NoError(t, table.AddNodeRecord(collectionID, model.RoleCollection, tokens(t, "250000.5")))
+	require.NoError(t, table.AddNodeRecord(consensusID, model.RoleConsensus, tokens(t, "500000.0")))
+	require.NoError(t, table.AddNodeRecord(accessID, model.RoleAccess, tokens(t, "0.5")))
+
+	delegatorID, err := table.RegisterNewDelegator(consensusID)
+	require.NoError(t, err)
+	require.NoError(t, table.DelegateNewTokens(consensusID, delegatorID, tokens(t, "250000.0")))
+
+	approved := map[string]bool{collectionID: true, consensusID: true, accessID: true}
+
+	weights := func(t *testing.T) map[string]uint64 {
+		weights := make(map[string]uint64)
+		for _, id := range []string{collectionID, consensusID, accessID} {
+			node, err := table.NodeInfo(id)
+			require.NoError(t, err)
+			weights[id] = node.InitialWeight
+		}
+		return weights
+	}
+
+	t.Run("Should weigh approved nodes by their committed stake", func(t *testing.T) {
+		require.NoError(t, table.EndStakingAuction(approved))
+
+		// Fractions of a token are not counted, but every approved node has some weight
+		assert.Equal(t, map[string]uint64{
+			collectionID: 250000,
+			consensusID:  750000,
+			accessID:     1,
+		}, weights(t))
+	})
+
+	t.Run("Shouldn't be able to set a maximum weight of zero", func(t *testing.T) {
+		assert.Error(t, table.SetMaximumInitialWeight(0))
+		assert.Equal(t, uint64(math.MaxUint64), table.MaximumInitialWeight())
+	})
+
+	t.Run("Should cap the weights at the maximum", func(t *testing.T) {
+		require.NoError(t, table.SetMaximumInitialWeight(500000))
+		require.NoError(t, table.EndStakingAuction(approved))
+
+		assert.Equal(t, map[string]uint64{
+			collectionID: 250000,
+			consensusID:  500000,
+			accessID:     1,
+		}, weights(t))
+	})
+}
+
+func TestIDTableDelegationCapacity(t *testing.T) {
+
+	table := model.NewIDTable(tokens(t, "1250000.0"), tokens(t, "0.08"))
+
+	require.NoError(t, table.AddNodeRecord(collectionID, model.RoleCollection, tokens(t, "250000.0")))
+
+	delegatorID, err := table.RegisterNewDelegator(collectionID)
+	require.NoError(t, err)
+
+	assertCapacity := func(t *testing.T, expected ufix64.UFix64) {
+		capacity, limited, err := table.RemainingDelegationCapacity(collectionID)
+		require.NoError(t, err)
+		assert.True(t, limited)
+		assert.Equal(t, expected, capacity)
+	}
+
+	t.Run("Should not limit delegation without a maximum ratio", func(t *testing.T) {
+		_, limited, err := table.RemainingDelegationCapacity(collectionID)
+		require.NoError(t, err)
+		assert.False(t, limited)
+
+		assert.Error(t, table.SetMaximumDelegationRatios(map[uint8]ufix64.UFix64{6: tokens(t, "1.0")}))
+		assert.Empty(t, table.MaximumDelegationRatios())
+	})
+
+	t.Run("Should limit new delegated tokens", func(t *testing.T) {
+		require.NoError(t, table.SetMaximumDelegationRatios(map[uint8]ufix64.UFix64{
+			model.RoleCollection: tokens(t, "0.5"),
+		}))
+		assertCapacity(t, tokens(t, "125000.0"))
+
+		require.NoError(t, table.DelegateNewTokens(collectionID, delegatorID, tokens(t, "100000.0")))
+		assertCapacity(t, tokens(t, "25000.0"))
+
+		before := table.Copy()
+		assert.Equal(t, model.ErrDelegationCapacityExceeded, table.DelegateNewTokens(collectionID, delegatorID, tokens(t, "25000.1")))
+		assert.Equal(t, before, table)
+	})
+
+	t.Run("Should limit delegated unstaked tokens", func(t *testing.T) {
+		require.NoError(t, table.RequestDelegatorUnstaking(collectionID, delegatorID, tokens(t, "10000.0")))
+		assertCapacity(t, tokens(t, "35000.0"))
+
+		assert.Equal(t, model.ErrDelegationCapacityExceeded, table.DelegateUnstakedTokens(collectionID, delegatorID, tokens(t, "35000.1")))
+		require.NoError(t, table.DelegateUnstakedTokens(collectionID, delegatorID, tokens(t, "10000.0")))
+		assertCapacity(t, tokens(t, "25000.0"))
+	})
+
+	t.Run("Should limit delegated rewarded tokens", func(t *testing.T) {
+		// The tokens are staked in the first epoch and rewarded in the second one
+		for epoch := 0; epoch < 2; epoch++ {
+			require.NoError(t, table.EndStakingAuction(map[string]bool{collectionID: true}))
+			require.NoError(t, table.PayRewards())
+			require.NoError(t, table.MoveTokens())
+		}
+
+		assert.Equal(t, model.ErrDelegationCapacityExceeded, table.DelegateRewardedTokens(collectionID, delegatorID, tokens(t, "25000.1")))
+		require.NoError(t, table.DelegateRewardedTokens(collectionID, delegatorID, tokens(t, "25000.0")))
+		assertCapacity(t, 0)
+	})
+
+	t.Run("Should raise the capacity with the stake of the node operator", func(t *testing.T) {
+		require.NoError(t, table.StakeNewTokens(collectionID, tokens(t, "50000.0")))
+		assertCapacity(t, tokens(t, "25000.0"))
+	})
+}
+
+func TestIDTableRewardRatios(t *testing.T) {
+
+	table := model.NewIDTable(tokens(t, "1000000.0"), tokens(t, "0.08"))
+
+	require.NoError(t, table.AddNodeRecord(collectionID, model.RoleCollection, tokens(t, "250000.0")))
+	require.NoError(t, table.AddNodeRecord(consensusID, model.RoleConsensus, tokens(t, "1000000.0")))
+	require.NoError(t, table.AddNodeRecord(accessID, model.RoleAccess, tokens(t, "1.0")))
+
+	require.NoError(t, table.EndStakingAuction(map[string]bool{collectionID: true, consensusID: true, accessID: true}))
+	require.NoError(t, table.MoveTokens())
+
+	t.Run("Shouldn't be able to set invalid ratios", func(t *testing.T) {
+		before := table.Copy()
+
+		assert.Error(t, table.SetRewardRatios(map[uint8]ufix64.UFix64{
+			model.RoleCollection: tokens(t, "0.5"),
+			model.RoleConsensus:  tokens(t, "0.5"),
+		}))
+
+		assert.Error(t, table.SetRewardRatios(map[uint8]ufix64.UFix64{
+			model.RoleCollection:   tokens(t, "0.2"),
+			model.RoleConsensus:    tokens(t, "0.2"),
+			model.RoleExecution:    tokens(t, "0.2"),
+			model.RoleVerification: tokens(t, "0.2"),
+			model.RoleAccess:       tokens(t, "0.1"),
+		}))
+
+		assert.Error(t, table.SetRewardRatios(map[uint8]ufix64.UFix64{
+			model.RoleCollection:   tokens(t, "0.2"),
+			model.RoleConsensus:    tokens(t, "0.2"),
+			model.RoleExecution:    tokens(t, "0.2"),
+			model.RoleVerification: tokens(t, "0.2"),
+			6:                      tokens(t, "0.2"),
+		}))
+
+		assert.Equal(t, before, table)
+	})
+
+	t.Run("Should split the payout between roles by their ratios", func(t *testing.T) {
+		require.NoError(t, table.SetRewardRatios(map[uint8]ufix64.UFix64{
+			model.RoleCollection:   tokens(t, "0.25"),
+			model.RoleConsensus:    tokens(t, "0.25"),
+			model.RoleExecution:    tokens(t, "0.2"),
+			model.RoleVerification: tokens(t, "0.2"),
+			model.RoleAccess:       tokens(t, "0.1"),
+		}))
+
+		require.NoError(t, table.PayRewards())
+
+		// The collection node has a quarter of the stake of the consensus node,
+		// but gets the same rewards, and the payout of the roles without stake is not paid
+		for id, expected := range map[string]ufix64.UFix64{
+			collectionID: tokens(t, "250000.0"),
+			consensusID:  tokens(t, "250000.0"),
+			accessID:     tokens(t, "100000.0"),
+		} {
+			node, err := table.NodeInfo(id)
+			require.NoError(t, err)
+			assert.Equal(t, expected, node.TokensRewarded)
+		}
+	})
+}
+
+func TestIDTableSlashNode(t *testing.T) {
+
+	table := model.NewIDTable(tokens(t, "1250000.0"), tokens(t, "0.08"))
+
+	require.NoError(t, table.AddNodeRecord(collectionID, model.RoleCollection, tokens(t, "500000.0")))
+
+	delegatorID, err := table.RegisterNewDelegator(collectionID)
+	require.NoError(t, err)
+	require.NoError(t, table.DelegateNewTokens(collectionID, delegatorID, tokens(t, "100000.0")))
+
+	require.NoError(t, table.EndStakingAuction(map[string]bool{collectionID: true}))
+	require.NoError(t, table.MoveTokens())
+
+	require.NoError(t, table.RequestUnstaking(collectionID, tokens(t, "100000.0")))
+	require.NoError(t, table.StakeNewTokens(collectionID, tokens(t, "10000.0")))
+
+	t.Run("Shouldn't be able to slash an invalid percentage", func(t *testing.T) {
+		before := table.Copy()
+
+		_, err := table.SlashNode(collectionID, 0, true)
+		assert.Error(t, err)
+
+		_, err = table.SlashNode(collectionID, tokens(t, "1.00000001"), true)
+		assert.Error(t, err)
+
+		_, err = table.SlashNode(consensusID, tokens(t, "0.1"), true)
+		assert.Error(t, err)
+
+		assert.Equal(t, before, table)
+	})
+
+	t.Run("Should slash the node, its delegators and its unstaking request", func(t *testing.T) {
+		slashes, err := table.SlashNode(collectionID, tokens(t, "0.1"), true)
+		require.NoError(t, err)
+
+		assert.Equal(t, []model.Slash{
+			{NodeID: collectionID, Amount: tokens(t, "51000.0")},
+			{NodeID: collectionID, DelegatorID: delegatorID, Amount: tokens(t, "10000.0")},
+		}, slashes)
+
+		node, err := table.NodeInfo(collectionID)
+		require.NoError(t, err)
+		assert.Equal(t, tokens(t, "450000.0"), node.TokensStaked)
+		assert.Equal(t, tokens(t, "9000.0"), node.TokensCommitted)
+		assert.Equal(t, tokens(t, "90000.0"), node.TokensRequestedToUnstake)
+		assert.Equal(t, tokens(t, "540000.0"), table.TotalStaked())
+
+		require.NoError(t, table.MoveTokens())
+
+		node, err = table.NodeInfo(collectionID)
+		require.NoError(t, err)
+		assert.Equal(t, tokens(t, "369000.0"), node.TokensStaked)
+		assert.Equal(t, tokens(t, "90000.0"), node.TokensUnstaking)
+	})
+
+	t.Run("Should slash unstaking tokens and skip delegators", func(t *testing.T) {
+		slashes, err := table.SlashNode(collectionID, tokens(t, "0.5"), false)
+		require.NoError(t, err)
+
+		assert.Equal(t, []model.Slash{
+			{NodeID: collectionID, Amount: tokens(t, "229500.0")},
+		}, slashes)
+
+		node, err := table.NodeInfo(collectionID)
+		require.NoError(t, err)
+		assert.Equal(t, tokens(t, "45000.0"), node.TokensUnstaking)
+
+		delegator, err := table.DelegatorInfo(collectionID, delegatorID)
+		require.NoError(t, err)
+		assert.Equal(t, tokens(t, "90000.0"), delegator.TokensStaked)
+	})
+}
+
+func TestProjectRewards(t *testing.T) {
+
+	t.Run("Should project the same rewards that are paid", func(t *testing.T) {
+		table := model.NewIDTable(tokens(t, "1250000.0"), tokens(t, "0.08"))
+
+		require.NoError(t, table.AddNodeRecord(collectionID, model.RoleCollection, tokens(t, "333333.33333333")))
+		require.NoError(t, table.AddNodeRecord(consensusID, model.RoleConsensus, tokens(t, "777777.7")))
+
+		delegatorStakes := []ufix64.UFix64{tokens(t, "12345.6789"), tokens(t, "0.00000001"), tokens(t, "99999.99999999")}
+
+		for _, stake := range delegatorStakes {
+			delegatorID, err := table.RegisterNewDelegator(collectionID)
+			require.NoError(t, err)
+			require.NoError(t, table.DelegateNewTokens(collectionID, delegatorID, stake))
+		}
+
+		require.NoError(t, table.EndStakingAuction(map[string]bool{collectionID: true, consensusID: true}))
+		require.NoError(t, table.MoveTokens())
+		require.NoError(t, table.PayRewards())
+
+		rolePayout := model.RolePayout(tokens(t, "1250000.0"), table.RewardRatios()[model.RoleCollection])
+		roleStaked := table.TotalTokensStakedByNodeType()[model.RoleCollection]
+
+		rewards, err := model.ProjectRewards(tokens(t, "333333.33333333"), delegatorStakes, roleStaked, rolePayout, tokens(t, "0.08"))
+		require.NoError(t, err)
+
+		node, err := table.NodeInfo(collectionID)
+		require.NoError(t, err)
+		assert.Equal(t, node.TokensRewarded, rewards.Node)
+
+		for i, delegatorID := range node.Delegators {
+			delegator, err := table.DelegatorInfo(collectionID, delegatorID)
+			require.NoError(t, err)
+			assert.Equal(t, delegator.TokensRewarded, rewards.Delegators[i])
+		}
+
+		annualized, err := rewards.Annualized(52)
+		require.NoError(t, err)
+		assert.Equal(t, rewards.Node*52, annualized.Node)
+	})
+
+	t.Run("Shouldn't be able to project rewards for more than the total staked", func(t *testing.T) {
+		_, err := model.ProjectRewards(tokens(t, "2.0"), nil, tokens(t, "1.0"), tokens(t, "1.0"), tokens(t, "0.08"))
+		assert.Error(t, err)
+
+		_, err = model.ProjectRewards(tokens(t, "1.0"), nil, 0, tokens(t, "1.0"), tokens(t, "0.08"))
+		assert.Error(t, err)
+	})
+
+	t.Run("Should return an error on overflow", func(t *testing.T) {
+		rewards, err := model.ProjectRewards(tokens(t, "1.0"), nil, tokens(t, "1.0"), tokens(t, "100000000000.0"), tokens(t, "0.08"))
+		require.NoError(t, err)
+
+		_, err = rewards.Annualized(1000)
+		assert.Error(t, err)
+	})
+}
+
+func TestCheckSupply(t *testing.T) {
+
+	totals := model.SupplyTotals{
+		Roles: map[uint8]model.RoleTotals{
+			model.RoleCollection: {
+				TokensCommitted:             tokens(t, "10.0"),
+				TokensStaked:                tokens(t, "250000.0"),
+				TokensRewarded:              tokens(t, "1.5"),
+				TotalTokensStakedByNodeType: tokens(t, "250000.0"),
+			},
+			model.RoleConsensus: {
+				TokensUnstaking: tokens(t, "3.0"),
+				TokensUnstaked:  tokens(t, "2.0"),
+			},
+		},
+		StakingAccountBalance: tokens(t, "100.0"),
+		TokensHeld:            tokens(t, "250116.5"),
+	}
+
+	t.Run("Should hold when the buckets add up", func(t *testing.T) {
+		assert.Empty(t, model.CheckSupply(totals))
+		assert.Equal(t, tokens(t, "250016.5"), totals.TokensInBuckets())
+	})
+
+	t.Run("Should report a bucket that leaks", func(t *testing.T) {
+		leaked := model.SupplyTotals{
+			Roles:                 map[uint8]model.RoleTotals{},
+			StakingAccountBalance: totals.StakingAccountBalance,
+			TokensHeld:            totals.TokensHeld,
+		}
+
+		for role, roleTotals := range totals.Roles {
+			leaked.Roles[role] = roleTotals
+		}
+
+		// The rewarded tokens left the bucket, but no other vault received them
+		collection := leaked.Roles[model.RoleCollection]
+		collection.TokensRewarded = 0
+		leaked.Roles[model.RoleCollection] = collection
+
+		violations := model.CheckSupply(leaked)
+
+		assert.Equal(t, []model.SupplyViolation{
+			{Expected: tokens(t, "250116.5"), Actual: tokens(t, "250115.0")},
+		}, violations)
+
+		assert.Equal(t,
+			"the staking account and the buckets hold 250115.00000000 FLOW, but the staking account holds 250116.50000000",
+			violations[0].String(),
+		)
+	})
+
+	t.Run("Should report every invariant that does not hold", func(t *testing.T) {
+		collection := totals.Roles[model.RoleCollection]
+		collection.TokensStaked = tokens(t, "249990.0")
+		totals.Roles[model.RoleCollection] = collection
+
+		consensus := totals.Roles[model.RoleConsensus]
+		consensus.TotalTokensStakedByNodeType = tokens(t, "1.0")
+		totals.Roles[model.RoleConsensus] = consensus
+
+		violations := model.CheckSupply(totals)
+
+		assert.Equal(t, []model.SupplyViolation{
+			{Role: model.RoleCollection, Expected: tokens(t, "250000.0"), Actual: tokens(t, "249990.0")},
+			{Role: model.RoleConsensus, Expected: tokens(t, "1.0"), Actual: 0},
+			{Expected: tokens(t, "250116.5"), Actual: tokens(t, "250106.5")},
+		}, violations)
+
+		assert.Equal(t, "role 2 has 0.00000000 FLOW staked, but the contract records 1.00000000", violations[1].String())
+	})
+}
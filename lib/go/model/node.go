@@ -0,0 +1,231 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// AddNodeRecord mirrors FlowIDTableStaking.addNodeRecord.
+//
+// The networking address and keys are not modelled,
+// so their validation is left to the contract.
+func (t *IDTable) AddNodeRecord(nodeID string, role uint8, tokensCommitted ufix64.UFix64) error {
+	if len(nodeID) != nodeIDLength {
+		return errors.New("node ID length must be 32 bytes (64 hex characters)")
+	}
+
+	if _, exists := t.nodes[nodeID]; exists {
+		return errors.New("the ID cannot already exist in the record")
+	}
+
+	if role < RoleCollection || role > RoleAccess {
+		return errors.New("the role must be 1, 2, 3, 4, or 5")
+	}
+
+	t.nodes[nodeID] = &nodeRecord{
+		id:              nodeID,
+		role:            role,
+		tokensCommitted: tokensCommitted,
+		delegators:      make(map[uint32]*delegatorRecord),
+	}
+
+	return nil
+}
+
+// StakeNewTokens mirrors NodeStaker.stakeNewTokens.
+func (t *IDTable) StakeNewTokens(nodeID string, amount ufix64.UFix64) error {
+	return t.atomically(func(c *IDTable) error {
+		node, err := c.borrowNodeRecord(nodeID)
+		if err != nil {
+			return err
+		}
+
+		node.tokensCommitted = add(node.tokensCommitted, amount)
+
+		return nil
+	})
+}
+
+// StakeUnstakedTokens mirrors NodeStaker.stakeUnstakedTokens.
+//
+// The amount first cancels any outstanding unstaking request
+// and only the remainder is moved from unstaked to committed.
+func (t *IDTable) StakeUnstakedTokens(nodeID string, amount ufix64.UFix64) error {
+	return t.atomically(func(c *IDTable) error {
+		node, err := c.borrowNodeRecord(nodeID)
+		if err != nil {
+			return err
+		}
+
+		requested, remainingAmount := cancelRequest(node.tokensRequestedToUnstake, amount)
+
+		if remainingAmount > node.tokensUnstaked {
+			return ErrInsufficientFunds
+		}
+
+		node.tokensRequestedToUnstake = requested
+		node.tokensUnstaked = sub(node.tokensUnstaked, remainingAmount)
+		node.tokensCommitted = add(node.tokensCommitted, remainingAmount)
+
+		return nil
+	})
+}
+
+// StakeRewardedTokens mirrors NodeStaker.stakeRewardedTokens.
+func (t *IDTable) StakeRewardedTokens(nodeID string, amount ufix64.UFix64) error {
+	return t.atomically(func(c *IDTable) error {
+		if amount == 0 {
+			return nil
+		}
+
+		node, err := c.borrowNodeRecord(nodeID)
+		if err != nil {
+			return err
+		}
+
+		if err := withdraw(&node.tokensRewarded, amount); err != nil {
+			return err
+		}
+
+		node.tokensCommitted = add(node.tokensCommitted, amount)
+
+		return nil
+	})
+}
+
+// RequestUnstaking mirrors NodeStaker.requestUnstaking.
+//
+// Committed tokens are unstaked immediately and whatever is left
+// is requested to be unstaked from the staked tokens at the end of the epoch.
+func (t *IDTable) RequestUnstaking(nodeID string, amount ufix64.UFix64) error {
+	return t.atomically(func(c *IDTable) error {
+		node, err := c.borrowNodeRecord(nodeID)
+		if err != nil {
+			return err
+		}
+
+		if add(node.tokensStaked, node.tokensCommitted) < add(amount, node.tokensRequestedToUnstake) {
+			return errors.New("not enough tokens to unstake")
+		}
+
+		if len(node.delegators) > 0 {
+			aboveMinimum, err := c.isGreaterThanMinimumForRole(sub(node.nodeFullCommittedBalance(), amount), node.role)
+			if err != nil {
+				return err
+			}
+
+			if !aboveMinimum {
+				return errors.New("cannot unstake below the minimum if there are delegators")
+			}
+		}
+
+		fromCommitted, requested := splitRequest(node.tokensCommitted, amount)
+
+		node.tokensCommitted = sub(node.tokensCommitted, fromCommitted)
+		node.tokensUnstaked = add(node.tokensUnstaked, fromCommitted)
+		node.tokensRequestedToUnstake = add(node.tokensRequestedToUnstake, requested)
+
+		return nil
+	})
+}
+
+// UnstakeAll mirrors NodeStaker.unstakeAll.
+func (t *IDTable) UnstakeAll(nodeID string) error {
+	return t.atomically(func(c *IDTable) error {
+		node, err := c.borrowNodeRecord(nodeID)
+		if err != nil {
+			return err
+		}
+
+		node.tokensUnstaked = add(node.tokensUnstaked, node.tokensCommitted)
+		node.tokensCommitted = 0
+
+		node.tokensRequestedToUnstake = node.tokensStaked
+
+		return nil
+	})
+}
+
+// WithdrawUnstakedTokens mirrors NodeStaker.withdrawUnstakedTokens.
+func (t *IDTable) WithdrawUnstakedTokens(nodeID string, amount ufix64.UFix64) error {
+	node, err := t.borrowNodeRecord(nodeID)
+	if err != nil {
+		return err
+	}
+
+	return withdraw(&node.tokensUnstaked, amount)
+}
+
+// WithdrawRewardedTokens mirrors NodeStaker.withdrawRewardedTokens.
+func (t *IDTable) WithdrawRewardedTokens(nodeID string, amount ufix64.UFix64) error {
+	node, err := t.borrowNodeRecord(nodeID)
+	if err != nil {
+		return err
+	}
+
+	return withdraw(&node.tokensRewarded, amount)
+}
+
+// NodeInfo mirrors the FlowIDTableStaking.NodeInfo initializer.
+func (t *IDTable) NodeInfo(nodeID string) (NodeInfo, error) {
+	node, err := t.borrowNodeRecord(nodeID)
+	if err != nil {
+		return NodeInfo{}, err
+	}
+
+	totalTokensStaked, err := t.NodeStakedBalanceWithDelegators(nodeID)
+	if err != nil {
+		return NodeInfo{}, err
+	}
+
+	return NodeInfo{
+		ID:                       node.id,
+		Role:                     node.role,
+		TokensStaked:             node.tokensStaked,
+		TotalTokensStaked:        totalTokensStaked,
+		TokensCommitted:          node.tokensCommitted,
+		TokensUnstaking:          node.tokensUnstaking,
+		TokensUnstaked:           node.tokensUnstaked,
+		TokensRewarded:           node.tokensRewarded,
+		Delegators:               node.sortedDelegatorIDs(),
+		DelegatorIDCounter:       node.delegatorIDCounter,
+		TokensRequestedToUnstake: node.tokensRequestedToUnstake,
+		InitialWeight:            node.initialWeight,
+	}, nil
+}
+
+// cancelRequest cancels up to amount of an outstanding unstaking request
+// and returns the new request and the part of amount that was left over.
+func cancelRequest(requested, amount ufix64.UFix64) (newRequested, remainingAmount ufix64.UFix64) {
+	if amount <= requested {
+		return sub(requested, amount), 0
+	}
+
+	return 0, sub(amount, requested)
+}
+
+// splitRequest splits an unstaking request into the part that can be taken
+// from committed tokens right away and the part that has to be requested.
+func splitRequest(committed, amount ufix64.UFix64) (fromCommitted, requested ufix64.UFix64) {
+	if committed >= amount {
+		return amount, 0
+	}
+
+	return committed, sub(amount, committed)
+}
+
+// isGreaterThanMinimumForRole mirrors FlowIDTableStaking.isGreaterThanMinimumForRole.
+func (t *IDTable) isGreaterThanMinimumForRole(numTokens ufix64.UFix64, role uint8) (bool, error) {
+	if role == RoleAccess {
+		return numTokens > 0, nil
+	}
+
+	minimum, ok := t.minimumStakeRequired[role]
+	if !ok {
+		return false, fmt.Errorf("no minimum stake requirement for role %d", role)
+	}
+
+	return numTokens >= minimum, nil
+}
@@ -0,0 +1,148 @@
+package model
+
+import (
+	"errors"
+	"math"
+
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// Rewards are the tokens paid to a node operator and its delegators in one epoch.
+type Rewards struct {
+	// Node is the reward of the node operator,
+	// including the cut it takes from its delegators' rewards.
+	Node ufix64.UFix64
+	// Delegators are the rewards of the delegators after the cut,
+	// in the same order as their stakes.
+	Delegators []ufix64.UFix64
+}
+
+// RolePayout returns the part of the epoch token payout that is paid
+// to the nodes of a role and their delegators, given the reward ratio of the role.
+func RolePayout(epochTokenPayout, rewardRatio ufix64.UFix64) ufix64.UFix64 {
+	return mul(epochTokenPayout, rewardRatio)
+}
+
+// ProjectRewards returns the rewards payRewards would pay to a node and its
+// delegators given their staked tokens, the total staked tokens of all nodes
+// of the same role and their delegators, the payout of the role, as returned
+// by RolePayout, and the delegator reward cut.
+func ProjectRewards(
+	nodeStaked ufix64.UFix64,
+	delegatorsStaked []ufix64.UFix64,
+	totalStaked, rolePayout, rewardCut ufix64.UFix64,
+) (rewards Rewards, err error) {
+
+	if totalStaked == 0 {
+		return Rewards{}, errors.New("total staked tokens must be greater than zero")
+	}
+
+	if rewardCut >= ufix64.One {
+		return Rewards{}, errors.New("cut percentage must be between 0 and 1")
+	}
+
+	defer recoverArithmeticError(&err)
+
+	sum := nodeStaked
+	for _, staked := range delegatorsStaked {
+		sum = add(sum, staked)
+	}
+
+	if sum > totalStaked {
+		return Rewards{}, errors.New("the stake of the node and its delegators exceeds the total staked tokens")
+	}
+
+	return nodeRewards(nodeStaked, delegatorsStaked, div(rolePayout, totalStaked), rewardCut), nil
+}
+
+// Annualized returns the rewards multiplied by the number of epochs in a year.
+// Rewards are not compounded, since rewarded tokens are not staked automatically.
+func (r Rewards) Annualized(epochsPerYear uint64) (annualized Rewards, err error) {
+	if epochsPerYear > math.MaxUint64/ufix64.Factor {
+		return Rewards{}, errors.New("UFix64 overflow")
+	}
+
+	defer recoverArithmeticError(&err)
+
+	epochs := ufix64.UFix64(epochsPerYear * ufix64.Factor)
+
+	result := Rewards{
+		Node:       mul(r.Node, epochs),
+		Delegators: make([]ufix64.UFix64, len(r.Delegators)),
+	}
+
+	for i, reward := range r.Delegators {
+		result.Delegators[i] = mul(reward, epochs)
+	}
+
+	return result, nil
+}
+
+// nodeRewards mirrors the body of the payRewards loop for a single node.
+//
+// Nodes and delegators without staked tokens, or whose reward
+// truncates to zero, get nothing and do not pay a cut.
+func nodeRewards(
+	nodeStaked ufix64.UFix64,
+	delegatorsStaked []ufix64.UFix64,
+	totalRewardScale, rewardCut ufix64.UFix64,
+) Rewards {
+
+	rewards := Rewards{
+		Delegators: make([]ufix64.UFix64, len(delegatorsStaked)),
+	}
+
+	if nodeStaked == 0 {
+		return rewards
+	}
+
+	rewardAmount := mul(nodeStaked, totalRewardScale)
+
+	if rewardAmount == 0 {
+		return rewards
+	}
+
+	tokenReward := rewardAmount
+
+	for i, delegatorStaked := range delegatorsStaked {
+
+		if delegatorStaked == 0 {
+			continue
+		}
+
+		delegatorReward := mul(delegatorStaked, totalRewardScale)
+
+		if delegatorReward == 0 {
+			continue
+		}
+
+		// take the node operator's cut
+		cut := mul(delegatorReward, rewardCut)
+		if cut > 0 {
+			tokenReward = add(tokenReward, cut)
+			delegatorReward = sub(delegatorReward, cut)
+		}
+
+		rewards.Delegators[i] = delegatorReward
+	}
+
+	rewards.Node = tokenReward
+
+	return rewards
+}
+
+// recoverArithmeticError turns the overflow, underflow and division by zero
+// panics of the arithmetic helpers into errors.
+func recoverArithmeticError(err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+
+	if e, ok := r.(error); ok && (e == ufix64.ErrOverflow || e == ufix64.ErrUnderflow || e == ufix64.ErrDivisionByZero) {
+		*err = e
+		return
+	}
+
+	panic(r)
+}
@@ -0,0 +1,13 @@
+module github.com/onflow/flow-core-contracts/lib/go/model/rewards
+
+go 1.13
+
+require (
+	github.com/onflow/flow-core-contracts/lib/go/model v0.0.0-00010101000000-000000000000
+	github.com/onflow/flow-core-contracts/lib/go/ufix64 v0.0.0-00010101000000-000000000000
+	github.com/spf13/cobra v1.1.1
+)
+
+replace github.com/onflow/flow-core-contracts/lib/go/model => ../
+
+replace github.com/onflow/flow-core-contracts/lib/go/ufix64 => ../../ufix64
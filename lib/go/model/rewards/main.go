@@ -0,0 +1,163 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-core-contracts/lib/go/model"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+type Config struct {
+	NodeStake       string
+	DelegatorStakes []string
+	TotalStaked     string
+	Payout          string
+	RewardRatio     string
+	Cut             string
+	EpochsPerYear   uint64
+}
+
+var conf Config
+
+var cmd = &cobra.Command{
+	Use:   "rewards",
+	Short: "Project the staking rewards of a node operator and its delegators",
+	Long: `Project the staking rewards of a node operator and its delegators.
+
+The rewards are calculated the same way FlowIDTableStaking.payRewards does,
+including the truncation to UFix64 precision. The nodes of each role share
+the reward ratio of their role of the payout, so the total staked tokens
+are the tokens staked for all the nodes of the role and their delegators.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		err := project(conf)
+		if err != nil {
+			exit(err)
+		}
+	},
+}
+
+func project(conf Config) error {
+
+	nodeStake, err := parseUFix64("node-stake", conf.NodeStake)
+	if err != nil {
+		return err
+	}
+
+	delegatorStakes := make([]ufix64.UFix64, len(conf.DelegatorStakes))
+	for i, stake := range conf.DelegatorStakes {
+		delegatorStakes[i], err = parseUFix64("delegator-stake", stake)
+		if err != nil {
+			return err
+		}
+	}
+
+	totalStaked, err := parseUFix64("total-staked", conf.TotalStaked)
+	if err != nil {
+		return err
+	}
+
+	payout, err := parseUFix64("payout", conf.Payout)
+	if err != nil {
+		return err
+	}
+
+	ratio, err := parseUFix64("reward-ratio", conf.RewardRatio)
+	if err != nil {
+		return err
+	}
+
+	cut, err := parseUFix64("cut", conf.Cut)
+	if err != nil {
+		return err
+	}
+
+	rewards, err := model.ProjectRewards(nodeStake, delegatorStakes, totalStaked, model.RolePayout(payout, ratio), cut)
+	if err != nil {
+		return err
+	}
+
+	annualized, err := rewards.Annualized(conf.EpochsPerYear)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.AlignRight)
+
+	fmt.Fprintln(w, "\tStaked\tPer epoch\tAnnualized\tAnnual rate\t")
+
+	printRewards(w, "Node operator", nodeStake, rewards.Node, annualized.Node)
+
+	for i, stake := range delegatorStakes {
+		printRewards(w, fmt.Sprintf("Delegator %d", i+1), stake, rewards.Delegators[i], annualized.Delegators[i])
+	}
+
+	return w.Flush()
+}
+
+func printRewards(w *tabwriter.Writer, name string, staked, perEpoch, annualized ufix64.UFix64) {
+
+	rate := "-"
+	if staked > 0 {
+		rate = rewardRate(staked, annualized)
+	}
+
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t\n", name, staked, perEpoch, annualized, rate)
+}
+
+// rewardRate returns the annualized reward as a percentage of the stake
+func rewardRate(staked, annualized ufix64.UFix64) string {
+	percentage, err := annualized.Mul(ufix64.UFix64(10000000000))
+	if err == nil {
+		percentage, err = percentage.Div(staked)
+	}
+	if err != nil {
+		return "overflow"
+	}
+
+	return fmt.Sprintf("%s%%", percentage)
+}
+
+func parseUFix64(name, value string) (ufix64.UFix64, error) {
+	if value == "" {
+		return 0, fmt.Errorf("missing --%s", name)
+	}
+
+	parsed, err := ufix64.Parse(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid --%s %s: %w", name, value, err)
+	}
+
+	return parsed, nil
+}
+
+func init() {
+	initConfig()
+}
+
+func initConfig() {
+	flags := cmd.PersistentFlags()
+
+	flags.StringVar(&conf.NodeStake, "node-stake", "", "Tokens staked by the node operator")
+	flags.StringSliceVar(&conf.DelegatorStakes, "delegator-stake", nil, "Tokens staked by a delegator, can be repeated")
+	flags.StringVar(&conf.TotalStaked, "total-staked", "", "Tokens staked by all nodes of the role and their delegators")
+	flags.StringVar(&conf.Payout, "payout", "", "Epoch token payout")
+	flags.StringVar(&conf.RewardRatio, "reward-ratio", "", "Ratio of the payout paid to the role, between 0.0 and 1.0")
+	flags.StringVar(&conf.Cut, "cut", "", "Cut of the delegators' rewards taken by the node operator, between 0.0 and 1.0")
+	flags.Uint64Var(&conf.EpochsPerYear, "epochs-per-year", 52, "Number of epochs in a year")
+}
+
+func main() {
+	if err := cmd.Execute(); err != nil {
+		exit(err)
+	}
+}
+
+func exit(err error) {
+	fmt.Println(err)
+	os.Exit(1)
+}
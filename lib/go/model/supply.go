@@ -0,0 +1,178 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// RoleTotals are the sums of the token buckets of all the nodes of a role
+// and of their delegators, as returned by the get_supply_totals.cdc script.
+type RoleTotals struct {
+	TokensCommitted ufix64.UFix64
+	TokensStaked    ufix64.UFix64
+	TokensUnstaking ufix64.UFix64
+	TokensUnstaked  ufix64.UFix64
+	TokensRewarded  ufix64.UFix64
+
+	// TotalTokensStakedByNodeType is the total staked that the contract records for the role.
+	TotalTokensStakedByNodeType ufix64.UFix64
+}
+
+// Sum returns the sum of all the buckets.
+func (r RoleTotals) Sum() ufix64.UFix64 {
+	sum := add(r.TokensCommitted, r.TokensStaked)
+	sum = add(sum, r.TokensUnstaking)
+	sum = add(sum, r.TokensUnstaked)
+	return add(sum, r.TokensRewarded)
+}
+
+// SupplyTotals are the bucket totals of every role and the FLOW held by the staking account.
+type SupplyTotals struct {
+	Roles map[uint8]RoleTotals
+
+	// StakingAccountBalance is the balance of the vault of the staking account.
+	StakingAccountBalance ufix64.UFix64
+
+	// TokensHeld is the FLOW total supply minus the balances of the vaults of all other accounts,
+	// which the staking account has to hold in its vault and in the buckets.
+	TokensHeld ufix64.UFix64
+}
+
+// TokensInBuckets returns the FLOW in the buckets of all the nodes and delegators.
+func (s SupplyTotals) TokensInBuckets() ufix64.UFix64 {
+	var sum ufix64.UFix64
+	for _, roleTotals := range s.Roles {
+		sum = add(sum, roleTotals.Sum())
+	}
+	return sum
+}
+
+// SupplyViolation is a supply invariant that does not hold.
+type SupplyViolation struct {
+	// Role is the role whose recorded total staked differs from its staked buckets,
+	// or zero if the FLOW in the vault of the staking account and in the buckets
+	// differs from the FLOW the staking account holds.
+	Role     uint8
+	Expected ufix64.UFix64
+	Actual   ufix64.UFix64
+}
+
+func (v SupplyViolation) String() string {
+	if v.Role == 0 {
+		return fmt.Sprintf("the staking account and the buckets hold %s FLOW, but the staking account holds %s", v.Actual, v.Expected)
+	}
+
+	return fmt.Sprintf("role %d has %s FLOW staked, but the contract records %s", v.Role, v.Actual, v.Expected)
+}
+
+// CheckSupply returns the supply invariants that do not hold, ordered by role:
+//
+//   - for every role, the staked buckets of its nodes and delegators sum up
+//     to the total staked that the contract records for it
+//   - the vault of the staking account and the buckets of all roles sum up
+//     to the FLOW held by the staking account
+func CheckSupply(totals SupplyTotals) []SupplyViolation {
+	roles := make([]int, 0, len(totals.Roles))
+	for role := range totals.Roles {
+		roles = append(roles, int(role))
+	}
+	sort.Ints(roles)
+
+	var violations []SupplyViolation
+
+	for _, role := range roles {
+		roleTotals := totals.Roles[uint8(role)]
+
+		if roleTotals.TokensStaked != roleTotals.TotalTokensStakedByNodeType {
+			violations = append(violations, SupplyViolation{
+				Role:     uint8(role),
+				Expected: roleTotals.TotalTokensStakedByNodeType,
+				Actual:   roleTotals.TokensStaked,
+			})
+		}
+	}
+
+	held := add(totals.StakingAccountBalance, totals.TokensInBuckets())
+
+	if held != totals.TokensHeld {
+		violations = append(violations, SupplyViolation{
+			Expected: totals.TokensHeld,
+			Actual:   held,
+		})
+	}
+
+	return violations
+}
+
+// DecodeSupplyTotals decodes the SupplyTotals struct
+// returned by the get_supply_totals.cdc script.
+func DecodeSupplyTotals(value cadence.Value) (SupplyTotals, error) {
+	fields, err := structFields(value, "SupplyTotals")
+	if err != nil {
+		return SupplyTotals{}, err
+	}
+
+	d := fieldDecoder{fields: fields}
+
+	rolesValue := d.field("roles")
+	stakingAccountBalance := d.ufix64("stakingAccountBalance")
+	tokensHeld := d.ufix64("tokensHeld")
+
+	if d.err != nil {
+		return SupplyTotals{}, d.err
+	}
+
+	dictionary, ok := rolesValue.(cadence.Dictionary)
+	if !ok {
+		return SupplyTotals{}, fmt.Errorf("unexpected type %T for field roles", rolesValue)
+	}
+
+	totals := SupplyTotals{
+		Roles:                 make(map[uint8]RoleTotals, len(dictionary.Pairs)),
+		StakingAccountBalance: stakingAccountBalance,
+		TokensHeld:            tokensHeld,
+	}
+
+	for _, pair := range dictionary.Pairs {
+		role, ok := pair.Key.(cadence.UInt8)
+		if !ok {
+			return SupplyTotals{}, fmt.Errorf("unexpected type %T for role", pair.Key)
+		}
+
+		roleTotals, err := decodeRoleTotals(pair.Value)
+		if err != nil {
+			return SupplyTotals{}, fmt.Errorf("role %d: %w", role, err)
+		}
+
+		totals.Roles[uint8(role)] = roleTotals
+	}
+
+	return totals, nil
+}
+
+func decodeRoleTotals(value cadence.Value) (RoleTotals, error) {
+	fields, err := structFields(value, "RoleTotals")
+	if err != nil {
+		return RoleTotals{}, err
+	}
+
+	var totals RoleTotals
+	d := fieldDecoder{fields: fields}
+
+	totals.TokensCommitted = d.ufix64("tokensCommitted")
+	totals.TokensStaked = d.ufix64("tokensStaked")
+	totals.TokensUnstaking = d.ufix64("tokensUnstaking")
+	totals.TokensUnstaked = d.ufix64("tokensUnstaked")
+	totals.TokensRewarded = d.ufix64("tokensRewarded")
+	totals.TotalTokensStakedByNodeType = d.ufix64("totalTokensStakedByNodeType")
+
+	if d.err != nil {
+		return RoleTotals{}, d.err
+	}
+
+	return totals, nil
+}
@@ -5,7 +5,6 @@ import (
 )
 
 const (
-	createDelegationFilename       = "idTableStaking/delegation/del_create_delegation.cdc"
 	delegatorAddCapabilityFilename = "idTableStaking/delegation/delegator_add_capability.cdc"
 
 	delegatorRegisterFilename         = "idTableStaking/delegation/register_delegator.cdc"
@@ -29,10 +28,11 @@ const (
 	registerManyDelegatorsFilename = "idTableStaking/delegation/register_many_delegators.cdc"
 )
 
+// GenerateCreateDelegationScript returns the transaction that registers a delegator.
+//
+// Deprecated: the transaction it used to return doesn't exist, use GenerateRegisterDelegatorScript.
 func GenerateCreateDelegationScript(env Environment) []byte {
-	code := assets.MustAssetString(createDelegationFilename)
-
-	return []byte(replaceAddresses(code, env))
+	return GenerateRegisterDelegatorScript(env)
 }
 
 func GenerateRegisterDelegatorScript(env Environment) []byte {
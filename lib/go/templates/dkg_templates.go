@@ -0,0 +1,119 @@
+package templates
+
+import (
+	"github.com/onflow/flow-core-contracts/lib/go/templates/internal/assets"
+)
+
+const (
+	// admin templates
+	startDKGFilename = "dkg/admin/start_dkg.cdc"
+	endDKGFilename   = "dkg/admin/end_dkg.cdc"
+
+	// participant templates
+	createParticipantFilename   = "dkg/participant/create_participant.cdc"
+	postMessageFilename         = "dkg/participant/post_message.cdc"
+	sendFinalSubmissionFilename = "dkg/participant/send_final_submission.cdc"
+
+	// scripts
+	getWhiteboardMessagesFilename     = "dkg/scripts/get_whiteboard_messages.cdc"
+	getWhiteboardMessageCountFilename = "dkg/scripts/get_whiteboard_message_count.cdc"
+	getDKGEnabledFilename             = "dkg/scripts/get_dkg_enabled.cdc"
+	getDKGCompletedFilename           = "dkg/scripts/get_dkg_completed.cdc"
+	getFinalSubmissionsFilename       = "dkg/scripts/get_final_submissions.cdc"
+	getSubmissionThresholdFilename    = "dkg/scripts/get_submission_threshold.cdc"
+	getConsensusNodesFilename         = "dkg/scripts/get_consensus_nodes.cdc"
+)
+
+// GenerateStartDKGScript generates a script that starts the DKG
+// with the given consensus nodes
+func GenerateStartDKGScript(env Environment) []byte {
+	code := assets.MustAssetString(startDKGFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateEndDKGScript generates a script that ends the DKG
+// once enough participants have submitted the same result
+func GenerateEndDKGScript(env Environment) []byte {
+	code := assets.MustAssetString(endDKGFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateCreateParticipantScript generates a script that creates the DKG participant
+// of the consensus node staked by the signer
+func GenerateCreateParticipantScript(env Environment) []byte {
+	code := assets.MustAssetString(createParticipantFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GeneratePostMessageScript generates a script that posts a broadcast message
+// to the DKG whiteboard
+func GeneratePostMessageScript(env Environment) []byte {
+	code := assets.MustAssetString(postMessageFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateSendFinalSubmissionScript generates a script that submits
+// the DKG result computed by a node
+func GenerateSendFinalSubmissionScript(env Environment) []byte {
+	code := assets.MustAssetString(sendFinalSubmissionFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetWhiteboardMessagesScript generates a script that returns
+// the whiteboard messages starting at an index
+func GenerateGetWhiteboardMessagesScript(env Environment) []byte {
+	code := assets.MustAssetString(getWhiteboardMessagesFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetWhiteboardMessageCountScript generates a script that returns
+// the number of whiteboard messages
+func GenerateGetWhiteboardMessageCountScript(env Environment) []byte {
+	code := assets.MustAssetString(getWhiteboardMessageCountFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetDKGEnabledScript generates a script that returns true if the DKG is in progress
+func GenerateGetDKGEnabledScript(env Environment) []byte {
+	code := assets.MustAssetString(getDKGEnabledFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetDKGCompletedScript generates a script that returns the agreed DKG result, if any
+func GenerateGetDKGCompletedScript(env Environment) []byte {
+	code := assets.MustAssetString(getDKGCompletedFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetFinalSubmissionsScript generates a script that returns
+// the final submissions of the current DKG
+func GenerateGetFinalSubmissionsScript(env Environment) []byte {
+	code := assets.MustAssetString(getFinalSubmissionsFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetSubmissionThresholdScript generates a script that returns the number
+// of identical submissions a result has to exceed
+func GenerateGetSubmissionThresholdScript(env Environment) []byte {
+	code := assets.MustAssetString(getSubmissionThresholdFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetConsensusNodesScript generates a script that returns
+// the participants of the current DKG
+func GenerateGetConsensusNodesScript(env Environment) []byte {
+	code := assets.MustAssetString(getConsensusNodesFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
@@ -0,0 +1,77 @@
+package templates
+
+import (
+	"github.com/onflow/flow-core-contracts/lib/go/templates/internal/assets"
+)
+
+const (
+	// admin templates
+	deployEpochFilename       = "epoch/admin/deploy_epoch.cdc"
+	endStakingAuctionFilename = "epoch/admin/end_staking_auction.cdc"
+	commitEpochFilename       = "epoch/admin/commit_epoch.cdc"
+	endCurrentEpochFilename   = "epoch/admin/end_epoch.cdc"
+	setNumViewsFilename       = "epoch/admin/set_num_views.cdc"
+
+	// scripts
+	getEpochCounterFilename  = "epoch/scripts/get_epoch_counter.cdc"
+	getEpochPhaseFilename    = "epoch/scripts/get_epoch_phase.cdc"
+	getEpochMetadataFilename = "epoch/scripts/get_epoch_metadata.cdc"
+)
+
+// GenerateDeployEpochScript generates a script that deploys the FlowEpoch
+// contract to the account of the FlowIDTableStaking contract
+func GenerateDeployEpochScript() []byte {
+	return assets.MustAsset(deployEpochFilename)
+}
+
+// GenerateEndStakingAuctionScript generates a script that ends the staking auction
+// and moves the epoch to the setup phase
+func GenerateEndStakingAuctionScript(env Environment) []byte {
+	code := assets.MustAssetString(endStakingAuctionFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateCommitEpochScript generates a script that moves the epoch to the committed phase
+func GenerateCommitEpochScript(env Environment) []byte {
+	code := assets.MustAssetString(commitEpochFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateEndCurrentEpochScript generates a script that pays rewards, moves tokens
+// and starts the next epoch
+func GenerateEndCurrentEpochScript(env Environment) []byte {
+	code := assets.MustAssetString(endCurrentEpochFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateSetNumViewsInEpochScript generates a script that changes
+// the number of views of the next epochs
+func GenerateSetNumViewsInEpochScript(env Environment) []byte {
+	code := assets.MustAssetString(setNumViewsFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetEpochCounterScript generates a script that returns the counter of the current epoch
+func GenerateGetEpochCounterScript(env Environment) []byte {
+	code := assets.MustAssetString(getEpochCounterFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetEpochPhaseScript generates a script that returns the phase of the current epoch
+func GenerateGetEpochPhaseScript(env Environment) []byte {
+	code := assets.MustAssetString(getEpochPhaseFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetEpochMetadataScript generates a script that returns the metadata of an epoch
+func GenerateGetEpochMetadataScript(env Environment) []byte {
+	code := assets.MustAssetString(getEpochMetadataFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
@@ -5,7 +5,8 @@ import (
 )
 
 const (
-	transferDeployFilename = "idTableStaking/admin/transfer_minter_deploy.cdc"
+	transferDeployFilename    = "idTableStaking/admin/transfer_minter_deploy.cdc"
+	transferDeployHexFilename = "idTableStaking/admin/transfer_minter_deploy_hex.cdc"
 
 	removeNodeFilename           = "idTableStaking/admin/remove_node.cdc"
 	endStakingFilename           = "idTableStaking/admin/end_staking.cdc"
@@ -16,6 +17,10 @@ const (
 	changeCutFilename            = "idTableStaking/admin/change_cut.cdc"
 	changePayoutFilename         = "idTableStaking/admin/change_payout.cdc"
 	endEpochChangePayoutFilename = "idTableStaking/admin/end_epoch_change_payout.cdc"
+	slashNodeFilename            = "idTableStaking/admin/slash_node.cdc"
+	changeRewardRatiosFilename   = "idTableStaking/admin/change_reward_ratios.cdc"
+	changeMaximumWeightFilename  = "idTableStaking/admin/change_maximum_initial_weight.cdc"
+	changeDelegationCapFilename  = "idTableStaking/admin/change_maximum_delegation_ratios.cdc"
 
 	registerNodeFilename            = "idTableStaking/node/register_node.cdc"
 	stakeNewTokensFilename          = "idTableStaking/node/stake_new_tokens.cdc"
@@ -24,7 +29,7 @@ const (
 	unstakeTokensFilename           = "idTableStaking/node/request_unstake.cdc"
 	unstakeAllFilename              = "idTableStaking/node/unstake_all.cdc"
 	withdrawUnstakedTokensFilename  = "idTableStaking/node/withdraw_unstaked_tokens.cdc"
-	withdrawRewardedTokensFilename  = "idTableStaking/node/withdraw_rewarded_tokens.cdc"
+	withdrawRewardedTokensFilename  = "idTableStaking/node/withdraw_reward_tokens.cdc"
 	addPublicNodeCapabilityFilename = "idTableStaking/node/node_add_capability.cdc"
 
 	registerManyNodesFilename = "idTableStaking/node/register_many_nodes.cdc"
@@ -39,6 +44,7 @@ const (
 	getNetworkingKeyFilename                    = "idTableStaking/scripts/get_node_networking_key.cdc"
 	getStakingKeyFilename                       = "idTableStaking/scripts/get_node_staking_key.cdc"
 	getInitialWeightFilename                    = "idTableStaking/scripts/get_node_initial_weight.cdc"
+	getMaximumInitialWeightFilename             = "idTableStaking/scripts/get_maximum_initial_weight.cdc"
 	stakedBalanceFilename                       = "idTableStaking/scripts/get_node_staked_tokens.cdc"
 	comittedBalanceFilename                     = "idTableStaking/scripts/get_node_committed_tokens.cdc"
 	unstakedBalanceFilename                     = "idTableStaking/scripts/get_node_unstaked_tokens.cdc"
@@ -48,12 +54,16 @@ const (
 	getTotalCommitmentWithoutDelegatorsFilename = "idTableStaking/scripts/get_node_total_commitment_without_delegators.cdc"
 	getUnstakingRequestFilename                 = "idTableStaking/scripts/get_node_unstaking_request.cdc"
 	getCutPercentageFilename                    = "idTableStaking/scripts/get_cut_percentage.cdc"
+	getDelegationCapacityFilename               = "idTableStaking/scripts/get_node_delegation_capacity.cdc"
 
 	stakeRequirementsFilename = "idTableStaking/scripts/get_stake_requirements.cdc"
 	totalStakedByTypeFilename = "idTableStaking/scripts/get_total_staked_by_type.cdc"
 	totalStakedFilename       = "idTableStaking/scripts/get_total_staked.cdc"
 	rewardRatioFilename       = "idTableStaking/scripts/get_node_type_ratio.cdc"
+	rewardRatiosFilename      = "idTableStaking/scripts/get_reward_ratios.cdc"
+	delegationRatiosFilename  = "idTableStaking/scripts/get_maximum_delegation_ratios.cdc"
 	weeklyPayoutFilename      = "idTableStaking/scripts/get_weekly_payout.cdc"
+	supplyTotalsFilename      = "idTableStaking/scripts/get_supply_totals.cdc"
 )
 
 // Admin Templates -----------------------------------------------------------
@@ -66,6 +76,15 @@ func GenerateTransferMinterAndDeployScript(env Environment) []byte {
 	return []byte(replaceAddresses(code, env))
 }
 
+// GenerateTransferMinterAndDeployHexScript generates a script that transfers
+// a flow minter and deploys the id table account with the code as a hex string,
+// which keeps the transaction below the size limit for the full contract
+func GenerateTransferMinterAndDeployHexScript(env Environment) []byte {
+	code := assets.MustAssetString(transferDeployHexFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
 // GenerateRemoveNodeScript creates a script that removes a node
 // from the record
 func GenerateRemoveNodeScript(env Environment) []byte {
@@ -101,6 +120,30 @@ func GenerateEndEpochScript(env Environment) []byte {
 	return []byte(replaceAddresses(code, env))
 }
 
+// GenerateChangeRewardRatiosScript creates a script that changes
+// the ratio of the epoch payout that each node type gets
+func GenerateChangeRewardRatiosScript(env Environment) []byte {
+	code := assets.MustAssetString(changeRewardRatiosFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateChangeMaximumInitialWeightScript creates a script that changes
+// the highest initial weight that a node can get at the end of the staking auction
+func GenerateChangeMaximumInitialWeightScript(env Environment) []byte {
+	code := assets.MustAssetString(changeMaximumWeightFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateChangeMaximumDelegationRatiosScript creates a script that changes
+// the highest ratio of delegated tokens to the tokens of the node operator for each node type
+func GenerateChangeMaximumDelegationRatiosScript(env Environment) []byte {
+	code := assets.MustAssetString(changeDelegationCapFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
 // GenerateChangeMinimumsScript creates a script that changes the staking minimums
 func GenerateChangeMinimumsScript(env Environment) []byte {
 	code := assets.MustAssetString(changeMinimumsFilename)
@@ -115,6 +158,14 @@ func GenerateChangeCutScript(env Environment) []byte {
 	return []byte(replaceAddresses(code, env))
 }
 
+// GenerateSlashNodeScript creates a script that slashes a percentage
+// of the tokens of a node and optionally of its delegators
+func GenerateSlashNodeScript(env Environment) []byte {
+	code := assets.MustAssetString(slashNodeFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
 // GenerateChangePayoutScript creates a script that changes the weekly payout
 func GenerateChangePayoutScript(env Environment) []byte {
 	code := assets.MustAssetString(changePayoutFilename)
@@ -233,6 +284,14 @@ func GenerateGetTotalTokensStakedScript(env Environment) []byte {
 	return []byte(replaceAddresses(code, env))
 }
 
+// GenerateGetSupplyTotalsScript returns the sums of the token buckets for each node type,
+// the total tokens staked recorded for each node type and the FLOW held by the staking account
+func GenerateGetSupplyTotalsScript(env Environment) []byte {
+	code := assets.MustAssetString(supplyTotalsFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
 // GenerateGetRewardRatioScript gets the reward ratio for a node type
 func GenerateGetRewardRatioScript(env Environment) []byte {
 	code := assets.MustAssetString(rewardRatioFilename)
@@ -240,6 +299,20 @@ func GenerateGetRewardRatioScript(env Environment) []byte {
 	return []byte(replaceAddresses(code, env))
 }
 
+// GenerateGetRewardRatiosScript gets the reward ratios of all node types
+func GenerateGetRewardRatiosScript(env Environment) []byte {
+	code := assets.MustAssetString(rewardRatiosFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetMaximumDelegationRatiosScript gets the maximum delegation ratios of the node types
+func GenerateGetMaximumDelegationRatiosScript(env Environment) []byte {
+	code := assets.MustAssetString(delegationRatiosFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
 // GenerateGetWeeklyPayoutScript gets the total weekly reward payout
 func GenerateGetWeeklyPayoutScript(env Environment) []byte {
 	code := assets.MustAssetString(weeklyPayoutFilename)
@@ -271,7 +344,7 @@ func GenerateReturnProposedTableScript(env Environment) []byte {
 }
 
 func GenerateGetNodeInfoScript(env Environment) []byte {
-	code := assets.MustAssetString(getNodeInfoFilename)
+	code := assets.MustAssetString(getNodeInfoScript)
 
 	return []byte(replaceAddresses(code, env))
 }
@@ -322,6 +395,22 @@ func GenerateGetInitialWeightScript(env Environment) []byte {
 	return []byte(replaceAddresses(code, env))
 }
 
+// GenerateGetDelegationCapacityScript creates a script
+// that returns the amount of tokens that can still be delegated to a node
+func GenerateGetDelegationCapacityScript(env Environment) []byte {
+	code := assets.MustAssetString(getDelegationCapacityFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetMaximumInitialWeightScript creates a script
+// that returns the highest initial weight that a node can get
+func GenerateGetMaximumInitialWeightScript(env Environment) []byte {
+	code := assets.MustAssetString(getMaximumInitialWeightFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
 // GenerateGetStakedBalanceScript creates a script
 // that returns the balance of the staked tokens of a node
 func GenerateGetStakedBalanceScript(env Environment) []byte {
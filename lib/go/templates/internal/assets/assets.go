@@ -1,8 +1,29 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
-// ../../../transactions/FlowServiceAccount/get_account_creators.cdc (126B)
+// ../../../transactions/FlowServiceAccount/get_account_creators.cdc (129B)
 // ../../../transactions/FlowServiceAccount/get_account_fee.cdc (124B)
 // ../../../transactions/FlowServiceAccount/get_tx_fee.cdc (120B)
+// ../../../transactions/dkg/admin/end_dkg.cdc (529B)
+// ../../../transactions/dkg/admin/start_dkg.cdc (609B)
+// ../../../transactions/dkg/participant/create_participant.cdc (656B)
+// ../../../transactions/dkg/participant/post_message.cdc (588B)
+// ../../../transactions/dkg/participant/send_final_submission.cdc (682B)
+// ../../../transactions/dkg/scripts/get_consensus_nodes.cdc (180B)
+// ../../../transactions/dkg/scripts/get_dkg_completed.cdc (226B)
+// ../../../transactions/dkg/scripts/get_dkg_enabled.cdc (167B)
+// ../../../transactions/dkg/scripts/get_final_submissions.cdc (179B)
+// ../../../transactions/dkg/scripts/get_submission_threshold.cdc (220B)
+// ../../../transactions/dkg/scripts/get_whiteboard_message_count.cdc (199B)
+// ../../../transactions/dkg/scripts/get_whiteboard_messages.cdc (255B)
+// ../../../transactions/epoch/admin/commit_epoch.cdc (515B)
+// ../../../transactions/epoch/admin/deploy_epoch.cdc (302B)
+// ../../../transactions/epoch/admin/end_epoch.cdc (576B)
+// ../../../transactions/epoch/admin/end_staking_auction.cdc (774B)
+// ../../../transactions/epoch/admin/set_num_views.cdc (556B)
+// ../../../transactions/epoch/scripts/get_epoch_counter.cdc (163B)
+// ../../../transactions/epoch/scripts/get_epoch_metadata.cdc (249B)
+// ../../../transactions/epoch/scripts/get_epoch_phase.cdc (243B)
+// ../../../transactions/flowFees/deploy_flow_fees.cdc (536B)
 // ../../../transactions/flowToken/burn_tokens.cdc (1.084kB)
 // ../../../transactions/flowToken/create_forwarder.cdc (1.815kB)
 // ../../../transactions/flowToken/mint_tokens.cdc (893B)
@@ -11,15 +32,20 @@
 // ../../../transactions/flowToken/setup_account.cdc (1.147kB)
 // ../../../transactions/flowToken/transfer_tokens.cdc (1.301kB)
 // ../../../transactions/idTableStaking/admin/change_cut.cdc (644B)
+// ../../../transactions/idTableStaking/admin/change_maximum_delegation_ratios.cdc (773B)
+// ../../../transactions/idTableStaking/admin/change_maximum_initial_weight.cdc (666B)
 // ../../../transactions/idTableStaking/admin/change_minimums.cdc (797B)
 // ../../../transactions/idTableStaking/admin/change_payout.cdc (604B)
+// ../../../transactions/idTableStaking/admin/change_reward_ratios.cdc (830B)
 // ../../../transactions/idTableStaking/admin/end_epoch.cdc (959B)
 // ../../../transactions/idTableStaking/admin/end_epoch_change_payout.cdc (1.032kB)
 // ../../../transactions/idTableStaking/admin/end_staking.cdc (769B)
 // ../../../transactions/idTableStaking/admin/move_tokens.cdc (559B)
 // ../../../transactions/idTableStaking/admin/pay_rewards.cdc (567B)
 // ../../../transactions/idTableStaking/admin/remove_node.cdc (627B)
+// ../../../transactions/idTableStaking/admin/slash_node.cdc (915B)
 // ../../../transactions/idTableStaking/admin/transfer_minter_deploy.cdc (840B)
+// ../../../transactions/idTableStaking/admin/transfer_minter_deploy_hex.cdc (851B)
 // ../../../transactions/idTableStaking/delegation/del_request_unstaking.cdc (569B)
 // ../../../transactions/idTableStaking/delegation/del_stake_new_tokens.cdc (842B)
 // ../../../transactions/idTableStaking/delegation/del_stake_rewarded.cdc (575B)
@@ -50,10 +76,13 @@
 // ../../../transactions/idTableStaking/node/withdraw_unstaked_tokens.cdc (826B)
 // ../../../transactions/idTableStaking/scripts/get_current_table.cdc (190B)
 // ../../../transactions/idTableStaking/scripts/get_cut_percentage.cdc (199B)
+// ../../../transactions/idTableStaking/scripts/get_maximum_delegation_ratios.cdc (282B)
+// ../../../transactions/idTableStaking/scripts/get_maximum_initial_weight.cdc (209B)
 // ../../../transactions/idTableStaking/scripts/get_node_committed_tokens.cdc (257B)
+// ../../../transactions/idTableStaking/scripts/get_node_delegation_capacity.cdc (318B)
 // ../../../transactions/idTableStaking/scripts/get_node_info.cdc (234B)
 // ../../../transactions/idTableStaking/scripts/get_node_info_from_address.cdc (477B)
-// ../../../transactions/idTableStaking/scripts/get_node_initial_weight.cdc (245B)
+// ../../../transactions/idTableStaking/scripts/get_node_initial_weight.cdc (322B)
 // ../../../transactions/idTableStaking/scripts/get_node_networking_addr.cdc (253B)
 // ../../../transactions/idTableStaking/scripts/get_node_networking_key.cdc (245B)
 // ../../../transactions/idTableStaking/scripts/get_node_rewarded_tokens.cdc (258B)
@@ -67,12 +96,14 @@
 // ../../../transactions/idTableStaking/scripts/get_node_unstaking_request.cdc (269B)
 // ../../../transactions/idTableStaking/scripts/get_node_unstaking_tokens.cdc (260B)
 // ../../../transactions/idTableStaking/scripts/get_proposed_table.cdc (192B)
+// ../../../transactions/idTableStaking/scripts/get_reward_ratios.cdc (218B)
 // ../../../transactions/idTableStaking/scripts/get_stake_requirements.cdc (241B)
+// ../../../transactions/idTableStaking/scripts/get_supply_totals.cdc (3.818kB)
 // ../../../transactions/idTableStaking/scripts/get_table.cdc (184B)
 // ../../../transactions/idTableStaking/scripts/get_total_staked.cdc (457B)
 // ../../../transactions/idTableStaking/scripts/get_total_staked_by_type.cdc (250B)
 // ../../../transactions/idTableStaking/scripts/get_weekly_payout.cdc (196B)
-// ../../../transactions/inspect_field.cdc (122B)
+// ../../../transactions/inspect_field.cdc (120B)
 // ../../../transactions/lockedTokens/admin/admin_create_shared_accounts.cdc (3.522kB)
 // ../../../transactions/lockedTokens/admin/admin_deploy_contract.cdc (345B)
 // ../../../transactions/lockedTokens/admin/admin_deposit_account_creator.cdc (856B)
@@ -85,7 +116,9 @@
 // ../../../transactions/lockedTokens/admin/custody_create_shared_accounts.cdc (3.399kB)
 // ../../../transactions/lockedTokens/admin/custody_setup_account_creator.cdc (643B)
 // ../../../transactions/lockedTokens/admin/deposit_locked_tokens.cdc (1.663kB)
+// ../../../transactions/lockedTokens/admin/deposit_locked_tokens_batch.cdc (1.609kB)
 // ../../../transactions/lockedTokens/admin/unlock_tokens.cdc (576B)
+// ../../../transactions/lockedTokens/admin/unlock_tokens_batch.cdc (968B)
 // ../../../transactions/lockedTokens/delegator/delegate_new_tokens.cdc (1.182kB)
 // ../../../transactions/lockedTokens/delegator/delegate_rewarded_tokens.cdc (528B)
 // ../../../transactions/lockedTokens/delegator/delegate_unstaked_tokens.cdc (520B)
@@ -114,11 +147,20 @@
 // ../../../transactions/lockedTokens/user/get_total_balance.cdc (3.628kB)
 // ../../../transactions/lockedTokens/user/get_unlock_limit.cdc (433B)
 // ../../../transactions/lockedTokens/user/withdraw_tokens.cdc (713B)
+// ../../../transactions/quorumCertificate/admin/start_voting.cdc (678B)
+// ../../../transactions/quorumCertificate/admin/stop_voting.cdc (539B)
+// ../../../transactions/quorumCertificate/scripts/get_cluster_complete.cdc (250B)
+// ../../../transactions/quorumCertificate/scripts/get_cluster_votes.cdc (240B)
+// ../../../transactions/quorumCertificate/scripts/get_node_has_voted.cdc (211B)
+// ../../../transactions/quorumCertificate/scripts/get_voting_completed.cdc (194B)
+// ../../../transactions/quorumCertificate/scripts/get_voting_in_progress.cdc (181B)
+// ../../../transactions/quorumCertificate/voter/create_voter.cdc (660B)
+// ../../../transactions/quorumCertificate/voter/submit_vote.cdc (616B)
 // ../../../transactions/stakingProxy/add_node_info.cdc (620B)
 // ../../../transactions/stakingProxy/get_node_info.cdc (506B)
 // ../../../transactions/stakingProxy/register_node.cdc (1.123kB)
-// ../../../transactions/stakingProxy/remove_node_info.cdc (307B)
-// ../../../transactions/stakingProxy/remove_staking_proxy.cdc (315B)
+// ../../../transactions/stakingProxy/remove_node_info.cdc (382B)
+// ../../../transactions/stakingProxy/remove_staking_proxy.cdc (386B)
 // ../../../transactions/stakingProxy/request_unstaking.cdc (477B)
 // ../../../transactions/stakingProxy/setup_node_account.cdc (511B)
 // ../../../transactions/stakingProxy/stake_new_tokens.cdc (475B)
@@ -200,7 +242,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _flowserviceaccountGet_account_creatorsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xca\xcc\x2d\xc8\x2f\x2a\x51\x70\xcb\xc9\x2f\x0f\x4e\x2d\x2a\xcb\x4c\x4e\x75\x4c\x4e\xce\x2f\xcd\x2b\x51\x48\x2b\xca\xcf\x55\x30\xa8\x08\x76\x0d\x0a\xf3\x74\x76\x75\x74\x71\x09\x72\x0d\x0e\xe6\xe2\x2a\x28\x4d\x52\x48\x2b\xcd\x53\xc8\x4d\xcc\xcc\xd3\xd0\xb4\x52\x08\x75\xcb\xac\x30\x33\x51\xa8\xe6\x52\x50\x50\x50\x28\x4a\x2d\x29\x2d\xca\xc3\x62\x9a\x5e\x22\x84\x76\x2e\x4a\x4d\x2c\xc9\x2f\x2a\xd6\xcb\x4e\xad\x2c\xe6\xaa\x05\x04\x00\x00\xff\xff\x0e\xa1\x1a\x36\x7e\x00\x00\x00"
+var _flowserviceaccountGet_account_creatorsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xca\xcc\x2d\xc8\x2f\x2a\x51\x70\xcb\xc9\x2f\x0f\x4e\x2d\x2a\xcb\x4c\x4e\x75\x4c\x4e\xce\x2f\xcd\x2b\x51\x48\x2b\xca\xcf\x55\x30\xa8\x08\x76\x0d\x0a\xf3\x74\x76\x75\x74\x71\x09\x72\x0d\x0e\xe6\xe2\x2a\x28\x4d\x52\x48\x2b\xcd\x53\xc8\x4d\xcc\xcc\xd3\xd0\xb4\x52\x88\x76\x4c\x49\x29\x4a\x2d\x2e\x8e\x55\xa8\xe6\x52\x50\x50\x50\x28\x4a\x2d\x29\x2d\xca\xc3\x62\xa0\x5e\x22\xc4\x60\xe7\xa2\xd4\xc4\x92\xfc\xa2\x62\xbd\xec\xd4\xca\x62\xae\x5a\xc0\x00\x50\x56\x3e\x78\x81\x00\x00\x00"
 
 func flowserviceaccountGet_account_creatorsCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -216,7 +258,7 @@ func flowserviceaccountGet_account_creatorsCdc() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "FlowServiceAccount/get_account_creators.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2d, 0xf3, 0x15, 0xf9, 0x2f, 0x15, 0x94, 0x33, 0x41, 0x80, 0xdd, 0xec, 0x88, 0x84, 0x29, 0x7c, 0xc2, 0x28, 0x83, 0x16, 0x53, 0x56, 0x7e, 0xc4, 0xf5, 0x3f, 0x9c, 0xc0, 0x3, 0x12, 0x5f, 0x45}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa1, 0x26, 0xae, 0x22, 0x6b, 0xe1, 0x99, 0x75, 0x31, 0xd0, 0xee, 0x25, 0xf3, 0x6d, 0x18, 0x6e, 0xff, 0x6b, 0xe9, 0x3, 0x43, 0x79, 0x7a, 0x75, 0x13, 0x85, 0x5c, 0x78, 0x9b, 0xcd, 0x3d, 0x8d}}
 	return a, nil
 }
 
@@ -260,6 +302,426 @@ func flowserviceaccountGet_tx_feeCdc() (*asset, error) {
 	return a, nil
 }
 
+var _dkgAdminEnd_dkgCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\xc1\xce\xda\x40\x0c\x84\xef\xfb\x14\xa3\xff\x50\x85\x4b\xd2\x33\x6a\xfb\x2b\x6a\x5a\x0e\xf4\x50\x41\x5f\xc0\xd9\x38\x64\xab\x64\x1d\xed\x3a\x80\x84\x78\xf7\x6a\x13\x40\xe5\x17\x92\x4f\x96\x67\xbe\xb1\xed\x86\x51\x82\xe2\x67\x2f\xa7\x6a\xbb\x41\x1b\x64\xc0\xe7\x73\xb5\xdd\x94\x55\xb5\xfb\xb1\xdf\x1b\x53\x14\xf8\xd3\xb9\x08\x0d\xe4\x23\x59\x75\xe2\xc1\xbe\x89\xd0\x8e\x91\x34\xe2\x2d\x83\xbd\x4c\x87\x0e\x23\x05\x75\xd6\x8d\xe4\x35\x26\x65\x47\x47\x46\x9c\xea\xc1\xa9\x72\x33\x4b\x22\x0d\x8c\xc0\x71\xea\xd5\x98\xff\x4d\x2f\xc6\x00\x40\x51\xe0\x97\x58\xea\x71\xa4\xe0\xa8\xee\x19\xad\x04\x10\x02\xb7\x1c\x38\xb1\x54\x1e\xec\xb2\x19\x9c\x87\xd4\x7f\xd9\xea\xac\xee\x59\x41\xa9\xb9\xe3\x76\x8d\x4f\xb7\xbd\xf2\x79\x6e\xf1\x1f\x03\x8f\x14\x38\x23\x6b\x75\x8d\x72\xd2\xae\xb4\x56\x26\xaf\x2b\x5c\xe6\x81\x5b\x88\x5a\x42\x90\xd3\x2b\x30\x7d\x84\xa6\x8a\xdc\xb7\xf9\x9d\x8c\xaf\x48\xf6\xf9\xe2\xf1\xe5\x39\xc6\xb7\x2c\x5d\x79\x7d\xbf\xf9\xd2\xdc\xab\x04\x3a\xf0\x6f\xd2\x6e\xf5\xf0\x4c\xf5\xfe\x8e\x91\xbc\xb3\xd9\xdb\x77\x99\xfa\x06\x5e\xf4\x1e\xed\x29\x58\xfa\xc4\x8c\x7f\x5b\xf4\xd7\x65\x5b\x3e\xb3\x9d\x94\x71\x79\x1d\x34\x67\xdf\x54\xdb\x4d\xb6\x32\x00\x70\x35\x57\xf3\x6f\x00\x3f\x81\x21\x94\x11\x02\x00\x00"
+
+func dkgAdminEnd_dkgCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_dkgAdminEnd_dkgCdc,
+		"dkg/admin/end_dkg.cdc",
+	)
+}
+
+func dkgAdminEnd_dkgCdc() (*asset, error) {
+	bytes, err := dkgAdminEnd_dkgCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "dkg/admin/end_dkg.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc9, 0x5d, 0x59, 0xb2, 0x48, 0x96, 0xcb, 0x1a, 0x24, 0x89, 0xfc, 0x42, 0xa8, 0x4c, 0x2c, 0x59, 0xf8, 0xd1, 0x74, 0x3d, 0xcf, 0xc3, 0x46, 0x93, 0xe0, 0x84, 0xe, 0x83, 0x3e, 0x9b, 0x25, 0x35}}
+	return a, nil
+}
+
+var _dkgAdminStart_dkgCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x41\x8b\xdb\x30\x10\x85\xef\xfa\x15\x8f\x3d\x94\xe4\x12\xf7\x1c\xda\x2e\xa1\x6e\x43\x49\x0f\x65\xd3\x5b\xe9\x61\x22\x8f\x63\x15\x47\x63\x46\xe3\x38\xb0\xe4\xbf\x17\xd9\xf1\xb6\xbb\xc4\xcc\x61\x18\x3c\xdf\x7b\xd2\x53\x38\x75\xa2\x86\xaf\xad\x0c\xe5\x6e\x8b\x5a\xe5\x84\xf7\x97\x72\xb7\xdd\x94\xe5\xd3\x97\xfd\xde\xb9\xa2\xc0\xcf\x26\x24\x98\x52\x4c\xe4\x2d\x48\x44\x32\x52\x4b\xb0\x86\x91\xb7\xa4\x1e\xdb\xc8\x17\x03\x77\xe2\x1b\x0c\xc1\x9a\x71\x76\x0c\x67\x8e\x99\xe1\x25\x26\x8e\xa9\x4f\x88\x52\x71\x02\xc5\x0a\x55\x48\x9e\xb4\x9a\x40\x43\x13\x8c\x0f\x42\x5a\xcd\xbc\x4e\xf9\x1c\xa4\x4f\x59\xc3\xb9\xff\xf4\x17\x19\xf1\xad\x4c\x6b\xfc\xda\x9b\x86\x78\xfc\xbd\xc4\xb3\x73\x00\x50\x14\xf8\x2e\x9e\x5a\x9c\x49\x03\x1d\x5a\x46\x2d\x0a\x82\x72\xcd\xca\xd1\x33\x4c\x5e\x7c\x6f\xaa\x53\x88\x90\xc3\x1f\xf6\x36\x6e\xb7\x6c\xa0\x3c\x7c\xe2\x7a\x8d\x77\xb7\x5b\x59\x8d\xff\x4d\xfc\x4e\xb9\x23\xe5\x05\x79\x6f\x6b\x6c\x7a\x6b\x36\xde\x4b\x1f\x2d\x3b\xc0\xed\x2b\x0a\x1c\x44\x55\x86\x7b\xc2\xf4\x56\x34\x57\xe2\xb6\x5e\xcd\xca\xf8\x88\x8c\x5f\x4d\x8c\x0f\xaf\x6d\x7c\x5a\xe4\x8c\xd6\x73\x62\xd3\x70\x6f\xa2\x74\xe4\x1f\x64\xcd\xf2\x85\x99\xeb\xf1\x11\x1d\xc5\xe0\x17\x0f\x9f\xa5\x6f\x2b\x44\xb1\xd9\xda\x2b\x63\x39\xc5\x51\xfe\x61\xda\xbf\x4e\xa7\xe5\x0b\xfb\xde\x18\xcf\xf7\x8d\xae\xc6\x77\x50\xee\xb6\xff\x02\xb9\x35\x4b\x07\x00\x57\x77\x75\x7f\x07\x00\xd5\xe9\xe2\xc3\x61\x02\x00\x00"
+
+func dkgAdminStart_dkgCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_dkgAdminStart_dkgCdc,
+		"dkg/admin/start_dkg.cdc",
+	)
+}
+
+func dkgAdminStart_dkgCdc() (*asset, error) {
+	bytes, err := dkgAdminStart_dkgCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "dkg/admin/start_dkg.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xda, 0xc2, 0x41, 0xe3, 0x5a, 0x56, 0x68, 0x20, 0x32, 0xe5, 0x35, 0x78, 0x27, 0xbd, 0xfb, 0xca, 0xc9, 0xdb, 0xcf, 0x2c, 0x33, 0xaf, 0x53, 0x5f, 0x17, 0x58, 0xed, 0xf5, 0x29, 0x10, 0x6b, 0x8d}}
+	return a, nil
+}
+
+var _dkgParticipantCreate_participantCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\x41\x6b\xdb\x40\x10\x85\xef\xfb\x2b\x1e\x39\x14\x19\x1a\xbb\x67\xe3\x36\xb8\x95\x1b\x4c\x4a\x08\xb1\x2e\x3d\x8e\x57\xa3\x68\x1b\x79\x47\xec\x8e\xea\x42\xc8\x7f\x2f\x92\x2a\x65\x83\xa1\xb0\x07\x21\xde\x7b\xf3\xcd\x3c\x77\x6a\x25\x28\xbe\x37\x72\xde\xe7\x05\x1d\x1b\x3e\x28\x3d\x3b\xff\x84\x2a\xc8\x09\x9f\xfe\xec\xf3\xdd\x7d\xb1\x2f\x7e\x16\xdb\xaf\x3f\x76\xdb\x3c\x7f\xdc\x1d\x0e\x26\x71\xe5\x77\xb7\x93\x34\xbf\xbb\x9d\x04\x66\xb5\x42\x51\xbb\x08\x0d\xe4\x23\x59\x75\xe2\x61\x03\x93\x72\x84\xd6\x8c\xde\xf6\x40\x41\x9d\x75\x2d\x79\x85\x54\x20\x44\xa5\x67\x2e\x61\xc5\x47\xf6\xb1\x8b\xf0\x52\x72\x1f\x75\x76\x5a\x0f\xb6\xfe\xc7\x28\x0b\x90\xe3\x2f\xb6\x8a\xa8\x12\xb8\x84\xf3\x83\x20\xd2\x89\x41\xd6\x4a\xe7\xd5\x98\x74\xfa\x8b\x31\x00\xd0\x06\x6e\x29\x70\x46\xd6\xea\x1a\xdb\x4e\xeb\xed\xa8\x5e\xe0\x65\x10\xf4\x6f\xb5\xc2\x51\x42\x90\x33\x08\x81\x2b\x0e\xec\x2d\x43\xe5\x8d\x61\x1c\x3e\x1b\x1a\xd6\x7f\x58\x8f\x5c\xe1\x73\x4f\xa0\xcb\x31\x62\xf3\xe1\xf2\xb8\xcb\x7b\x29\x87\x6f\x0e\x5f\xb2\xfe\x7a\x6b\xfc\x57\x74\x50\x09\xf4\xc4\x0f\xa4\xf5\x62\x9e\xd9\xbf\x9b\x1b\xb4\xe4\x9d\xcd\xae\xbe\x49\xd7\x94\xf0\xa2\x13\xf9\x3b\xee\xe4\x6e\x57\x0b\xf3\x0e\xbb\x4d\x5a\xd8\x5c\x4f\x9d\x2e\xc7\xb2\x92\x8a\x32\x3f\xe3\xac\xdf\x76\x4d\xc2\x86\x9d\x23\xfd\xe6\x6c\x73\x9d\x84\x7e\x84\xca\x7a\x8e\x4d\x02\x2f\x96\x7a\x35\xaf\xe6\xef\x00\xfb\x2c\x9f\xb5\x90\x02\x00\x00"
+
+func dkgParticipantCreate_participantCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_dkgParticipantCreate_participantCdc,
+		"dkg/participant/create_participant.cdc",
+	)
+}
+
+func dkgParticipantCreate_participantCdc() (*asset, error) {
+	bytes, err := dkgParticipantCreate_participantCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "dkg/participant/create_participant.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf4, 0xbd, 0x93, 0xda, 0x7d, 0x6d, 0x3d, 0x68, 0x34, 0x3, 0x1e, 0xe1, 0x1b, 0x18, 0xf8, 0x51, 0x8b, 0x2f, 0xd, 0xb0, 0x1a, 0xb8, 0x57, 0xec, 0xf7, 0x41, 0x3d, 0x8b, 0xf5, 0x5e, 0x83, 0x14}}
+	return a, nil
+}
+
+var _dkgParticipantPost_messageCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\xc1\x8a\xdb\x40\x0c\x86\xef\xf3\x14\x3f\x7b\x28\xc9\xc5\xee\xd9\xb4\x5d\x42\xdd\xee\x61\x5b\x58\xd6\x7d\x01\x79\x22\xc7\x53\x9c\xd1\xa0\x91\x9b\x85\x25\xef\x5e\xa6\xde\xb4\x09\x98\x0e\xba\x09\xfd\xfa\x34\x5f\x38\x26\x51\xc3\xd7\x49\x4e\xed\xe3\x03\x06\x95\x23\xde\xbf\xb4\x8f\x0f\xbb\xb6\x7d\xfe\xd2\x75\xce\xd5\x35\x7e\x8c\x21\xc3\x94\x62\x26\x6f\x41\x22\x92\x64\xcb\x20\xf4\x2a\xb4\xf7\x94\x0d\x47\xce\x99\x0e\x0c\x13\xd8\xc8\x28\x59\xa7\x31\x18\xf7\x42\xba\x77\xee\x6a\x78\xe3\x25\x1a\x47\x6b\xd0\x99\x86\x78\xd8\xe2\xd5\x39\x00\xa8\x6b\x7c\x13\x4f\x13\x7e\x91\x06\xea\x27\xc6\x20\x0a\x82\xf2\xc0\xca\xd1\xdf\x84\x3f\x91\x5a\xf0\x21\x51\x34\x48\xff\x93\xbd\xfd\xc9\x98\xd8\x90\xfe\xb5\x9e\x79\x68\xf0\xee\xed\xb8\xea\x6a\x66\xd9\x98\x94\x13\x29\x6f\xc8\x7b\x6b\xb0\x9b\x6d\xdc\x79\x2f\x73\xb4\xc2\x84\xb7\x57\xd7\xe8\x45\x55\x4e\x6b\x28\x69\x1d\xa3\x54\xe6\x69\xa8\x6e\x59\xf0\x11\x65\x55\xb5\xe4\x7d\x58\x03\xfb\xb4\x29\x0a\x1a\xac\xb4\x3a\x13\xa5\x03\x3f\x91\x8d\xdb\xbf\x5b\x4a\xdd\xdf\x23\x51\x0c\x7e\x73\xf7\x59\xe6\x69\x8f\x28\x76\x41\xbe\x01\x2e\x52\xae\x80\xee\x96\x94\xf3\xf2\x17\xfc\xc2\x7e\x36\xc6\xeb\xff\x0e\xa8\x8a\xf8\xef\x8b\xea\x8b\xc7\xad\x03\x80\xb3\x3b\xbb\xdf\x03\x00\x23\xf4\x5c\x8b\x4c\x02\x00\x00"
+
+func dkgParticipantPost_messageCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_dkgParticipantPost_messageCdc,
+		"dkg/participant/post_message.cdc",
+	)
+}
+
+func dkgParticipantPost_messageCdc() (*asset, error) {
+	bytes, err := dkgParticipantPost_messageCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "dkg/participant/post_message.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbe, 0xb3, 0x72, 0x2f, 0x11, 0x19, 0x53, 0xfe, 0x6b, 0x55, 0xdb, 0xdb, 0xa3, 0x72, 0x28, 0x7f, 0x17, 0x6c, 0x37, 0x17, 0x27, 0x8c, 0x1b, 0x6, 0xdc, 0x73, 0x50, 0x1f, 0xd6, 0xcb, 0x2e, 0xa6}}
+	return a, nil
+}
+
+var _dkgParticipantSend_final_submissionCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\x41\x6b\x1b\x31\x10\x85\xef\xfa\x15\x8f\x1c\x8a\x03\xc5\xdb\xb3\x69\x1b\x4c\xdd\xe4\x90\x1e\x42\xb6\xb7\xd2\x83\x56\x3b\xeb\x55\x2b\x6b\xc4\x68\x14\xc7\x04\xff\xf7\xa2\x75\xea\x6e\xc0\x74\x99\xcb\xec\x30\xef\x7d\x9a\xe7\x77\x89\x45\x71\x1b\x78\xbf\xb9\xbf\xc3\x20\xbc\xc3\x87\xe7\xcd\xfd\xdd\x7a\xb3\x79\xfc\xda\xb6\xc6\x34\x0d\xbe\x8f\x3e\x43\xc5\xc6\x6c\x9d\x7a\x8e\xc8\xa5\xdb\x79\xcd\xd0\x91\x20\x94\x4b\x50\xf0\x30\x75\x55\x44\x47\xab\x53\x13\xb9\x27\x38\xde\xa5\xa2\xd4\xbf\xaf\x4a\xf5\xef\x56\xb8\x24\xa4\xd2\x05\xef\xf0\x9b\x0e\x18\x38\x04\xde\x53\x8f\xee\x30\xad\xcd\x46\x3c\x80\x9e\x48\x0e\x48\x56\xd4\x3b\x9f\x6c\x54\x63\x66\x28\x8b\x09\x25\x67\xcf\x71\x85\x1f\xad\x8a\x8f\xdb\x9f\xd7\x78\x31\x06\x00\x9a\x06\xdf\xd8\xd9\x80\x27\x2b\xde\x76\x81\x30\xb0\xc0\x42\x68\x20\xa1\xe8\x08\xca\x67\xec\x87\x7f\x16\xe0\xee\x17\x39\x9d\x34\x02\xe9\xdc\xfd\x91\x86\x15\xde\xbd\xde\x6b\x39\xdb\x39\x39\x26\xa1\x64\x85\x16\xd6\x39\x5d\x61\x5d\x74\x5c\x3b\xc7\x25\x6a\x65\xc2\xeb\xd7\x34\xe8\x58\x84\xf7\x97\x50\xd2\x65\x8c\x5a\x99\xc2\xb0\x7c\xcb\x82\x4f\xa8\x56\xcb\x93\xde\xc7\x4b\x60\x9f\x17\x35\xd5\x15\x2e\x8c\x5a\x65\xb1\x5b\x7a\xb0\x3a\x5e\x9f\x5d\x6a\xdd\xdc\x20\xd9\xe8\xdd\xe2\xea\x0b\x97\xd0\x23\xb2\xfe\x45\x7e\x03\x5c\xe3\x9e\x01\x5d\x9d\x54\x8e\xa7\x5b\xd0\x33\xb9\xa2\x84\x97\xff\x3d\x60\x99\x29\xf6\xb7\x3e\xda\xd0\x9e\x93\x9c\x85\x7a\x6d\x00\xe0\x68\x8e\xe6\xcf\x00\x73\xe4\xf1\x16\xaa\x02\x00\x00"
+
+func dkgParticipantSend_final_submissionCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_dkgParticipantSend_final_submissionCdc,
+		"dkg/participant/send_final_submission.cdc",
+	)
+}
+
+func dkgParticipantSend_final_submissionCdc() (*asset, error) {
+	bytes, err := dkgParticipantSend_final_submissionCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "dkg/participant/send_final_submission.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa7, 0x58, 0xdf, 0xb8, 0xed, 0x17, 0xdf, 0xc7, 0xae, 0xcb, 0xb9, 0xdd, 0xb4, 0xa8, 0xed, 0x71, 0xb6, 0x43, 0x43, 0xf0, 0xf2, 0xea, 0x71, 0x97, 0xf1, 0xbf, 0x1d, 0x3b, 0x78, 0xc8, 0xc3, 0x19}}
+	return a, nil
+}
+
+var _dkgScriptsGet_consensus_nodesCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8e\x31\x8b\xc2\x40\x10\x46\xfb\xfd\x15\x5f\x99\x34\x97\xab\xaf\x3b\x6e\xcf\x10\x02\x16\xc6\x4e\x2c\x62\xb2\x49\x16\xcc\xcc\x32\x33\x8b\x82\xf8\xdf\x05\x51\xcb\xf7\x8a\xc7\x8b\x6b\x62\x31\x6c\xce\x7c\xf1\x6d\x8d\x49\x78\xc5\xf7\xd5\xb7\xf5\xaf\xf7\xbb\xff\xae\x73\xae\xaa\xb0\x5f\xa2\x42\x07\x89\xc9\x20\xc1\xb2\x90\xc2\x96\x00\xe2\x31\xa0\xf1\x0a\x9e\x9e\x9c\x7a\xb1\x38\xc4\xd4\x93\x7d\xdc\x90\x45\x02\x19\x7c\x5b\x3b\x97\xf2\x09\x53\x26\xac\x7d\xa4\xa2\xfc\xc1\xa1\x33\x89\x34\x1f\x71\x73\x00\x5e\xed\xf7\xcc\xd7\x1c\xec\x8f\x49\x03\x69\xd6\x2d\x8f\xa1\xf1\x5a\x94\xee\xee\x1e\x03\x00\x11\xd7\x4b\x3e\xb4\x00\x00\x00"
+
+func dkgScriptsGet_consensus_nodesCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_dkgScriptsGet_consensus_nodesCdc,
+		"dkg/scripts/get_consensus_nodes.cdc",
+	)
+}
+
+func dkgScriptsGet_consensus_nodesCdc() (*asset, error) {
+	bytes, err := dkgScriptsGet_consensus_nodesCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "dkg/scripts/get_consensus_nodes.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x46, 0xb7, 0xd7, 0x7b, 0x8f, 0xb2, 0xdc, 0x0, 0x80, 0x1b, 0x61, 0x3b, 0x16, 0xa1, 0xe1, 0xe5, 0x3b, 0xf8, 0xd8, 0xa8, 0xfa, 0x3f, 0x1d, 0xf5, 0x1b, 0x52, 0xea, 0x76, 0xd, 0xa6, 0x99, 0x91}}
+	return a, nil
+}
+
+var _dkgScriptsGet_dkg_completedCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\x8f\xb1\x4e\x03\x31\x10\x44\x7b\x7f\xc5\x94\x89\x84\x08\x35\x0d\x42\x1c\xa4\x48\xc7\xd1\x21\x0a\xe7\x6e\x2f\x5e\x61\xef\x5a\xeb\x35\x20\x21\xfe\x1d\x1d\x24\xdd\x14\x6f\x46\x6f\xb8\x54\x35\xc7\x53\xd6\xcf\xe1\xb0\xc7\x62\x5a\x70\xf3\x35\x1c\xf6\xf7\xc3\xf0\xfc\x38\x8e\x21\xec\x76\x78\x49\xdc\xd0\x26\xe3\xea\x30\xf2\x6e\xd2\xe0\x89\x60\xd4\x7a\x76\x78\x8a\x8e\xa2\x46\x6b\x12\xa4\x98\x17\xe8\xf2\x47\xd4\x68\xce\x13\xd7\x28\xde\xd6\xa5\x14\x3f\x08\xad\x1f\x0b\xbb\xd3\x7c\x05\x35\x08\x67\xf0\x3f\xbd\x1a\x70\x83\xa8\x63\xd2\x52\x33\x39\x85\x50\xfb\x11\x4b\x17\x94\xc8\xb2\xd9\xde\xe2\x75\x74\x63\x39\xbd\xdd\xe1\x3b\x00\x38\x0b\x5d\x1e\x5c\xcf\xef\xa7\x87\x73\x77\xde\x6c\xc3\x4f\xf8\x1d\x00\xf9\x61\x3a\x7e\xe2\x00\x00\x00"
+
+func dkgScriptsGet_dkg_completedCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_dkgScriptsGet_dkg_completedCdc,
+		"dkg/scripts/get_dkg_completed.cdc",
+	)
+}
+
+func dkgScriptsGet_dkg_completedCdc() (*asset, error) {
+	bytes, err := dkgScriptsGet_dkg_completedCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "dkg/scripts/get_dkg_completed.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe5, 0x70, 0x1d, 0x6a, 0x28, 0xa3, 0x15, 0xaf, 0xf6, 0x5a, 0x1d, 0x4e, 0xb5, 0xec, 0x20, 0xbe, 0xfd, 0x5d, 0xf9, 0x59, 0x35, 0xd9, 0x10, 0xcc, 0x6, 0xaf, 0xd4, 0x15, 0xbd, 0xb8, 0x1a, 0xa3}}
+	return a, nil
+}
+
+var _dkgScriptsGet_dkg_enabledCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\xce\x31\x8e\xc2\x30\x10\x46\xe1\x7e\x4e\xf1\x97\xbb\xcd\x66\xeb\xed\x16\x39\xa4\x48\x47\xb8\x80\x93\x4c\x92\x11\xf1\xd8\xf2\xd8\x02\x09\x71\x77\x84\x04\x07\x78\x4f\x9f\x84\x14\x73\xc1\x71\x8f\x57\xd7\x77\x58\x72\x0c\xf8\xbd\xb9\xbe\xfb\x77\xee\xd4\x0e\x03\x51\xd3\xe0\xbc\x89\xc1\xa6\x2c\xa9\x20\x73\xa9\x59\x0d\x25\x57\x86\x2c\x28\x1b\xe3\x55\x8a\xc1\x4f\x13\xa7\x22\xba\x22\xb0\x99\x5f\xd9\xe0\x75\x86\xd5\x31\x88\x99\x44\x35\xa2\x54\x47\x2c\x55\x11\xbc\xe8\xd7\xf7\x1f\x0e\x31\xee\xb8\x13\x80\xf7\xf9\x43\xf9\x99\x2f\x6b\xab\x7e\xdc\x79\xa6\x07\x3d\x07\x00\x52\xe9\xf0\x24\xa7\x00\x00\x00"
+
+func dkgScriptsGet_dkg_enabledCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_dkgScriptsGet_dkg_enabledCdc,
+		"dkg/scripts/get_dkg_enabled.cdc",
+	)
+}
+
+func dkgScriptsGet_dkg_enabledCdc() (*asset, error) {
+	bytes, err := dkgScriptsGet_dkg_enabledCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "dkg/scripts/get_dkg_enabled.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb0, 0xe3, 0x5, 0x42, 0x27, 0xfe, 0xb2, 0x6d, 0xbd, 0xf4, 0xb5, 0xf5, 0x25, 0x57, 0xb0, 0x9f, 0x83, 0xcb, 0xc9, 0x83, 0xe3, 0x78, 0x9d, 0xa7, 0xa, 0xe4, 0x80, 0xc8, 0x37, 0x5b, 0x4b, 0x2e}}
+	return a, nil
+}
+
+var _dkgScriptsGet_final_submissionsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\xce\x3f\xcb\x83\x30\x18\x04\xf0\x3d\x9f\xe2\x46\x5d\x5e\xdf\xd9\xad\x90\xea\xe0\xd6\x74\x2b\x1d\x54\x12\x7d\xc0\x24\xf2\xe4\x09\x2d\x88\xdf\xbd\xf4\x1f\xdd\x0e\x0e\x7e\x77\xe4\xd7\xc8\x82\x66\x89\x37\xdd\xb5\x70\x1c\x3d\xfe\xef\xba\x6b\x0f\x5a\x9f\x8e\xc6\x28\x55\x55\x38\xcf\x94\x90\x46\xa6\x55\xc0\x56\x32\x87\x04\x99\x2d\x1c\x85\x7e\x41\xca\x83\xa7\x94\x28\x86\x84\xe8\x5e\xc5\x98\x99\x6d\x10\xe8\xae\x55\x6a\xcd\x03\x5c\x0e\xf0\x3d\x85\xa2\xac\xb1\x19\x61\x0a\x53\x8d\xcb\x3b\x5c\x77\x6c\x0a\xc0\x87\xfe\x7e\xf9\x9b\xac\x34\xcf\x01\xf3\xf3\x8b\x52\xed\xea\x31\x00\x6e\xbf\xe5\x57\xb3\x00\x00\x00"
+
+func dkgScriptsGet_final_submissionsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_dkgScriptsGet_final_submissionsCdc,
+		"dkg/scripts/get_final_submissions.cdc",
+	)
+}
+
+func dkgScriptsGet_final_submissionsCdc() (*asset, error) {
+	bytes, err := dkgScriptsGet_final_submissionsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "dkg/scripts/get_final_submissions.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x62, 0x56, 0x4f, 0xb7, 0xdc, 0x5a, 0x15, 0x4d, 0x52, 0x7e, 0xde, 0x44, 0x79, 0xe, 0xf0, 0x88, 0x22, 0xf6, 0xed, 0xd8, 0x80, 0x21, 0x31, 0x8e, 0xe6, 0xb0, 0x92, 0xbc, 0x96, 0x89, 0xdc, 0xa2}}
+	return a, nil
+}
+
+var _dkgScriptsGet_submission_thresholdCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\x8e\xb1\x4e\x03\x31\x10\x05\x7b\x7f\xc5\x2b\x93\x86\x50\xd3\x21\x1d\x44\x28\x1d\x97\x1f\xf0\xdd\xad\xf1\x4a\xb6\xd7\xda\x5d\x8b\x48\x88\x7f\x47\x39\x01\xfd\x68\x66\xb8\x76\x51\xc7\x6b\x91\xcf\xe9\x72\x46\x52\xa9\x78\xbc\x4d\x97\xf3\xf3\x34\xbd\xbf\xcc\x73\x08\xa7\x13\xae\x99\x0d\xb6\x2a\x77\x87\x92\x0f\x6d\x06\xcf\x84\x36\xea\x42\x0a\x49\xe0\x8d\x9a\xf3\x1a\x0b\x6c\x2c\x95\xcd\x58\x76\x26\x3a\x22\x94\x6c\x14\xbf\x8b\x72\x34\xb8\x80\x6e\x2b\xd1\x86\x24\xba\x6b\xee\x61\x17\x2c\x84\x55\x6a\x2f\xe4\x14\x42\x1f\x0b\xd2\x68\xa8\x91\xdb\xe1\xf8\x84\xb7\xe6\xf8\x0a\x00\x7e\x07\xfe\x8e\x1f\x3e\xc8\xe7\xff\xe4\x35\x2b\x59\x96\xb2\x1d\x8e\xe1\x3b\xfc\x0c\x00\x2c\xa3\x3f\xe9\xdc\x00\x00\x00"
+
+func dkgScriptsGet_submission_thresholdCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_dkgScriptsGet_submission_thresholdCdc,
+		"dkg/scripts/get_submission_threshold.cdc",
+	)
+}
+
+func dkgScriptsGet_submission_thresholdCdc() (*asset, error) {
+	bytes, err := dkgScriptsGet_submission_thresholdCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "dkg/scripts/get_submission_threshold.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8c, 0x61, 0xaa, 0x81, 0xd2, 0x3e, 0x1d, 0xc4, 0xfc, 0xf, 0x93, 0x9b, 0x19, 0x28, 0xda, 0x11, 0x7, 0xdb, 0x5, 0xd4, 0xe0, 0x21, 0xce, 0xed, 0xb9, 0xbe, 0x6f, 0x99, 0x4b, 0xfe, 0x2, 0x8e}}
+	return a, nil
+}
+
+var _dkgScriptsGet_whiteboard_message_countCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\xce\x3d\x6b\xc3\x30\x10\xc6\xf1\x5d\x9f\xe2\x19\xed\xa5\xee\xdc\xad\x54\xad\x29\xa6\x4b\x5d\xe8\x2c\xc5\x67\x4b\x10\xe9\x84\x74\x8a\x03\x21\xdf\x3d\x38\x6f\xeb\xc1\xff\x7e\x8f\x0f\x89\xb3\xe0\x6b\xcf\xab\x1e\x7a\xcc\x99\x03\x5e\x8f\x7a\xe8\xdf\xb5\xfe\xfd\x1c\x47\xa5\xba\x0e\x7f\xce\x17\x94\x5d\xf6\x49\x90\x49\x6a\x8e\x05\xe2\x08\xb1\x06\x4b\x19\x3c\x23\x50\x29\x66\xa1\xed\x6c\x04\xce\x1c\x08\x96\x28\x22\x71\x11\x9a\xb6\x1f\xc2\xd7\x64\x43\x56\xe7\x85\x2c\x9b\x3c\x29\x95\xaa\xc5\x5c\x23\x82\xf1\xb1\x69\xdf\xf0\x1d\x05\x27\x05\xe0\x0e\x3d\x96\xbd\x2c\x24\xff\xcf\xee\xe7\xc6\x7d\x70\x8d\xd2\xb4\xea\xac\x2e\x03\x00\xc3\x22\x38\x8f\xc7\x00\x00\x00"
+
+func dkgScriptsGet_whiteboard_message_countCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_dkgScriptsGet_whiteboard_message_countCdc,
+		"dkg/scripts/get_whiteboard_message_count.cdc",
+	)
+}
+
+func dkgScriptsGet_whiteboard_message_countCdc() (*asset, error) {
+	bytes, err := dkgScriptsGet_whiteboard_message_countCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "dkg/scripts/get_whiteboard_message_count.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xba, 0x93, 0xf2, 0xba, 0x61, 0x48, 0xfb, 0x8, 0x98, 0xba, 0x19, 0xc3, 0xab, 0x9d, 0xde, 0xe2, 0x4a, 0xf8, 0xee, 0x29, 0x95, 0x63, 0xfb, 0xf0, 0x7, 0x8b, 0xe6, 0xd7, 0x15, 0x73, 0x8, 0xf2}}
+	return a, nil
+}
+
+var _dkgScriptsGet_whiteboard_messagesCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\x8f\x41\x4b\x03\x41\x0c\x85\xef\xf3\x2b\xde\xd1\x82\xb4\x9e\x7b\x13\xd6\x96\x52\xbc\x58\xc1\x83\x78\x98\x75\xd3\x9d\x80\x93\x19\x92\x6c\x5b\x10\xff\xbb\x4c\x5d\xf5\x98\xe4\xe5\xbd\xef\x71\xae\x45\x1d\x9b\x8f\x72\xee\xf6\x5b\x1c\xb5\x64\xdc\x5d\xba\xfd\xf6\xbe\xeb\x9e\x1e\x0e\x87\x10\x56\x2b\x3c\x27\x36\xd8\xbb\x72\x75\x28\xf9\xa4\x62\xf0\x44\xc8\x64\x16\x47\x6a\x43\x74\xa4\x78\x22\xf4\x44\x82\x5a\xcc\x69\x68\x9f\x5e\xae\xc2\x66\x7d\x4e\xec\xd4\x97\xa8\xc3\x2d\xcc\xa3\x3a\xcb\x88\xe8\xd7\xfb\xc8\x27\x12\xb0\x0c\x74\x09\xa1\x4e\x3d\x8e\x93\x20\x47\x96\x9b\x06\xb4\x6b\xfb\x35\x76\xe2\x8b\x35\x5e\x67\xd4\xe5\xe3\x4f\xf8\x1b\x3e\x03\x80\x99\xeb\xb7\xc8\x72\x24\x7f\xf9\x0b\x9c\xa5\xb6\xd1\x92\xff\x1d\x17\xe1\x2b\x7c\x0f\x00\x45\x84\x49\xba\xff\x00\x00\x00"
+
+func dkgScriptsGet_whiteboard_messagesCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_dkgScriptsGet_whiteboard_messagesCdc,
+		"dkg/scripts/get_whiteboard_messages.cdc",
+	)
+}
+
+func dkgScriptsGet_whiteboard_messagesCdc() (*asset, error) {
+	bytes, err := dkgScriptsGet_whiteboard_messagesCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "dkg/scripts/get_whiteboard_messages.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf9, 0xf2, 0xaa, 0xaa, 0x36, 0x9, 0x80, 0xc0, 0x5e, 0xcc, 0x8b, 0x1a, 0x92, 0xb4, 0x7a, 0x85, 0x3, 0xc, 0x2, 0x8b, 0xf4, 0xa1, 0x8f, 0x21, 0xac, 0x95, 0x4c, 0x43, 0xa, 0xa2, 0x6, 0xd5}}
+	return a, nil
+}
+
+var _epochAdminCommit_epochCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\xc1\x6e\xe2\x40\x10\x44\xef\xf3\x15\x25\x0e\x2b\x73\xb1\xf7\x8c\x76\x17\x59\xc0\x2a\x87\x48\x41\x90\x1f\x68\x86\x76\x3c\x91\x3d\x6d\x8d\xdb\x80\x84\xf8\xf7\xc8\x33\x81\x24\x08\xa9\x2f\x6e\x75\x55\x3d\xd7\xb8\xb6\x93\xa0\xf8\xdf\xc8\x71\xd5\x89\xad\x51\x05\x69\xf1\xfb\xb4\x5a\xbf\x2c\x9e\xca\xe5\x72\xb3\xda\x6e\x8d\x29\x0a\xbc\xd6\xae\x87\x06\xf2\x3d\x59\x75\xe2\xd1\xca\x81\x7b\x68\xcd\xe0\x28\x54\x89\x1f\x56\xda\xd6\xa9\xf2\x1e\x5d\x4d\x3d\x1b\xf3\x5d\x73\x36\x06\x00\x8a\x02\xcf\x62\xa9\xc1\x81\x82\xa3\x5d\xc3\xa8\x24\x80\x10\xb8\xe2\xc0\xde\xf2\xd5\x2c\x21\x95\xfb\xd6\x79\xc8\xee\x9d\xad\x46\x7d\xc3\x0a\x1a\x97\x1b\xae\x66\xf8\x75\x83\xcf\xe3\x65\xca\xe8\x02\x77\x14\x38\x23\x6b\x75\x86\x72\xd0\xba\xb4\x56\x06\xaf\x53\x9c\xe3\xc1\x27\xc8\x4e\x42\x90\xe3\xa3\x70\xba\x8f\x1d\xa7\xe7\xa6\xca\xaf\xd9\xf8\x8b\xd1\x3e\x4f\x1e\x7f\xee\x41\xfe\x65\x63\x99\xb3\xaf\x72\xd3\x7a\xab\x12\xe8\x8d\xd7\xa4\xf5\xf4\xe6\x3b\xce\x7c\x8e\x8e\xbc\xb3\xd9\x64\x21\x43\xb3\x87\x17\xbd\xe2\xfd\x80\x4b\x7d\x47\x88\x49\x72\xb8\xa4\x7f\xe6\x13\xdb\x41\x19\xe7\xc7\xb8\x79\x7a\x9b\x58\x55\x36\x35\x00\x70\x31\x17\xf3\x31\x00\xaf\xa1\xc6\x79\x03\x02\x00\x00"
+
+func epochAdminCommit_epochCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_epochAdminCommit_epochCdc,
+		"epoch/admin/commit_epoch.cdc",
+	)
+}
+
+func epochAdminCommit_epochCdc() (*asset, error) {
+	bytes, err := epochAdminCommit_epochCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "epoch/admin/commit_epoch.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xdd, 0xaf, 0x62, 0x39, 0x51, 0x44, 0x65, 0xfa, 0x53, 0x5e, 0x8b, 0x72, 0xaa, 0xdb, 0xd3, 0xa8, 0x3b, 0xf7, 0x61, 0xc8, 0xf0, 0x21, 0xc7, 0x5d, 0xd6, 0xa6, 0x90, 0x20, 0x82, 0x39, 0xb5, 0xce}}
+	return a, nil
+}
+
+var _epochAdminDeploy_epochCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8f\xb1\x4e\xc3\x40\x0c\x86\xf7\x7b\x8a\x5f\x9d\x52\xa9\x4a\x16\xc4\x90\x2d\x52\x41\x64\x2e\xb0\x1b\x9f\xd5\x9c\x48\xec\xe8\xce\x51\x41\xa8\xef\x8e\xd2\x42\x19\xf0\x60\x0f\xbf\x3f\xf9\x73\xd3\x60\x2f\xf3\x68\x9f\x05\x3e\x08\x1e\x47\x3b\x3d\xcc\xc6\x03\xd8\xd4\x33\xb1\xc3\xed\x92\x10\xb3\x2d\xea\xf0\x81\x1c\xc5\x2d\x4b\x09\x4d\x73\x83\xfa\xfd\x33\xbd\x8d\x72\x70\x7a\x4f\x7a\xfc\xa3\x49\x23\x92\x17\x74\x71\x4a\x8a\x2c\xc5\x96\xcc\x12\x82\x67\xd2\x42\xec\xc9\xb4\x62\x8b\xd2\xe2\xe0\x39\xe9\x71\x07\x5d\xa6\xd7\x24\xa7\xd2\xeb\x45\xa4\xc5\x4b\xaf\x7e\x7f\xb7\xc5\x57\x08\x00\x30\x67\x99\x29\x4b\x45\xcc\xde\xa2\x5b\x7c\xe8\xae\x6a\xeb\x06\x7e\x6a\x0d\xeb\x5f\x89\x52\x53\x8c\x95\xd2\x24\x2d\x36\xb7\x0f\x37\x3b\x5c\x0f\xaf\xbd\x8e\xb2\x8e\x27\xf9\xa8\xb6\xff\x14\xb6\x01\x00\xce\xe1\x1c\xbe\x07\x00\xd0\x7e\xce\xaf\x2e\x01\x00\x00"
+
+func epochAdminDeploy_epochCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_epochAdminDeploy_epochCdc,
+		"epoch/admin/deploy_epoch.cdc",
+	)
+}
+
+func epochAdminDeploy_epochCdc() (*asset, error) {
+	bytes, err := epochAdminDeploy_epochCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "epoch/admin/deploy_epoch.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x66, 0x50, 0x8a, 0x9c, 0x87, 0x46, 0xf, 0x50, 0xc6, 0x3e, 0x66, 0xd1, 0xb7, 0x53, 0xea, 0xc5, 0x12, 0x30, 0x72, 0xf6, 0xda, 0xe7, 0x86, 0xba, 0x8c, 0x2c, 0xa4, 0x77, 0x9d, 0x2d, 0x63, 0xdc}}
+	return a, nil
+}
+
+var _epochAdminEnd_epochCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\x41\x8b\xdb\x40\x0c\x85\xef\xf3\x2b\x1e\x7b\x28\x0e\x14\xbb\xe7\xd0\x76\x31\xbb\x29\x3d\x14\xba\x24\xfd\x03\xf2\x58\xae\xdd\xd8\x23\xa3\x91\xe3\x94\x90\xff\x5e\x6c\x27\x69\x1b\x02\x3a\x69\xa4\xf7\x3d\xcd\x6b\xba\x5e\xd4\xf0\xa5\x95\x71\xd3\x8b\xaf\x51\xa9\x74\xf8\x70\xdc\xbc\x7d\x7f\xf9\x9a\xbf\xbe\x6e\x37\xbb\x9d\x73\x59\x86\x1f\x75\x13\x61\x4a\x21\x92\xb7\x46\x02\x38\x94\x11\x56\x33\xfc\xa0\xca\xc1\xc0\xf3\x3e\x85\x12\xd1\x48\x6d\x79\x0c\x7c\x34\x48\xe0\xf7\x93\xc6\x58\x37\xbe\x46\x4f\xbf\x23\x94\x47\xd2\x32\xce\xe3\x9d\x1c\x38\xc2\x64\xcf\x21\xa2\x60\x1b\x99\x03\x8a\xc1\xef\xd9\xa2\x73\xff\x32\x4f\xce\x01\x40\x96\xe1\x9b\x78\x6a\x71\x20\x6d\xa8\x68\x19\x95\x28\x08\xca\x15\x2b\x07\xcf\x30\x99\xe9\xcb\x49\x79\xd9\x35\x01\x52\xfc\x62\x6f\xf3\x7e\xcb\x06\x9a\x9a\x5b\xae\xd6\x78\x77\x3b\x3e\x9d\x27\x17\x46\xaf\xdc\x93\x72\x42\xde\xdb\x1a\xf9\x60\x75\xee\xbd\x0c\xc1\x56\x38\xcd\x03\x17\x23\x85\xa8\xca\xf8\x08\x4e\xf7\xd8\xa9\x22\xb7\x55\x7a\x65\xe3\x13\x26\xf9\x74\xd1\xf8\x78\x6f\xe4\x73\x32\x85\xb1\xfe\x1b\xce\xd2\xde\x99\x28\xfd\xe4\x37\xb2\x7a\x75\xd3\x9d\xea\xf9\x19\x3d\x85\xc6\x27\x4f\x2f\x32\xb4\x25\x82\xd8\xd5\xde\x7f\xe6\x2e\x41\x4d\x5a\x4f\x8b\xc2\x79\xb9\x99\x8f\xec\x07\x63\x9c\x1e\xdb\x4d\x39\x94\xf3\x3f\x25\x2b\x07\x00\x67\x77\x76\x7f\x06\x00\x24\x66\xdf\xb2\x40\x02\x00\x00"
+
+func epochAdminEnd_epochCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_epochAdminEnd_epochCdc,
+		"epoch/admin/end_epoch.cdc",
+	)
+}
+
+func epochAdminEnd_epochCdc() (*asset, error) {
+	bytes, err := epochAdminEnd_epochCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "epoch/admin/end_epoch.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x75, 0x4c, 0xd2, 0x56, 0x47, 0xdc, 0xe0, 0xb6, 0xe3, 0x64, 0x77, 0x40, 0xe6, 0xd6, 0x14, 0x2, 0xad, 0x6c, 0xc9, 0x1d, 0x2e, 0xb4, 0x45, 0xdf, 0x8b, 0x6c, 0x7c, 0xb0, 0xea, 0x57, 0x8, 0xe}}
+	return a, nil
+}
+
+var _epochAdminEnd_staking_auctionCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x41\x8b\xdb\x3e\x10\xc5\xef\xfa\x14\x8f\x3d\xfc\x49\xe0\x4f\xdc\xb3\x69\xba\xa4\x9b\x94\x16\x4a\xbb\x6c\x7a\x5b\xf6\xa0\x48\xe3\x58\xad\xa3\x31\xd2\x38\x09\x04\x7f\xf7\x22\xa9\x49\xdd\x65\xcd\x5c\x3c\x9a\xf9\xcd\xd3\x1b\xb9\x43\xcf\x41\xf0\xa9\xe3\xd3\xa6\x67\xd3\xa2\x09\x7c\xc0\xbb\xf3\xe6\xf1\xfb\xc3\xe7\xd5\x7a\xfd\xb4\xd9\x6e\x95\xaa\x2a\xfc\x68\x5d\x84\x04\xed\xa3\x36\xe2\xd8\x83\xbc\x8d\x90\x96\x10\x45\xff\x72\x7e\x0f\x3d\x94\x03\x6e\x72\xda\xd3\x59\x40\x89\xf9\x7f\xea\x3f\xb5\xce\xb4\x08\xd4\x0c\xa9\xcf\xb3\xa5\x88\x93\x93\x16\xce\xc7\xa1\x69\x9c\x71\xe4\x25\xb3\x28\xd7\x6b\x6f\x71\xe0\x23\x95\x19\x99\x03\xe1\xfc\x13\x49\x86\x1e\x7d\xab\x23\x29\x35\x91\x34\x73\x36\xd6\x78\xde\x4a\x70\x7e\xff\x32\xc7\x45\x29\x00\xa8\x2a\x7c\x65\xa3\x3b\x1c\x75\x70\x7a\xd7\x11\x1a\x0e\xd0\x49\x0b\x05\xf2\x86\xae\xdc\x62\xc0\xca\x1e\x9c\x07\xef\x7e\x92\x91\xdc\xdf\x91\x40\xa7\xe4\x13\x35\x35\xfe\xbb\x59\xb5\xc8\x95\x65\x46\x1f\xa8\xd7\x81\x66\xda\x18\xa9\xb1\x1a\xa4\x5d\x19\xc3\x83\x97\xa4\x02\x7f\xbe\xaa\xc2\x8e\x43\xe0\xd3\x5b\xc3\xf5\xeb\xb1\x29\x22\x75\xcd\xe2\x3a\x1b\x4b\x24\xfc\xa2\x30\xde\xbf\x16\xf2\x61\x96\x56\x57\xff\x5d\x65\x49\x6f\x85\x83\xde\xd3\xa3\x96\x76\x7e\xe3\xa6\xb8\xbf\x47\xaf\xbd\x33\xb3\xbb\x07\x1e\x3a\x0b\xcf\x72\x95\xf7\x8f\xb8\x62\x7d\x16\x71\x57\x08\x63\xb9\x33\x9d\xc9\x0c\x42\x93\x0b\x66\xa7\xfa\x3e\xf0\x91\xec\x97\x75\xac\x71\x29\xbb\xa8\xf1\x91\xb9\x1b\xb1\xc4\x65\xbc\x15\xa7\x25\x38\x0b\xe7\xe1\x6c\x9c\x40\x52\x4c\x20\xcf\xce\xbe\x60\x09\x09\x03\xdd\x4a\x46\xf5\xb6\x43\x0b\xf2\x76\x5b\x5e\xe3\xaa\x3c\xc6\xd9\x95\xf4\x8d\x2d\x65\x49\x13\xf4\x5c\x01\xc0\xa8\x46\xf5\x7b\x00\xd6\x79\xe1\xe9\x06\x03\x00\x00"
+
+func epochAdminEnd_staking_auctionCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_epochAdminEnd_staking_auctionCdc,
+		"epoch/admin/end_staking_auction.cdc",
+	)
+}
+
+func epochAdminEnd_staking_auctionCdc() (*asset, error) {
+	bytes, err := epochAdminEnd_staking_auctionCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "epoch/admin/end_staking_auction.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x45, 0xaa, 0x2a, 0xba, 0x30, 0x0, 0xb2, 0x83, 0x30, 0x39, 0xbd, 0xc, 0x96, 0x48, 0xc, 0x9e, 0x74, 0x9f, 0x67, 0x9e, 0xfd, 0xdc, 0x6b, 0x70, 0x4e, 0xdf, 0x66, 0x3d, 0x42, 0x94, 0x60, 0x1}}
+	return a, nil
+}
+
+var _epochAdminSet_num_viewsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x51\xc1\x6a\xc2\x40\x10\xbd\xef\x57\x3c\x3c\x94\x78\x49\x7a\x28\x3d\x84\xb6\x12\xd4\x52\xa1\xb4\xa2\x6d\xef\x9b\x75\x62\x52\x92\x9d\xb0\x99\xa8\x20\xfe\x7b\xd9\x44\x6d\x2b\x2e\x73\x58\x1e\x33\xef\xbd\x79\x53\x54\x35\x3b\xc1\x73\xc9\xdb\x69\xcd\x26\x47\xe6\xb8\xc2\xed\x6e\x3a\x7f\x1f\xbf\x24\x93\xc9\x62\xba\x5c\x2a\x15\x45\xf8\xc8\x8b\x06\xe2\xb4\x6d\xb4\x91\x82\x2d\x4c\xae\xed\x9a\x1a\x48\x4e\xb0\x6d\x95\x92\x03\x67\xd8\x14\xb4\x6d\xfc\xa7\x83\x69\x27\x20\x4f\xdb\x28\xf5\x67\x36\xb0\x6d\xf5\xe5\x1b\x63\x7c\xce\xac\xdc\xdf\x0d\xb1\x57\x0a\x00\xa2\x08\xaf\x6c\x74\x89\x8d\x76\x85\x4e\x4b\x42\xc6\x0e\x1a\x8e\x32\x72\x64\x0d\x41\xb8\x53\xec\xcd\x26\xab\xaa\xb0\xe0\xf4\x9b\x8c\x74\xf3\x25\x09\xb4\x07\x17\x94\xc5\xb8\x39\xaf\x15\x76\x9d\xbd\x46\xed\xa8\xd6\x8e\x02\x6d\x8c\xc4\x48\x5a\xc9\x13\x63\xb8\xb5\xe2\x5d\xe0\xf8\xa2\x08\x29\x3b\xc7\xdb\x6b\xe2\xfa\x52\xd6\x57\x43\x65\x16\x9e\xb4\xf1\x08\x4f\x1f\xf6\x1c\x0f\x97\x46\x9e\x02\x1f\x73\xfc\x1b\x7b\x0f\x2f\x85\x9d\x5e\xd3\x5c\x4b\x3e\x3c\xf3\xfa\x1a\x8d\x50\x6b\x5b\x98\x60\x30\xe6\xb6\x5c\xc1\xb2\x9c\xec\xfd\x33\xd7\x65\xdd\x07\x30\xe8\x19\x0e\xfd\xce\xb4\x23\xd3\x0a\x61\x7f\xdd\x6e\xd8\x90\xbc\x1d\x6f\x32\xb3\x5d\x62\xe7\x1b\x0d\x15\x00\x1c\xd4\x41\xfd\x0c\x00\x8b\xad\xf5\x3a\x2c\x02\x00\x00"
+
+func epochAdminSet_num_viewsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_epochAdminSet_num_viewsCdc,
+		"epoch/admin/set_num_views.cdc",
+	)
+}
+
+func epochAdminSet_num_viewsCdc() (*asset, error) {
+	bytes, err := epochAdminSet_num_viewsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "epoch/admin/set_num_views.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x1a, 0x50, 0x2a, 0x28, 0xa, 0xf8, 0x63, 0x80, 0x77, 0x94, 0xa2, 0x0, 0x33, 0xaf, 0x70, 0xcd, 0x95, 0xd8, 0xef, 0xa8, 0x0, 0x68, 0x81, 0xf7, 0xc7, 0x79, 0xde, 0x58, 0x36, 0xb7, 0x26, 0x9e}}
+	return a, nil
+}
+
+var _epochScriptsGet_epoch_counterCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x44\xce\xb1\x0a\xc2\x40\x10\x04\xd0\x7e\xbf\x62\x4a\x6d\x8c\x85\x58\xd8\x49\x12\xd1\x4a\x31\xfa\x01\x1a\x2e\xe4\xc0\xec\x1e\x7b\xbb\x28\x88\xff\x2e\x6a\xc0\x72\x8a\x79\x33\x71\x48\xa2\x86\xcd\x4d\xee\x75\x92\xb6\x47\xa7\x32\x60\xfe\xa8\x0f\xfb\x72\xbb\xae\xaa\x63\xdd\x34\x44\x45\x81\x53\x1f\x33\x72\xab\x31\x19\x34\x98\x2b\x67\x58\x1f\xd0\x8a\xb3\x05\x85\x74\xbf\xe8\xaa\x81\x0d\xe1\x83\x11\x25\xbf\xa2\x73\xc6\x70\x89\x3c\x99\xae\x70\xde\xb1\x2d\x17\x78\x12\x80\x91\xf9\x6f\xcf\xc6\xf2\xf7\x48\x29\xce\x16\x94\x5e\xf4\x1e\x00\x80\x7b\xa5\x9a\xa3\x00\x00\x00"
+
+func epochScriptsGet_epoch_counterCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_epochScriptsGet_epoch_counterCdc,
+		"epoch/scripts/get_epoch_counter.cdc",
+	)
+}
+
+func epochScriptsGet_epoch_counterCdc() (*asset, error) {
+	bytes, err := epochScriptsGet_epoch_counterCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "epoch/scripts/get_epoch_counter.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4f, 0x61, 0x79, 0xb, 0xa3, 0x56, 0x76, 0x3a, 0xc3, 0xb5, 0xbb, 0x32, 0x79, 0x19, 0x34, 0x7d, 0x98, 0xc7, 0xa0, 0x75, 0x5, 0xb2, 0xec, 0x4d, 0x45, 0x24, 0x72, 0x64, 0xfc, 0xe, 0xe3, 0xee}}
+	return a, nil
+}
+
+var _epochScriptsGet_epoch_metadataCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8e\xbd\x4a\x04\x31\x14\x85\xfb\x3c\xc5\x61\xab\x9d\xc6\xb5\x10\x8b\x6d\x16\x71\x47\xb4\xf0\x07\x47\x1f\x20\x66\x6e\x4c\xc0\xe4\xc6\x9b\x1b\x14\xc4\x77\x97\x19\x1c\x46\xe1\x94\x1f\xdf\xf9\x62\x2a\x2c\x8a\xab\x37\xfe\xe8\x0b\xbb\x00\x2f\x9c\x70\xfa\xd9\x3f\xdc\x5f\x5e\x5f\x1c\x8f\x8f\xfd\x30\x18\xb3\xdb\xe1\x29\xc4\x8a\xea\x24\x16\x85\x90\x36\xc9\x15\x1a\x08\x89\xd4\x8e\x56\x2d\xd8\xc3\x66\xd0\x24\x31\xa6\xb4\x17\xf8\x96\x91\x6c\xcc\x5b\xc7\x2d\x2b\xc9\x1e\xcf\x37\x59\xcf\xcf\xba\xfd\x7a\x77\x32\x9f\xde\x2e\x8e\x2f\x03\xe0\x57\xff\x07\x7a\x25\xfd\xc7\x2d\xc6\x6e\xc6\xa7\x1d\x0e\x28\x36\x47\xb7\xdd\xdc\xf1\x9a\xe4\x59\xe6\x46\xa1\xf7\x46\x55\x69\x04\x15\x76\x61\xd3\x99\x6f\xf3\x33\x00\xf5\x46\x5d\x35\xf9\x00\x00\x00"
+
+func epochScriptsGet_epoch_metadataCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_epochScriptsGet_epoch_metadataCdc,
+		"epoch/scripts/get_epoch_metadata.cdc",
+	)
+}
+
+func epochScriptsGet_epoch_metadataCdc() (*asset, error) {
+	bytes, err := epochScriptsGet_epoch_metadataCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "epoch/scripts/get_epoch_metadata.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3a, 0x9c, 0xf4, 0x6e, 0xf9, 0xa9, 0x94, 0xef, 0xf7, 0x7d, 0xaa, 0x8e, 0x60, 0xe8, 0xc9, 0x4a, 0xd1, 0x6f, 0x72, 0x76, 0x43, 0xd7, 0x65, 0x34, 0x64, 0xec, 0xb2, 0x37, 0xa3, 0xcc, 0xa1, 0xf4}}
+	return a, nil
+}
+
+var _epochScriptsGet_epoch_phaseCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8f\x41\x4b\x83\x31\x0c\x86\xef\xfd\x15\xef\x51\x61\x6c\xd3\x93\xec\x26\xee\x13\x3d\x39\x9c\x7a\xaf\x5d\x6a\x8b\x6b\x52\xd2\x94\x09\xe2\x7f\x97\xaf\x0c\xf4\x98\xbc\xc9\xcb\xf3\xe4\x52\x45\x0d\xf7\x47\x39\x4d\x55\x42\x42\x54\x29\x58\x7f\x4d\xbb\xa7\xbb\x87\xdb\xed\xf6\x79\xda\xef\x9d\x5b\xad\xf0\x92\x72\x43\x0b\x9a\xab\x41\xc9\xba\x72\x83\x25\x42\x4d\xbe\x11\x24\x8e\x21\x74\x55\x62\x03\xcd\x55\x9b\xf9\x6d\x8d\x28\x3a\xb2\x66\xfe\x33\xf3\x07\x7c\x0f\x96\x85\x17\xb8\x1a\xd1\x38\x45\x23\xeb\x75\x01\xcf\x07\x5c\xff\x5b\x07\x29\x25\x9b\xd1\xc1\xb9\xda\xdf\x11\x3b\xa3\xf8\xcc\x17\x97\x1b\xbc\x3e\xb2\xdd\xe0\xdb\x01\x38\xf3\xfc\x49\x2c\xcf\x1c\xc3\x68\x37\x03\x2e\xd5\x9f\xde\xfc\xb1\x93\xfb\x71\xbf\x03\x00\x97\x42\x9f\x92\xf3\x00\x00\x00"
+
+func epochScriptsGet_epoch_phaseCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_epochScriptsGet_epoch_phaseCdc,
+		"epoch/scripts/get_epoch_phase.cdc",
+	)
+}
+
+func epochScriptsGet_epoch_phaseCdc() (*asset, error) {
+	bytes, err := epochScriptsGet_epoch_phaseCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "epoch/scripts/get_epoch_phase.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2d, 0xdf, 0xf4, 0xda, 0x9, 0xeb, 0x77, 0x51, 0xe6, 0x18, 0x39, 0xd0, 0x64, 0x71, 0xa0, 0xc, 0x44, 0x7e, 0xe2, 0xdf, 0x15, 0x43, 0x73, 0x4b, 0xfb, 0xba, 0xae, 0xb6, 0x39, 0xfc, 0x4f, 0x7f}}
+	return a, nil
+}
+
+var _flowfeesDeploy_flow_feesCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x91\x41\x8b\xdb\x30\x10\x85\xef\xfa\x15\x8f\x9c\x6c\x30\xf6\xb5\x18\x7a\x08\x85\xd0\xd2\x4b\xa1\xed\x29\xe4\x30\x2b\x4d\x62\x11\x67\x64\xa4\x09\x5e\x13\xf2\xdf\x17\xc5\x71\x36\x04\x16\x06\xfb\xf0\xde\xfb\x34\xd2\x6b\x1a\xfc\x88\x4c\xca\x09\x04\xe1\x11\x64\x6d\x38\x8b\x82\xc4\xc1\xf1\xd0\x87\x29\x41\x3b\xc6\xa6\x0f\xe3\x86\x39\xc1\x06\xd1\x48\x56\xa1\x01\x5e\x6b\xd3\x34\xf8\xd7\x31\xf6\x59\x5b\xc2\x49\x43\xe4\x04\xaf\x09\x61\x14\xac\xdd\xc9\x8b\x4f\x1a\x49\x43\xac\x90\x02\xb4\x23\xbd\x61\x8f\x3c\xa5\x8c\x20\xe7\xd8\xcd\x48\x58\x12\x8c\x5e\x3b\x17\x69\xbc\x99\x6c\xe8\x7b\xb6\xca\xee\x76\x4a\x6d\x8c\x46\x92\x44\x56\x7d\x90\xc2\x06\xc7\x2d\xfe\x6a\xf4\x72\xa8\x30\x9c\xdf\x7a\x6f\x7f\xf3\x94\x5a\x6c\xb7\xff\x7f\x89\x7e\xdb\xed\x4a\x5c\x8c\x01\x80\x21\xf2\x40\x91\x8b\xe4\x0f\xc2\xb1\xc5\xfa\xac\xdd\x7a\xde\xf9\xe1\xc9\xd3\xb3\x62\x7f\xbf\xf0\x5d\xc7\xf7\x67\x77\x31\xd0\x94\x01\x33\xa8\xfc\x4c\xee\x43\xc4\x91\x27\x78\x79\x5a\x05\x97\x87\x9e\xe7\x85\x5c\x93\x73\x7f\x16\x6f\x71\xe4\xa9\x7c\xb8\xaf\xc6\x7c\x15\x5a\x6a\x48\x39\x5e\x08\x9d\xb8\xc5\x6a\x29\x69\x55\x61\x7e\x96\xfc\xad\x1d\xe7\xdf\x4f\x7e\x2f\xca\x0a\x94\xbb\xb8\x43\xda\x57\x6a\x69\x00\xe0\x6a\xae\xe6\x63\x00\xf1\x0d\x95\xc6\x18\x02\x00\x00"
+
+func flowfeesDeploy_flow_feesCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_flowfeesDeploy_flow_feesCdc,
+		"flowFees/deploy_flow_fees.cdc",
+	)
+}
+
+func flowfeesDeploy_flow_feesCdc() (*asset, error) {
+	bytes, err := flowfeesDeploy_flow_feesCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "flowFees/deploy_flow_fees.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xaf, 0xc3, 0xd2, 0x6, 0x60, 0x61, 0x16, 0x48, 0x9c, 0x4e, 0xb8, 0x32, 0xb0, 0x98, 0xfe, 0x21, 0x53, 0xc5, 0x56, 0x82, 0x92, 0xf7, 0x5, 0x84, 0xa2, 0x5c, 0x44, 0xcc, 0x20, 0x22, 0x7c, 0xd0}}
+	return a, nil
+}
+
 var _flowtokenBurn_tokensCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x41\x6f\xdb\x3e\x0c\xc5\xef\xfe\x14\xef\xdf\xc3\x1f\xc9\xa1\x76\x07\x0c\x3b\x04\xd9\xba\xb4\x4d\x86\x61\x43\x06\xac\xe9\x76\x96\x6d\x26\x16\x66\x4b\x06\x25\xcf\x29\x8a\x7e\xf7\x41\x92\xad\xda\x87\xe4\x10\x27\x26\xf9\xf8\x7b\x24\xb3\x0c\x87\x4a\x1a\x58\x16\xca\x88\xc2\x4a\xad\x20\x0d\x04\x2c\x35\x6d\x2d\x2c\xe1\xa8\xd9\xfd\x9d\xc4\x6d\x25\x6c\x92\x65\x28\x74\x57\x97\xc8\x09\x9d\xa1\x12\xf9\x33\x6c\x45\x10\x65\x23\x15\x44\x51\xe8\x4e\x59\x58\x8d\xbc\x63\x05\xab\xff\x90\x32\xae\xe8\xc8\xba\x71\x89\x92\x61\xac\x66\x2a\xf1\x4b\x74\xb5\xd3\x4b\x3c\x0b\xf9\x02\xa9\x4e\x10\x8d\x97\xe8\xc7\x2e\x02\xad\x60\xd1\x90\x25\x76\xba\xae\xd9\x84\x2a\x49\x64\xd3\x6a\xb6\xd8\x75\xea\x24\xf3\x9a\x0e\xae\x65\x68\x77\x73\xde\x3d\xed\xbf\x7c\xbd\xfb\xbe\x3d\xfc\xf8\xb6\xdd\x6f\x1e\x1e\x7e\x6e\x1f\x1f\x63\x41\xad\xfb\x59\xf2\x2c\x29\x99\xf4\x58\x04\xa4\x15\x9e\x76\xf2\xfc\xe1\xfd\x12\x2f\x49\x02\x00\x59\x16\x4c\x80\xc9\xe8\x8e\x0b\xf2\x23\x42\xa5\xeb\xd2\x04\x4e\x6f\x3f\xbc\x15\x4c\xc8\xc9\x19\x74\x46\xa9\xf4\x0a\x35\x59\xfc\x75\x12\x2b\x7c\x9e\x19\x48\xc3\x74\x62\x92\x1f\xef\x0a\xff\x47\xe8\x74\xe3\xde\x48\x63\x59\x58\xcd\x21\xb1\x65\x6a\x05\xd3\xc2\xc8\x93\x22\x5e\x61\xd3\xd9\x6a\x13\x36\x12\x99\x07\xee\xdf\xd2\x56\x25\x8b\x1e\xef\x6e\x46\xca\x71\x43\xc3\x2a\x3d\x16\xa4\xf2\xeb\x12\x27\x8a\xd5\x86\xea\x63\x1a\xa2\xeb\x6b\x84\x5e\x69\xae\x99\x75\xbf\x9e\xf0\x79\x03\x9f\x16\x4e\x75\x85\x6c\x10\xc9\x8e\x63\xdc\x87\x97\xff\x45\x55\xf7\x49\xfb\x81\x2a\x0e\x3c\x3c\x97\x33\xf4\x7b\x26\x77\x9f\x02\x4c\x47\x62\x52\x6e\xec\x7a\x7a\x83\xfe\x3b\xae\xe4\x92\x83\x90\xf6\xf1\xb2\x81\xd9\x80\x2f\x1b\xf1\x69\xcb\x99\x8f\xdb\x5b\xb4\x42\xc9\x62\x71\x75\xef\x8f\x58\x69\x8b\xa0\x7f\x99\x7a\xe4\xbd\x0a\x52\xaf\xc1\x32\x9d\xa9\xe8\x2c\xe1\x25\xea\xbb\x63\xf0\x07\xc4\x7e\xfa\xd1\x49\x5a\xf8\xb1\xec\xa9\xbf\xf3\xd1\xc5\x1b\x52\xfc\x11\xea\x52\xf7\xf0\xe8\x66\x30\xb5\xbe\x7e\xdb\xe9\x64\xd6\x25\x19\xcb\xfa\x79\x28\x1b\xb0\x5e\x13\xfc\x0b\x00\x00\xff\xff\x7a\x03\x55\x74\x3c\x04\x00\x00"
 
 func flowtokenBurn_tokensCdcBytes() ([]byte, error) {
@@ -400,23 +862,63 @@ func flowtokenTransfer_tokensCdc() (*asset, error) {
 	return a, nil
 }
 
-var _idtablestakingAdminChange_cutCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x4f\x6b\xdb\x40\x10\xc5\xef\xfa\x14\x8f\x1c\x8a\x73\x91\x7a\x28\x3d\x98\xb6\x41\x8d\x1c\x30\x84\x12\x62\xf5\xd0\xe3\x78\x35\xfa\x53\xaf\x77\xcc\x68\x54\x19\x4a\xbe\x7b\x59\xc9\x2e\x71\xe3\xce\x65\x61\x99\x7d\xef\x37\xf3\xb6\xdb\x1f\x44\x0d\x0f\x5e\xc6\x75\x51\xd2\xd6\xf3\xc6\x68\xd7\x85\x06\xb5\xca\x1e\xef\x8f\xeb\x62\xf5\xad\x5c\x97\x3f\xca\xfc\xeb\xe3\x2a\x2f\x8a\xe7\xd5\x66\x93\x24\x59\x86\xb2\xed\x7a\x98\x52\xe8\xc9\x59\x27\x01\xae\xa5\xd0\x70\x0f\x6b\x19\xb5\x97\x11\x26\x3b\x0e\x50\x1e\x49\x2b\xb8\xc1\x60\x2d\x19\x82\x54\xb1\x89\x76\x3c\x5b\x54\xec\xb9\x21\x13\xed\x93\xe4\x95\xdc\x22\xf0\x78\x3f\xd8\x13\xab\xe3\x60\xd4\xf0\x12\xdf\x1f\xba\xe3\xc7\x0f\xb7\xf8\x9d\x24\x00\x90\x65\x78\x14\x47\x1e\xbf\x48\xbb\x48\x8e\x5a\x14\x04\xe5\x9a\x95\x83\x63\x98\x4c\x30\xeb\x02\xd3\x64\xc8\xab\x7d\x17\x20\xdb\x9f\xec\x6c\x92\xf0\x6c\xa0\x78\xf9\xcc\xf5\x12\xef\xde\x6e\x21\x9d\x9e\xcc\x7e\x07\xe5\x03\x29\x2f\xc8\x39\x5b\x22\x1f\xac\xcd\x9d\x93\x21\x58\x24\xc2\xa9\xb2\x0c\x5b\x51\x95\xf1\x1a\x08\xfd\xeb\x1f\xab\x67\x5f\xa7\x67\x08\x7c\x46\x94\x4f\x67\x8d\x4f\xff\x25\xfa\xb2\x88\xbb\x5b\x5e\xc9\x2d\x3d\x9d\x53\xdb\xc6\x44\xa9\xe1\x27\xb2\xf6\xf6\xaf\x61\xac\xbb\x3b\x1c\x28\x74\x6e\x71\x73\x2f\x83\xaf\x10\xc4\xce\xdc\x17\xd4\xfd\xe9\x33\x4c\x7c\x37\xb3\xc6\xcb\xbc\x0e\x3e\xb2\x1b\x8c\x5f\xcd\x7e\x31\x49\xda\xb3\x5d\xe4\xf7\x26\xd0\xb3\xda\xcb\x9f\x00\x00\x00\xff\xff\x8e\xa5\x1f\xd1\x84\x02\x00\x00"
+var _idtablestakingAdminChange_cutCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x4f\x6b\xdb\x40\x10\xc5\xef\xfa\x14\x8f\x1c\x8a\x73\x91\x7a\x28\x3d\x98\xb6\x41\x8d\x1c\x30\x84\x12\x62\xf5\xd0\xe3\x78\x35\xfa\x53\xaf\x77\xcc\x68\x54\x19\x4a\xbe\x7b\x59\xc9\x2e\x71\xe3\xce\x65\x61\x99\x7d\xef\x37\xf3\xb6\xdb\x1f\x44\x0d\x0f\x5e\xc6\x75\x51\xd2\xd6\xf3\xc6\x68\xd7\x85\x06\xb5\xca\x1e\xef\x8f\xeb\x62\xf5\xad\x5c\x97\x3f\xca\xfc\xeb\xe3\x2a\x2f\x8a\xe7\xd5\x66\x93\x24\x59\x86\xb2\xed\x7a\x98\x52\xe8\xc9\x59\x27\x01\xae\xa5\xd0\x70\x0f\x6b\x19\xb5\x97\x11\x26\x3b\x0e\x50\x1e\x49\x2b\xb8\xc1\x60\x2d\x19\x82\x54\xb1\x89\x76\x3c\x5b\x54\xec\xb9\x21\x13\xed\x93\xe4\x95\xdc\x22\xf0\x78\x3f\xd8\x13\xab\xe3\x60\xd4\xf0\x12\xdf\x1f\xba\xe3\xc7\x0f\xb7\xf8\x9d\x24\x00\x90\x65\x78\x14\x47\x1e\xbf\x48\xbb\x48\x8e\x5a\x14\x04\xe5\x9a\x95\x83\x63\x98\x4c\x30\xeb\x02\xd3\x64\xc8\xab\x7d\x17\x20\xdb\x9f\xec\x6c\x92\xf0\x6c\xa0\x78\xf9\xcc\xf5\x12\xef\xde\x6e\x21\x9d\x9e\xcc\x7e\x07\xe5\x03\x29\x2f\xc8\x39\x5b\x22\x1f\xac\xcd\x9d\x93\x21\x58\x24\xc2\xa9\xb2\x0c\x5b\x51\x95\xf1\x1a\x08\xfd\xeb\x1f\xab\x67\x5f\xa7\x67\x08\x7c\x46\x94\x4f\x67\x8d\x4f\xff\x25\xfa\xb2\x88\xbb\x5b\x5e\xc9\x2d\x3d\x9d\x53\xdb\xc6\x44\xa9\xe1\x27\xb2\xf6\xf6\xaf\x61\xac\xbb\x3b\x1c\x28\x74\x6e\x71\x73\x2f\x83\xaf\x10\xc4\xce\xdc\x17\xd4\xfd\xe9\x33\x4c\x7c\x37\xb3\xc6\xcb\xbc\x0e\x3e\xb2\x1b\x8c\x5f\xcd\x7e\x31\x49\xda\xb3\x5d\xe4\xf7\x26\xd0\xb3\xda\xcb\x9f\x00\x00\x00\xff\xff\x8e\xa5\x1f\xd1\x84\x02\x00\x00"
+
+func idtablestakingAdminChange_cutCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_idtablestakingAdminChange_cutCdc,
+		"idTableStaking/admin/change_cut.cdc",
+	)
+}
+
+func idtablestakingAdminChange_cutCdc() (*asset, error) {
+	bytes, err := idtablestakingAdminChange_cutCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "idTableStaking/admin/change_cut.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x10, 0x16, 0x86, 0xfb, 0xee, 0x3b, 0xc9, 0x84, 0xcd, 0xcb, 0x97, 0x24, 0x33, 0xd9, 0xe3, 0x6b, 0x46, 0x7d, 0x40, 0x73, 0x24, 0x7a, 0x38, 0x38, 0xd5, 0x51, 0xa1, 0x58, 0x44, 0xaf, 0xb3, 0x7a}}
+	return a, nil
+}
+
+var _idtablestakingAdminChange_maximum_delegation_ratiosCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x4f\x6b\xdb\x40\x10\xc5\xef\xfa\x14\x8f\x1c\x8a\x7d\x91\x7a\x28\xa5\x98\xb6\xc1\xad\x1c\x30\xa4\xa1\xd8\xce\xa1\xc7\xf1\x6a\x24\x6d\x23\xed\x88\xd5\xa8\xb6\x31\xfe\xee\x65\x57\x76\xfa\x27\x8e\x58\x10\x1a\xcd\xbe\xf9\xcd\xbc\xb1\x6d\x27\x5e\x71\xd7\xc8\x6e\x99\x6f\x68\xdb\xf0\x5a\xe9\xc9\xba\x0a\xa5\x97\x16\x6f\xf7\xcb\x7c\xf1\xb0\x59\x6e\x7e\x6c\xe6\x5f\xee\x17\xf3\x3c\x5f\x2d\xd6\xeb\x24\xc9\x32\x6c\x6a\xdb\x43\x3d\xb9\x9e\x8c\x5a\x71\x30\x35\xb9\x8a\x7b\x68\xcd\xa8\x6d\x55\x73\xaf\xf0\xa4\x56\x20\x25\x0a\x6e\xb8\x22\xe5\x02\x2a\x4f\xec\xfa\xa0\xa0\x12\x73\xc7\x40\x48\x0a\x5f\x4e\x0a\x86\x74\xec\x49\xc5\xa3\x14\x0f\x26\x53\x8f\x61\x3d\x74\x0c\xeb\xe2\xad\xc2\xc6\xaa\xe4\x0f\x69\xd0\x7a\xb8\xfc\x0f\xf5\x49\x41\x3e\x48\xe9\xcb\x6c\x90\x31\xdc\x29\xc8\x1d\x2e\x50\x56\x5c\x92\xfc\xd5\xc9\xc4\xf1\x6e\x15\xc2\xfd\x0c\xc7\xc7\xa5\xd3\x0f\x33\x3c\xde\xd9\xfd\xfb\x77\xa7\x29\x8e\x49\x02\x00\x59\x86\x7b\x31\xd4\xe0\x17\x79\x1b\xc6\x16\x51\x09\x9e\x4b\xf6\xec\x0c\x5f\xba\x5b\xe6\x88\x63\xc5\xbc\x68\xad\x83\x6c\x7f\xb2\xd1\x28\xd1\xb0\x82\x42\x70\xc5\xe5\x0c\x6f\x5e\x5a\x90\xc6\x2b\x63\xbd\xce\x73\x47\x9e\x27\x64\x8c\xce\x30\x1f\xb4\x9e\x1b\x23\x83\xd3\x40\x84\xf3\x93\x65\xd8\x8a\xf7\xb2\xbb\x06\x42\xff\xd7\x0f\xa7\xe7\xa6\x4c\x2f\x10\xf8\x14\x86\xa3\xe9\xa8\xf1\xf1\x55\xa2\xcf\x93\xb0\x1b\xb3\x2b\x4b\x93\x9e\xdf\x31\x6d\xad\xe2\xa9\xe2\xef\xa4\xf5\xf4\xb9\x60\x38\xb7\xb7\xe8\xc8\x59\x33\xb9\xf9\x2a\x43\x53\x44\x9f\xce\xdc\xff\x50\xf7\xe7\x4d\x8c\x7c\x37\xa3\xc6\x69\x1c\x07\xef\xd9\x0c\xca\x38\x5e\xef\x24\xed\x59\xbf\xd1\xde\xb6\x43\x9b\x3f\x7b\x3c\x5a\xfa\xc7\xdc\x69\x02\x00\xa7\xe4\x94\xfc\x1e\x00\x82\xef\x74\xd1\x05\x03\x00\x00"
+
+func idtablestakingAdminChange_maximum_delegation_ratiosCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_idtablestakingAdminChange_maximum_delegation_ratiosCdc,
+		"idTableStaking/admin/change_maximum_delegation_ratios.cdc",
+	)
+}
+
+func idtablestakingAdminChange_maximum_delegation_ratiosCdc() (*asset, error) {
+	bytes, err := idtablestakingAdminChange_maximum_delegation_ratiosCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "idTableStaking/admin/change_maximum_delegation_ratios.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe, 0x35, 0x8e, 0x7a, 0xd1, 0x4b, 0x87, 0x90, 0x9f, 0xaa, 0xe9, 0x4d, 0xb2, 0xb7, 0x62, 0xf6, 0xe8, 0xfa, 0x9a, 0xd0, 0xd0, 0x99, 0xf4, 0x8a, 0x15, 0x49, 0xab, 0xf9, 0x30, 0x88, 0x30, 0xc4}}
+	return a, nil
+}
+
+var _idtablestakingAdminChange_maximum_initial_weightCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x41\x8b\xd4\x40\x10\x85\xef\xfd\x2b\x1e\x7b\x90\x99\x4b\xe2\x41\x3c\x0c\xea\x12\xcd\x08\x81\x55\x64\x27\x22\x1e\x6b\x7a\x2a\xe9\xd6\xa4\x3b\x74\x2a\x66\x60\x99\xff\x2e\xdd\xc9\x2e\xab\x8e\x49\x20\x21\x74\xbd\xf7\x55\xbd\xb2\xfd\xe0\x83\xe0\x63\xe7\xe7\xaa\xac\xe9\xd8\xf1\x41\xe8\xa7\x75\x2d\x9a\xe0\x7b\xbc\x3c\x57\xe5\xfe\x73\x5d\xd5\xdf\xeb\xe2\xfd\xdd\xbe\x28\xcb\xfb\xfd\xe1\xa0\x54\x9e\xa3\x36\x76\x84\x04\x72\x23\x69\xb1\xde\x41\x1b\x72\x2d\x8f\x10\xc3\x30\xb6\x35\x3c\x0a\xac\xb3\x62\xa9\xc3\xcc\xb6\x35\x12\xcb\xc4\x90\x80\xe0\xfc\x89\xa1\xc9\xa1\x65\x01\x49\x2a\x62\x77\x82\x6f\xd2\xe7\xb8\x42\xd0\x94\xb4\x95\x7a\x66\xb4\x71\x3c\x7f\xa2\xb3\xed\xa7\x7e\x87\xaf\x95\x93\xd7\xaf\xb6\x78\x50\x0a\x00\xf2\x1c\x77\x5e\x53\x87\x5f\x14\x6c\xec\x06\x8d\x0f\x20\x04\x6e\x38\xb0\xd3\x0c\xf1\xc9\xa0\x2a\x91\xba\x45\x71\xea\xad\x83\x3f\xfe\x60\x2d\x49\xa2\x8b\x40\xf1\xe7\x3d\x37\x3b\xbc\xf8\x77\x32\x59\x2a\x59\xfc\x86\xc0\x03\x05\xde\x90\xd6\xb2\x43\x31\x89\x29\xb4\xf6\x93\x93\x48\x84\xf5\xca\x73\x1c\x7d\x08\x7e\xbe\x06\x42\x7f\xfb\xc7\x7b\xe4\xae\xc9\x1e\x21\xf0\x16\x51\x3e\x5b\x34\xde\xfc\x97\xe8\xdd\x26\x46\xb6\xbb\x92\x65\xb6\xbe\xd3\xb1\x83\xf8\x40\x2d\x7f\x21\x31\xdb\x27\xc3\xf8\xdc\xde\x62\x20\x67\xf5\xe6\xe6\x83\x9f\xba\x13\x9c\x97\x47\xee\x3f\xa8\x9f\xb2\x89\x6a\x37\x8b\xc6\x65\x19\x07\x9f\x59\x4f\xc2\x78\xb8\xde\x49\x36\xb2\xac\xc9\x55\xcb\x62\x7c\x4b\x7b\xf1\x2c\xd1\xad\x02\x80\x8b\xba\xa8\xdf\x03\x00\x62\xd1\x96\x52\x9a\x02\x00\x00"
 
-func idtablestakingAdminChange_cutCdcBytes() ([]byte, error) {
+func idtablestakingAdminChange_maximum_initial_weightCdcBytes() ([]byte, error) {
 	return bindataRead(
-		_idtablestakingAdminChange_cutCdc,
-		"idTableStaking/admin/change_cut.cdc",
+		_idtablestakingAdminChange_maximum_initial_weightCdc,
+		"idTableStaking/admin/change_maximum_initial_weight.cdc",
 	)
 }
 
-func idtablestakingAdminChange_cutCdc() (*asset, error) {
-	bytes, err := idtablestakingAdminChange_cutCdcBytes()
+func idtablestakingAdminChange_maximum_initial_weightCdc() (*asset, error) {
+	bytes, err := idtablestakingAdminChange_maximum_initial_weightCdcBytes()
 	if err != nil {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "idTableStaking/admin/change_cut.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x10, 0x16, 0x86, 0xfb, 0xee, 0x3b, 0xc9, 0x84, 0xcd, 0xcb, 0x97, 0x24, 0x33, 0xd9, 0xe3, 0x6b, 0x46, 0x7d, 0x40, 0x73, 0x24, 0x7a, 0x38, 0x38, 0xd5, 0x51, 0xa1, 0x58, 0x44, 0xaf, 0xb3, 0x7a}}
+	info := bindataFileInfo{name: "idTableStaking/admin/change_maximum_initial_weight.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xdd, 0x6b, 0x94, 0xdd, 0x78, 0x5c, 0xe4, 0x2b, 0x80, 0xc0, 0xb1, 0xbf, 0x73, 0x85, 0x2, 0xf9, 0xce, 0xc4, 0x0, 0x9e, 0x7b, 0xa2, 0x75, 0x89, 0x8c, 0xdb, 0x6, 0xb8, 0x14, 0xb7, 0x45, 0x72}}
 	return a, nil
 }
 
@@ -460,6 +962,26 @@ func idtablestakingAdminChange_payoutCdc() (*asset, error) {
 	return a, nil
 }
 
+var _idtablestakingAdminChange_reward_ratiosCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x52\xd1\x6a\xdb\x40\x10\x7c\xd7\x57\x0c\x79\x28\x32\x2d\x56\x03\xa5\x14\x51\x37\xb8\xb5\x03\x86\x50\x8a\xed\x3c\x94\x90\x87\xf5\x79\x65\x5d\x2b\xdf\x8a\xd3\x3a\x76\x30\xfa\xf7\x72\x27\xdb\x75\x5a\x47\x1c\x08\xe9\x66\x67\x66\x77\xd6\xae\x6b\xf1\x8a\xdb\x4a\xb6\x93\xd1\x9c\x16\x15\xcf\x94\x7e\x5b\xb7\x42\xe1\x65\x8d\xf7\xbb\xc9\x68\xfc\x7d\x3e\x99\xff\x9c\x0f\xbf\xde\x8d\x87\xa3\xd1\x74\x3c\x9b\x25\x49\x96\x61\x5e\xda\x06\xea\xc9\x35\x64\xd4\x8a\x83\x29\xc9\xad\xb8\x81\x96\x0c\x4f\x6a\x05\x52\xc4\x0f\xae\xc5\x94\xa8\xe9\x59\x36\x1a\x2a\xb5\x24\x05\x93\x29\xe1\x64\xc9\xd0\xe7\x9a\xb1\x62\x6d\xde\xc1\xba\x58\x20\x7e\xc9\xfe\x58\x1d\x31\x5e\x2a\x6e\x92\xe4\x4c\x2e\x75\xbc\x9d\x06\x95\x26\xc7\xc3\xfd\xad\xdd\x7d\xfc\xf0\xd8\xc3\x3e\x49\x00\x20\xcb\x70\x27\x86\x2a\x3c\x91\xb7\xa1\x29\x14\xe2\x41\xf0\x5c\xb0\x67\x67\x18\x2a\x51\x69\x32\x42\x6c\x1a\xc3\xe5\xda\x3a\xc8\xe2\x17\x1b\x8d\x14\x15\x2b\x28\xfc\x9c\x72\x91\xe3\xcd\xff\x03\xea\xc7\x92\x4e\xaf\xf6\x5c\x93\xe7\x94\x8c\xd1\x1c\xc3\x8d\x96\x43\x63\x64\xe3\x34\x38\xc2\xe1\xc9\x32\x2c\xc4\x7b\xd9\x5e\x32\x42\xff\xea\x87\xd3\x70\x55\xf4\x8f\x26\x30\x40\xa0\xef\x77\x1c\x9f\x5f\x75\xf4\x25\x0d\xc9\xe5\x17\x22\xed\x1f\xde\x11\x36\x53\xf1\xb4\xe2\x1f\xa4\x65\xef\x24\x18\xce\xcd\x0d\x6a\x72\xd6\xa4\x57\xdf\x64\x53\x2d\xe1\x44\x8f\xbe\x5f\xb8\x6e\x0e\x7b\x12\xfd\x5d\x75\x1c\x6d\x37\x0e\xde\xb1\xd9\x28\x9f\xf5\x1e\xa6\xe9\x0f\x69\xed\xef\x27\x4e\x3f\xe5\xe8\x42\x6b\x31\xc0\xbe\x3d\x01\x9f\xc8\xc3\xe6\x88\x10\x0c\x70\x7d\xba\x08\x01\x46\x86\xb0\x24\xa7\xec\xcf\x24\xc2\x89\x80\xe6\xc1\x3e\x62\xd0\xad\xe0\x8b\x5b\x8b\x01\x2c\xde\x76\xe4\xe9\xf5\xdf\xb6\xdb\xe4\xf2\xc8\xfb\x0d\xeb\x94\xb7\xe4\x97\xdd\xa6\xa5\x91\xb3\xe9\x25\x00\xd0\x26\x6d\xf2\x67\x00\x85\xf7\x2f\xab\x3e\x03\x00\x00"
+
+func idtablestakingAdminChange_reward_ratiosCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_idtablestakingAdminChange_reward_ratiosCdc,
+		"idTableStaking/admin/change_reward_ratios.cdc",
+	)
+}
+
+func idtablestakingAdminChange_reward_ratiosCdc() (*asset, error) {
+	bytes, err := idtablestakingAdminChange_reward_ratiosCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "idTableStaking/admin/change_reward_ratios.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4c, 0x70, 0xe5, 0x78, 0x82, 0x44, 0x50, 0xb4, 0x28, 0x15, 0xe7, 0xf6, 0x43, 0xe8, 0x5e, 0x55, 0xd1, 0x24, 0xef, 0xba, 0x9f, 0xb, 0x17, 0x97, 0x45, 0x16, 0x7, 0xd7, 0x1, 0xb6, 0x5a, 0x83}}
+	return a, nil
+}
+
 var _idtablestakingAdminEnd_epochCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x4f\x6b\xdb\x40\x10\xc5\xef\xfa\x14\x8f\x1c\x8a\x03\xc5\xea\xd9\x34\x0d\x4e\x95\x82\x20\x84\x12\xeb\x52\x42\x08\xab\xdd\x51\xb4\x8d\xbc\x23\x76\x47\x56\x8a\xf1\x77\x2f\xab\x3f\xae\xd3\x24\x73\x31\x58\xf3\xde\xfb\xed\xcc\xd8\x6d\xcb\x5e\xf0\xa3\xe1\x3e\xcf\x0a\x55\x36\xb4\x11\xf5\x6c\xdd\x13\x2a\xcf\x5b\x7c\x79\xc9\xb3\xeb\xdb\x22\x2f\x7e\x15\xeb\xab\x9b\xeb\x75\x96\xdd\x5d\x6f\x36\x49\x92\xa6\x28\x6a\x1b\x20\x5e\xb9\xa0\xb4\x58\x76\xa0\xaa\x22\x2d\x76\x47\xcd\x1f\x90\x33\x01\x52\x13\xa8\x65\x5d\x43\x39\x83\x20\xca\x4b\x80\x82\xa3\x1e\xec\x68\x99\xa4\x69\xf4\xc9\x05\x9a\xb7\xa5\x75\x34\x29\x9c\x79\x0c\x13\x43\xd4\x6d\x79\x47\x8f\xc2\xcf\xe4\x5e\xc5\x85\xa8\xed\x6b\xab\xeb\x7f\x61\x47\x59\x37\xb4\x7c\x9e\xbe\x7b\xaa\xba\xd8\xe2\xd8\x50\x40\x6f\xa5\x86\x75\xa1\xab\x2a\xab\x2d\x39\x19\x64\x14\xed\xe6\xb8\x80\x29\xaf\x24\xe9\x89\x1c\xca\x4e\x3f\x93\x84\x24\x39\x01\x58\x58\x13\x56\xb8\xdf\x88\xb7\xee\xe9\xe1\x1c\xfb\x24\x01\x80\x34\xc5\x0d\x6b\xd5\x60\xa7\xbc\x8d\xf3\x44\xc5\x1e\x2a\x42\x90\x27\xa7\x09\xc2\x03\x6c\x9e\x61\x98\x37\xd6\x66\x6b\x1d\xb8\xfc\x4d\x5a\x06\x8b\x86\x04\x2a\xfe\x79\x47\xd5\x0a\x9f\xde\xee\x66\x39\x48\xc6\xbc\xd6\x53\xab\x3c\x2d\x94\xd6\xb2\xc2\xba\x93\x7a\xad\x35\x77\x4e\x22\x11\xa6\x4a\x53\x94\xec\x3d\xf7\xef\x81\xa8\xff\xf3\x63\x05\x6a\xaa\xe5\x0c\x81\x0b\x44\xfb\xe5\xe8\xf1\xf5\x43\xa2\x6f\x8b\x78\x34\xab\x77\xae\x69\x39\xfd\x0e\x6d\x1b\x61\xaf\x9e\xe8\xa7\x92\xfa\xfc\x18\x18\xeb\xf2\x12\xad\x72\x56\x2f\xce\xbe\x73\xd7\x18\x38\x96\x99\xfb\x15\xf5\x71\xcf\xd1\xed\x6c\xf4\x38\x8c\xe3\xa0\x17\xd2\x9d\xd0\xc9\xdb\x87\x69\xb6\xad\xe7\x1d\x99\x3c\x0b\x2b\xec\xc7\x95\xad\x70\xc5\xdc\x1c\x70\x81\xfd\xe1\xd8\x1c\x77\x65\x0d\xac\x83\x35\xe1\xc4\x24\xd6\x89\xc9\xbd\x35\x0f\xb8\x80\xf8\x8e\x8e\x2d\x13\xc0\x9b\xe1\x2d\xc9\x99\xf9\xf5\xe3\x5d\x2e\x66\xa7\x5b\x36\x34\x20\x9d\x58\x9f\x7f\x64\x13\x0f\xb3\x18\xce\x72\x31\xbf\xf8\x90\xfc\x0d\x00\x00\xff\xff\x7b\xc2\x29\x11\xbf\x03\x00\x00"
 
 func idtablestakingAdminEnd_epochCdcBytes() ([]byte, error) {
@@ -580,7 +1102,27 @@ func idtablestakingAdminRemove_nodeCdc() (*asset, error) {
 	return a, nil
 }
 
-var _idtablestakingAdminTransfer_minter_deployCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\xc1\x6a\xdb\x40\x10\xbd\xeb\x2b\x86\x1c\x8a\x0c\x8e\xe4\x42\x29\x45\x38\x0d\xaa\x13\x43\x49\xeb\x94\x38\xa1\x87\x90\xc3\x7a\x35\x76\x16\x4b\x3b\x62\x34\xaa\x62\x82\xff\xbd\xac\x56\x92\x1d\xb7\x87\xec\x61\x0f\x33\x6f\x66\xde\xbc\x79\xa6\x28\x89\x05\xe6\x39\x35\xf7\xb4\x45\x0b\x6b\xa6\x02\x26\x2f\xf3\x1f\xb7\xbf\xef\x6f\x6f\xae\x17\xe9\xd5\xd5\xdd\xf5\x72\x19\x04\xc2\xca\x56\x4a\x8b\x21\x1b\x96\xf5\x2a\x37\xfa\x06\x77\x55\x02\x8f\x8f\x0f\xdf\xad\x7c\x79\x7a\x1a\x83\x26\x2b\xac\xb4\x2c\x54\x81\x09\x2c\x85\x8d\xdd\xb8\x68\x86\x09\x74\xa8\x31\x30\x36\x8a\xb3\xb4\xa0\xda\x4a\x02\x0f\x73\xf3\xf2\xf9\x53\x1f\x9d\xd5\x43\x68\x04\xaf\x41\x00\x50\x32\x96\x8a\x31\xac\xcc\xc6\x22\x27\x90\xd6\xf2\x9c\x6a\xed\x8a\x3b\x04\x40\x8e\x02\x4a\x6b\x81\x8b\xe3\x74\x58\xaa\x9d\xab\xf0\x95\xa3\x16\xd9\x7e\x6b\x62\xd8\xe2\x0e\x8c\x85\xc3\x1e\xf0\xda\xe6\xdc\x73\xad\x22\x95\x65\xbf\xfa\x64\xb8\xc5\x9d\xaf\xdf\xfb\x81\x71\x1c\xc3\x37\x62\xa6\x06\x14\x30\xae\x91\xd1\x6a\x04\x21\x90\x67\x6c\xb5\x04\x2f\x66\x9a\x15\xc6\xba\x41\x2e\xae\x3c\x2f\xa8\x84\x58\x6d\x70\xa0\xbe\xee\xb5\xf7\xe8\x8b\x8e\x71\xb4\x6a\x27\x4c\x3f\x0c\xb7\x89\x5a\x80\xa9\x84\x95\x10\x7f\x0d\xdd\xa9\x12\x88\xbb\x7e\xf1\xdb\x3e\xa3\x61\x9f\xcb\x4b\x28\x95\x35\x3a\x3c\x9b\x51\x9d\x67\x60\x49\x60\xf5\x7e\xf6\x8c\x15\xd5\xac\xf1\x6c\x74\x58\x7e\xc6\xa8\x04\x41\x1d\xb8\xff\x34\x56\x90\xef\x3a\xec\xbf\xbb\xf9\x3c\x4c\xcf\x4f\xd6\x8d\x74\xdb\x6a\x81\x8d\x47\x84\x2a\xcf\xa9\xc1\xc1\x1f\x1f\x27\xfd\x8b\x26\x1d\x81\xf6\x3e\x95\xfa\x83\xe1\xf4\xfc\xa4\xff\x18\x84\xfe\xa7\x88\xcf\x1e\xd7\xf7\x56\xad\xdc\xa5\x43\xdb\x1a\xf6\xd8\xbe\xbd\x6d\xdd\xff\xd6\xb3\x47\x5e\x75\x1a\xef\x83\x60\x1f\x40\xf0\x37\x00\x00\xff\xff\x76\x8b\xcc\x2a\x48\x03\x00\x00"
+var _idtablestakingAdminSlash_nodeCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x41\x8b\xdb\x30\x10\x85\xef\xfe\x15\x8f\x3d\x94\x04\x8c\xdd\x43\xe9\xc1\xb4\x0d\xde\x3a\x0b\x81\x65\x29\x1b\xf7\xd0\xa3\x22\x8d\x13\x35\x8e\xc6\x48\xe3\x6e\x60\xc9\x7f\x2f\x72\xe2\xdd\x24\x4d\x83\x20\x42\x1a\xbd\xf7\x8d\xdf\xd8\x5d\xc7\x5e\xf0\xd0\xf2\xcb\xa2\xaa\xd5\xaa\xa5\xa5\xa8\xad\x75\x6b\x34\x9e\x77\xf8\xb8\x5f\x54\xf3\xa7\x7a\x51\xff\xaa\xcb\xfb\xc7\x79\x59\x55\xcf\xf3\xe5\x32\x49\xf2\x1c\xf5\xc6\x06\x88\x57\x2e\x28\x2d\x96\x1d\x42\xab\xc2\x86\x02\x14\x3a\xf2\x9a\x9c\xa8\x35\x81\x1b\xc8\x86\x10\x44\x6d\xc9\xa4\xd0\xbc\xdb\x59\x11\x32\x50\xce\xa0\x77\xe1\x64\x26\xbc\x25\x17\x62\xb5\x82\x63\x43\x69\x74\x88\x25\xdc\xc0\x4a\x80\xa1\x96\xd6\x4a\xd8\x07\xd8\xe6\xe8\x54\x9d\x1d\x45\x90\x9e\xb2\xf8\xa8\x8e\x6e\xf1\x9e\xcc\xa8\xaa\x3c\xc1\x50\xc7\xc1\x46\x63\xe1\x81\xc8\x93\xb6\x9d\x25\x27\x29\xd8\x63\xd5\x7b\x47\x26\x6a\x5f\xdc\xc1\x06\x38\xdb\x26\xc9\x59\x9f\x93\xc8\xb7\xa8\x0a\x2c\xc5\x5b\xb7\x4e\xcf\xba\x2d\xf0\xf3\xc1\xee\x3f\x7f\x4a\xaf\x09\x0b\xdc\x33\xb7\xe9\xbb\x70\x81\xd2\x18\x4f\x21\xcc\xa6\x78\x4d\x12\x00\xc8\x73\x3c\xb2\x56\x2d\xfe\x28\x6f\x63\x10\x68\xd8\x43\xc1\x53\x43\x9e\x9c\xa6\x91\x7c\x51\x61\x08\x0a\xa5\xd9\x59\x07\x5e\xfd\x26\x2d\x83\x44\x4b\x02\x15\x0f\x9f\xa9\x29\xf0\xe1\xdf\x50\xb3\xe1\xc9\xd1\xaf\xf3\xd4\x29\x4f\x13\xa5\xb5\x14\x28\x7b\xd9\x94\x5a\x73\xef\x24\x12\xe1\xf4\xcb\x73\xac\xd8\x7b\x7e\xb9\x05\xa2\xae\xfd\xe3\x0a\xd4\x36\xd9\x08\x81\xaf\x88\xf2\xd9\x51\xe3\xcb\x7f\x89\xbe\x4d\xe2\xb4\x15\x37\xc6\x30\x3b\xfd\x0f\x65\x4b\x61\xaf\xd6\xf4\x43\xc9\x66\xfa\x66\x18\xd7\x6c\x86\x4e\x39\xab\x27\x77\xdf\xb9\x6f\x0d\x1c\xcb\xc8\x7d\x41\x3d\x8e\xdb\xc0\x77\x77\xd4\x38\x1c\x3f\x07\xed\x49\xf7\x42\x78\xbd\xdd\x49\x36\x24\xfa\xc4\x86\x4e\xf9\x5f\xe6\xfe\xbe\xbf\x91\xfd\xd5\xc1\xc5\x18\xbc\x6d\xa7\x09\x00\x1c\x92\x43\xf2\x77\x00\x16\xec\xce\x98\x93\x03\x00\x00"
+
+func idtablestakingAdminSlash_nodeCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_idtablestakingAdminSlash_nodeCdc,
+		"idTableStaking/admin/slash_node.cdc",
+	)
+}
+
+func idtablestakingAdminSlash_nodeCdc() (*asset, error) {
+	bytes, err := idtablestakingAdminSlash_nodeCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "idTableStaking/admin/slash_node.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6f, 0x1, 0xd1, 0x92, 0x5c, 0x20, 0x98, 0xa3, 0xa, 0xd2, 0x58, 0x10, 0xd7, 0xc6, 0x66, 0x74, 0x18, 0xe0, 0x1e, 0x55, 0x77, 0x14, 0x93, 0xcd, 0xd3, 0xa0, 0x2e, 0xf2, 0x7e, 0xf5, 0x21, 0x96}}
+	return a, nil
+}
+
+var _idtablestakingAdminTransfer_minter_deployCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\x4d\x6b\xdb\x40\x10\xbd\xeb\x57\x3c\x72\x28\x32\x38\xb6\x0b\xa5\x14\xe1\x34\xb8\x4e\x0c\x25\xad\x53\xe2\x84\x1e\x42\x0e\xeb\xd5\xd8\x59\x2c\xed\x8a\xd1\xa8\x8a\x09\xfe\xef\x65\x57\x1f\x76\xda\x1e\x2a\xc4\x1e\x66\xde\xbc\x79\x33\xf3\x4c\x5e\x38\x16\x2c\x32\x57\xdf\xbb\x1d\x59\x6c\xd8\xe5\x98\xbc\x2c\xbe\xdd\xfe\xbc\xbf\xbd\xb9\x5e\xce\xae\xae\xee\xae\x57\xab\x28\x12\x56\xb6\x54\x5a\x8c\xb3\x71\x51\xad\x33\xa3\x6f\x68\x5f\x26\x78\x7c\x7c\xf8\x6a\xe5\xd3\xd3\xd3\x10\xda\x59\x61\xa5\x65\xa9\x72\x4a\xb0\x12\x36\x76\xeb\xa3\x29\x25\x68\x51\x43\x30\xd5\x8a\xd3\x59\xee\x2a\x2b\x09\x1e\x16\xe6\xe5\xe3\x87\x2e\x3a\xaf\xfa\xd0\x00\xaf\x51\x04\x14\x4c\x85\x62\x8a\x4b\xb3\xb5\xc4\x09\x66\x95\x3c\xcf\xb4\xf6\xc5\x2d\x02\xc8\x48\xa0\xb4\x16\x5c\x9c\xa6\xe3\x42\xed\x7d\x45\x53\x39\x08\xc8\xf0\x6c\x1c\x63\x47\x7b\x18\x8b\xe3\x1c\x78\x0d\x39\xff\x7b\xaa\x91\x4a\xd3\x1f\x5d\x32\xde\xd1\xbe\xa9\x3f\x78\x49\xc0\x78\x3c\xc6\x17\xc7\xec\x6a\x28\x30\x6d\x88\xc9\x6a\x82\x38\xc8\x33\x85\x5d\xa2\x59\xe6\x2c\xcd\x8d\xf5\x8d\x7c\x5c\x35\xba\x50\x8a\x63\xb5\xa5\x5e\xfa\xa6\xdb\x7d\x83\xbe\x68\x15\x8f\xd6\xa1\xc3\xf4\x5d\x7f\x9b\x51\x00\x98\x52\x58\x89\xe3\xcf\xb1\x3f\x55\x82\x71\xcb\x37\x7e\xcb\x33\xe8\xe7\xb9\xbc\x44\xa1\xac\xd1\xf1\xd9\xdc\x55\x59\x0a\xeb\x04\xeb\xff\x57\xcf\x54\xba\x8a\x35\x9d\x0d\x8e\xc3\xcf\x99\x94\x10\x14\xfa\x9e\xdf\x8d\x15\xe2\xbb\x16\xfb\xf7\x6c\x4d\x1e\xd3\xf3\x63\x28\x4c\x33\xd2\x81\x6a\x49\x75\x83\x88\x55\x96\xb9\x9a\x7a\x7f\xbc\x9f\x74\xdf\x68\xd2\x0a\x08\xf7\x29\xd5\x2f\x8a\xa7\xe7\x7f\xf0\x0f\x21\xee\x5f\x1b\x69\xb8\x4f\xeb\x3b\xab\x96\xfe\xd2\xb1\x0d\x86\x3d\xb5\x6f\x67\x5b\xff\xbe\xf5\xec\x89\x57\x07\x11\x70\x88\xa2\x43\x84\xe8\xf7\x00\x76\x8b\xcc\x2a\x48\x03\x00\x00"
 
 func idtablestakingAdminTransfer_minter_deployCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -600,6 +1142,26 @@ func idtablestakingAdminTransfer_minter_deployCdc() (*asset, error) {
 	return a, nil
 }
 
+var _idtablestakingAdminTransfer_minter_deploy_hexCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\x4d\x6b\xdb\x40\x10\xbd\xeb\x57\x0c\x39\x94\x15\x24\x92\x0b\xa5\x14\xe1\x34\xa8\x4e\x4c\x4b\x5a\xa7\xc4\x09\x3d\x84\x1c\xd6\xab\xb1\xb3\x58\xda\x15\xa3\x51\x65\x13\xfc\xdf\xcb\xae\x3e\x2c\xb7\x3d\x44\x88\x15\x9a\x79\xf3\xf6\xcd\xcc\xd3\x45\x69\x89\x61\x9e\xdb\xe6\xc1\x6e\xd1\xc0\x9a\x6c\x01\x93\xdd\xfc\xfb\xdd\xaf\x87\xbb\xdb\x9b\x45\x7a\x7d\x7d\x7f\xb3\x5c\x06\x01\x93\x34\x95\x54\xac\xad\x11\x65\xbd\xca\xb5\xba\xc5\x7d\x95\xc0\xd3\xd3\xe3\x37\xc3\x9f\x9e\x9f\xcf\x41\x59\xc3\x24\x15\x2f\x64\x81\x09\x2c\x99\xb4\xd9\xb8\x68\x36\xfa\x23\x6c\x24\x65\x69\x61\x6b\xc3\x09\x3c\xce\xf5\xee\xe3\x87\x3e\x3a\xab\x87\x50\x08\xaf\x41\x00\x50\x12\x96\x92\x50\x54\x7a\x63\x90\x12\x48\x6b\x7e\x49\x95\x72\xc5\x1d\x02\x20\x47\x06\xa9\x14\xc3\xe5\x38\x2d\x4a\xb9\x77\x15\x6d\x65\xe8\x91\xfe\x58\x5b\x82\x2d\xee\x41\x1b\x38\xb6\x01\xaf\x3e\xe7\x5e\x47\x15\xc9\x2c\xfb\xd9\x27\xc5\x16\xf7\x6d\xfd\xc1\x49\x02\x88\xe3\x18\xbe\x58\x22\xdb\x80\x04\xc2\x35\x12\x1a\x85\xc0\x16\xf8\x05\xfd\x28\xa1\x9d\x65\x9a\x15\xda\xb8\x8b\x5c\x5c\xb6\xba\xa0\x62\x4b\x72\x83\x83\xf4\x75\x3f\xfa\x16\x7d\xd9\x29\x8e\x56\xfe\x86\xe9\xbb\x61\x35\x91\x07\xe8\x8a\x49\xb2\xa5\xcf\xc2\x6d\x2a\x81\xb8\xe3\x8b\x4f\x79\xc2\xa1\x9f\xab\x2b\x28\xa5\xd1\x4a\x9c\xcd\x6c\x9d\x67\x60\x2c\xc3\xea\xed\xea\x09\x2b\x5b\x93\xc2\xb3\xf0\xd8\xfc\x8c\x50\x32\x82\x84\xe1\xce\x1f\xda\x30\xd2\x7d\x87\xfd\xb7\xb7\x36\x0f\xd3\x8b\x63\xc8\x77\x13\x29\x4f\xb5\xc0\xa6\x45\x08\x99\xe7\xb6\xc1\xc1\x1f\xef\x27\xfd\x13\x4d\x3a\x01\x7e\x3f\x95\xfc\x8d\x62\x7a\xf1\x17\xff\x39\xb0\xfd\xdf\x44\x5a\xee\x71\x7d\xef\xd4\xca\x6d\x5a\x18\xef\xd7\xb1\x7b\x7b\xd7\xba\x33\xca\xd0\x7d\xbe\xe2\x4e\x84\xa7\xfe\x1d\xf9\x36\x0c\x00\x0e\x41\x70\x08\x20\xf8\x33\x00\x40\xce\xaa\x0c\x53\x03\x00\x00"
+
+func idtablestakingAdminTransfer_minter_deploy_hexCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_idtablestakingAdminTransfer_minter_deploy_hexCdc,
+		"idTableStaking/admin/transfer_minter_deploy_hex.cdc",
+	)
+}
+
+func idtablestakingAdminTransfer_minter_deploy_hexCdc() (*asset, error) {
+	bytes, err := idtablestakingAdminTransfer_minter_deploy_hexCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "idTableStaking/admin/transfer_minter_deploy_hex.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x30, 0xa0, 0x4d, 0x41, 0x19, 0x3e, 0x58, 0x8f, 0xa1, 0x83, 0x69, 0xb7, 0x7c, 0x47, 0x9a, 0xb, 0x8f, 0x37, 0xf8, 0xed, 0xcf, 0x2, 0xc3, 0x23, 0xd0, 0xca, 0x86, 0x79, 0x5b, 0x48, 0xf7, 0x92}}
+	return a, nil
+}
+
 var _idtablestakingDelegationDel_request_unstakingCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\x41\x6b\xe3\x40\x0c\x85\xef\xfe\x15\x22\x87\xc5\xb9\xd8\x7b\x58\xf6\x60\x76\x1b\xdc\x3a\x81\x40\x08\x25\x76\x0e\x3d\x2a\x63\x39\x71\x3b\x19\xb9\xb2\xdc\x04\x4a\xfe\x7b\x99\xba\x31\x2d\x35\x54\x17\x1d\x66\xa4\xf7\xbd\xa7\xfa\xd8\xb0\x28\x2c\x2c\x9f\x96\x59\x81\x3b\x4b\xb9\xe2\x53\xed\xf6\x50\x09\x1f\xe1\xf7\x79\x99\xcd\xd7\xc5\xb2\x78\x28\xd2\xdb\xd5\x3c\xcd\xb2\xcd\x3c\xcf\x83\x20\x50\x41\xd7\xa2\xd1\x9a\x5d\x88\x47\xee\x9c\x26\xb0\x5d\xd4\xe7\xbf\x7f\xa6\xf0\x1a\x04\x00\x00\x71\x0c\x2b\x36\x68\xe1\x05\xa5\xf6\x9b\xa1\x62\x01\x04\xa1\x8a\x84\x9c\x21\x50\x06\x3d\x10\x64\x64\x69\x8f\xca\x02\xbc\x7b\x24\xa3\xef\xd3\x96\x14\xca\xeb\xc3\x86\xaa\x04\x7e\x7d\x87\x8c\xd6\x5c\xd2\x30\xde\xcb\x36\x42\x0d\x0a\x85\x68\x8c\x26\x90\x76\x7a\x48\x8d\xf1\x80\x1e\x0c\x3e\x2a\x8e\x61\xc7\x22\x7c\x1a\xe3\x29\xc7\x78\x7c\xb5\x64\xab\xe8\x33\x14\xfc\x07\x2f\x13\xf5\xbb\xfe\xfd\x48\x78\x13\xfa\x54\x93\x91\xb8\xa3\xe1\x4f\xae\x2c\xb8\xa7\x7b\xd4\xc3\x74\x50\xf6\x35\x9b\x41\x83\xae\x36\xe1\xe4\x8e\x3b\x5b\x82\x63\xbd\x9a\xf8\x62\x61\x00\x9c\x4c\xfb\x44\x2e\x7d\xa3\x33\x99\x4e\xe9\x7a\x9e\x51\x43\x91\xd0\x73\x47\xad\x6e\x5d\xdb\x73\x0d\xc7\xed\xfb\xb0\xf1\xf2\x16\x00\x00\xff\xff\x59\x8c\xf0\xa8\x39\x02\x00\x00"
 
 func idtablestakingDelegationDel_request_unstakingCdcBytes() ([]byte, error) {
@@ -1200,6 +1762,46 @@ func idtablestakingScriptsGet_cut_percentageCdc() (*asset, error) {
 	return a, nil
 }
 
+var _idtablestakingScriptsGet_maximum_delegation_ratiosCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\x31\x6b\xc3\x40\x0c\x46\xf7\xfb\x15\xdf\x98\x2c\x4d\x87\x52\x4a\xb6\x14\x27\x60\x68\x3b\xc4\xce\xd0\x51\x89\x65\x9f\x88\x7d\x3a\xee\x64\xea\x12\xf2\xdf\x8b\xeb\x8e\x1d\xf5\x78\x82\xf7\xc9\x10\x35\x19\x0e\xbd\x7e\x95\x45\x4d\xe7\x9e\x2b\xa3\xab\x84\x0e\x6d\xd2\x01\x8f\x53\x59\xec\x3f\xea\xb2\xfe\xac\x77\xaf\x6f\xfb\x5d\x51\x1c\xf7\x55\xe5\xdc\x66\x83\xda\x4b\x46\xbe\x24\x89\x86\xc4\x36\xa6\x90\x61\x9e\xe1\xa5\xf3\x9c\x0d\x89\x4c\x14\xda\xa2\xe1\x9e\x3b\x32\x6e\x60\x7a\xe5\x90\xe7\x67\xd3\x5f\x77\x01\xb3\x34\x5f\x41\x1b\x86\x46\x4e\x64\x9a\xd0\x6a\x02\xd3\xc5\x2f\xd8\xbe\x23\xc3\x3c\x19\x3c\x65\x68\x60\xe7\xe2\x78\x46\x3b\x06\x0c\x24\x61\xb5\xde\xe2\x76\x2a\x83\xbd\x6c\x71\x3a\xc8\xf4\xfc\x74\xc7\xcd\x01\xf8\x4b\xfb\x67\xe0\x43\xc7\xf6\x4e\x93\x0c\xe3\x50\x2c\x85\xa2\xe1\x48\x26\x9a\x57\x6b\x77\x77\x3f\x03\x00\x24\x07\xbb\xa8\x1a\x01\x00\x00"
+
+func idtablestakingScriptsGet_maximum_delegation_ratiosCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_idtablestakingScriptsGet_maximum_delegation_ratiosCdc,
+		"idTableStaking/scripts/get_maximum_delegation_ratios.cdc",
+	)
+}
+
+func idtablestakingScriptsGet_maximum_delegation_ratiosCdc() (*asset, error) {
+	bytes, err := idtablestakingScriptsGet_maximum_delegation_ratiosCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "idTableStaking/scripts/get_maximum_delegation_ratios.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xad, 0x39, 0x75, 0x1e, 0x6b, 0xb2, 0x4f, 0xa9, 0x8b, 0x9b, 0xd0, 0xb4, 0xe, 0xee, 0x9b, 0x72, 0x39, 0x53, 0x83, 0xc6, 0xac, 0xc8, 0xd9, 0x94, 0x22, 0x6c, 0xfa, 0x8d, 0x96, 0x95, 0x13, 0x7c}}
+	return a, nil
+}
+
+var _idtablestakingScriptsGet_maximum_initial_weightCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xce\x31\x4e\x03\x31\x10\x46\xe1\xde\xa7\xf8\xcb\xa4\x21\x14\x88\x82\x2e\xc8\x8b\x64\x09\x28\x58\x23\x44\x39\x09\x13\x7b\xc4\x7a\xbc\xb2\xc7\x4a\x24\xc4\xdd\x29\xa0\xe4\x02\xef\x7d\x52\xd6\xda\x0c\x0f\x4b\x3d\x07\x1f\xe9\xb0\xf0\x6c\xf4\x29\x9a\x70\x6a\xb5\xe0\xfa\x12\xfc\xf4\x1c\x43\x7c\x8f\xfb\xfb\xc7\x69\xef\xfd\xcb\x34\xcf\xce\xed\x76\x88\x59\x3a\xfa\xb1\xc9\x6a\x68\x6c\xa3\x69\x87\x65\x46\x96\x94\xb9\x1b\x44\xc5\x84\x16\x9c\x59\x52\x36\x58\x26\x03\x41\xeb\x07\xe3\x48\x8a\xc4\xe6\xdc\x3a\x0e\x38\x0d\x45\x21\xd1\xcd\xf6\x0e\xaf\x41\xed\xf6\x06\x5f\x0e\xc0\x5f\xf4\x1f\xda\x55\x62\x7b\xa2\x8b\x94\x51\xc2\xef\xe4\x8d\x25\x65\xdb\x6c\xdd\xb7\xfb\x19\x00\x65\xf1\x6b\x4f\xd1\x00\x00\x00"
+
+func idtablestakingScriptsGet_maximum_initial_weightCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_idtablestakingScriptsGet_maximum_initial_weightCdc,
+		"idTableStaking/scripts/get_maximum_initial_weight.cdc",
+	)
+}
+
+func idtablestakingScriptsGet_maximum_initial_weightCdc() (*asset, error) {
+	bytes, err := idtablestakingScriptsGet_maximum_initial_weightCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "idTableStaking/scripts/get_maximum_initial_weight.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbb, 0x3, 0x72, 0x99, 0xfc, 0x32, 0xa5, 0x5d, 0xb, 0x5d, 0xc7, 0xab, 0xdf, 0xbf, 0x35, 0xc6, 0x82, 0x6f, 0x41, 0x76, 0x2d, 0x5f, 0x27, 0x7e, 0xe9, 0x18, 0x69, 0x0, 0x40, 0x73, 0xd7, 0xf1}}
+	return a, nil
+}
+
 var _idtablestakingScriptsGet_node_committed_tokensCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\x31\x6b\xc3\x30\x10\x46\x77\xfd\x8a\x6f\x6c\x96\xa4\x43\xe9\x10\xe8\x90\x56\x0e\x08\x4a\x86\x5a\x1d\x3a\xca\xf1\x39\x11\xb6\xee\x8c\x74\xa6\x81\xd2\xff\x5e\x12\x17\x4f\x99\x0e\xbe\xe3\x3d\x5e\x4c\xa3\x64\xc5\x7e\x90\x6f\x67\x7d\x68\x06\xaa\x35\xf4\x91\x4f\xe8\xb2\x24\x3c\x5e\x9c\xad\x0e\xde\xf9\x2f\xbf\x7b\x7d\xaf\x76\xd6\x7e\x54\x75\x6d\xcc\x66\x03\x7f\x8e\x05\xe5\x98\xe3\xa8\xc8\xa4\x53\xe6\x02\x3d\x13\x9a\x30\x04\x3e\x12\xa4\x43\xd1\xd0\x53\x0b\x95\x9e\xb8\x5c\x87\x00\x96\x96\x8c\x19\xa7\x06\xdd\xc4\x48\x21\xf2\xc3\x75\x72\x76\x8b\x5a\x73\xe4\xd3\x6a\x8b\xcf\x7d\xbc\x3c\x3f\xe1\xc7\x00\xc0\x40\x7a\x83\x1c\x77\x82\x97\x3b\xa1\xeb\xc3\xff\x77\x11\xcd\x77\x75\xc3\xe7\xb2\xc5\xb0\x9e\x5b\xde\x24\xa5\xa8\x4a\xad\xf9\xfd\x0b\x00\x00\xff\xff\xd7\xe0\x3c\x12\x01\x01\x00\x00"
 
 func idtablestakingScriptsGet_node_committed_tokensCdcBytes() ([]byte, error) {
@@ -1220,6 +1822,26 @@ func idtablestakingScriptsGet_node_committed_tokensCdc() (*asset, error) {
 	return a, nil
 }
 
+var _idtablestakingScriptsGet_node_delegation_capacityCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcf\xb1\x6a\xeb\x40\x10\x85\xe1\x7e\x9f\xe2\x94\x36\x5c\xae\x53\x84\x14\x6e\x82\x93\xb5\x41\x10\x5c\x58\x4a\x91\x72\x2d\x8d\xe4\xc1\xab\x19\xb1\x3b\x22\x36\x21\xef\x1e\xd6\x98\x54\x69\x87\x9f\x8f\x33\x3c\x4e\x9a\x0c\xbb\xa8\x9f\x95\x6f\xc2\x31\x52\x6d\xe1\xcc\x32\xa0\x4f\x3a\xe2\xe1\x52\xf9\xed\xbe\xa9\x9a\x8f\x66\xf3\xf2\xb6\xdd\x78\x7f\xd8\xd6\xb5\x73\xab\x15\x9a\x13\x67\xe4\x36\xf1\x64\x48\x64\x73\x92\x0c\x3b\x11\xc2\xa8\xb3\x18\xb4\x87\xe9\x99\x6e\xc7\x60\x68\x83\x20\x1b\xc7\x88\x23\xa1\xa3\x48\x43\x30\xea\x60\x8a\x00\xd1\x8e\xfe\x15\x52\x13\x84\x23\xb8\xbf\x41\xf7\x8a\x55\x4a\x56\xa2\x5c\x54\xb6\x0c\xbb\x4e\x04\xce\x10\x35\x44\x1e\xd9\xa8\x73\x6e\x9a\x8f\xe8\x67\xc1\x18\x58\x16\x25\xaf\xfc\x1a\xb5\x25\x96\x61\xb9\xc6\xfb\x8e\x2f\x4f\x8f\xcf\xf8\x72\x00\xee\x83\xff\x78\xfb\xff\x40\xb6\xd7\x8e\x0e\x54\x18\x96\xc1\xff\xae\x78\x0d\x53\x68\xd9\xae\x0b\xd1\x8e\x2a\xbf\x74\xdf\xee\x67\x00\x4a\x3f\x3e\x2c\x3e\x01\x00\x00"
+
+func idtablestakingScriptsGet_node_delegation_capacityCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_idtablestakingScriptsGet_node_delegation_capacityCdc,
+		"idTableStaking/scripts/get_node_delegation_capacity.cdc",
+	)
+}
+
+func idtablestakingScriptsGet_node_delegation_capacityCdc() (*asset, error) {
+	bytes, err := idtablestakingScriptsGet_node_delegation_capacityCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "idTableStaking/scripts/get_node_delegation_capacity.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe, 0xec, 0xca, 0xb5, 0x85, 0xf4, 0x9a, 0x2b, 0x63, 0xd3, 0x54, 0x13, 0x3b, 0x4c, 0x2, 0x9f, 0x70, 0x60, 0x4f, 0xdb, 0x9a, 0xe9, 0x74, 0xd8, 0x49, 0xf, 0xed, 0x6e, 0xd, 0xef, 0x28, 0x89}}
+	return a, nil
+}
+
 var _idtablestakingScriptsGet_node_infoCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xce\x31\x4b\xc5\x30\x14\xc5\xf1\x3d\x9f\xe2\x8c\x76\xb1\xce\xdd\x2a\xa9\x10\x90\x0e\x26\x8b\x63\x6a\xd3\xf6\x62\x7a\x53\x92\x1b\x14\xc4\xef\x2e\xc5\xc7\x9b\x1e\x6f\x3a\xcb\x9f\x1f\x87\xf6\x23\x65\xc1\x4b\x4c\x5f\x46\x3b\x3f\xc5\x60\xc5\x7f\x12\xaf\x58\x72\xda\xf1\xf4\x6d\xf4\x30\x3a\xe3\xde\x5d\xff\xfc\x3a\xf4\x5a\xbf\x0d\xd6\x2a\xd5\xb6\x70\x1b\x15\x94\x8f\x4c\x87\x60\x0d\x52\xe0\x63\x84\x6c\x01\xc4\x4b\x82\x9f\x52\x15\x78\x70\x9a\x03\x3c\xcf\xc8\x41\x6a\xe6\x02\x12\xa5\x8e\x3a\x61\xa9\x8c\xdd\x13\x3f\x9c\x85\xd1\x1d\xac\x64\xe2\xb5\xe9\x6e\x7c\x79\x1c\xcf\xe6\x64\x7f\x14\x80\x8b\x75\x2f\xbc\xaa\xff\xdb\xa8\x5f\xf5\x17\x00\x00\xff\xff\x58\x6d\x78\x81\xea\x00\x00\x00"
 
 func idtablestakingScriptsGet_node_infoCdcBytes() ([]byte, error) {
@@ -1260,7 +1882,7 @@ func idtablestakingScriptsGet_node_info_from_addressCdc() (*asset, error) {
 	return a, nil
 }
 
-var _idtablestakingScriptsGet_node_initial_weightCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xce\x41\x4b\xc4\x30\x10\x05\xe0\x7b\x7e\xc5\x3b\xba\x97\x5d\x0f\xe2\x61\xc1\xc3\x4a\x2a\x04\xa4\x07\x13\x11\x8f\xa9\x26\xed\x60\x3a\x29\xe9\x94\x0a\xe2\x7f\x17\xdb\xd2\xd3\x9e\x06\xe6\xf1\x1e\x1f\xf5\x43\x2e\x82\xa7\x94\x67\xa3\x9d\x6f\x52\xb0\xe2\xbf\x88\x5b\xc4\x92\x7b\xdc\x7e\x1b\x5d\xd5\xce\xb8\x77\x77\x79\x7c\xae\x2e\x5a\xbf\x54\xd6\x2a\x75\x3a\xc1\x75\x34\x62\xfc\x28\x34\x08\x4a\x90\xa9\xf0\x08\xe9\x02\x88\x49\xc8\x27\xcc\x81\xda\x4e\x90\x23\x3c\x38\x7f\x06\xa5\x86\xa9\x41\x9c\x18\xbd\x27\xbe\xf9\x7f\x19\x7d\x86\x95\x42\xdc\x1e\xce\x78\x35\x2c\xf7\x77\xf8\x51\x00\x90\x82\x2c\x25\xc3\x31\xe3\xe1\x0a\xef\x58\x6f\xe9\x3e\xb4\xde\xc3\x52\x5f\x3d\xfb\xc2\x71\x33\xbd\x2d\x24\xf5\xfb\x17\x00\x00\xff\xff\x4a\xf0\xca\x23\xf5\x00\x00\x00"
+var _idtablestakingScriptsGet_node_initial_weightCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\x3f\x4b\xc4\x40\x10\xc5\xfb\xfd\x14\xaf\xf4\x40\xee\x2c\xc4\xe2\xc0\xe2\x24\x11\x16\xe4\x0a\x13\x11\xcb\xbd\x64\x92\x1d\x2e\x99\x0d\xbb\x13\x22\x88\xdf\x5d\xf2\x87\xab\xac\x66\x60\xf8\xfd\xe6\x3d\xee\x87\x10\x15\xaf\x5d\x98\x6c\x56\xba\x4b\x47\x85\xba\x2b\x4b\x8b\x26\x86\x1e\x0f\xdf\x36\xcb\xcf\xa5\x2d\xbf\xca\xd3\xcb\x5b\x7e\xca\xb2\xf7\xbc\x28\x8c\x39\x1c\x50\x7a\x4e\x48\x55\xe4\x41\x11\x49\xc7\x28\x09\xea\x09\x2c\xac\xec\x3a\x4c\xc4\xad\x57\x84\x06\x0e\x12\x6a\xba\x9f\xa1\xc9\x73\xe5\x31\x83\xa4\xeb\x07\xd6\x84\x2a\xf4\x3d\xab\x52\x8d\xa4\xee\x4a\x70\xba\x98\x48\xea\x19\x9f\xd7\xb4\x85\x72\x63\xa5\x1c\xc4\x98\x61\xbc\xa0\x19\x05\xbd\x63\xb9\x9b\xf5\x36\x3b\xa2\xd0\xc8\xd2\xee\x8e\xf8\xb0\xa2\x4f\x8f\xf8\x31\x00\xd0\x91\x2e\x01\xac\x34\x01\xcf\xff\x54\xdd\x9f\xb7\xeb\x4d\xb4\xce\xdd\x82\xaf\xdd\x6e\x86\xfd\xd6\xef\x93\xb8\xf5\x6a\x7e\xcd\xdf\x00\xe1\x3a\xd4\x3a\x42\x01\x00\x00"
 
 func idtablestakingScriptsGet_node_initial_weightCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -1276,7 +1898,7 @@ func idtablestakingScriptsGet_node_initial_weightCdc() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "idTableStaking/scripts/get_node_initial_weight.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf, 0xc0, 0x17, 0x67, 0x2c, 0xf0, 0xf8, 0xba, 0x42, 0x20, 0x9f, 0x54, 0x8b, 0x43, 0x3, 0x86, 0x8c, 0x2b, 0xbf, 0x6b, 0x3f, 0xa2, 0x5f, 0x47, 0xbc, 0x87, 0xa7, 0x24, 0x30, 0xc2, 0x95, 0xf5}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xfe, 0x5e, 0x4, 0x6e, 0xad, 0x8a, 0x1d, 0xff, 0xc2, 0x65, 0xb, 0x6d, 0x77, 0x5e, 0xa3, 0x71, 0x1, 0xc5, 0xbf, 0x9f, 0x26, 0xb0, 0x43, 0x5f, 0x6f, 0xc0, 0xfb, 0x25, 0xe8, 0x7f, 0xe3, 0xfa}}
 	return a, nil
 }
 
@@ -1540,6 +2162,26 @@ func idtablestakingScriptsGet_proposed_tableCdc() (*asset, error) {
 	return a, nil
 }
 
+var _idtablestakingScriptsGet_reward_ratiosCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xce\xb1\x6a\xc3\x30\x10\x87\xf1\x5d\x4f\xf1\x1f\x93\xa5\xe9\x50\x4a\xc9\x96\x62\x07\x0c\xa5\x83\xad\x0c\x1d\x2f\xce\xd9\x3a\x1a\xeb\x84\x74\x22\x09\x21\xef\x5e\x28\x1d\x3b\x7e\xd3\xf7\x93\x25\x69\x36\xec\xcf\x7a\xe9\x1a\x4f\xc7\x33\x0f\x46\xdf\x12\x67\x4c\x59\x17\x3c\x5f\xbb\xa6\xfd\xf4\x9d\xff\xf2\xbb\xf7\x8f\x76\xd7\x34\x7d\x3b\x0c\xce\x6d\x36\xf0\x41\x0a\xca\x98\x25\x19\x32\x5b\xcd\xb1\xc0\x02\x23\x93\x89\x42\xa7\xdf\xe0\xa4\x63\x40\xa2\x9b\x56\x83\x05\x32\x30\x8d\x01\x51\x4f\x0c\xbb\x25\xc6\xcc\x56\x9c\x4b\xf5\x88\xa9\x46\x2c\x24\x71\xb5\xde\xe2\x7e\xe8\xa2\xbd\x6d\x71\xd8\xcb\xf5\xf5\xe5\x81\xbb\x03\xf0\x77\xf9\xc7\xfa\x34\xb3\xf5\x7c\xa1\x7c\xea\xc9\x44\xcb\x6a\xed\x1e\xee\x67\x00\xd0\x0f\xac\xe5\xda\x00\x00\x00"
+
+func idtablestakingScriptsGet_reward_ratiosCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_idtablestakingScriptsGet_reward_ratiosCdc,
+		"idTableStaking/scripts/get_reward_ratios.cdc",
+	)
+}
+
+func idtablestakingScriptsGet_reward_ratiosCdc() (*asset, error) {
+	bytes, err := idtablestakingScriptsGet_reward_ratiosCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "idTableStaking/scripts/get_reward_ratios.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe1, 0x21, 0x89, 0x74, 0x75, 0xa8, 0xf1, 0x18, 0x6a, 0x20, 0xae, 0x12, 0xac, 0xb5, 0xe4, 0xec, 0x9d, 0xe8, 0xfe, 0x8b, 0x1f, 0x29, 0x5a, 0x38, 0xba, 0x4d, 0xc8, 0xdf, 0xbe, 0xcf, 0x17, 0x68}}
+	return a, nil
+}
+
 var _idtablestakingScriptsGet_stake_requirementsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\x31\x4b\x33\x41\x14\x45\xfb\xf9\x15\xf7\xeb\x92\xe6\x8b\x85\x88\x04\x2c\x22\xbb\x81\x05\xb5\xc8\x4e\x0a\x11\x8b\xd9\xe4\x6d\xf2\xd8\x99\x37\x9b\x99\x37\x18\x10\xff\xbb\x8c\xb6\xb6\x07\xee\xb9\x87\xc3\x1c\x93\x62\xeb\xe3\x47\xd7\x58\x37\x78\xea\xd5\x4d\x2c\x27\x8c\x29\x06\xdc\x5c\xbb\xa6\x7d\xb1\x9d\x7d\xb5\x9b\xc7\xa7\x76\xd3\x34\xbb\xb6\xef\x8d\x59\xad\x60\xcf\x9c\x91\x0f\x89\x67\x45\x22\x2d\x49\x32\xf4\x4c\x18\x9c\x77\x72\x20\xc4\x11\x59\xdd\x44\x47\x68\x9c\x48\x72\x05\x0e\x12\x8f\x64\xcc\x5c\x06\x8c\x45\x10\x1c\xcb\x22\x45\x4f\x6b\xec\x3b\xd1\xfb\xe5\x1a\xfb\x2d\x5f\xef\x6e\xf1\x69\x00\xc0\x53\x75\x5f\xf0\xf0\x47\xe0\xff\x13\xe9\x33\x0b\x87\x12\x2a\xa1\x1d\x5d\x0a\x27\x0a\x24\x9a\x17\x4b\xf3\xb3\xff\xed\xaa\x8a\xb7\xfa\xf2\xfe\xcf\x7c\x7d\x07\x00\x00\xff\xff\x94\x17\x67\xd0\xf1\x00\x00\x00"
 
 func idtablestakingScriptsGet_stake_requirementsCdcBytes() ([]byte, error) {
@@ -1560,6 +2202,26 @@ func idtablestakingScriptsGet_stake_requirementsCdc() (*asset, error) {
 	return a, nil
 }
 
+var _idtablestakingScriptsGet_supply_totalsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x57\x5f\x6b\xeb\x36\x14\x7f\xf7\xa7\x38\xf7\x65\x38\xdc\xce\xe9\xc3\x18\x23\xcc\xbb\xb4\x37\xed\x16\x56\x52\x68\xdd\x5d\x46\xb9\x0c\xc5\x96\x13\x11\x47\x32\x92\xdc\xdc\x50\xf2\xdd\x87\x64\x49\x96\x63\x39\x29\x81\xd6\xd6\x39\xe7\x77\xfe\xe8\xa7\x73\x64\xb2\xab\x19\x97\x70\xdf\xd0\x35\x59\x55\x38\x63\x5b\x4c\xa1\xe4\x6c\x07\xd7\x3f\xee\x5f\x96\x7f\x2e\x6e\x1f\xee\xb2\xc7\xbf\xef\x96\x37\xf3\xf9\xd3\xdd\xf3\x73\x64\x0d\x2a\xb6\xef\x2b\x3f\x3c\x7e\x1b\x53\x5c\xcc\x33\xb4\xaa\xf0\xb3\x44\x5b\x42\xd7\xd6\x62\x31\xbf\x5b\x66\x8b\xec\xdf\xec\xe6\xf6\xe1\xce\x5a\x45\xd3\x29\x64\x1b\x22\x40\xe4\x9c\xd4\x12\x44\xb3\x13\x20\x37\x18\xa4\x76\xf6\x86\x9a\x4a\x0a\x60\x25\xa0\xaa\xd2\xeb\x94\x15\x58\x00\xa2\x05\x14\xb8\xc2\x6b\x24\x19\xd7\x72\x8c\xf2\x0d\x70\x56\x61\x85\xa8\xc4\x1c\xcb\x86\x53\x0d\xb6\x83\x3d\x91\x1b\x03\x2b\x51\x25\x40\x48\xb4\xc5\x05\xc8\x0d\x92\x7a\x39\x67\x54\x72\x94\x4b\xe0\x38\x67\xbc\x10\x50\x32\xde\x41\x26\xd1\x74\xaa\x60\x17\x12\x50\x25\x98\x0f\x0d\x2b\x54\x21\x9a\x63\x15\x82\x02\xd2\x01\xdb\x17\x61\x0a\x80\xf2\x9c\x35\x54\xea\xa8\xd5\xfa\xfd\xc3\xe3\x37\x85\xa7\xdd\x13\x01\x94\x49\x20\xb4\xb3\x17\x16\x60\x4d\xde\x30\xb5\xe6\xe2\x0a\xf6\x1b\x92\x6f\x80\xe8\xa4\x34\x4a\x9b\x0f\x88\xa6\xae\xab\x83\x82\xdc\x11\xda\x68\x31\xe1\x36\x34\x91\x40\x16\x08\x46\xd5\x7c\x4b\xea\x1a\x17\x40\x4a\x20\x52\xc1\x6a\x7f\x2a\x5b\x78\xa4\xd5\x41\xad\xe3\x37\xcc\x0f\xc0\xe4\x06\x73\x67\xa9\xc3\xde\xb0\xaa\x10\x6d\x0c\xd6\xf0\x0a\xa4\xda\x49\x22\x86\x49\x86\xaa\xd1\x22\x10\x0a\x44\x0a\x53\x37\x55\xa0\x60\x21\x42\xbb\x9e\x44\x51\xdd\xac\x40\x48\xde\xe4\x12\x9e\x98\x62\xb3\xde\xdc\xf7\x08\x00\xa0\x6e\x56\xb1\xc0\x72\x02\x6f\x88\xb7\x6c\x12\x5f\xd9\x6e\x47\xa4\xc4\xc5\x0c\x5e\xee\xc9\x8f\x5f\x7f\x19\x53\x54\xcc\xbd\xac\xf5\x42\x4d\x4a\x1f\x53\xbc\x0c\xf8\x84\xf7\x88\x17\x43\x3d\xa8\xb0\x6c\x77\x3a\xf3\xc2\xbb\x3d\x2c\x59\x81\xb3\x43\x8d\x9d\xbe\x36\x20\x94\xc8\xf8\x03\xda\x13\x53\x28\xf5\x13\xb8\x2a\x93\x93\x22\x41\x0a\xd7\xc9\x75\x48\xa5\xf5\x3f\x2e\x77\x85\xb9\xa4\x72\x0e\xc4\x16\x23\xac\x31\x9a\x1d\xa4\xe7\x2a\xa5\x61\x8e\xd1\xb1\x47\x9d\x67\x7d\x7a\x4e\xc9\xa3\x8b\xae\x1a\x8a\x98\xc1\xfb\xcb\x82\xca\xdf\x66\x1e\xc9\x8e\x3d\x35\x93\xee\x4d\x7b\x40\x6e\xdb\x73\xe7\x76\xc5\xd7\x6c\x73\xfb\x0b\x57\x45\x60\xd3\xc6\xbd\x5d\x9d\x77\x71\x15\xc0\x1d\x6c\xaf\x46\x87\x54\x77\x34\xd1\x17\x05\xc1\x21\x0d\x3b\xed\x9b\x76\x8e\x21\xf5\xa2\xe8\x17\xba\x6c\xa8\xed\x46\xf1\x7f\x80\x8a\x82\x63\x21\x66\x70\xd3\x3e\x4c\x6c\xc8\x26\x62\x52\xea\xa2\xea\x96\xf0\x84\x4b\x48\x61\x8d\xa5\x89\x21\x36\xc6\x13\x17\x45\xb2\xc6\xf2\x2b\xaa\xd1\x8a\x54\x44\x1e\xe2\x69\xdd\xac\x2a\x92\x4f\x4b\x3b\xb0\x4c\xd4\x9e\xc1\x8a\x71\xce\xf6\xbf\xff\xe4\x66\x5a\xf2\x8f\x72\xf5\xde\x1b\x8a\x89\xb1\x3b\xfe\x11\xab\x4a\x3a\xeb\xb6\xf7\xbb\xe0\x12\x93\x96\xc9\x37\xf2\x54\xd4\xe1\xf1\xf2\xdf\x21\x42\x63\xd3\xfb\xc4\x0c\x5e\x4d\xf2\xdf\x27\xb3\x10\x01\xcf\x93\x0f\x52\x78\x37\xce\x5c\x6b\x70\x87\x72\x38\x81\x55\x89\xb2\xf1\x43\x11\xb7\xb5\x51\x23\x4f\xb9\xd4\xa3\xa8\x43\x4c\xb6\xf8\x60\xa3\x52\x3f\xa5\x22\x5e\xd5\xdf\xef\x90\x7a\x41\x9d\x6f\x39\x5e\x88\xad\xed\xa7\x89\x5f\x32\xe5\x5b\xf5\xf8\xc5\x5c\x79\x0f\x67\xa0\xc0\x16\x73\x11\xfb\xc4\x56\xd9\x6b\x3b\x5a\xb2\x70\xea\x4b\x23\x8d\xb5\xda\x7c\x66\xdc\x74\x74\x70\x05\x74\x67\xe3\xd5\x22\x26\x3a\x52\xf8\xf2\xe5\xc3\x69\x5e\x27\xd7\x93\x8e\x2b\x5a\x55\x04\xfa\xea\x88\xe0\xb3\xcb\xe5\x54\x14\x86\x74\x5b\x1e\x5a\x1d\x80\xb5\xda\x61\x24\xbf\x63\x8f\x08\x06\x78\x4e\x74\x0e\x72\x18\x9e\x5b\x1f\x01\x1c\x0b\xd1\x9b\x07\xe1\xf5\x01\x9e\x95\x74\x1b\xa2\x68\xe6\x2e\x10\x2d\xd7\x9c\x4d\x77\xb1\xf0\xf8\x65\x09\xd2\x19\x8d\x12\x6d\xee\xab\x9c\xb0\xed\xca\xf7\x3a\xf3\x5f\x3c\xb6\x0c\x32\xee\x88\x91\x8e\x09\x3e\x7b\x58\x5d\xe2\x4e\x3e\x8e\x7d\x81\x3a\x21\xd4\x13\xfe\x0c\x20\x3b\xaa\xa4\x63\x82\x30\xb0\x93\x5f\xc2\x1e\x06\xec\xd6\xcf\x21\x9f\x0b\xda\xb1\x27\x1d\x59\x0f\x03\x5b\xb1\x03\x3e\x46\x27\x0d\xd2\xf1\xca\x76\xca\x16\xde\x6f\x7a\xc3\x9b\x83\x1b\x89\x90\x8e\x7e\x30\x29\xcb\xee\xca\x68\x06\x6f\x37\xca\xb4\x9b\x76\xa0\xb8\xce\x6a\x6f\xdc\xa4\xfb\x96\xf0\x38\x4e\x4a\x77\x25\xff\x74\x3a\xf2\x4f\x8e\x42\xcf\xa7\xf7\xf2\xb3\x9b\xee\x06\xa9\x6b\xaf\xc7\xc0\x64\xf4\xe7\x9d\xbd\xf4\xe8\x7f\xa3\xd7\x1c\x0b\xdf\x17\x4f\xfa\xf7\x9e\xee\x79\x12\x1d\xa3\xff\x07\x00\x1d\x2b\xfb\xba\xea\x0e\x00\x00"
+
+func idtablestakingScriptsGet_supply_totalsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_idtablestakingScriptsGet_supply_totalsCdc,
+		"idTableStaking/scripts/get_supply_totals.cdc",
+	)
+}
+
+func idtablestakingScriptsGet_supply_totalsCdc() (*asset, error) {
+	bytes, err := idtablestakingScriptsGet_supply_totalsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "idTableStaking/scripts/get_supply_totals.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbb, 0xf5, 0x1f, 0xb8, 0x92, 0xe3, 0xaa, 0x49, 0xa3, 0x4a, 0xff, 0xe0, 0x92, 0x1f, 0xb5, 0x73, 0x33, 0xe, 0xb2, 0xb9, 0x82, 0x4d, 0x7e, 0x1, 0x99, 0xe5, 0x98, 0x6c, 0xac, 0x89, 0x42, 0x16}}
+	return a, nil
+}
+
 var _idtablestakingScriptsGet_tableCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xce\xbf\x4a\x04\x31\x10\x07\xe0\x3e\x4f\xf1\x2b\xef\x1a\xcf\xda\xee\x24\x2b\x04\x64\x0b\x93\x46\xc4\x62\xff\xcc\x66\x07\x77\x27\xcb\x64\x82\x8a\xf8\xee\x22\x58\xfa\x02\x1f\x1f\xef\x47\x51\xc3\xc3\x56\xde\x83\x4f\xc3\xb8\x51\xb4\xe1\x8d\x25\x63\xd1\xb2\xe3\xf6\x23\xf8\xae\x4f\x21\x3d\xa7\xeb\xfd\x63\x77\xf5\xfe\xa9\x8b\xd1\xb9\xcb\x05\x69\xe5\x8a\x3a\x29\x1f\x06\x25\x6b\x2a\x15\xb6\x12\xa6\xa6\x4a\x62\xe0\x99\xc4\xd8\x3e\x61\xbf\x2a\x36\x92\x6c\xab\x73\x47\x1b\xb1\x34\xc1\x3e\xb0\x9c\xce\x77\x78\x89\xa6\x2c\xf9\x15\x5f\x0e\xc0\x9f\xf4\xcf\xe7\x26\x93\xf5\x65\xa6\xe0\xeb\xe9\xec\xbe\x7f\x02\x00\x00\xff\xff\x94\x0c\xfa\xd5\xb8\x00\x00\x00"
 
 func idtablestakingScriptsGet_tableCdcBytes() ([]byte, error) {
@@ -1640,7 +2302,7 @@ func idtablestakingScriptsGet_weekly_payoutCdc() (*asset, error) {
 	return a, nil
 }
 
-var _inspect_fieldCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcc\xc1\x09\x42\x31\x0c\x06\xe0\x7b\xa7\xf8\x8f\x7a\x91\x22\x5a\x1f\xde\xbc\x74\x01\x71\x80\xbe\x9a\x42\xc0\x26\x8f\x98\xea\x03\x71\x77\x17\x70\x81\x8f\xfb\xa2\xe6\xc8\x0f\x7d\x5f\xc9\x5e\x5c\xe9\x52\xab\x0e\x71\x34\xd3\x8e\xb8\xb6\xe9\x9e\x28\x1e\xa7\x34\xc7\xb2\x8f\xf5\x14\xc2\x32\x66\xb4\x21\xe8\x85\x65\xb3\x3d\xe3\x96\x79\x4d\x07\x7c\x02\x00\x18\xf9\x30\xf9\xe3\xed\xdc\x8a\x3c\x4b\x75\x56\xc9\x44\xe1\xfb\x0b\x00\x00\xff\xff\x7c\xe1\x51\x3b\x7a\x00\x00\x00"
+var _inspect_fieldCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x78\x00\x87\xff\x69\x6d\x70\x6f\x72\x74\x20\x46\x6c\x6f\x77\x53\x65\x72\x76\x69\x63\x65\x41\x63\x63\x6f\x75\x6e\x74\x20\x66\x72\x6f\x6d\x20\x30\x78\x53\x45\x52\x56\x49\x43\x45\x41\x44\x44\x52\x45\x53\x53\x0a\x0a\x70\x75\x62\x20\x66\x75\x6e\x20\x6d\x61\x69\x6e\x28\x29\x3a\x20\x55\x46\x69\x78\x36\x34\x20\x7b\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x46\x6c\x6f\x77\x53\x65\x72\x76\x69\x63\x65\x41\x63\x63\x6f\x75\x6e\x74\x2e\x74\x72\x61\x6e\x73\x61\x63\x74\x69\x6f\x6e\x46\x65\x65\x0a\x7d\x03\x00\x7d\xde\x8a\x83\x78\x00\x00\x00"
 
 func inspect_fieldCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -1656,7 +2318,7 @@ func inspect_fieldCdc() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "inspect_field.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x74, 0xeb, 0x82, 0x68, 0x87, 0xc8, 0xaf, 0x97, 0x60, 0xf6, 0x63, 0x18, 0x23, 0x85, 0x7b, 0xb6, 0xf6, 0xbb, 0x8c, 0x4d, 0x40, 0x9c, 0x25, 0xc, 0xc5, 0x56, 0xa, 0xdf, 0x63, 0xa8, 0x28, 0xea}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3c, 0xe1, 0x1d, 0xb1, 0x3, 0xae, 0xe6, 0x8f, 0xff, 0xe3, 0x3, 0x1a, 0x3, 0x95, 0x3b, 0x88, 0x48, 0x19, 0xe3, 0xe8, 0xa3, 0xa3, 0x6a, 0xe4, 0x4f, 0xf4, 0xee, 0x15, 0x70, 0x19, 0xbc, 0x15}}
 	return a, nil
 }
 
@@ -1900,6 +2562,26 @@ func lockedtokensAdminDeposit_locked_tokensCdc() (*asset, error) {
 	return a, nil
 }
 
+var _lockedtokensAdminDeposit_locked_tokens_batchCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xdf\x6f\xda\x30\x10\x7e\xcf\x5f\x71\xf0\xd0\x06\xa9\x4b\xf6\x30\xed\x01\xf5\x87\x18\xd0\x6a\x2a\x6a\x27\xda\x6e\x0f\xd3\x1e\x8c\x73\x01\xab\xc1\x8e\xec\x4b\x29\xaa\xf8\xdf\x27\xdb\x71\x9a\x34\x55\x37\x61\x09\xf0\xdd\x77\x3f\x3e\x7f\x77\x62\x5b\x2a\x4d\x70\x59\xc9\xb5\x58\x15\x78\xaf\x1e\x51\x42\xae\xd5\x16\x3e\x3f\x5f\x3e\xdc\x5c\x7d\xff\xb6\x98\xdf\xdf\x5e\xcf\x6f\x26\xb3\xd9\x72\x7e\x77\x17\x05\x40\xa1\x76\x5d\xe7\xc5\xed\xaf\x8e\x63\xf0\x5c\x28\xfe\x88\x99\xf3\x35\xc1\x79\x71\x3b\xbd\x9e\xcf\xba\xee\x69\x0a\x33\x2c\x95\x11\x64\xa0\x70\x18\x20\x0f\x12\x92\x14\x6c\x99\xdc\x87\x7b\xc6\xb9\xaa\x24\x19\x60\x04\x4a\x72\x4c\xa2\x34\x85\xfb\x0d\xc2\x23\xee\x0d\xa8\x1c\xb2\x10\x88\x69\x04\xda\x20\x98\x0d\xd3\x2d\xe0\x89\xbb\x7c\x62\x45\x85\xc6\xfd\x64\x5b\x77\x0f\xa4\x02\x36\x89\x22\xd2\x4c\x1a\xc6\x49\x28\x19\x87\x88\x63\x78\x99\x64\x99\x46\x63\xc6\xf0\x70\x29\x9e\xbf\x7e\x39\x8c\xe0\x25\x8a\x00\x00\x4a\x8d\x25\xd3\x18\xb3\x6c\x2b\xe4\x18\x26\x15\x6d\x26\x3e\x61\xe3\x62\x4f\x9a\xc2\x15\x12\x30\xd0\x98\xa3\x46\xc9\xd1\xa6\x75\x55\x58\xe0\xb1\x01\x43\xca\x56\xfb\xc4\xaa\x82\x1a\x58\x81\xe4\x6f\x96\x98\xc3\x19\xb8\x24\xc9\x4a\x69\xad\x76\xa7\x47\xcd\x73\x24\x3f\xad\xcb\x79\x6c\x89\x1e\x43\x6a\x23\xb1\x35\xa6\x79\xb0\x3b\xf3\xa8\x09\x6a\xcf\xc5\x05\x94\x4c\x0a\x1e\x0f\xa7\xaa\x2a\x32\x90\x8a\xc0\xc7\xed\x57\xa8\x76\x12\xf5\xb1\x01\x17\x66\x30\x1c\xbd\x76\x65\xcb\x73\x35\xb5\xca\xeb\xa4\x69\x6a\x6d\x0b\x22\x71\x5f\x13\x8b\x9b\xaa\xa2\x40\x47\xf6\x79\xdc\x01\xda\xe3\xdb\xe9\x20\x5b\x7f\xde\xe0\xef\x7c\xd3\x3f\x18\x6d\x3a\x81\xfe\xbb\xed\x77\x9e\xa6\xad\x48\xcf\x3d\xf0\x26\x61\x9b\x87\x5c\x69\x4b\x96\x90\x8d\x08\x13\xa7\xca\x96\x00\xec\x61\xc6\xa0\xa6\x7e\xa3\x81\xc2\x64\x8d\x54\x8b\x27\x66\x41\x70\xa4\x46\x30\x38\x03\x29\x8a\x93\x1e\x70\x8b\xc6\xb0\x35\x8e\x61\x68\x07\xc1\x94\xc8\x45\x2e\x5e\x25\x0f\xc2\xb8\x87\x65\x6f\x66\x68\x00\x53\x26\xad\xc1\xa0\xcc\x82\xcd\xcf\xdd\xb0\x93\xa3\xd5\xe2\xc7\x22\xd6\xc8\x45\x29\x50\xd2\xb1\x81\x25\x72\x14\x4f\xa8\x3b\x50\xab\x14\x5d\x1b\xbc\x58\x5a\xbd\x92\xea\xbe\x92\xfd\x58\x2e\xa6\xac\x64\x2b\x51\x08\xda\x9f\x1e\x4d\xe4\x7e\x89\x46\x55\x9a\xe3\x4b\x67\x71\x25\x21\xdf\xe1\x1d\x09\xd9\x93\x96\xd5\xaa\x10\x3c\xf5\x7d\x36\x43\xf3\x6e\x99\x7d\xc5\xb4\x54\x1c\xf7\x2d\x1f\x8e\x91\x8f\xff\x31\x59\x35\xf9\x6e\xb4\x86\x7d\xba\xeb\xe5\xe8\xd4\x58\x2f\xc6\x9d\xa0\x4d\xa6\xd9\xae\x5e\xc2\xff\x5c\x21\xf6\xb4\x98\x4f\x6a\x85\xd6\xcb\xe2\xf4\x53\x58\x2f\x49\x08\x1c\xfb\xbd\x38\x6e\xb4\xfc\x9b\xd4\x9f\xc1\xe8\xb5\xf9\x43\x04\x00\x70\x88\x0e\xd1\xdf\x01\x00\x48\xc0\xae\xd1\x49\x06\x00\x00"
+
+func lockedtokensAdminDeposit_locked_tokens_batchCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_lockedtokensAdminDeposit_locked_tokens_batchCdc,
+		"lockedTokens/admin/deposit_locked_tokens_batch.cdc",
+	)
+}
+
+func lockedtokensAdminDeposit_locked_tokens_batchCdc() (*asset, error) {
+	bytes, err := lockedtokensAdminDeposit_locked_tokens_batchCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "lockedTokens/admin/deposit_locked_tokens_batch.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x17, 0xd4, 0xe0, 0xf1, 0x4f, 0x87, 0x8, 0x57, 0x61, 0x37, 0x49, 0xea, 0x3, 0xde, 0x16, 0xee, 0x58, 0x19, 0xee, 0x90, 0x6e, 0x74, 0xfb, 0xb8, 0xf4, 0xc6, 0x5e, 0x49, 0x76, 0xdf, 0x56, 0x33}}
+	return a, nil
+}
+
 var _lockedtokensAdminUnlock_tokensCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x91\xcd\x6e\xea\x30\x10\x85\xf7\x79\x8a\xb9\x2c\xee\x4d\xa4\xab\xa8\x8b\xaa\x8b\xa8\x2d\x8a\x80\x6e\xa0\xa5\xe2\xe7\x01\xa6\xce\x04\x2c\x12\x4f\x34\x9e\xa8\x48\x15\xef\x5e\x25\x46\x34\xb0\xed\x6c\xc6\x96\x8f\xed\xef\x9c\xb1\x75\xc3\xa2\xb0\x60\x73\xa0\x62\xc3\x07\x72\x1e\x4a\xe1\x1a\xee\x8e\x8b\xe5\x64\x3e\x9b\x6e\x96\xf3\xd9\x5b\x3e\x9d\xae\x66\xeb\x75\x14\xa9\xa0\xf3\x68\xd4\xb2\x8b\x15\x65\x47\x9a\x1b\xc3\xad\xd3\x0c\xf2\xa2\x10\xf2\xfe\x3f\x14\x54\x29\x66\xb0\x7d\xb1\xc7\x87\xfb\x04\xbe\xa2\x08\x00\xa0\x11\x6a\x50\x28\xc6\xa2\xb6\x2e\x83\xbc\xd5\xfd\xf9\xea\x45\xd2\x55\x45\x0a\xbd\x64\x45\x25\x3c\x85\x65\xfa\xc1\x22\xfc\xf9\xf8\x77\x48\x99\xf6\x2d\xef\xce\x27\x5c\x55\xd4\x33\x3d\xc7\x1d\x7b\x76\x65\x27\x1d\x6c\x6e\xe4\x6b\x65\xc1\x1d\xbd\xa3\xee\x93\x0b\x41\x57\xe3\x31\x34\xe8\xac\x89\x47\x13\x6e\xab\x02\x1c\x2b\x04\x08\x40\x10\x2a\x49\xc8\x19\x02\x65\xd0\x3d\x05\x48\x30\x97\x67\x47\xc9\xb5\x1f\xed\xbe\x7e\x45\x87\x3b\x92\x81\xad\x15\x95\xe9\x4f\x80\x31\x86\xfc\x32\xb8\xca\x35\xf9\x73\x76\x1f\xff\x86\xb0\xf5\x24\xff\x7c\x00\x81\x3a\x90\x0c\x29\x6f\x08\x53\xeb\x8c\x10\x7a\xda\xba\x8a\xcd\x61\x61\x6b\xab\xf1\x79\xac\x7d\x0b\x2c\xa7\xe8\x14\x7d\x07\x00\x00\xff\xff\xd0\xd3\x4e\x0e\x40\x02\x00\x00"
 
 func lockedtokensAdminUnlock_tokensCdcBytes() ([]byte, error) {
@@ -1920,6 +2602,26 @@ func lockedtokensAdminUnlock_tokensCdc() (*asset, error) {
 	return a, nil
 }
 
+var _lockedtokensAdminUnlock_tokens_batchCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x4d\x8b\xdb\x3c\x10\xbe\xfb\x57\x3c\x9b\xc3\xfb\x3a\x50\xbc\x3d\x94\x1e\x4c\xdb\xc5\x38\x29\x94\x4d\xbb\x25\xc9\x9e\x4a\x0f\xb3\xf2\x38\x16\xb1\x25\x23\xc9\xed\x86\x25\xff\xbd\x48\xb6\x93\x38\x94\xa5\x20\xb0\x3d\x9a\x8f\xe7\x63\x2c\x9b\x56\x1b\x87\x95\x16\x7b\x2e\xb6\x7a\xcf\xca\xa2\x34\xba\xc1\xdb\xe7\xd5\x43\x7e\xbf\x5c\x6c\x1f\xee\x97\xdf\xb2\xc5\x62\xbd\xdc\x6c\xa2\xe8\xf6\x16\x5f\x94\x30\x4c\x96\x2d\x5c\xc5\xe8\x54\xad\xc5\x1e\xb5\x6c\xa4\x83\x2e\xd1\x90\x3a\xc0\x87\xb8\x00\x09\xa1\x3b\xe5\x2c\xc8\x41\x2b\xc1\x89\x2f\xdf\x56\x8c\x3d\x1f\xac\x4f\xee\x8b\x2d\xc8\x70\x68\x66\x2b\x32\x17\x75\x6f\x42\xf0\x17\xd5\xdd\x30\x8c\x9a\x10\x87\xd3\x43\x69\x12\x45\xce\x90\xb2\x24\x9c\xd4\x2a\x1e\xfa\xa5\x78\xc9\x8a\xc2\xb0\xb5\x29\x1e\x3f\xcb\xe7\xf7\xef\x8e\x73\xbc\x44\x11\x00\xb4\x86\x5b\x32\x1c\x53\xd1\x48\x95\x22\xeb\x5c\x95\xf5\xe3\x4e\x29\xfe\xd4\xec\x10\x52\xd6\x5c\xe2\x63\xff\x9a\x3c\x69\x63\xf4\xef\x0f\xff\x5d\x8a\x95\x84\x47\xe6\xef\x73\x5d\xd7\x1c\x80\x7c\x8a\xbd\x84\xe9\x44\xd5\xe4\xe2\xe3\x2a\x7d\xe3\xb4\xa1\x1d\x7f\x27\x57\xcd\x4f\x08\xfc\xb9\xbb\x43\x4b\x4a\x8a\x78\x96\xeb\xae\x2e\xa0\xb4\x43\x0f\x02\x04\xc3\x25\x1b\x56\x82\xbd\x1c\x5e\xa8\x00\x12\xe2\xd4\x76\x36\x3f\xf3\x29\xb5\x81\x23\xb3\x63\x37\xb0\x85\x54\xa3\xfc\x49\xb0\xe3\x82\xfc\x28\x80\xf3\xc0\xbf\x92\xa2\x1d\x9b\x9c\x5a\x7a\x92\xb5\x74\x87\x51\x8e\x35\x97\xc9\xb9\x5f\x4c\xa3\xe2\x93\x31\x53\x3e\x53\x4e\x7e\x13\x6c\xcb\x42\x96\xf2\x6c\x3a\xa4\x0d\x34\xe9\x6a\x89\x6e\x90\x93\xf2\x17\x72\x58\x3f\x48\x67\x07\x06\xfd\xf6\xcd\xe6\xaf\x33\xe8\x9d\xfc\x3b\xa7\xc1\xda\xf8\x35\xb8\xff\x62\x41\x67\xd9\xfc\x6f\xfb\x21\xfe\x57\xf0\x53\xae\x71\x5d\x61\x4a\x46\x42\x8f\x81\xcb\xca\x53\x89\x0b\xae\x1d\xa5\xa3\x41\x3f\x26\x92\xfe\xbc\x39\xa3\x3c\x46\x00\x70\x8c\x8e\xd1\x9f\x01\x00\x3d\x03\xa0\x62\xc8\x03\x00\x00"
+
+func lockedtokensAdminUnlock_tokens_batchCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_lockedtokensAdminUnlock_tokens_batchCdc,
+		"lockedTokens/admin/unlock_tokens_batch.cdc",
+	)
+}
+
+func lockedtokensAdminUnlock_tokens_batchCdc() (*asset, error) {
+	bytes, err := lockedtokensAdminUnlock_tokens_batchCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "lockedTokens/admin/unlock_tokens_batch.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x84, 0x5, 0x8c, 0x12, 0x76, 0x10, 0x26, 0x16, 0xad, 0x57, 0x8, 0x90, 0x6, 0xbb, 0x49, 0x14, 0x51, 0x9f, 0x82, 0x14, 0x55, 0xec, 0xfd, 0x52, 0xf0, 0x44, 0x73, 0x14, 0x9, 0x77, 0x3e, 0x20}}
+	return a, nil
+}
+
 var _lockedtokensDelegatorDelegate_new_tokensCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x52\x4d\x6f\xda\x40\x10\xbd\xfb\x57\x4c\x39\x54\xe6\x10\xa7\x87\xaa\x07\x04\x8d\x48\x80\xb6\x0a\x82\x28\x24\xed\x79\xb1\xc7\x1f\x62\xf1\x58\xbb\xe3\x42\x85\xf8\xef\xd5\x7a\xd7\xc4\xeb\x24\x52\xa5\xfa\xb2\x82\x79\xf3\xde\x9b\x99\x57\xec\x2b\x52\x0c\x0b\x49\x87\x27\xda\x61\x09\xa9\xa2\x3d\x7c\x3a\x2e\x96\xeb\x5f\x4f\xeb\xfb\xf9\x6a\x3a\x9b\x3d\xce\x37\x9b\xa0\x05\xd6\x65\x56\x6c\x25\xfa\xe0\xe7\xd5\xb7\x1f\xb7\xcb\xf9\x5b\x0d\x4b\x8a\x77\x98\x34\x70\xdd\xe2\x97\xeb\xbb\xfb\xf9\xcc\x43\x07\xac\x44\xa9\x45\xcc\x05\x95\xa1\xd8\x53\x5d\xf2\x08\x9e\x17\xc5\xf1\xcb\xe7\x21\x9c\x82\x00\x00\x40\x22\x43\x4e\x32\x41\xf5\x88\xe9\x08\x3e\x76\xa9\xa3\xe6\xf9\xde\x54\x5f\xd0\xbf\x45\x2d\xd9\x82\x2f\x13\x46\x3f\xcd\x9f\x16\x53\x29\xac\x84\xc2\x50\xc4\xb1\x55\x9c\xd6\x9c\x4f\xed\x0f\x23\x0b\xee\xd3\x28\xd3\xe8\x22\x0d\x13\x70\x0d\xd1\x96\x94\xa2\xc3\xf8\x5d\x2b\x5f\x43\x33\xf2\x08\xde\xab\x6f\x98\x94\xc8\xf0\x41\x70\x3e\xbc\xa8\x99\xef\xe6\x06\x2a\x51\x16\x71\x38\xb8\xa3\x5a\x26\x50\x12\x83\x15\x03\x85\x29\x2a\x2c\x63\x04\x26\xe8\x70\x0d\x86\x81\x6f\xb8\x9d\xfe\x0d\xbf\xbd\x6d\xb4\x36\xaf\xb5\xf5\x73\x9d\xb6\xf5\xa6\xfc\xcf\xd6\x4c\x1b\x70\x13\x8d\x46\xfc\xc5\xeb\xc0\x72\x9c\xad\x45\x3c\x62\x5c\x33\x76\x36\x6c\xae\xa5\x59\xec\x50\x3d\x28\x3a\xfe\x81\x49\x6f\xe7\xce\xf9\x0c\x25\x66\x82\x49\x85\x9d\x61\x4d\xaf\x6c\x16\x7c\x2b\xa4\x30\x8b\x79\xd5\x9d\x21\xdb\x13\xb8\xe3\x3a\x60\x97\xa5\x48\xc1\xe6\x0e\xc6\x93\x1e\xdd\x29\xf0\x16\xd0\xf1\x19\x25\xd6\x10\xae\xd0\xee\x4b\x5f\xc2\x6b\xdf\x8e\xc0\x19\x50\x6a\x34\x3a\xa1\x03\xc1\x95\x2f\x34\x34\xd2\xde\xe9\xa2\x6d\x5b\xe9\x7b\xf0\xe7\x4b\xb0\x22\x5d\xb0\x3b\xe3\xf8\xca\x27\x39\x14\x9c\x27\x4a\x1c\x7a\xde\x5e\xc9\x0f\xff\x67\xce\xde\x98\x27\x8f\xca\x05\x66\x45\x0c\x58\x52\x9d\xe5\x36\x25\xda\x44\xb8\x91\xf9\x30\xe8\x30\xb8\xa8\x9c\x83\xbf\x01\x00\x00\xff\xff\x25\x14\xe5\x23\x9e\x04\x00\x00"
 
 func lockedtokensDelegatorDelegate_new_tokensCdcBytes() ([]byte, error) {
@@ -2480,6 +3182,186 @@ func lockedtokensUserWithdraw_tokensCdc() (*asset, error) {
 	return a, nil
 }
 
+var _quorumcertificateAdminStart_votingCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\x41\x8b\xdb\x40\x0c\x85\xef\xf3\x2b\x1e\x7b\x28\xc9\x25\xee\x39\xb4\x5d\x82\xd3\x42\xa1\x94\x66\x53\x7a\x59\xf6\xa0\x8c\xe5\x78\x8a\x33\x32\x1a\x4d\x36\xb0\xe4\xbf\x17\xdb\xc9\xd2\xb4\x66\x8d\x2e\x12\xd2\xd3\x67\xbd\x09\x87\x4e\xd4\xf0\xa5\x95\xe7\x4d\x16\xcd\x87\x92\xd5\x42\x1d\x3c\x19\xa3\x56\x39\xe0\xfd\x69\x53\xae\xd6\xeb\x87\xcf\xdb\xad\x73\x45\x81\x9f\x4d\x48\x30\xa5\x98\xc8\x5b\x90\x88\x64\xa4\x96\x60\x0d\x63\x53\xe2\x28\x16\xe2\x1e\xb5\xe8\x50\xf1\x6d\x4e\xc6\x9a\x20\xf5\x90\x47\x3e\x19\xb8\x13\xdf\xf4\x5a\x14\x2b\x54\x21\x79\xd2\x6a\x14\x38\x8a\xf1\x6b\x6f\xa7\x7c\x0c\x92\xd3\x45\xd3\xb9\xbf\xb6\xce\x2e\xc2\xdf\xa5\xe2\xaf\xeb\xb4\xc4\xe3\xe3\xd6\x34\xc4\xfd\xd3\xd3\x1c\x2f\xce\x01\x40\x51\xe0\x9b\x78\x6a\x71\x24\x0d\xb4\x6b\x79\xa0\x22\x28\xd7\xac\x1c\x3d\xc3\xe4\x8a\xbd\xaa\x0e\x21\x42\x76\xbf\xd9\xdb\x30\xdc\xb2\x81\xfa\xe2\x03\xd7\x4b\xbc\x9b\x3c\xd0\x62\x98\x1a\x97\x75\xca\x1d\x29\xcf\xc8\x7b\x5b\x62\x95\xad\x59\x79\x2f\x39\x5a\x8f\x83\xcb\x57\x14\xd8\x89\xaa\x3c\x4f\x51\xd0\xbf\x08\x7d\x24\x6e\xeb\xc5\x95\x03\x1f\xd1\xcb\x2f\x46\x8d\x0f\x6f\x41\x7d\x9a\xf5\xe6\x2d\xa7\x8d\x1d\x5b\xb6\x26\x4a\x7b\xfe\x41\xd6\xcc\x5f\xf7\xf5\x71\x7f\x8f\x8e\x62\xf0\xb3\xbb\x52\x72\x5b\x21\x8a\x5d\xb1\x6f\xa0\x37\xe5\x78\xa1\xbb\x71\xfc\x3c\x1e\x82\x4f\xec\xb3\x31\x5e\xa6\xff\x61\x31\x3c\x97\x5f\x83\xa3\xff\x99\x78\x9b\xcf\x1d\x00\x9c\xdd\xd9\xfd\x19\x00\xa2\xa9\xc1\xb8\xa6\x02\x00\x00"
+
+func quorumcertificateAdminStart_votingCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_quorumcertificateAdminStart_votingCdc,
+		"quorumCertificate/admin/start_voting.cdc",
+	)
+}
+
+func quorumcertificateAdminStart_votingCdc() (*asset, error) {
+	bytes, err := quorumcertificateAdminStart_votingCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "quorumCertificate/admin/start_voting.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x98, 0xe9, 0x25, 0x4d, 0xf8, 0x89, 0xc5, 0x45, 0x98, 0xa3, 0x13, 0xba, 0x6b, 0x28, 0x5f, 0xd8, 0xa, 0xec, 0xaf, 0xc6, 0xd9, 0xd2, 0x20, 0xe1, 0x6a, 0x4, 0x16, 0x94, 0x7b, 0xa, 0xb4, 0xc9}}
+	return a, nil
+}
+
+var _quorumcertificateAdminStop_votingCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\xc1\x6a\xf3\x40\x0c\x84\xef\xfb\x14\x43\x0e\x3f\xce\xc5\xfe\xcf\xa1\x6d\x30\x4e\x7b\xea\xa1\x4e\x4a\xef\xca\x46\x8e\xb7\xd8\x2b\xb3\x96\x93\x40\xc8\xbb\x97\x8d\x9b\xd2\x16\x53\xd0\x49\xcc\x48\x9f\x46\xae\xed\x24\x28\x9e\x1a\x39\x96\x83\x84\xa1\x2d\x38\xa8\xab\x9c\x25\x65\x54\x41\x5a\xfc\x3f\x95\x45\xbe\x5a\xad\x1f\x37\x1b\x63\xb2\x0c\xaf\xb5\xeb\xa1\x81\x7c\x4f\x56\x9d\x78\xf4\x2a\x5d\x0f\xb2\x96\x3b\x75\x7e\x8f\xb2\xc0\x41\x94\x7b\x63\xbe\xab\xce\xc6\x00\x40\x96\xe1\x59\x2c\x35\x38\x50\x70\xb4\x6d\x18\x95\x04\x10\x02\x57\x1c\xd8\x5b\x86\x0a\xb4\xe6\x38\x25\xdf\xb5\xce\x43\xb6\xef\x6c\xf5\x6a\x6e\x58\x41\xb1\xb9\xe6\x6a\x81\x7f\x93\xd0\xe9\xd5\x35\x2e\xeb\x02\x77\x14\x38\x21\x6b\x75\x81\x7c\xd0\x3a\xb7\x56\x06\xaf\x73\x9c\xaf\x82\x4f\xa2\xad\x84\x20\xc7\x29\x0a\xfa\x8d\x10\xab\xe7\xa6\x4a\x6f\x1c\xb8\x8f\xa7\x6b\x3a\xce\xb8\xfb\x0b\xea\x21\x89\x81\x2e\xa6\xc3\x1e\x25\x1b\x95\x40\x7b\x7e\x21\xad\xe7\x5f\xfb\x62\x2d\x97\xe8\xc8\x3b\x9b\xcc\x0a\x19\x9a\x1d\xbc\xe8\x0d\xfb\x07\x74\x59\x8c\x09\xcd\x46\xfb\x65\x0c\x82\x4f\x6c\x07\x65\x9c\xa7\x6f\x48\xe3\x0b\xdf\x24\x7e\x2f\x99\x1b\x00\xb8\x98\x8b\xf9\x18\x00\xf7\xf5\xa4\xfe\x1b\x02\x00\x00"
+
+func quorumcertificateAdminStop_votingCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_quorumcertificateAdminStop_votingCdc,
+		"quorumCertificate/admin/stop_voting.cdc",
+	)
+}
+
+func quorumcertificateAdminStop_votingCdc() (*asset, error) {
+	bytes, err := quorumcertificateAdminStop_votingCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "quorumCertificate/admin/stop_voting.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xaa, 0x35, 0x3e, 0xef, 0x7, 0x9b, 0xf3, 0x21, 0xa2, 0x4e, 0x4e, 0x3d, 0x58, 0x4e, 0x64, 0xf1, 0x6a, 0x27, 0x7e, 0xb6, 0x8a, 0xb1, 0x3, 0xf0, 0x7b, 0xc8, 0x3b, 0x4, 0x3c, 0x87, 0xf1, 0xed}}
+	return a, nil
+}
+
+var _quorumcertificateScriptsGet_cluster_completeCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xce\x31\x4e\x43\x31\x10\x84\xe1\xde\xa7\x98\x92\x34\x04\x1a\x8a\x74\xe0\x80\x94\x32\x04\x0e\x60\x9e\xd7\xf2\x4a\xb6\xd7\x5a\xaf\x93\x48\x88\xbb\x23\xc4\x6b\x90\xe8\x47\xdf\xfc\x5c\xbb\xa8\xe1\xa5\xc8\xe5\x38\x45\x67\xf5\xa4\xc6\x89\x97\x60\x84\xa4\x52\x71\x77\x3d\xfa\xc7\xfd\xfe\xf5\xf9\x74\x72\x6e\xbb\xc5\x5b\xe6\x81\xb1\x28\x77\x83\x92\x4d\x6d\x03\xa6\x93\xc0\x09\x55\x94\x60\x39\x34\xd8\x45\x60\x99\x35\x0e\x48\x82\x65\x42\x93\x48\xe3\x07\x90\x84\x80\xa5\xcc\x61\xa4\xc8\xe1\x4c\x38\x8b\x51\x74\xae\xcf\x0f\xa4\xd9\x50\x03\xb7\x9b\x75\x70\x68\x91\xae\x3b\xbc\x1f\x9a\xdd\x3f\x6c\x76\x78\x12\x29\xf8\x74\x00\xd6\xf3\xff\xd3\x6f\x79\xf8\x5f\xc0\x4b\xed\x85\x8c\xfe\x80\x1b\xf7\xe5\xbe\x07\x00\x91\x29\x08\x6f\xfa\x00\x00\x00"
+
+func quorumcertificateScriptsGet_cluster_completeCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_quorumcertificateScriptsGet_cluster_completeCdc,
+		"quorumCertificate/scripts/get_cluster_complete.cdc",
+	)
+}
+
+func quorumcertificateScriptsGet_cluster_completeCdc() (*asset, error) {
+	bytes, err := quorumcertificateScriptsGet_cluster_completeCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "quorumCertificate/scripts/get_cluster_complete.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf0, 0xa, 0x4, 0x3f, 0x4c, 0xb4, 0xfc, 0x14, 0xdf, 0x17, 0x57, 0x1c, 0x25, 0xd5, 0x85, 0xa1, 0xcc, 0xef, 0x75, 0x9c, 0x76, 0xc7, 0x7c, 0x5d, 0x64, 0x53, 0x29, 0x6f, 0x6a, 0x87, 0xd9, 0xfe}}
+	return a, nil
+}
+
+var _quorumcertificateScriptsGet_cluster_votesCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\x31\x4f\x84\x40\x14\x84\xfb\xfd\x15\x53\x7a\x8d\xa7\x8d\x05\x9d\xe1\x34\xb9\x12\x51\xfb\x05\xde\xc2\x4b\xd8\x5d\xf2\xf6\x2d\x92\x10\xfe\xbb\x21\xd0\x98\x58\xcd\x64\x8a\x6f\x3e\xf6\x53\x14\xc5\xfb\x18\x7f\xaa\x1c\x25\xfb\x92\x44\xd9\x71\x6b\x95\xe0\x24\x7a\x3c\x2d\x55\xf9\x7a\xbb\x7d\xbc\xd5\xb5\x31\xd7\x2b\x3e\x07\x4e\x48\xad\xf0\xa4\x10\xd2\x2c\x21\x41\x07\xc2\x1c\x95\xf6\x66\x15\x83\x9d\x09\x0d\x51\x40\xca\x8d\x67\x55\xea\xe0\xa2\xc0\xa2\x1d\x73\x52\x12\x63\xa6\xdc\xc0\xe5\x00\x6f\x39\x3c\x9c\xeb\x3d\x74\xb4\x14\xf8\xba\x07\x7d\x7e\xb9\x14\x58\x6b\x15\x0e\x7d\x81\x23\x37\xac\x06\xc0\x79\xfa\xbf\xf2\x63\x4f\x5a\x1e\xb4\xef\xdd\xe7\x0f\xfa\x62\x36\xf3\x3b\x00\xa5\xaa\x8d\x79\xf0\x00\x00\x00"
+
+func quorumcertificateScriptsGet_cluster_votesCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_quorumcertificateScriptsGet_cluster_votesCdc,
+		"quorumCertificate/scripts/get_cluster_votes.cdc",
+	)
+}
+
+func quorumcertificateScriptsGet_cluster_votesCdc() (*asset, error) {
+	bytes, err := quorumcertificateScriptsGet_cluster_votesCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "quorumCertificate/scripts/get_cluster_votes.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa7, 0xc2, 0x17, 0xfb, 0xb, 0x53, 0x1f, 0x5c, 0x9f, 0x53, 0xc9, 0x92, 0x7e, 0x92, 0xe6, 0x6, 0x8a, 0xb4, 0xd1, 0xb6, 0xbb, 0xf6, 0x98, 0x97, 0x35, 0xf3, 0xf0, 0x84, 0x8d, 0x9d, 0x98, 0x2f}}
+	return a, nil
+}
+
+var _quorumcertificateScriptsGet_node_has_votedCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xce\x31\x4f\xc3\x30\x10\xc5\xf1\xdd\x9f\xe2\x8d\xed\x42\x99\xbb\x41\x0d\xa2\x63\x08\x62\x37\xc9\x39\x39\x29\xbe\x8b\xce\x67\x40\x42\x7c\x77\x64\x89\xb1\xeb\x93\xfe\xfa\x3d\x2e\xbb\x9a\xe3\x79\xd3\xaf\xa1\xa9\xb5\x72\x21\x73\xce\x3c\x25\x27\x64\xd3\x82\xfb\xef\xe1\xf2\x10\xe3\xeb\xd3\x38\x86\x70\x3a\xe1\x6d\xe5\x8a\x3a\x19\xef\x0e\x23\x6f\x26\x15\x6e\x8d\xc0\x19\x09\xa2\x33\x61\x4d\x15\x9f\xea\x34\x83\x05\xbe\x12\xa6\x66\x46\xe2\x7d\x64\x59\x42\xd8\xdb\x07\x72\x13\x94\xc4\x72\xe8\xc9\x35\x9e\x31\xba\xb1\x2c\xc7\x33\x1e\x55\x37\xfc\x04\x00\xff\xc0\xed\x7b\x77\x3d\x7c\x49\xf5\xbd\x4b\x07\xd1\x99\xae\xf1\x18\x7e\xc3\xdf\x00\x61\x62\x44\x9b\xd3\x00\x00\x00"
+
+func quorumcertificateScriptsGet_node_has_votedCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_quorumcertificateScriptsGet_node_has_votedCdc,
+		"quorumCertificate/scripts/get_node_has_voted.cdc",
+	)
+}
+
+func quorumcertificateScriptsGet_node_has_votedCdc() (*asset, error) {
+	bytes, err := quorumcertificateScriptsGet_node_has_votedCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "quorumCertificate/scripts/get_node_has_voted.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x59, 0xc0, 0xdc, 0xe0, 0xca, 0x1e, 0x6c, 0xc5, 0xc5, 0xfd, 0x6f, 0x89, 0xc0, 0x6d, 0xc4, 0xdb, 0x66, 0xad, 0x5, 0x9f, 0xe3, 0x26, 0x94, 0x9e, 0xb8, 0x39, 0x52, 0xc8, 0xb6, 0x87, 0x33, 0x92}}
+	return a, nil
+}
+
+var _quorumcertificateScriptsGet_voting_completedCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xce\x31\x52\xc3\x30\x10\x46\xe1\x5e\xa7\xf8\x4b\xbb\xc1\xd4\x74\x60\x43\x6f\xcc\x05\x8c\x58\xe1\x9d\x91\xb4\x9a\xd5\xca\x49\x26\x93\xbb\xa7\x48\xd2\xe5\x00\xef\x9b\xc7\xa9\x88\x1a\xbe\xa2\x1c\xe6\x26\xda\xd2\x48\x6a\x1c\xd8\xaf\x46\x08\x2a\x09\xaf\xc7\x79\x7c\x9f\xa6\xef\xcf\x65\x71\x6e\x18\xf0\xb3\x71\x45\xf5\xca\xc5\xa0\x64\x4d\x73\x85\x69\x23\x70\x00\xed\xa4\x27\xf8\xd8\xaa\x91\x62\x5b\x2b\xbc\xa4\x12\xc9\xe8\x0f\x6c\x15\xbb\x18\xe7\x7f\xe7\x4a\xfb\x45\x68\x19\x69\xe5\xdc\xf5\x6f\xf8\x10\x89\x38\x3b\x00\x77\xf2\xf9\xd0\xcb\xad\x1f\x1f\x66\xd7\xbb\x8b\xbb\x0e\x00\x47\x51\xd0\x4b\xc2\x00\x00\x00"
+
+func quorumcertificateScriptsGet_voting_completedCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_quorumcertificateScriptsGet_voting_completedCdc,
+		"quorumCertificate/scripts/get_voting_completed.cdc",
+	)
+}
+
+func quorumcertificateScriptsGet_voting_completedCdc() (*asset, error) {
+	bytes, err := quorumcertificateScriptsGet_voting_completedCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "quorumCertificate/scripts/get_voting_completed.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x20, 0x88, 0x2a, 0x10, 0xa7, 0xdb, 0xf1, 0xd4, 0xe6, 0xa0, 0x50, 0xa8, 0x7a, 0x87, 0xc1, 0x69, 0x80, 0xb9, 0x81, 0xe0, 0xc1, 0x73, 0xb5, 0x9a, 0x3a, 0x4a, 0xf7, 0x52, 0x39, 0xbf, 0xf8, 0x79}}
+	return a, nil
+}
+
+var _quorumcertificateScriptsGet_voting_in_progressCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xce\xb1\xae\xc2\x30\x0c\x46\xe1\x3d\x4f\xf1\x8f\xf7\x2e\x94\x99\x0d\x5a\x98\x29\xe5\x05\x4a\xe4\xb4\x96\x9a\x38\x72\x9c\x82\x84\x78\x77\x24\xc4\xc8\x78\x96\xa3\x8f\x63\x16\x35\x9c\x16\xb9\xf7\x55\xb4\xc6\x96\xd4\x38\xb0\x1f\x8d\x10\x54\x22\xb6\x8f\xbe\xdd\x77\xdd\xe5\x38\x0c\xce\x35\x0d\xae\x33\x17\x14\xaf\x9c\x0d\x4a\x56\x35\x15\x98\x56\x02\x07\xd8\x4c\xe8\x5b\xac\x62\x9c\x26\x70\xc1\xe8\x3d\xe5\x4f\xac\x62\x54\x9c\xcb\xf5\x86\x50\x13\xe2\xc8\xe9\xef\x7f\x87\x83\xc8\x82\xa7\x03\xf0\x9d\xfd\xa6\x6c\x38\x9d\x55\x26\xa5\x52\xdc\xcb\xbd\x07\x00\x43\x76\x4d\xdb\xb5\x00\x00\x00"
+
+func quorumcertificateScriptsGet_voting_in_progressCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_quorumcertificateScriptsGet_voting_in_progressCdc,
+		"quorumCertificate/scripts/get_voting_in_progress.cdc",
+	)
+}
+
+func quorumcertificateScriptsGet_voting_in_progressCdc() (*asset, error) {
+	bytes, err := quorumcertificateScriptsGet_voting_in_progressCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "quorumCertificate/scripts/get_voting_in_progress.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8e, 0xb9, 0x62, 0x98, 0x99, 0x3d, 0x4b, 0xb9, 0x24, 0x51, 0x84, 0x86, 0x3, 0xc7, 0x74, 0x71, 0x5d, 0x1a, 0x52, 0x41, 0xdc, 0xee, 0x26, 0xbc, 0x2d, 0x48, 0x6a, 0x24, 0x62, 0xd5, 0x3d, 0xc9}}
+	return a, nil
+}
+
+var _quorumcertificateVoterCreate_voterCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\x41\x6b\xdb\x40\x10\x85\xef\xfb\x2b\x86\x1c\x8a\x0c\x8d\xd5\xb3\x70\x1b\x54\xc9\x05\x43\x09\x75\x24\x0a\x3d\x8e\x57\xa3\x68\x1b\x69\x47\x8c\x46\x71\x21\xe4\xbf\x17\xad\x6a\x47\xc6\x6d\x61\x0f\x42\x7c\xef\xcd\x9b\x37\xae\xeb\x59\x14\xbe\xb4\x7c\xdc\xe5\x25\x1e\x5a\x2a\x14\x9f\x9c\x7f\x84\x5a\xb8\x83\x0f\xbf\x76\xf9\xf6\xbe\xdc\x95\x3f\xca\xf4\xf3\xd7\x6d\x9a\xe7\x0f\xdb\xa2\x30\x0b\xd5\x7e\x64\x19\xbb\x8c\x44\x5d\xed\x2c\x2a\x9d\x84\xfb\xec\x44\x9b\x38\x86\xb2\x71\x03\xa8\xa0\x1f\xd0\xaa\x63\x0f\x56\x08\x95\x06\xd0\x86\x60\x9f\xc1\x77\x56\x12\xe0\x1a\x10\x2c\xb7\x2d\xcd\x90\xe7\x8a\x26\xf5\xd1\x69\x13\xc8\xe9\x07\x0c\x8a\x4f\x13\x7c\xf8\x49\x56\x61\x50\x16\xaa\xc0\xf9\x00\x0c\xd8\x11\xa0\xb5\x3c\x7a\x35\x66\x39\xf0\xc5\x18\x00\x80\x5e\xa8\x47\xa1\x08\xad\xd5\x04\xd2\x51\x9b\x74\xa6\x57\xf0\x12\x80\xe9\xc5\x31\x1c\x58\x84\x8f\x80\x20\x54\x93\x90\xb7\x04\xca\x6f\x19\xe6\xe1\x67\x41\x4b\xfa\x27\xd6\x03\xd5\xf0\x71\x4a\xa0\xeb\xd9\x62\xf3\xee\xba\xdc\xf5\x3d\x57\xe1\x9b\xe4\x53\x34\xf5\x95\xc0\x7f\xa1\x42\x59\xf0\x91\xbe\xa1\x36\xab\xf3\xcc\xe9\xdd\xdd\x41\x8f\xde\xd9\xe8\x26\xe3\xb1\xad\xc0\xb3\x9e\x92\x5f\xe4\x5e\xf4\x76\xb3\x32\x17\xb1\x9f\x43\xf3\x9b\xdb\xbf\x5f\x73\x3d\xdf\x29\x9c\x27\xf2\xe7\x40\xc9\xdb\xb6\x0b\xbb\xb0\xf5\x80\xcf\x14\x6d\x6e\x83\xed\x7b\x50\x4e\xfe\x61\x1c\x2c\xaf\x16\x7b\x35\xaf\xe6\xf7\x00\x71\xc4\x81\x71\x94\x02\x00\x00"
+
+func quorumcertificateVoterCreate_voterCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_quorumcertificateVoterCreate_voterCdc,
+		"quorumCertificate/voter/create_voter.cdc",
+	)
+}
+
+func quorumcertificateVoterCreate_voterCdc() (*asset, error) {
+	bytes, err := quorumcertificateVoterCreate_voterCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "quorumCertificate/voter/create_voter.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x30, 0x17, 0x7d, 0x96, 0x4d, 0xb9, 0x9a, 0x57, 0x91, 0xee, 0xe7, 0x86, 0xfb, 0x4, 0x4a, 0xd4, 0x14, 0x11, 0x40, 0x3b, 0xa8, 0xfb, 0x1d, 0xeb, 0x7b, 0x63, 0x79, 0xb3, 0x80, 0xd5, 0xb2, 0xe9}}
+	return a, nil
+}
+
+var _quorumcertificateVoterSubmit_voteCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\x41\x8f\xd3\x30\x10\x85\xef\xfe\x15\x4f\x7b\x40\xe9\xa5\xe1\x1c\x01\xab\x2a\x0b\x27\x0e\x64\x83\xb8\x3b\xde\x49\x63\xe4\x78\xa2\xc9\xb8\xad\x54\xf5\xbf\x23\x27\x14\x51\x14\x61\xf9\xf8\xde\x9b\x6f\xe6\xf9\x71\x62\x51\x7c\x09\x7c\x6e\x12\x4b\x1a\x6b\x12\xf5\xbd\x77\x56\x09\xbd\xf0\x88\xf7\x97\xa6\x3e\xbc\xbc\xbc\x7e\x6e\x5b\x63\xca\x12\xdf\x07\x3f\x43\xc5\xc6\xd9\x3a\xf5\x1c\x31\xa7\x6e\xf4\x3a\x43\x07\xc2\x89\x95\xc0\x3d\x2c\x1c\x87\x40\xab\x20\xf2\x1b\x65\x67\xcf\xb2\x88\x84\x59\xd1\xd4\x59\x97\x7d\x2e\xa4\x59\x49\x8c\xf9\x2b\xb4\xc8\x41\xad\x3f\x46\xab\x49\xa8\x42\xab\xe2\xe3\x71\x87\xab\x31\x00\x50\x96\xf8\xca\xce\x06\x9c\xac\x78\xdb\x05\x5a\xb2\x2d\x84\x7a\x12\x8a\x8e\xa0\xbc\x8c\x6a\x6a\xfc\x60\x25\x01\x77\x3f\xc9\xe9\x62\x0e\xa4\x0b\xa7\xbc\x52\x5f\xe1\xdd\xe6\xea\xfb\xc5\xb5\x0e\x9b\x84\x26\x2b\x54\x58\xe7\xb4\xc2\x21\xe9\x70\x70\x8e\x53\xd4\x8c\x83\xdf\xaf\x2c\xd1\xb1\x08\x9f\xb7\x28\x4e\xff\x22\xe4\x3f\x53\xe8\xf7\x77\x0e\x7c\x44\x8e\xdf\xaf\x19\x1f\xfe\x07\xf5\xa9\xc8\xb5\x54\xdb\x95\xad\x92\x56\x59\xec\x91\xbe\x59\x1d\x76\x7f\xe6\xe5\xff\xfc\x8c\xc9\x46\xef\x8a\xa7\x9a\x53\x78\x43\x64\xbd\x63\x3f\x40\x37\xf5\x7a\xa1\xa7\xd5\x7e\x5b\x0f\x41\x17\x72\x49\x09\xd7\xed\x1d\x96\x65\x1e\x8b\xdb\x19\x00\xb8\x99\x9b\xf9\x35\x00\x62\xd9\xb7\x5f\x68\x02\x00\x00"
+
+func quorumcertificateVoterSubmit_voteCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_quorumcertificateVoterSubmit_voteCdc,
+		"quorumCertificate/voter/submit_vote.cdc",
+	)
+}
+
+func quorumcertificateVoterSubmit_voteCdc() (*asset, error) {
+	bytes, err := quorumcertificateVoterSubmit_voteCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "quorumCertificate/voter/submit_vote.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7e, 0x48, 0x95, 0x1, 0x78, 0xc2, 0x97, 0x7b, 0x6e, 0xc6, 0x88, 0xf8, 0x9f, 0x58, 0x53, 0x72, 0xe8, 0xfa, 0x6, 0x84, 0x3e, 0xbd, 0xd4, 0xfd, 0x9f, 0xac, 0x9b, 0x79, 0xf7, 0xbb, 0x1, 0x98}}
+	return a, nil
+}
+
 var _stakingproxyAdd_node_infoCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\x41\x6b\xc2\x40\x10\x85\xef\xfb\x2b\x06\x0f\x25\x82\x48\x8f\x25\xd4\x4a\xd0\xd2\x8a\xa0\xc1\xb4\xd0\x1e\xd7\xec\xa8\x4b\xe3\xce\x32\x19\x51\x29\xfe\xf7\xb2\x46\x4d\x24\xdd\x43\xc8\xbe\xcc\x64\xbe\xf7\xc6\x6e\x3d\xb1\x40\x26\xfa\xc7\xba\x75\xca\x74\x38\xc2\x8a\x69\x0b\x8f\x87\xec\x23\x99\x4e\x66\x6f\xe9\x62\xfe\xf5\x9d\x8c\xc7\x8b\xd7\x2c\x53\x4a\x58\xbb\x52\xe7\x62\xc9\x45\xd6\xc4\x90\x09\x5b\xb7\xee\x01\x53\x81\x31\x7c\x4e\x9c\x3c\xf5\xc0\xa1\xec\x89\xc3\x0f\x13\x63\x18\xcb\xb2\xae\xab\x3f\x4d\xf1\x58\xcb\x65\x35\xbf\xa1\x75\xe1\x57\x29\x00\x00\xcf\xe8\x35\x63\xa4\xf3\x9c\x76\x4e\x62\x48\x76\xb2\x49\xaa\x4b\x28\x82\xcb\x29\x50\xc0\x07\xfe\x77\x2a\x0c\x32\x0c\xe0\xd2\xd1\x5f\x12\x33\xed\x9f\x1f\x9a\x26\xfb\x33\x32\x18\x04\xe4\xb4\x6e\x7a\x89\x82\xf7\x18\x5a\x95\x73\x8f\xac\x85\x78\xa4\xbd\x5e\xda\xc2\xca\x31\x13\x62\xbd\xc6\x54\xcb\xa6\x7b\x63\x08\x67\x38\x04\xaf\x9d\xcd\xa3\xce\x88\x76\x85\x01\x47\x02\x15\x01\x30\xae\x90\xd1\xe5\x08\x42\x57\xcb\x15\x33\x6c\xce\xf3\x3b\x5d\x75\xe7\xc7\x91\xc1\x89\x5b\x11\x0c\xda\x48\x41\x3f\xaf\xc0\x9a\x6b\xfc\xe1\xf9\x6f\xfa\x2d\xa9\xb5\x88\xbb\xeb\xfd\x3e\xea\xf7\x06\x5d\x23\xe9\xbe\x36\xe6\x06\x74\x25\x8e\x6f\xec\x55\x3a\x27\x75\x52\x7f\x01\x00\x00\xff\xff\x9f\x31\xb9\x98\x6c\x02\x00\x00"
 
 func stakingproxyAdd_node_infoCdcBytes() ([]byte, error) {
@@ -2540,7 +3422,7 @@ func stakingproxyRegister_nodeCdc() (*asset, error) {
 	return a, nil
 }
 
-var _stakingproxyRemove_node_infoCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8f\x51\x4b\xc3\x40\x0c\xc7\xdf\xef\x53\xe4\x49\xda\x97\xe2\x73\x51\xe1\xb0\xa2\x45\xd8\x8e\x9d\x0f\xfa\x98\xb5\x59\x77\xd8\x5e\x8e\x98\xe9\x86\xec\xbb\xcb\xb9\x31\x0b\xe6\x25\x24\xfc\x7f\xe4\x97\x30\x25\x16\x05\xaf\xf8\x1e\xe2\xe0\x84\xf7\x07\xd8\x08\x4f\x70\xbd\xf7\x2f\xf6\xb9\x5d\x3c\xba\xd5\xf2\xf5\xcd\x36\xcd\xea\xc1\x7b\x63\x54\x30\x7e\x60\xa7\x81\x63\x11\xb9\xa7\xb6\xa9\xc1\xab\x84\x38\x94\xf0\x6d\x0c\x00\x40\x12\x4a\x28\x54\x60\xd7\xf1\x2e\x6a\x0d\x76\xa7\x5b\x7b\x1a\x72\x08\xce\x35\x92\x42\xca\x07\x9f\x78\xec\x49\xe0\x16\xce\x44\xb5\x66\x11\xfe\xba\xb9\x9a\x5b\x55\x0b\xee\x29\x2f\x48\xdc\x1f\x74\x57\x64\xd9\x1a\xfe\x25\x97\x89\x04\x95\xe5\x1e\x13\xae\xc3\x18\xf4\xe0\x95\x05\x07\x72\xa8\xdb\xd2\x5c\x24\x66\x02\x95\xd0\xc4\x9f\x94\xe9\x36\x6e\xf8\xf2\xde\xa9\x97\xbf\xc8\xd1\x1c\xcd\x4f\x00\x00\x00\xff\xff\x64\x64\x88\xd5\x33\x01\x00\x00"
+var _stakingproxyRemove_node_infoCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xd0\x41\x6b\x32\x31\x10\x06\xe0\x7b\x7e\xc5\x8b\x87\x8f\xdd\x8b\x7c\x67\x69\x2b\x8b\x96\x56\x0a\xba\x98\x1e\xda\x63\xdc\x1d\x35\x74\x37\x13\xc6\xd9\x56\x29\xfe\xf7\x12\x57\xb6\x36\x04\x42\xc2\x4c\xde\x27\xf1\x6d\x64\x51\x58\x75\x1f\x3e\xec\x4a\xe1\xe3\x09\x5b\xe1\x16\xff\x8f\xf6\xb5\x78\x59\x2c\x9f\xca\xf5\xea\xed\xbd\x98\xcf\xd7\x8f\xd6\x1a\xa3\xe2\xc2\xc1\x55\xea\x39\x64\x81\x6b\x5a\xcc\x27\xb0\x2a\x3e\xec\x72\x7c\x1b\x03\x00\x51\x28\x3a\xa1\xcc\x55\x15\x77\x41\x27\x28\x3a\xdd\x17\xfd\x26\x15\xe1\x3a\x1a\x52\xc4\x14\xf8\xcc\x4d\x4d\x82\x7b\x5c\x3b\xc6\x1b\x16\xe1\xaf\xbb\x7f\xb7\xaa\xf1\x92\x6b\x4a\x07\x24\xe5\x6f\xd3\x43\x96\xb0\x93\x3f\xfe\x4b\xe5\x2a\x92\x38\x65\x99\xb9\xe8\x36\xbe\xf1\x7a\xb2\xca\xe2\x76\x54\x3a\xdd\xe7\x83\x21\xcd\xe9\x14\xd1\x05\x5f\x65\xa3\x19\x77\x4d\x8d\xc0\x8a\x5e\x00\xa1\x2d\x09\x85\x8a\xa0\x8c\x43\xaf\xe9\xcd\xd8\x5f\xf2\x47\xb9\x19\xee\xba\x79\xcb\x58\xa8\xe5\x4f\x4a\x90\x45\xd8\xf2\xf0\x53\xfd\x9a\x1b\x00\x38\x9b\xb3\xf9\x19\x00\x6a\x2e\x80\x91\x7e\x01\x00\x00"
 
 func stakingproxyRemove_node_infoCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -2556,11 +3438,11 @@ func stakingproxyRemove_node_infoCdc() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "stakingProxy/remove_node_info.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd0, 0x54, 0xc1, 0x59, 0xe, 0x14, 0xb4, 0xd3, 0xf1, 0xd2, 0xef, 0x53, 0xff, 0x63, 0xb9, 0x6d, 0x1c, 0x93, 0xfc, 0x57, 0x6, 0xae, 0xa4, 0x12, 0x64, 0x2f, 0xc5, 0x89, 0x83, 0xfe, 0x93, 0x46}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7f, 0x35, 0x8d, 0x44, 0xba, 0x4, 0xaf, 0xca, 0x1e, 0xf4, 0x11, 0xb6, 0x49, 0xd9, 0x26, 0x38, 0xf8, 0x9, 0xb0, 0x1c, 0xc7, 0x8, 0xe4, 0x1a, 0x6f, 0xae, 0x48, 0x70, 0xda, 0x59, 0xb6, 0xaf}}
 	return a, nil
 }
 
-var _stakingproxyRemove_staking_proxyCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8f\xc1\x4a\xc3\x40\x10\x86\xef\xfb\x14\x73\x92\xe4\x12\x3c\x07\x15\x16\x23\x5a\x84\x76\xe9\x7a\xd0\xe3\x34\x19\x93\xc5\x64\x67\x19\xa7\xda\x22\x7d\x77\x59\x5b\x6a\xa0\x7b\x59\x66\xf8\xbf\x99\x6f\xc2\x94\x58\x14\xbc\xe2\x47\x88\xbd\x13\xde\xed\xe1\x5d\x78\x82\xeb\x9d\x7f\xb1\xcf\x8b\xe5\xa3\x5b\xaf\x5e\xdf\x6c\xd3\xac\x1f\xbc\x37\x46\x05\xe3\x27\xb6\x1a\x38\x16\x91\x3b\x5a\x34\x35\x78\x95\x10\xfb\x12\x7e\x8c\x01\x00\x48\x42\x09\x85\x0a\x6c\x5b\xde\x46\xad\xc1\x6e\x75\xb0\xc7\x22\x87\xe0\xf4\x46\x52\x48\x79\xe1\x13\x8f\x1d\x09\xdc\xc2\x89\xa8\x36\x2c\xc2\xdf\x37\x57\x73\xab\x6a\xc9\x1d\xe5\x06\x89\xfb\x87\xee\x8a\x2c\x5b\x43\xc2\x8b\xec\x2a\x91\xa0\xb2\xdc\x63\xc2\x4d\x18\x83\xee\xbd\xb2\x60\x4f\x0e\x75\xd0\xa1\x34\x67\x91\x99\x44\x25\x34\xf1\x17\xcd\x87\x9d\xcf\x3c\xfe\xe5\x1f\x76\x30\x07\xf3\x1b\x00\x00\xff\xff\x4f\x9f\x84\x5d\x3b\x01\x00\x00"
+var _stakingproxyRemove_staking_proxyCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xd0\x51\x6b\xf2\x30\x14\x06\xe0\xfb\xfc\x8a\x17\x2f\x3e\xda\x1b\xf9\xae\x65\x9b\x14\x1d\x9b\x0c\xb4\x98\x5d\x6c\x97\xb1\x3d\xda\xb0\x36\x27\x1c\x4f\x37\x65\xf8\xdf\x47\xad\x74\x5d\x08\x84\x84\x73\xf2\x3e\x89\x6f\x22\x8b\xc2\xaa\xfb\xf0\xe1\x90\x0b\x9f\xce\xd8\x0b\x37\xf8\x7f\xb2\xaf\xd9\xcb\x6a\xfd\x94\x6f\x37\x6f\xef\xd9\x72\xb9\x7d\xb4\xd6\x18\x15\x17\x8e\xae\x50\xcf\x21\x09\x5c\xd2\x6a\x39\x83\x55\xf1\xe1\x90\xe2\xdb\x18\x00\x88\x42\xd1\x09\x25\xae\x28\xb8\x0d\x3a\x43\xd6\x6a\x95\xf5\x9b\xae\x08\xb7\x51\x93\x22\x76\x81\xcf\x5c\x97\x24\xb8\xc7\xad\x63\xba\x63\x11\xfe\xba\xfb\x37\x56\x4d\xd7\x5c\x52\x77\x40\x92\xff\x36\x3d\x24\x1d\x76\xf6\xc7\x7f\xad\xdc\x44\x12\xa7\x2c\x0b\x17\xdd\xce\xd7\x5e\xcf\x56\x59\xdc\x81\x72\xa7\x55\x3a\x18\xba\x39\x9f\x23\xba\xe0\x8b\x64\xb2\xe0\xb6\x2e\x11\x58\xd1\x0b\x20\xb4\x27\xa1\x50\x10\x94\x71\xec\x35\xbd\x19\xd5\x35\x7f\x92\x9a\xe1\xae\xd1\x5b\xa6\x42\x0d\x7f\xd2\x58\x35\xfc\x56\xbf\xa6\x06\x00\x2e\xe6\x62\x7e\x06\x00\xb0\x87\x75\x3d\x82\x01\x00\x00"
 
 func stakingproxyRemove_staking_proxyCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -2576,7 +3458,7 @@ func stakingproxyRemove_staking_proxyCdc() (*asset, error) {
 	}
 
 	info := bindataFileInfo{name: "stakingProxy/remove_staking_proxy.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xcd, 0xb7, 0xb8, 0xff, 0xfc, 0x1e, 0x9f, 0xd3, 0x1a, 0x76, 0xac, 0x78, 0xe0, 0x9c, 0xf6, 0x9e, 0xd9, 0xed, 0x85, 0xd4, 0x2b, 0xa4, 0x7e, 0xed, 0xc5, 0xbf, 0x38, 0x7c, 0x76, 0xe6, 0x74, 0x14}}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5b, 0x23, 0xec, 0x76, 0xda, 0xa6, 0x31, 0x24, 0xf5, 0x94, 0x37, 0x2b, 0xe4, 0x9a, 0x3b, 0x8c, 0x26, 0xf8, 0x2a, 0xf, 0xa1, 0xa2, 0x65, 0xec, 0x18, 0x22, 0x70, 0x40, 0x24, 0xa3, 0x85, 0x80}}
 	return a, nil
 }
 
@@ -2914,6 +3796,27 @@ var _bindata = map[string]func() (*asset, error){
 	"FlowServiceAccount/get_account_creators.cdc":                             flowserviceaccountGet_account_creatorsCdc,
 	"FlowServiceAccount/get_account_fee.cdc":                                  flowserviceaccountGet_account_feeCdc,
 	"FlowServiceAccount/get_tx_fee.cdc":                                       flowserviceaccountGet_tx_feeCdc,
+	"dkg/admin/end_dkg.cdc":                                                   dkgAdminEnd_dkgCdc,
+	"dkg/admin/start_dkg.cdc":                                                 dkgAdminStart_dkgCdc,
+	"dkg/participant/create_participant.cdc":                                  dkgParticipantCreate_participantCdc,
+	"dkg/participant/post_message.cdc":                                        dkgParticipantPost_messageCdc,
+	"dkg/participant/send_final_submission.cdc":                               dkgParticipantSend_final_submissionCdc,
+	"dkg/scripts/get_consensus_nodes.cdc":                                     dkgScriptsGet_consensus_nodesCdc,
+	"dkg/scripts/get_dkg_completed.cdc":                                       dkgScriptsGet_dkg_completedCdc,
+	"dkg/scripts/get_dkg_enabled.cdc":                                         dkgScriptsGet_dkg_enabledCdc,
+	"dkg/scripts/get_final_submissions.cdc":                                   dkgScriptsGet_final_submissionsCdc,
+	"dkg/scripts/get_submission_threshold.cdc":                                dkgScriptsGet_submission_thresholdCdc,
+	"dkg/scripts/get_whiteboard_message_count.cdc":                            dkgScriptsGet_whiteboard_message_countCdc,
+	"dkg/scripts/get_whiteboard_messages.cdc":                                 dkgScriptsGet_whiteboard_messagesCdc,
+	"epoch/admin/commit_epoch.cdc":                                            epochAdminCommit_epochCdc,
+	"epoch/admin/deploy_epoch.cdc":                                            epochAdminDeploy_epochCdc,
+	"epoch/admin/end_epoch.cdc":                                               epochAdminEnd_epochCdc,
+	"epoch/admin/end_staking_auction.cdc":                                     epochAdminEnd_staking_auctionCdc,
+	"epoch/admin/set_num_views.cdc":                                           epochAdminSet_num_viewsCdc,
+	"epoch/scripts/get_epoch_counter.cdc":                                     epochScriptsGet_epoch_counterCdc,
+	"epoch/scripts/get_epoch_metadata.cdc":                                    epochScriptsGet_epoch_metadataCdc,
+	"epoch/scripts/get_epoch_phase.cdc":                                       epochScriptsGet_epoch_phaseCdc,
+	"flowFees/deploy_flow_fees.cdc":                                           flowfeesDeploy_flow_feesCdc,
 	"flowToken/burn_tokens.cdc":                                               flowtokenBurn_tokensCdc,
 	"flowToken/create_forwarder.cdc":                                          flowtokenCreate_forwarderCdc,
 	"flowToken/mint_tokens.cdc":                                               flowtokenMint_tokensCdc,
@@ -2922,15 +3825,20 @@ var _bindata = map[string]func() (*asset, error){
 	"flowToken/setup_account.cdc":                                             flowtokenSetup_accountCdc,
 	"flowToken/transfer_tokens.cdc":                                           flowtokenTransfer_tokensCdc,
 	"idTableStaking/admin/change_cut.cdc":                                     idtablestakingAdminChange_cutCdc,
+	"idTableStaking/admin/change_maximum_delegation_ratios.cdc":               idtablestakingAdminChange_maximum_delegation_ratiosCdc,
+	"idTableStaking/admin/change_maximum_initial_weight.cdc":                  idtablestakingAdminChange_maximum_initial_weightCdc,
 	"idTableStaking/admin/change_minimums.cdc":                                idtablestakingAdminChange_minimumsCdc,
 	"idTableStaking/admin/change_payout.cdc":                                  idtablestakingAdminChange_payoutCdc,
+	"idTableStaking/admin/change_reward_ratios.cdc":                           idtablestakingAdminChange_reward_ratiosCdc,
 	"idTableStaking/admin/end_epoch.cdc":                                      idtablestakingAdminEnd_epochCdc,
 	"idTableStaking/admin/end_epoch_change_payout.cdc":                        idtablestakingAdminEnd_epoch_change_payoutCdc,
 	"idTableStaking/admin/end_staking.cdc":                                    idtablestakingAdminEnd_stakingCdc,
 	"idTableStaking/admin/move_tokens.cdc":                                    idtablestakingAdminMove_tokensCdc,
 	"idTableStaking/admin/pay_rewards.cdc":                                    idtablestakingAdminPay_rewardsCdc,
 	"idTableStaking/admin/remove_node.cdc":                                    idtablestakingAdminRemove_nodeCdc,
+	"idTableStaking/admin/slash_node.cdc":                                     idtablestakingAdminSlash_nodeCdc,
 	"idTableStaking/admin/transfer_minter_deploy.cdc":                         idtablestakingAdminTransfer_minter_deployCdc,
+	"idTableStaking/admin/transfer_minter_deploy_hex.cdc":                     idtablestakingAdminTransfer_minter_deploy_hexCdc,
 	"idTableStaking/delegation/del_request_unstaking.cdc":                     idtablestakingDelegationDel_request_unstakingCdc,
 	"idTableStaking/delegation/del_stake_new_tokens.cdc":                      idtablestakingDelegationDel_stake_new_tokensCdc,
 	"idTableStaking/delegation/del_stake_rewarded.cdc":                        idtablestakingDelegationDel_stake_rewardedCdc,
@@ -2961,7 +3869,10 @@ var _bindata = map[string]func() (*asset, error){
 	"idTableStaking/node/withdraw_unstaked_tokens.cdc":                        idtablestakingNodeWithdraw_unstaked_tokensCdc,
 	"idTableStaking/scripts/get_current_table.cdc":                            idtablestakingScriptsGet_current_tableCdc,
 	"idTableStaking/scripts/get_cut_percentage.cdc":                           idtablestakingScriptsGet_cut_percentageCdc,
+	"idTableStaking/scripts/get_maximum_delegation_ratios.cdc":                idtablestakingScriptsGet_maximum_delegation_ratiosCdc,
+	"idTableStaking/scripts/get_maximum_initial_weight.cdc":                   idtablestakingScriptsGet_maximum_initial_weightCdc,
 	"idTableStaking/scripts/get_node_committed_tokens.cdc":                    idtablestakingScriptsGet_node_committed_tokensCdc,
+	"idTableStaking/scripts/get_node_delegation_capacity.cdc":                 idtablestakingScriptsGet_node_delegation_capacityCdc,
 	"idTableStaking/scripts/get_node_info.cdc":                                idtablestakingScriptsGet_node_infoCdc,
 	"idTableStaking/scripts/get_node_info_from_address.cdc":                   idtablestakingScriptsGet_node_info_from_addressCdc,
 	"idTableStaking/scripts/get_node_initial_weight.cdc":                      idtablestakingScriptsGet_node_initial_weightCdc,
@@ -2978,7 +3889,9 @@ var _bindata = map[string]func() (*asset, error){
 	"idTableStaking/scripts/get_node_unstaking_request.cdc":                   idtablestakingScriptsGet_node_unstaking_requestCdc,
 	"idTableStaking/scripts/get_node_unstaking_tokens.cdc":                    idtablestakingScriptsGet_node_unstaking_tokensCdc,
 	"idTableStaking/scripts/get_proposed_table.cdc":                           idtablestakingScriptsGet_proposed_tableCdc,
+	"idTableStaking/scripts/get_reward_ratios.cdc":                            idtablestakingScriptsGet_reward_ratiosCdc,
 	"idTableStaking/scripts/get_stake_requirements.cdc":                       idtablestakingScriptsGet_stake_requirementsCdc,
+	"idTableStaking/scripts/get_supply_totals.cdc":                            idtablestakingScriptsGet_supply_totalsCdc,
 	"idTableStaking/scripts/get_table.cdc":                                    idtablestakingScriptsGet_tableCdc,
 	"idTableStaking/scripts/get_total_staked.cdc":                             idtablestakingScriptsGet_total_stakedCdc,
 	"idTableStaking/scripts/get_total_staked_by_type.cdc":                     idtablestakingScriptsGet_total_staked_by_typeCdc,
@@ -2996,7 +3909,9 @@ var _bindata = map[string]func() (*asset, error){
 	"lockedTokens/admin/custody_create_shared_accounts.cdc":                   lockedtokensAdminCustody_create_shared_accountsCdc,
 	"lockedTokens/admin/custody_setup_account_creator.cdc":                    lockedtokensAdminCustody_setup_account_creatorCdc,
 	"lockedTokens/admin/deposit_locked_tokens.cdc":                            lockedtokensAdminDeposit_locked_tokensCdc,
+	"lockedTokens/admin/deposit_locked_tokens_batch.cdc":                      lockedtokensAdminDeposit_locked_tokens_batchCdc,
 	"lockedTokens/admin/unlock_tokens.cdc":                                    lockedtokensAdminUnlock_tokensCdc,
+	"lockedTokens/admin/unlock_tokens_batch.cdc":                              lockedtokensAdminUnlock_tokens_batchCdc,
 	"lockedTokens/delegator/delegate_new_tokens.cdc":                          lockedtokensDelegatorDelegate_new_tokensCdc,
 	"lockedTokens/delegator/delegate_rewarded_tokens.cdc":                     lockedtokensDelegatorDelegate_rewarded_tokensCdc,
 	"lockedTokens/delegator/delegate_unstaked_tokens.cdc":                     lockedtokensDelegatorDelegate_unstaked_tokensCdc,
@@ -3025,6 +3940,15 @@ var _bindata = map[string]func() (*asset, error){
 	"lockedTokens/user/get_total_balance.cdc":                                 lockedtokensUserGet_total_balanceCdc,
 	"lockedTokens/user/get_unlock_limit.cdc":                                  lockedtokensUserGet_unlock_limitCdc,
 	"lockedTokens/user/withdraw_tokens.cdc":                                   lockedtokensUserWithdraw_tokensCdc,
+	"quorumCertificate/admin/start_voting.cdc":                                quorumcertificateAdminStart_votingCdc,
+	"quorumCertificate/admin/stop_voting.cdc":                                 quorumcertificateAdminStop_votingCdc,
+	"quorumCertificate/scripts/get_cluster_complete.cdc":                      quorumcertificateScriptsGet_cluster_completeCdc,
+	"quorumCertificate/scripts/get_cluster_votes.cdc":                         quorumcertificateScriptsGet_cluster_votesCdc,
+	"quorumCertificate/scripts/get_node_has_voted.cdc":                        quorumcertificateScriptsGet_node_has_votedCdc,
+	"quorumCertificate/scripts/get_voting_completed.cdc":                      quorumcertificateScriptsGet_voting_completedCdc,
+	"quorumCertificate/scripts/get_voting_in_progress.cdc":                    quorumcertificateScriptsGet_voting_in_progressCdc,
+	"quorumCertificate/voter/create_voter.cdc":                                quorumcertificateVoterCreate_voterCdc,
+	"quorumCertificate/voter/submit_vote.cdc":                                 quorumcertificateVoterSubmit_voteCdc,
 	"stakingProxy/add_node_info.cdc":                                          stakingproxyAdd_node_infoCdc,
 	"stakingProxy/get_node_info.cdc":                                          stakingproxyGet_node_infoCdc,
 	"stakingProxy/register_node.cdc":                                          stakingproxyRegister_nodeCdc,
@@ -3093,6 +4017,43 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"get_account_fee.cdc": {flowserviceaccountGet_account_feeCdc, map[string]*bintree{}},
 		"get_tx_fee.cdc": {flowserviceaccountGet_tx_feeCdc, map[string]*bintree{}},
 	}},
+	"dkg": {nil, map[string]*bintree{
+		"admin": {nil, map[string]*bintree{
+			"end_dkg.cdc": {dkgAdminEnd_dkgCdc, map[string]*bintree{}},
+			"start_dkg.cdc": {dkgAdminStart_dkgCdc, map[string]*bintree{}},
+		}},
+		"participant": {nil, map[string]*bintree{
+			"create_participant.cdc": {dkgParticipantCreate_participantCdc, map[string]*bintree{}},
+			"post_message.cdc": {dkgParticipantPost_messageCdc, map[string]*bintree{}},
+			"send_final_submission.cdc": {dkgParticipantSend_final_submissionCdc, map[string]*bintree{}},
+		}},
+		"scripts": {nil, map[string]*bintree{
+			"get_consensus_nodes.cdc": {dkgScriptsGet_consensus_nodesCdc, map[string]*bintree{}},
+			"get_dkg_completed.cdc": {dkgScriptsGet_dkg_completedCdc, map[string]*bintree{}},
+			"get_dkg_enabled.cdc": {dkgScriptsGet_dkg_enabledCdc, map[string]*bintree{}},
+			"get_final_submissions.cdc": {dkgScriptsGet_final_submissionsCdc, map[string]*bintree{}},
+			"get_submission_threshold.cdc": {dkgScriptsGet_submission_thresholdCdc, map[string]*bintree{}},
+			"get_whiteboard_message_count.cdc": {dkgScriptsGet_whiteboard_message_countCdc, map[string]*bintree{}},
+			"get_whiteboard_messages.cdc": {dkgScriptsGet_whiteboard_messagesCdc, map[string]*bintree{}},
+		}},
+	}},
+	"epoch": {nil, map[string]*bintree{
+		"admin": {nil, map[string]*bintree{
+			"commit_epoch.cdc": {epochAdminCommit_epochCdc, map[string]*bintree{}},
+			"deploy_epoch.cdc": {epochAdminDeploy_epochCdc, map[string]*bintree{}},
+			"end_epoch.cdc": {epochAdminEnd_epochCdc, map[string]*bintree{}},
+			"end_staking_auction.cdc": {epochAdminEnd_staking_auctionCdc, map[string]*bintree{}},
+			"set_num_views.cdc": {epochAdminSet_num_viewsCdc, map[string]*bintree{}},
+		}},
+		"scripts": {nil, map[string]*bintree{
+			"get_epoch_counter.cdc": {epochScriptsGet_epoch_counterCdc, map[string]*bintree{}},
+			"get_epoch_metadata.cdc": {epochScriptsGet_epoch_metadataCdc, map[string]*bintree{}},
+			"get_epoch_phase.cdc": {epochScriptsGet_epoch_phaseCdc, map[string]*bintree{}},
+		}},
+	}},
+	"flowFees": {nil, map[string]*bintree{
+		"deploy_flow_fees.cdc": {flowfeesDeploy_flow_feesCdc, map[string]*bintree{}},
+	}},
 	"flowToken": {nil, map[string]*bintree{
 		"burn_tokens.cdc": {flowtokenBurn_tokensCdc, map[string]*bintree{}},
 		"create_forwarder.cdc": {flowtokenCreate_forwarderCdc, map[string]*bintree{}},
@@ -3107,15 +4068,20 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"idTableStaking": {nil, map[string]*bintree{
 		"admin": {nil, map[string]*bintree{
 			"change_cut.cdc": {idtablestakingAdminChange_cutCdc, map[string]*bintree{}},
+			"change_maximum_delegation_ratios.cdc": {idtablestakingAdminChange_maximum_delegation_ratiosCdc, map[string]*bintree{}},
+			"change_maximum_initial_weight.cdc": {idtablestakingAdminChange_maximum_initial_weightCdc, map[string]*bintree{}},
 			"change_minimums.cdc": {idtablestakingAdminChange_minimumsCdc, map[string]*bintree{}},
 			"change_payout.cdc": {idtablestakingAdminChange_payoutCdc, map[string]*bintree{}},
+			"change_reward_ratios.cdc": {idtablestakingAdminChange_reward_ratiosCdc, map[string]*bintree{}},
 			"end_epoch.cdc": {idtablestakingAdminEnd_epochCdc, map[string]*bintree{}},
 			"end_epoch_change_payout.cdc": {idtablestakingAdminEnd_epoch_change_payoutCdc, map[string]*bintree{}},
 			"end_staking.cdc": {idtablestakingAdminEnd_stakingCdc, map[string]*bintree{}},
 			"move_tokens.cdc": {idtablestakingAdminMove_tokensCdc, map[string]*bintree{}},
 			"pay_rewards.cdc": {idtablestakingAdminPay_rewardsCdc, map[string]*bintree{}},
 			"remove_node.cdc": {idtablestakingAdminRemove_nodeCdc, map[string]*bintree{}},
+			"slash_node.cdc": {idtablestakingAdminSlash_nodeCdc, map[string]*bintree{}},
 			"transfer_minter_deploy.cdc": {idtablestakingAdminTransfer_minter_deployCdc, map[string]*bintree{}},
+			"transfer_minter_deploy_hex.cdc": {idtablestakingAdminTransfer_minter_deploy_hexCdc, map[string]*bintree{}},
 		}},
 		"delegation": {nil, map[string]*bintree{
 			"del_request_unstaking.cdc": {idtablestakingDelegationDel_request_unstakingCdc, map[string]*bintree{}},
@@ -3152,7 +4118,10 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"scripts": {nil, map[string]*bintree{
 			"get_current_table.cdc": {idtablestakingScriptsGet_current_tableCdc, map[string]*bintree{}},
 			"get_cut_percentage.cdc": {idtablestakingScriptsGet_cut_percentageCdc, map[string]*bintree{}},
+			"get_maximum_delegation_ratios.cdc": {idtablestakingScriptsGet_maximum_delegation_ratiosCdc, map[string]*bintree{}},
+			"get_maximum_initial_weight.cdc": {idtablestakingScriptsGet_maximum_initial_weightCdc, map[string]*bintree{}},
 			"get_node_committed_tokens.cdc": {idtablestakingScriptsGet_node_committed_tokensCdc, map[string]*bintree{}},
+			"get_node_delegation_capacity.cdc": {idtablestakingScriptsGet_node_delegation_capacityCdc, map[string]*bintree{}},
 			"get_node_info.cdc": {idtablestakingScriptsGet_node_infoCdc, map[string]*bintree{}},
 			"get_node_info_from_address.cdc": {idtablestakingScriptsGet_node_info_from_addressCdc, map[string]*bintree{}},
 			"get_node_initial_weight.cdc": {idtablestakingScriptsGet_node_initial_weightCdc, map[string]*bintree{}},
@@ -3169,7 +4138,9 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"get_node_unstaking_request.cdc": {idtablestakingScriptsGet_node_unstaking_requestCdc, map[string]*bintree{}},
 			"get_node_unstaking_tokens.cdc": {idtablestakingScriptsGet_node_unstaking_tokensCdc, map[string]*bintree{}},
 			"get_proposed_table.cdc": {idtablestakingScriptsGet_proposed_tableCdc, map[string]*bintree{}},
+			"get_reward_ratios.cdc": {idtablestakingScriptsGet_reward_ratiosCdc, map[string]*bintree{}},
 			"get_stake_requirements.cdc": {idtablestakingScriptsGet_stake_requirementsCdc, map[string]*bintree{}},
+			"get_supply_totals.cdc": {idtablestakingScriptsGet_supply_totalsCdc, map[string]*bintree{}},
 			"get_table.cdc": {idtablestakingScriptsGet_tableCdc, map[string]*bintree{}},
 			"get_total_staked.cdc": {idtablestakingScriptsGet_total_stakedCdc, map[string]*bintree{}},
 			"get_total_staked_by_type.cdc": {idtablestakingScriptsGet_total_staked_by_typeCdc, map[string]*bintree{}},
@@ -3191,7 +4162,9 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"custody_create_shared_accounts.cdc": {lockedtokensAdminCustody_create_shared_accountsCdc, map[string]*bintree{}},
 			"custody_setup_account_creator.cdc": {lockedtokensAdminCustody_setup_account_creatorCdc, map[string]*bintree{}},
 			"deposit_locked_tokens.cdc": {lockedtokensAdminDeposit_locked_tokensCdc, map[string]*bintree{}},
+			"deposit_locked_tokens_batch.cdc": {lockedtokensAdminDeposit_locked_tokens_batchCdc, map[string]*bintree{}},
 			"unlock_tokens.cdc": {lockedtokensAdminUnlock_tokensCdc, map[string]*bintree{}},
+			"unlock_tokens_batch.cdc": {lockedtokensAdminUnlock_tokens_batchCdc, map[string]*bintree{}},
 		}},
 		"delegator": {nil, map[string]*bintree{
 			"delegate_new_tokens.cdc": {lockedtokensDelegatorDelegate_new_tokensCdc, map[string]*bintree{}},
@@ -3228,6 +4201,23 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"withdraw_tokens.cdc": {lockedtokensUserWithdraw_tokensCdc, map[string]*bintree{}},
 		}},
 	}},
+	"quorumCertificate": {nil, map[string]*bintree{
+		"admin": {nil, map[string]*bintree{
+			"start_voting.cdc": {quorumcertificateAdminStart_votingCdc, map[string]*bintree{}},
+			"stop_voting.cdc": {quorumcertificateAdminStop_votingCdc, map[string]*bintree{}},
+		}},
+		"scripts": {nil, map[string]*bintree{
+			"get_cluster_complete.cdc": {quorumcertificateScriptsGet_cluster_completeCdc, map[string]*bintree{}},
+			"get_cluster_votes.cdc": {quorumcertificateScriptsGet_cluster_votesCdc, map[string]*bintree{}},
+			"get_node_has_voted.cdc": {quorumcertificateScriptsGet_node_has_votedCdc, map[string]*bintree{}},
+			"get_voting_completed.cdc": {quorumcertificateScriptsGet_voting_completedCdc, map[string]*bintree{}},
+			"get_voting_in_progress.cdc": {quorumcertificateScriptsGet_voting_in_progressCdc, map[string]*bintree{}},
+		}},
+		"voter": {nil, map[string]*bintree{
+			"create_voter.cdc": {quorumcertificateVoterCreate_voterCdc, map[string]*bintree{}},
+			"submit_vote.cdc": {quorumcertificateVoterSubmit_voteCdc, map[string]*bintree{}},
+		}},
+	}},
 	"stakingProxy": {nil, map[string]*bintree{
 		"add_node_info.cdc": {stakingproxyAdd_node_infoCdc, map[string]*bintree{}},
 		"get_node_info.cdc": {stakingproxyGet_node_infoCdc, map[string]*bintree{}},
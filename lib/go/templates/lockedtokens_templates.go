@@ -12,6 +12,8 @@ const (
 	checkMainRegistrationFilename           = "lockedTokens/admin/check_main_registration.cdc"
 	depositLockedTokensFilename             = "lockedTokens/admin/deposit_locked_tokens.cdc"
 	increaseUnlockLimitFilename             = "lockedTokens/admin/unlock_tokens.cdc"
+	depositLockedTokensBatchFilename        = "lockedTokens/admin/deposit_locked_tokens_batch.cdc"
+	increaseUnlockLimitBatchFilename        = "lockedTokens/admin/unlock_tokens_batch.cdc"
 	depositAccountCreatorCapabilityFilename = "lockedTokens/admin/admin_deposit_account_creator.cdc"
 	removeDelegatorFilename                 = "lockedTokens/admin/admin_remove_delegator.cdc"
 
@@ -93,6 +95,18 @@ func GenerateIncreaseUnlockLimitScript(env Environment) []byte {
 	return []byte(replaceAddresses(code, env))
 }
 
+func GenerateDepositLockedTokensBatchScript(env Environment) []byte {
+	code := assets.MustAssetString(depositLockedTokensBatchFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+func GenerateIncreaseUnlockLimitBatchScript(env Environment) []byte {
+	code := assets.MustAssetString(increaseUnlockLimitBatchFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
 func GenerateDepositAccountCreatorScript(env Environment) []byte {
 	code := assets.MustAssetString(depositAccountCreatorCapabilityFilename)
 
@@ -0,0 +1,90 @@
+package templates
+
+import (
+	"github.com/onflow/flow-core-contracts/lib/go/templates/internal/assets"
+)
+
+const (
+	// admin templates
+	startVotingFilename = "quorumCertificate/admin/start_voting.cdc"
+	stopVotingFilename  = "quorumCertificate/admin/stop_voting.cdc"
+
+	// voter templates
+	createVoterFilename = "quorumCertificate/voter/create_voter.cdc"
+	submitVoteFilename  = "quorumCertificate/voter/submit_vote.cdc"
+
+	// scripts
+	getClusterCompleteFilename  = "quorumCertificate/scripts/get_cluster_complete.cdc"
+	getVotingCompletedFilename  = "quorumCertificate/scripts/get_voting_completed.cdc"
+	getClusterVotesFilename     = "quorumCertificate/scripts/get_cluster_votes.cdc"
+	getNodeHasVotedFilename     = "quorumCertificate/scripts/get_node_has_voted.cdc"
+	getVotingInProgressFilename = "quorumCertificate/scripts/get_voting_in_progress.cdc"
+)
+
+// GenerateStartVotingScript generates a script that starts the QC voting
+// for the clusters of the next epoch
+func GenerateStartVotingScript(env Environment) []byte {
+	code := assets.MustAssetString(startVotingFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateStopVotingScript generates a script that stops accepting QC votes
+func GenerateStopVotingScript(env Environment) []byte {
+	code := assets.MustAssetString(stopVotingFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateCreateVoterScript generates a script that creates the QC voter
+// of the collection node staked by the signer
+func GenerateCreateVoterScript(env Environment) []byte {
+	code := assets.MustAssetString(createVoterFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateSubmitVoteScript generates a script that submits the vote of a collection node
+func GenerateSubmitVoteScript(env Environment) []byte {
+	code := assets.MustAssetString(submitVoteFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetClusterCompleteScript generates a script that returns true
+// if a cluster has enough votes for its QC
+func GenerateGetClusterCompleteScript(env Environment) []byte {
+	code := assets.MustAssetString(getClusterCompleteFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetVotingCompletedScript generates a script that returns true
+// if every cluster has enough votes for its QC
+func GenerateGetVotingCompletedScript(env Environment) []byte {
+	code := assets.MustAssetString(getVotingCompletedFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetClusterVotesScript generates a script that returns the votes of a cluster
+func GenerateGetClusterVotesScript(env Environment) []byte {
+	code := assets.MustAssetString(getClusterVotesFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetNodeHasVotedScript generates a script that returns true if a node has voted
+func GenerateGetNodeHasVotedScript(env Environment) []byte {
+	code := assets.MustAssetString(getNodeHasVotedFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
+
+// GenerateGetVotingInProgressScript generates a script that returns true
+// if the QC voting is accepting votes
+func GenerateGetVotingInProgressScript(env Environment) []byte {
+	code := assets.MustAssetString(getVotingInProgressFilename)
+
+	return []byte(replaceAddresses(code, env))
+}
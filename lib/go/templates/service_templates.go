@@ -6,6 +6,10 @@ import (
 
 const (
 
+	// FlowFees templates
+
+	deployFlowFeesFilename = "flowFees/deploy_flow_fees.cdc"
+
 	// FlowStorageFees templates
 
 	changeStorageFeeParametersFilename = "storageFees/admin/set_parameters.cdc"
@@ -17,6 +21,12 @@ const (
 	getStorageCapacityFilename         = "storageFees/scripts/get_storage_capacity.cdc"
 )
 
+// FlowFees Templates
+
+func GenerateDeployFlowFeesScript() []byte {
+	return assets.MustAsset(deployFlowFeesFilename)
+}
+
 // StorageFees Templates
 
 func GenerateChangeStorageFeeParametersScript(env Environment) []byte {
@@ -5,8 +5,11 @@ package templates
 //go:generate go run ./manifest/main.go ./manifest/manifest.go manifest.mainnet.json --network mainnet
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates/internal/assets"
 )
 
 const (
@@ -16,6 +19,9 @@ const (
 	placeholderLockedTokensAddress  = "0xLOCKEDTOKENADDRESS"
 	placeholderStakingProxyAddress  = "0xSTAKINGPROXYADDRESS"
 	placeholderStorageFeesAddress   = "0xFLOWSTORAGEFEESADDRESS"
+	placeholderEpochAddress         = "0xEPOCHADDRESS"
+	placeholderQCAddress            = "0xQCADDRESS"
+	placeholderDKGAddress           = "0xDKGADDRESS"
 )
 
 type Environment struct {
@@ -26,6 +32,9 @@ type Environment struct {
 	LockedTokensAddress  string
 	StakingProxyAddress  string
 	StorageFeesAddress   string
+	EpochAddress         string
+	QCAddress            string
+	DKGAddress           string
 }
 
 func withHexPrefix(address string) string {
@@ -78,5 +87,38 @@ func replaceAddresses(code string, env Environment) string {
 		withHexPrefix(env.StorageFeesAddress),
 	)
 
+	code = strings.ReplaceAll(
+		code,
+		placeholderEpochAddress,
+		withHexPrefix(env.EpochAddress),
+	)
+
+	code = strings.ReplaceAll(
+		code,
+		placeholderQCAddress,
+		withHexPrefix(env.QCAddress),
+	)
+
+	code = strings.ReplaceAll(
+		code,
+		placeholderDKGAddress,
+		withHexPrefix(env.DKGAddress),
+	)
+
 	return code
 }
+
+// ErrUnknownTemplate is returned by Get for a path that isn't a template.
+var ErrUnknownTemplate = errors.New("unknown template")
+
+// Get returns the code of a template with the addresses of the environment,
+// by its path relative to the transactions directory, e.g. "idTableStaking/node/register_node.cdc".
+// An error wrapping ErrUnknownTemplate is returned for a path that isn't a template.
+func Get(path string, env Environment) ([]byte, error) {
+	code, err := assets.Asset(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q", ErrUnknownTemplate, path)
+	}
+
+	return []byte(replaceAddresses(string(code), env)), nil
+}
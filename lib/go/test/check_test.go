@@ -0,0 +1,392 @@
+package test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	cadenceast "github.com/onflow/cadence/runtime/ast"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/onflow/cadence/runtime/errors"
+	parser2 "github.com/onflow/cadence/runtime/parser2"
+	"github.com/onflow/cadence/runtime/sema"
+	"github.com/onflow/cadence/runtime/stdlib"
+	ftcontracts "github.com/onflow/flow-ft/lib/go/contracts"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+const (
+	transactionsDir = "../../../transactions"
+	contractsDir    = "../../../contracts"
+)
+
+// The placeholders of some transactions that templates.Environment doesn't replace,
+// because the transactions are only used by other repositories.
+const (
+	placeholderTokenAddress      = "0xTOKENADDRESS"
+	placeholderIDTableAddress    = "0xFLOWIDTABLESTAKINGADDRESS"
+	placeholderServiceAddress    = "0xSERVICEADDRESS"
+	placeholderForwardingAddress = "0xFORWARDINGADDRESS"
+)
+
+// checkedContract is a contract that the imports of the checked programs resolve to.
+type checkedContract struct {
+	name     string
+	filename string
+	address  string
+	code     []byte
+}
+
+// cadenceChecker type-checks Cadence programs offline, resolving their imports
+// to the core contracts deployed to the addresses of a network.
+type cadenceChecker struct {
+	contracts    map[common.AddressLocation]checkedContract
+	elaborations map[common.LocationID]*sema.Elaboration
+}
+
+var (
+	checkerValueDeclarations = append(
+		append(stdlib.FlowBuiltInFunctions(stdlib.FlowBuiltinImpls{}), stdlib.BuiltinFunctions...).ToSemaValueDeclarations(),
+		stdlib.BuiltinValues.ToSemaValueDeclarations()...,
+	)
+
+	checkerTypeDeclarations = append(stdlib.FlowBuiltInTypes, stdlib.BuiltinTypes...).ToTypeDeclarations()
+)
+
+func newCadenceChecker(t *testing.T, network goldenNetwork) *cadenceChecker {
+	env := network.env
+
+	address := func(hex string) contracts.Address {
+		address, err := contracts.ParseAddress(hex, network.chain)
+		require.NoError(t, err)
+		return address
+	}
+
+	location := func(hex, name string) common.AddressLocation {
+		return common.AddressLocation{Address: common.Address(address(hex)), Name: name}
+	}
+
+	addresses := contracts.Addresses{
+		FungibleToken: address(env.FungibleTokenAddress),
+		FlowToken:     address(env.FlowTokenAddress),
+		FlowFees:      address(network.flowFeesAddress),
+		StorageFees:   address(env.StorageFeesAddress),
+		IDTable:       address(env.IDTableAddress),
+		StakingProxy:  address(env.StakingProxyAddress),
+	}
+
+	deployed := []checkedContract{
+		{name: contracts.FungibleTokenName, address: env.FungibleTokenAddress},
+		{name: contracts.FlowTokenName, filename: "FlowToken.cdc", address: env.FlowTokenAddress},
+		{name: contracts.FlowFeesName, filename: "FlowFees.cdc", address: network.flowFeesAddress},
+		{name: contracts.FlowStorageFeesName, filename: "FlowStorageFees.cdc", address: env.StorageFeesAddress},
+		{name: contracts.FlowServiceAccountName, filename: "FlowServiceAccount.cdc", address: env.StorageFeesAddress},
+		{name: contracts.FlowIDTableStakingName, filename: "FlowIDTableStaking.cdc", address: env.IDTableAddress},
+		{name: contracts.StakingProxyName, filename: "StakingProxy.cdc", address: env.StakingProxyAddress},
+		{name: contracts.LockedTokensName, filename: "LockedTokens.cdc", address: env.LockedTokensAddress},
+		{name: contracts.FlowEpochName, filename: "epochs/FlowEpoch.cdc", address: env.EpochAddress},
+		{name: contracts.FlowQuorumCertificateName, filename: "epochs/FlowQuorumCertificate.cdc", address: env.QCAddress},
+		{name: contracts.FlowDKGName, filename: "epochs/FlowDKG.cdc", address: env.DKGAddress},
+	}
+
+	c := &cadenceChecker{
+		contracts:    make(map[common.AddressLocation]checkedContract, len(deployed)+1),
+		elaborations: make(map[common.LocationID]*sema.Elaboration),
+	}
+
+	for _, contract := range deployed {
+		code, err := contracts.Get(contract.name, addresses)
+		require.NoError(t, err)
+
+		contract.code = code
+		if contract.filename == "" {
+			contract.filename = "FungibleToken.cdc (flow-ft)"
+		} else {
+			contract.filename = filepath.Join("contracts", contract.filename)
+		}
+
+		c.contracts[location(contract.address, contract.name)] = contract
+	}
+
+	// TokenForwarding isn't a core contract, but the forwarder transaction of FlowToken imports it
+	c.contracts[location(env.FungibleTokenAddress, "TokenForwarding")] = checkedContract{
+		name:     "TokenForwarding",
+		filename: "TokenForwarding.cdc (flow-ft)",
+		address:  env.FungibleTokenAddress,
+		code:     ftcontracts.TokenForwarding(env.FungibleTokenAddress),
+	}
+
+	return c
+}
+
+// replacePlaceholders replaces the placeholders that templates.Get doesn't replace.
+func replacePlaceholders(code []byte, network goldenNetwork) []byte {
+	env := network.env
+
+	return []byte(strings.NewReplacer(
+		placeholderTokenAddress, "0x"+env.FlowTokenAddress,
+		placeholderIDTableAddress, "0x"+env.IDTableAddress,
+		placeholderServiceAddress, "0x"+env.StorageFeesAddress,
+		placeholderForwardingAddress, "0x"+env.FungibleTokenAddress,
+	).Replace(string(code)))
+}
+
+// check parses and type-checks a program and returns its errors, formatted with the filename and position.
+func (c *cadenceChecker) check(filename string, code []byte, location common.Location) ([]string, *sema.Elaboration) {
+	program, err := parser2.ParseProgram(string(code))
+	if err != nil {
+		return formatCadenceErrors(filename, err), nil
+	}
+
+	checker, err := sema.NewChecker(
+		program,
+		location,
+		sema.WithPredeclaredValues(checkerValueDeclarations),
+		sema.WithPredeclaredTypes(checkerTypeDeclarations),
+		sema.WithLocationHandler(c.resolveLocation),
+		sema.WithImportHandler(c.importProgram),
+	)
+	if err != nil {
+		return []string{fmt.Sprintf("%s: %s", filename, err)}, nil
+	}
+
+	if err := checker.Check(); err != nil {
+		return formatCadenceErrors(filename, err), checker.Elaboration
+	}
+
+	return nil, checker.Elaboration
+}
+
+// resolveLocation resolves every identifier imported from an address to its contract,
+// like the Flow virtual machine.
+func (c *cadenceChecker) resolveLocation(identifiers []cadenceast.Identifier, location common.Location) ([]sema.ResolvedLocation, error) {
+	addressLocation, ok := location.(common.AddressLocation)
+	if !ok {
+		return []sema.ResolvedLocation{{Location: location, Identifiers: identifiers}}, nil
+	}
+
+	resolved := make([]sema.ResolvedLocation, len(identifiers))
+	for i, identifier := range identifiers {
+		resolved[i] = sema.ResolvedLocation{
+			Location: common.AddressLocation{
+				Address: addressLocation.Address,
+				Name:    identifier.Identifier,
+			},
+			Identifiers: []cadenceast.Identifier{identifier},
+		}
+	}
+
+	return resolved, nil
+}
+
+func (c *cadenceChecker) importProgram(_ *sema.Checker, location common.Location) (sema.Import, error) {
+	if location == stdlib.CryptoChecker.Location {
+		return sema.ElaborationImport{Elaboration: stdlib.CryptoChecker.Elaboration}, nil
+	}
+
+	if elaboration, ok := c.elaborations[location.ID()]; ok {
+		return sema.ElaborationImport{Elaboration: elaboration}, nil
+	}
+
+	addressLocation, ok := location.(common.AddressLocation)
+	if !ok {
+		return nil, fmt.Errorf("cannot import %s", location)
+	}
+
+	contract, ok := c.contracts[addressLocation]
+	if !ok {
+		return nil, fmt.Errorf("no contract %s is deployed to %s", addressLocation.Name, addressLocation.Address.ShortHexWithPrefix())
+	}
+
+	errs, elaboration := c.check(contract.filename, contract.code, location)
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("contract %s has errors:\n%s", contract.name, strings.Join(errs, "\n"))
+	}
+
+	c.elaborations[location.ID()] = elaboration
+
+	return sema.ElaborationImport{Elaboration: elaboration}, nil
+}
+
+// formatCadenceErrors returns the parse or type errors of a program, one per line,
+// prefixed with the filename and the position of the error.
+func formatCadenceErrors(filename string, err error) []string {
+	var errs []error
+	if parent, ok := err.(errors.ParentError); ok {
+		errs = parent.ChildErrors()
+	} else {
+		errs = []error{err}
+	}
+
+	formatted := make([]string, len(errs))
+	for i, err := range errs {
+		message := err.Error()
+		if secondary, ok := err.(errors.SecondaryError); ok {
+			message = fmt.Sprintf("%s: %s", message, secondary.SecondaryError())
+		}
+
+		if positioned, ok := err.(cadenceast.HasPosition); ok {
+			position := positioned.StartPosition()
+			formatted[i] = fmt.Sprintf("%s:%d:%d: %s", filename, position.Line, position.Column+1, message)
+		} else {
+			formatted[i] = fmt.Sprintf("%s: %s", filename, message)
+		}
+	}
+
+	return formatted
+}
+
+// transactionFilenames returns the paths of all transactions and scripts, relative to the transactions directory.
+func transactionFilenames(t *testing.T) []string {
+	var filenames []string
+
+	err := filepath.Walk(transactionsDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != ".cdc" {
+			return err
+		}
+
+		filename, err := filepath.Rel(transactionsDir, path)
+		if err != nil {
+			return err
+		}
+
+		filenames = append(filenames, filepath.ToSlash(filename))
+		return nil
+	})
+	require.NoError(t, err)
+
+	return filenames
+}
+
+// TestCadenceCheck parses and type-checks every contract, transaction and script
+// with the addresses of every network, without an emulator.
+func TestCadenceCheck(t *testing.T) {
+	filenames := transactionFilenames(t)
+	require.NotEmpty(t, filenames)
+
+	for _, network := range goldenNetworks {
+		network := network
+
+		t.Run(network.env.Network, func(t *testing.T) {
+			checker := newCadenceChecker(t, network)
+
+			t.Run("contracts", func(t *testing.T) {
+				locations := make([]common.AddressLocation, 0, len(checker.contracts))
+				for location := range checker.contracts {
+					locations = append(locations, location)
+				}
+				sort.Slice(locations, func(i, j int) bool { return locations[i].ID() < locations[j].ID() })
+
+				for _, location := range locations {
+					contract := checker.contracts[location]
+
+					errs, _ := checker.check(contract.filename, contract.code, location)
+					for _, err := range errs {
+						t.Error(err)
+					}
+				}
+
+				// The test version of FlowIDTableStaking is never deployed next to the real one
+				errs, _ := checker.check(
+					"contracts/testContracts/TestFlowIDTableStaking.cdc",
+					contracts.TESTFlowIDTableStaking(network.env.FungibleTokenAddress, network.env.FlowTokenAddress),
+					common.StringLocation("TestFlowIDTableStaking"),
+				)
+				for _, err := range errs {
+					t.Error(err)
+				}
+			})
+
+			t.Run("transactions", func(t *testing.T) {
+				for _, filename := range filenames {
+					code, err := templates.Get(filename, network.env)
+					require.NoError(t, err)
+
+					errs, _ := checker.check(
+						"transactions/"+filename,
+						replacePlaceholders(code, network),
+						common.StringLocation(filename),
+					)
+					for _, err := range errs {
+						t.Error(err)
+					}
+				}
+			})
+		})
+	}
+}
+
+// TestTemplateFilenames checks that the filename constants of the templates and contracts packages
+// name existing transactions, scripts and contracts, so that no Generate* function panics.
+func TestTemplateFilenames(t *testing.T) {
+	for dir, root := range map[string]string{
+		"../templates": transactionsDir,
+		"../contracts": contractsDir,
+	} {
+		packages, err := parser.ParseDir(token.NewFileSet(), dir, func(info os.FileInfo) bool {
+			return !strings.HasSuffix(info.Name(), "_test.go")
+		}, 0)
+		require.NoError(t, err)
+
+		for _, pkg := range packages {
+			for filename, file := range pkg.Files {
+				for _, constant := range cadenceFilenameConstants(file) {
+					_, err := os.Stat(filepath.Join(root, constant.value))
+					assert.NoError(t, err, "%s: %s", filename, constant.name)
+				}
+			}
+		}
+	}
+}
+
+type filenameConstant struct {
+	name  string
+	value string
+}
+
+// cadenceFilenameConstants returns the string constants of a file that name a Cadence file.
+func cadenceFilenameConstants(file *ast.File) []filenameConstant {
+	var constants []filenameConstant
+
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+
+			for i, name := range value.Names {
+				if i >= len(value.Values) {
+					break
+				}
+
+				literal, ok := value.Values[i].(*ast.BasicLit)
+				if !ok || literal.Kind != token.STRING {
+					continue
+				}
+
+				s, err := strconv.Unquote(literal.Value)
+				if err != nil || !strings.HasSuffix(s, ".cdc") {
+					continue
+				}
+
+				constants = append(constants, filenameConstant{name: name.Name, value: s})
+			}
+		}
+	}
+
+	return constants
+}
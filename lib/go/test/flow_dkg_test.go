@@ -0,0 +1,240 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	sdktemplates "github.com/onflow/flow-go-sdk/templates"
+	"github.com/onflow/flow-go-sdk/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+func stringArray(values ...string) cadence.Array {
+	array := make([]cadence.Value, len(values))
+	for i, value := range values {
+		array[i] = cadence.NewString(value)
+	}
+
+	return cadence.NewArray(array)
+}
+
+func TestDKG(t *testing.T) {
+
+	t.Parallel()
+
+	b := newBlockchain()
+
+	env := templates.Environment{
+		FungibleTokenAddress: emulatorFTAddress,
+		FlowTokenAddress:     emulatorFlowTokenAddress,
+	}
+
+	accountKeys := test.AccountKeyGenerator()
+
+	IDTableAccountKey, IDTableSigner := accountKeys.NewWithSigner()
+	idTableAddress := deployStakingContract(t, b, IDTableAccountKey, env)
+
+	env.IDTableAddress = idTableAddress.Hex()
+
+	DKGAccountKey, DKGSigner := accountKeys.NewWithSigner()
+	dkgAddress, err := b.CreateAccount([]*flow.AccountKey{DKGAccountKey}, []sdktemplates.Contract{
+		{
+			Name:   "FlowDKG",
+			Source: string(contracts.FlowDKG(idTableAddress.Hex())),
+		},
+	})
+	require.NoError(t, err)
+
+	env.DKGAddress = dkgAddress.Hex()
+
+	type node struct {
+		id      string
+		address flow.Address
+		signer  crypto.Signer
+	}
+
+	newNode := func(id string, number int, role uint8, amount string) node {
+		key, signer := accountKeys.NewWithSigner()
+		address, _ := b.CreateAccount([]*flow.AccountKey{key}, nil)
+		mintTokensForAccount(t, b, address)
+
+		submitWithAuthorizer(t, b, templates.GenerateRegisterNodeScript(env), address, signer, false,
+			cadence.NewString(id),
+			cadence.NewUInt8(role),
+			cadence.NewString(fmt.Sprintf("%0128d", number)),
+			cadence.NewString(fmt.Sprintf("%0128d", number)),
+			cadence.NewString(fmt.Sprintf("%0192d", number)),
+			CadenceUFix64(amount),
+		)
+
+		return node{id: id, address: address, signer: signer}
+	}
+
+	// josh, max and bastian are consensus nodes, access is a consensus node
+	// that doesn't commit enough tokens and admin is a collection node
+	joshNode := newNode(joshID, josh, 2, "500000.0")
+	maxNode := newNode(maxID, max, 2, "500000.0")
+	bastianNode := newNode(bastianID, bastian, 2, "500000.0")
+	accessNode := newNode(accessID, access, 2, "100000.0")
+	adminNode := newNode(adminID, admin, 1, "250000.0")
+
+	t.Run("Shouldn't be able to create a participant before the node is staked", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateCreateParticipantScript(env), joshNode.address, joshNode.signer, true)
+	})
+
+	submitWithAuthorizer(t, b, templates.GenerateEndStakingScript(env), idTableAddress, IDTableSigner, false,
+		stringArray(joshID, maxID, bastianID, accessID, adminID),
+	)
+	submitWithAuthorizer(t, b, templates.GenerateMoveTokensScript(env), idTableAddress, IDTableSigner, false)
+
+	t.Run("Shouldn't be able to create a participant for a node that isn't a staked consensus node", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateCreateParticipantScript(env), accessNode.address, accessNode.signer, true)
+		submitWithAuthorizer(t, b, templates.GenerateCreateParticipantScript(env), adminNode.address, adminNode.signer, true)
+	})
+
+	t.Run("Should be able to create participants for staked consensus nodes", func(t *testing.T) {
+		for _, n := range []node{joshNode, maxNode, bastianNode} {
+			submitWithAuthorizer(t, b, templates.GenerateCreateParticipantScript(env), n.address, n.signer, false)
+		}
+
+		submitWithAuthorizer(t, b, templates.GenerateCreateParticipantScript(env), joshNode.address, joshNode.signer, true)
+	})
+
+	t.Run("Shouldn't be able to post messages before the DKG starts", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GeneratePostMessageScript(env), joshNode.address, joshNode.signer, true, cadence.NewString("hello"))
+	})
+
+	t.Run("Shouldn't be able to start the DKG with nodes that aren't staked consensus nodes", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateStartDKGScript(env), dkgAddress, DKGSigner, true, stringArray(joshID, accessID))
+		submitWithAuthorizer(t, b, templates.GenerateStartDKGScript(env), dkgAddress, DKGSigner, true, stringArray(joshID, adminID))
+		submitWithAuthorizer(t, b, templates.GenerateStartDKGScript(env), dkgAddress, DKGSigner, true, stringArray(joshID, nonexistantID))
+		submitWithAuthorizer(t, b, templates.GenerateStartDKGScript(env), dkgAddress, DKGSigner, true, stringArray(joshID, joshID))
+	})
+
+	t.Run("Shouldn't be able to start the DKG from another account", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateStartDKGScript(env), joshNode.address, joshNode.signer, true, stringArray(joshID))
+	})
+
+	t.Run("Should be able to start the DKG", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateStartDKGScript(env), dkgAddress, DKGSigner, false, stringArray(joshID, maxID))
+
+		result := executeScriptAndCheck(t, b, templates.GenerateGetDKGEnabledScript(env), nil)
+		assertEqual(t, cadence.NewBool(true), result)
+
+		result = executeScriptAndCheck(t, b, templates.GenerateGetConsensusNodesScript(env), nil)
+		assert.ElementsMatch(t, stringArray(joshID, maxID).Values, result.(cadence.Array).Values)
+
+		result = executeScriptAndCheck(t, b, templates.GenerateGetDKGCompletedScript(env), nil)
+		assertEqual(t, cadence.NewOptional(nil), result)
+	})
+
+	t.Run("Shouldn't be able to post messages for a node that isn't a participant", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GeneratePostMessageScript(env), bastianNode.address, bastianNode.signer, true, cadence.NewString("hello"))
+		submitWithAuthorizer(t, b, templates.GeneratePostMessageScript(env), adminNode.address, adminNode.signer, true, cadence.NewString("hello"))
+	})
+
+	t.Run("Should be able to post messages and read them since an index", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GeneratePostMessageScript(env), joshNode.address, joshNode.signer, false, cadence.NewString("first"))
+		submitWithAuthorizer(t, b, templates.GeneratePostMessageScript(env), maxNode.address, maxNode.signer, false, cadence.NewString("second"))
+		submitWithAuthorizer(t, b, templates.GeneratePostMessageScript(env), joshNode.address, joshNode.signer, false, cadence.NewString("third"))
+
+		submitWithAuthorizer(t, b, templates.GeneratePostMessageScript(env), joshNode.address, joshNode.signer, true, cadence.NewString(""))
+
+		result := executeScriptAndCheck(t, b, templates.GenerateGetWhiteboardMessageCountScript(env), nil)
+		assertEqual(t, cadence.NewInt(3), result)
+
+		result = executeScriptAndCheck(t, b, templates.GenerateGetWhiteboardMessagesScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewInt(1))})
+		messages := result.(cadence.Array).Values
+		require.Len(t, messages, 2)
+		assertEqual(t, cadence.NewString(maxID), messages[0].(cadence.Struct).Fields[0])
+		assertEqual(t, cadence.NewString("second"), messages[0].(cadence.Struct).Fields[1])
+		assertEqual(t, cadence.NewString(joshID), messages[1].(cadence.Struct).Fields[0])
+		assertEqual(t, cadence.NewString("third"), messages[1].(cadence.Struct).Fields[1])
+
+		result = executeScriptAndCheck(t, b, templates.GenerateGetWhiteboardMessagesScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewInt(3))})
+		assert.Len(t, result.(cadence.Array).Values, 0)
+
+		// an index past the end of the whiteboard is out of range
+		scriptResult, err := b.ExecuteScript(templates.GenerateGetWhiteboardMessagesScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewInt(4))})
+		require.NoError(t, err)
+		assert.Error(t, scriptResult.Error)
+	})
+
+	t.Run("Shouldn't be able to submit a result with the wrong number of keys", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateSendFinalSubmissionScript(env), joshNode.address, joshNode.signer, true,
+			stringArray("groupKey", "joshKey"),
+		)
+	})
+
+	t.Run("Shouldn't be able to end the DKG before enough results are submitted", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateEndDKGScript(env), dkgAddress, DKGSigner, true)
+	})
+
+	result := stringArray("groupKey", "joshKey", "maxKey")
+
+	t.Run("Should detect the threshold of identical results", func(t *testing.T) {
+		// two participants have to submit the same result, more than half
+		threshold := executeScriptAndCheck(t, b, templates.GenerateGetSubmissionThresholdScript(env), nil)
+		assertEqual(t, cadence.NewInt(1), threshold)
+
+		submitWithAuthorizer(t, b, templates.GenerateSendFinalSubmissionScript(env), joshNode.address, joshNode.signer, false, result)
+
+		completed := executeScriptAndCheck(t, b, templates.GenerateGetDKGCompletedScript(env), nil)
+		assertEqual(t, cadence.NewOptional(nil), completed)
+
+		submitWithAuthorizer(t, b, templates.GenerateEndDKGScript(env), dkgAddress, DKGSigner, true)
+
+		submitWithAuthorizer(t, b, templates.GenerateSendFinalSubmissionScript(env), maxNode.address, maxNode.signer, false, result)
+
+		completed = executeScriptAndCheck(t, b, templates.GenerateGetDKGCompletedScript(env), nil)
+		assertEqual(t, cadence.NewOptional(result), completed)
+
+		submissions := executeScriptAndCheck(t, b, templates.GenerateGetFinalSubmissionsScript(env), nil)
+		assert.Len(t, submissions.(cadence.Dictionary).Pairs, 2)
+	})
+
+	t.Run("Shouldn't be able to submit a result twice", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateSendFinalSubmissionScript(env), joshNode.address, joshNode.signer, true, result)
+	})
+
+	t.Run("Should be able to end the DKG", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateEndDKGScript(env), dkgAddress, DKGSigner, false)
+
+		enabled := executeScriptAndCheck(t, b, templates.GenerateGetDKGEnabledScript(env), nil)
+		assertEqual(t, cadence.NewBool(false), enabled)
+
+		submitWithAuthorizer(t, b, templates.GeneratePostMessageScript(env), joshNode.address, joshNode.signer, true, cadence.NewString("late"))
+	})
+
+	t.Run("Shouldn't complete the DKG when the results disagree", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateStartDKGScript(env), dkgAddress, DKGSigner, false, stringArray(joshID, maxID, bastianID))
+
+		count := executeScriptAndCheck(t, b, templates.GenerateGetWhiteboardMessageCountScript(env), nil)
+		assertEqual(t, cadence.NewInt(0), count)
+
+		submitWithAuthorizer(t, b, templates.GenerateSendFinalSubmissionScript(env), joshNode.address, joshNode.signer, false,
+			stringArray("groupKey", "joshKey", "maxKey", "bastianKey"),
+		)
+		submitWithAuthorizer(t, b, templates.GenerateSendFinalSubmissionScript(env), maxNode.address, maxNode.signer, false,
+			stringArray("otherGroupKey", "joshKey", "maxKey", "bastianKey"),
+		)
+
+		completed := executeScriptAndCheck(t, b, templates.GenerateGetDKGCompletedScript(env), nil)
+		assertEqual(t, cadence.NewOptional(nil), completed)
+
+		submitWithAuthorizer(t, b, templates.GenerateSendFinalSubmissionScript(env), bastianNode.address, bastianNode.signer, false,
+			stringArray("groupKey", "joshKey", "maxKey", "bastianKey"),
+		)
+
+		completed = executeScriptAndCheck(t, b, templates.GenerateGetDKGCompletedScript(env), nil)
+		assertEqual(t, cadence.NewOptional(stringArray("groupKey", "joshKey", "maxKey", "bastianKey")), completed)
+	})
+}
@@ -0,0 +1,245 @@
+package test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	emulator "github.com/onflow/flow-emulator"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go-sdk/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+const (
+	epochPhaseStakingAuction uint8 = iota
+	epochPhaseSetup
+	epochPhaseCommitted
+)
+
+// deployEpochContract deploys FlowEpoch to the account of the staking contract
+func deployEpochContract(
+	t *testing.T,
+	b *emulator.Blockchain,
+	idTableAddress flow.Address,
+	idTableSigner crypto.Signer,
+	numViewsInEpoch uint64,
+) {
+	code := contracts.FlowEpoch(idTableAddress.Hex())
+
+	tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateDeployEpochScript(), idTableAddress)
+	_ = tx.AddArgument(cadence.NewString(hex.EncodeToString(code)))
+	_ = tx.AddArgument(cadence.NewUInt64(numViewsInEpoch))
+
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{b.ServiceKey().Address, idTableAddress},
+		[]crypto.Signer{b.ServiceKey().Signer(), idTableSigner},
+		false,
+	)
+}
+
+// submitEpochAdminTransaction submits a FlowEpoch admin transaction
+// and returns the events it emitted
+func submitEpochAdminTransaction(
+	t *testing.T,
+	b *emulator.Blockchain,
+	script []byte,
+	idTableAddress flow.Address,
+	idTableSigner crypto.Signer,
+	shouldRevert bool,
+	arguments ...cadence.Value,
+) []flow.Event {
+
+	tx := createTxWithTemplateAndAuthorizer(b, script, idTableAddress)
+
+	for _, argument := range arguments {
+		_ = tx.AddArgument(argument)
+	}
+
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{b.ServiceKey().Address, idTableAddress},
+		[]crypto.Signer{b.ServiceKey().Signer(), idTableSigner},
+		shouldRevert,
+	)
+
+	result, err := b.GetTransactionResult(tx.ID())
+	require.NoError(t, err)
+
+	return result.Events
+}
+
+func assertEpochState(t *testing.T, b *emulator.Blockchain, env templates.Environment, counter uint64, phase uint8) {
+	result := executeScriptAndCheck(t, b, templates.GenerateGetEpochCounterScript(env), nil)
+	assertEqual(t, cadence.NewUInt64(counter), result)
+
+	result = executeScriptAndCheck(t, b, templates.GenerateGetEpochPhaseScript(env), nil)
+	assertEqual(t, cadence.NewUInt8(phase), result)
+}
+
+func assertEpochMetadata(t *testing.T, b *emulator.Blockchain, env templates.Environment, counter, firstView, finalView uint64, nodeIDs ...string) {
+	result := executeScriptAndCheck(t, b, templates.GenerateGetEpochMetadataScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewUInt64(counter))})
+
+	fields := result.(cadence.Struct).Fields
+	assertEqual(t, cadence.NewUInt64(counter), fields[0])
+	assertEqual(t, cadence.NewUInt64(firstView), fields[1])
+	assertEqual(t, cadence.NewUInt64(finalView), fields[2])
+
+	ids := make([]cadence.Value, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		ids[i] = cadence.NewString(nodeID)
+	}
+	assertEqual(t, cadence.NewArray(ids), fields[3])
+}
+
+func TestEpochLifecycle(t *testing.T) {
+
+	t.Parallel()
+
+	b := newBlockchain()
+
+	env := templates.Environment{
+		FungibleTokenAddress: emulatorFTAddress,
+		FlowTokenAddress:     emulatorFlowTokenAddress,
+	}
+
+	accountKeys := test.AccountKeyGenerator()
+
+	IDTableAccountKey, IDTableSigner := accountKeys.NewWithSigner()
+	idTableAddress := deployStakingContract(t, b, IDTableAccountKey, env)
+
+	env.IDTableAddress = idTableAddress.Hex()
+	env.EpochAddress = idTableAddress.Hex()
+
+	epochEventType := func(name string) string {
+		return fmt.Sprintf("A.%s.FlowEpoch.%s", idTableAddress.Hex(), name)
+	}
+
+	deployEpochContract(t, b, idTableAddress, IDTableSigner, 100)
+
+	joshAccountKey, joshSigner := accountKeys.NewWithSigner()
+	joshAddress, _ := b.CreateAccount([]*flow.AccountKey{joshAccountKey}, nil)
+	mintTokensForAccount(t, b, joshAddress)
+
+	maxAccountKey, maxSigner := accountKeys.NewWithSigner()
+	maxAddress, _ := b.CreateAccount([]*flow.AccountKey{maxAccountKey}, nil)
+	mintTokensForAccount(t, b, maxAddress)
+
+	t.Run("Should start in the staking auction of the first epoch", func(t *testing.T) {
+		assertEpochState(t, b, env, 0, epochPhaseStakingAuction)
+		assertEpochMetadata(t, b, env, 0, 0, 99)
+	})
+
+	t.Run("Shouldn't be able to commit or end the epoch during the staking auction", func(t *testing.T) {
+		submitEpochAdminTransaction(t, b, templates.GenerateCommitEpochScript(env), idTableAddress, IDTableSigner, true)
+		submitEpochAdminTransaction(t, b, templates.GenerateEndCurrentEpochScript(env), idTableAddress, IDTableSigner, true)
+
+		assertEpochState(t, b, env, 0, epochPhaseStakingAuction)
+	})
+
+	t.Run("Shouldn't be able to use the epoch admin from another account", func(t *testing.T) {
+		submitEpochAdminTransaction(t, b, templates.GenerateEndStakingAuctionScript(env), joshAddress, joshSigner, true,
+			cadence.NewArray([]cadence.Value{}),
+		)
+	})
+
+	// josh commits enough tokens for a collection node, max doesn't for a consensus node
+	registerNode(t, b, env, joshAddress, joshSigner,
+		joshID, fmt.Sprintf("%0128d", josh), fmt.Sprintf("%0128d", josh), fmt.Sprintf("%0192d", josh),
+		25000000000000, 0, 1, false)
+
+	registerNode(t, b, env, maxAddress, maxSigner,
+		maxID, fmt.Sprintf("%0128d", max), fmt.Sprintf("%0128d", max), fmt.Sprintf("%0192d", max),
+		10000000000000, 0, 2, false)
+
+	approvedIDs := cadence.NewArray([]cadence.Value{cadence.NewString(joshID), cadence.NewString(maxID)})
+
+	t.Run("Should be able to end the staking auction", func(t *testing.T) {
+		events := submitEpochAdminTransaction(t, b, templates.GenerateEndStakingAuctionScript(env), idTableAddress, IDTableSigner, false, approvedIDs)
+
+		assertEpochState(t, b, env, 0, epochPhaseSetup)
+		assertEpochMetadata(t, b, env, 1, 100, 199, joshID)
+
+		var setup *flow.Event
+		for i, event := range events {
+			if event.Type == epochEventType("EpochSetup") {
+				setup = &events[i]
+			}
+		}
+		require.NotNil(t, setup)
+		assertEqual(t, cadence.NewUInt64(1), setup.Value.Fields[0])
+		assertEqual(t, cadence.NewArray([]cadence.Value{cadence.NewString(joshID)}), setup.Value.Fields[1])
+
+		// max didn't commit enough tokens and is refunded
+		result := executeScriptAndCheck(t, b, templates.GenerateGetUnstakedBalanceScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewString(maxID))})
+		assertEqual(t, CadenceUFix64("100000.0"), result)
+	})
+
+	t.Run("Shouldn't be able to end the staking auction twice or end the epoch before it is committed", func(t *testing.T) {
+		submitEpochAdminTransaction(t, b, templates.GenerateEndStakingAuctionScript(env), idTableAddress, IDTableSigner, true, approvedIDs)
+		submitEpochAdminTransaction(t, b, templates.GenerateEndCurrentEpochScript(env), idTableAddress, IDTableSigner, true)
+
+		assertEpochState(t, b, env, 0, epochPhaseSetup)
+	})
+
+	t.Run("Should be able to commit the epoch", func(t *testing.T) {
+		events := submitEpochAdminTransaction(t, b, templates.GenerateCommitEpochScript(env), idTableAddress, IDTableSigner, false)
+
+		assertEpochState(t, b, env, 0, epochPhaseCommitted)
+
+		require.Len(t, events, 1)
+		assert.Equal(t, epochEventType("EpochCommitted"), events[0].Type)
+		assertEqual(t, cadence.NewUInt64(1), events[0].Value.Fields[0])
+	})
+
+	t.Run("Should be able to end the epoch and stake the committed tokens", func(t *testing.T) {
+		submitEpochAdminTransaction(t, b, templates.GenerateEndCurrentEpochScript(env), idTableAddress, IDTableSigner, false)
+
+		assertEpochState(t, b, env, 1, epochPhaseStakingAuction)
+
+		result := executeScriptAndCheck(t, b, templates.GenerateGetStakedBalanceScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewString(joshID))})
+		assertEqual(t, CadenceUFix64("250000.0"), result)
+
+		// Nothing was staked during the first epoch, so there are no rewards
+		result = executeScriptAndCheck(t, b, templates.GenerateGetRewardBalanceScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewString(joshID))})
+		assertEqual(t, CadenceUFix64("0.0"), result)
+	})
+
+	t.Run("Should be able to change the number of views of the next epochs", func(t *testing.T) {
+		submitEpochAdminTransaction(t, b, templates.GenerateSetNumViewsInEpochScript(env), idTableAddress, IDTableSigner, true, cadence.NewUInt64(0))
+		submitEpochAdminTransaction(t, b, templates.GenerateSetNumViewsInEpochScript(env), idTableAddress, IDTableSigner, false, cadence.NewUInt64(50))
+	})
+
+	t.Run("Should pay rewards at the end of the second epoch", func(t *testing.T) {
+		submitEpochAdminTransaction(t, b, templates.GenerateEndStakingAuctionScript(env), idTableAddress, IDTableSigner, false, approvedIDs)
+		assertEpochMetadata(t, b, env, 2, 200, 249, joshID)
+
+		submitEpochAdminTransaction(t, b, templates.GenerateCommitEpochScript(env), idTableAddress, IDTableSigner, false)
+
+		events := submitEpochAdminTransaction(t, b, templates.GenerateEndCurrentEpochScript(env), idTableAddress, IDTableSigner, false)
+		assertEpochState(t, b, env, 2, epochPhaseStakingAuction)
+
+		var started bool
+		for _, event := range events {
+			if event.Type == epochEventType("EpochStart") {
+				started = true
+				assertEqual(t, cadence.NewUInt64(2), event.Value.Fields[0])
+				assertEqual(t, cadence.NewUInt64(200), event.Value.Fields[1])
+				assertEqual(t, cadence.NewUInt64(249), event.Value.Fields[2])
+			}
+		}
+		assert.True(t, started)
+
+		// josh runs the only staked node, so it gets the whole collection share of the payout
+		result := executeScriptAndCheck(t, b, templates.GenerateGetRewardBalanceScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewString(joshID))})
+		assertEqual(t, CadenceUFix64("210000.0"), result)
+	})
+}
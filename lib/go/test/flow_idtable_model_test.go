@@ -0,0 +1,453 @@
+package test
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	emulator "github.com/onflow/flow-emulator"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go-sdk/test"
+
+	"github.com/onflow/flow-core-contracts/lib/go/model"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/testkit"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+var modelSeed = flag.Int64("model.seed", 1, "seed of the first random action sequence of the staking model tests")
+
+const (
+	modelTestSequences = 3
+	modelTestActions   = 40
+)
+
+// modelActor is an account that holds either a node staker or a node delegator
+type modelActor struct {
+	address     flow.Address
+	signer      crypto.Signer
+	nodeID      string
+	delegatorID uint32
+	registered  bool
+	isDelegator bool
+}
+
+// modelAction is a transaction sent by an actor, or by the admin if the actor is nil,
+// together with the operation that is expected to have the same effect on the model
+type modelAction struct {
+	name  string
+	actor *modelActor
+	code  []byte
+	args  []cadence.Value
+	apply func(table *model.IDTable) error
+}
+
+// TestIDTableModel sends random sequences of transactions to the FlowIDTableStaking
+// contract and checks that the state of every node and delegator matches the model.
+//
+// The sequences are the same in every run. Other sequences can be run,
+// and a failing one reproduced, with the -model.seed flag.
+func TestIDTableModel(t *testing.T) {
+
+	t.Parallel()
+
+	for i := int64(0); i < modelTestSequences; i++ {
+		sequenceSeed := *modelSeed + i
+
+		t.Run(fmt.Sprintf("Seed %d", sequenceSeed), func(t *testing.T) {
+			t.Cleanup(func() {
+				if t.Failed() {
+					t.Logf("the sequence can be reproduced with -model.seed %d", sequenceSeed)
+				}
+			})
+
+			runModelSequence(t, rand.New(rand.NewSource(sequenceSeed)))
+		})
+	}
+}
+
+// TestIDTableProperties runs random sequences of staking actions
+// with the property checker of the test kit.
+func TestIDTableProperties(t *testing.T) {
+
+	t.Parallel()
+
+	testkit.CheckStakingProperties(t, testkit.PropertyConfig{
+		Seed:       *modelSeed,
+		Sequences:  3,
+		Actions:    40,
+		Nodes:      5,
+		Delegators: 10,
+	})
+}
+
+func runModelSequence(t *testing.T, r *rand.Rand) {
+
+	b := newBlockchain()
+
+	env := templates.Environment{
+		FungibleTokenAddress: emulatorFTAddress,
+		FlowTokenAddress:     emulatorFlowTokenAddress,
+	}
+
+	accountKeys := test.AccountKeyGenerator()
+
+	IDTableAccountKey, IDTableSigner := accountKeys.NewWithSigner()
+	idTableAddress := deployStakingContract(t, b, IDTableAccountKey, env)
+
+	env.IDTableAddress = idTableAddress.Hex()
+
+	// Same initializer arguments as deployStakingContract
+	table := model.NewIDTable(125000000000000, 8000000)
+
+	var nodes, delegators []*modelActor
+
+	for role := model.RoleCollection; role <= model.RoleAccess; role++ {
+		accountKey, signer := accountKeys.NewWithSigner()
+		address, _ := b.CreateAccount([]*flow.AccountKey{accountKey}, nil)
+		mintTokensForAccount(t, b, address)
+
+		nodes = append(nodes, &modelActor{
+			address: address,
+			signer:  signer,
+			nodeID:  fmt.Sprintf("%064d", role),
+		})
+	}
+
+	for i := 0; i < 2*len(nodes); i++ {
+		accountKey, signer := accountKeys.NewWithSigner()
+		address, _ := b.CreateAccount([]*flow.AccountKey{accountKey}, nil)
+		mintTokensForAccount(t, b, address)
+
+		delegators = append(delegators, &modelActor{
+			address:     address,
+			signer:      signer,
+			nodeID:      nodes[i%len(nodes)].nodeID,
+			isDelegator: true,
+		})
+	}
+
+	admin := &modelActor{address: idTableAddress, signer: IDTableSigner}
+
+	for i, node := range nodes {
+		role := uint8(i + 1)
+		minimum := table.MinimumStakeRequirements()[role]
+
+		// Some nodes start below the minimum, so that they get refunded
+		amount := randomAmount(r, minimum+minimum/2)
+		if role == model.RoleAccess {
+			amount = randomAmount(r, 10000000000)
+		}
+
+		node := node
+		runModelAction(t, b, table, modelAction{
+			name:  fmt.Sprintf("register node %d", role),
+			actor: node,
+			code:  templates.GenerateRegisterNodeScript(env),
+			args: []cadence.Value{
+				cadence.NewString(node.nodeID),
+				cadence.NewUInt8(role),
+				cadence.NewString(fmt.Sprintf("%0128d", role)),
+				cadence.NewString(fmt.Sprintf("%0128d", role)),
+				cadence.NewString(fmt.Sprintf("%0192d", role)),
+				cadence.UFix64(amount),
+			},
+			apply: func(table *model.IDTable) error {
+				return table.AddNodeRecord(node.nodeID, role, amount)
+			},
+		})
+	}
+
+	for i := 0; i < modelTestActions; i++ {
+		var action modelAction
+
+		switch n := r.Intn(10); {
+		case n < 4:
+			action = randomNodeAction(env, r, table, nodes[r.Intn(len(nodes))])
+		case n < 8:
+			action = randomDelegatorAction(env, r, table, delegators[r.Intn(len(delegators))])
+		default:
+			action = randomAdminAction(env, r, table, admin)
+		}
+
+		runModelAction(t, b, table, action)
+
+		assertModelMatches(t, b, env, table, nodes, delegators, action.name)
+	}
+
+	// Finish with a full epoch so that every sequence moves tokens at least once
+	for _, action := range []modelAction{
+		endStakingAction(env, r, table, admin),
+		payRewardsAction(env, admin),
+		moveTokensAction(env, admin),
+	} {
+		runModelAction(t, b, table, action)
+		assertModelMatches(t, b, env, table, nodes, delegators, action.name)
+	}
+}
+
+// runModelAction applies the action to the model and checks that the
+// transaction succeeds or reverts the same way the model operation does
+func runModelAction(t *testing.T, b *emulator.Blockchain, table *model.IDTable, action modelAction) {
+
+	modelErr := action.apply(table)
+
+	actor := action.actor
+
+	tx := createTxWithTemplateAndAuthorizer(b, action.code, actor.address)
+
+	for _, arg := range action.args {
+		err := tx.AddArgument(arg)
+		require.NoError(t, err)
+	}
+
+	err := tx.SignPayload(actor.address, 0, actor.signer)
+	require.NoError(t, err)
+	err = tx.SignEnvelope(b.ServiceKey().Address, 0, b.ServiceKey().Signer())
+	require.NoError(t, err)
+
+	err = b.AddTransaction(*tx)
+	require.NoError(t, err)
+
+	result, err := b.ExecuteNextTransaction()
+	require.NoError(t, err)
+
+	_, err = b.CommitBlock()
+	require.NoError(t, err)
+
+	if modelErr != nil {
+		require.True(t, result.Reverted(), "%s: expected the transaction to revert with: %s", action.name, modelErr)
+	} else if result.Reverted() {
+		require.FailNow(t, "unexpected revert", "%s: %s", action.name, result.Error)
+	}
+}
+
+func assertModelMatches(
+	t *testing.T,
+	b *emulator.Blockchain,
+	env templates.Environment,
+	table *model.IDTable,
+	nodes, delegators []*modelActor,
+	actionName string,
+) {
+
+	for _, node := range nodes {
+		expected, err := table.NodeInfo(node.nodeID)
+		if err != nil {
+			continue
+		}
+
+		result := executeScriptAndCheck(t, b, templates.GenerateGetNodeInfoScript(env), [][]byte{jsoncdc.MustEncode(cadence.String(node.nodeID))})
+
+		actual, err := model.DecodeNodeInfo(result)
+		require.NoError(t, err)
+
+		// The networking fields are not part of the model
+		actual.NetworkingAddress = ""
+		actual.NetworkingKey = ""
+		actual.StakingKey = ""
+
+		require.Equal(t, expected, actual, "node %s after %s", node.nodeID, actionName)
+	}
+
+	for _, delegator := range delegators {
+		if !delegator.registered {
+			continue
+		}
+
+		expected, err := table.DelegatorInfo(delegator.nodeID, delegator.delegatorID)
+		require.NoError(t, err)
+
+		result := executeScriptAndCheck(t, b, templates.GenerateGetDelegatorInfoScript(env), [][]byte{jsoncdc.MustEncode(cadence.String(delegator.nodeID)), jsoncdc.MustEncode(cadence.UInt32(delegator.delegatorID))})
+
+		actual, err := model.DecodeDelegatorInfo(result)
+		require.NoError(t, err)
+
+		require.Equal(t, expected, actual, "delegator %s.%d after %s", delegator.nodeID, delegator.delegatorID, actionName)
+	}
+
+	result := executeScriptAndCheck(t, b, templates.GenerateGetTotalTokensStakedScript(env), nil)
+	assert.Equal(t, cadence.UFix64(table.TotalStaked()), result, "total staked after %s", actionName)
+}
+
+func randomNodeAction(env templates.Environment, r *rand.Rand, table *model.IDTable, node *modelActor) modelAction {
+
+	info, _ := table.NodeInfo(node.nodeID)
+
+	switch r.Intn(7) {
+	case 0:
+		amount := randomAmount(r, 5000000000000)
+		return amountAction("node stake new tokens", node, templates.GenerateStakeNewTokensScript(env), amount,
+			func(table *model.IDTable) error { return table.StakeNewTokens(node.nodeID, amount) })
+	case 1:
+		amount := randomAmount(r, info.TokensUnstaked+info.TokensRequestedToUnstake+1)
+		return amountAction("node stake unstaked tokens", node, templates.GenerateStakeUnstakedTokensScript(env), amount,
+			func(table *model.IDTable) error { return table.StakeUnstakedTokens(node.nodeID, amount) })
+	case 2:
+		amount := randomAmount(r, info.TokensRewarded+1)
+		return amountAction("node stake rewarded tokens", node, templates.GenerateStakeRewardedTokensScript(env), amount,
+			func(table *model.IDTable) error { return table.StakeRewardedTokens(node.nodeID, amount) })
+	case 3:
+		amount := randomAmount(r, info.TokensCommitted+info.TokensStaked+1)
+		return amountAction("node request unstaking", node, templates.GenerateUnstakeTokensScript(env), amount,
+			func(table *model.IDTable) error { return table.RequestUnstaking(node.nodeID, amount) })
+	case 4:
+		return modelAction{
+			name:  "node unstake all",
+			actor: node,
+			code:  templates.GenerateUnstakeAllScript(env),
+			apply: func(table *model.IDTable) error { return table.UnstakeAll(node.nodeID) },
+		}
+	case 5:
+		amount := randomAmount(r, info.TokensUnstaked+1)
+		return amountAction("node withdraw unstaked tokens", node, templates.GenerateWithdrawUnstakedTokensScript(env), amount,
+			func(table *model.IDTable) error { return table.WithdrawUnstakedTokens(node.nodeID, amount) })
+	default:
+		amount := randomAmount(r, info.TokensRewarded+1)
+		return amountAction("node withdraw rewarded tokens", node, templates.GenerateWithdrawRewardedTokensScript(env), amount,
+			func(table *model.IDTable) error { return table.WithdrawRewardedTokens(node.nodeID, amount) })
+	}
+}
+
+func randomDelegatorAction(env templates.Environment, r *rand.Rand, table *model.IDTable, delegator *modelActor) modelAction {
+
+	if !delegator.registered {
+		return modelAction{
+			name:  "register delegator",
+			actor: delegator,
+			code:  templates.GenerateRegisterDelegatorScript(env),
+			args:  []cadence.Value{cadence.NewString(delegator.nodeID)},
+			apply: func(table *model.IDTable) error {
+				delegatorID, err := table.RegisterNewDelegator(delegator.nodeID)
+				if err != nil {
+					return err
+				}
+
+				delegator.delegatorID = delegatorID
+				delegator.registered = true
+
+				return nil
+			},
+		}
+	}
+
+	nodeID, delegatorID := delegator.nodeID, delegator.delegatorID
+
+	info, _ := table.DelegatorInfo(nodeID, delegatorID)
+
+	switch r.Intn(6) {
+	case 0:
+		amount := randomAmount(r, 5000000000000)
+		return amountAction("delegator stake new tokens", delegator, templates.GenerateDelegatorStakeNewScript(env), amount,
+			func(table *model.IDTable) error { return table.DelegateNewTokens(nodeID, delegatorID, amount) })
+	case 1:
+		amount := randomAmount(r, info.TokensUnstaked+info.TokensRequestedToUnstake+1)
+		return amountAction("delegator stake unstaked tokens", delegator, templates.GenerateDelegatorStakeUnstakedScript(env), amount,
+			func(table *model.IDTable) error { return table.DelegateUnstakedTokens(nodeID, delegatorID, amount) })
+	case 2:
+		amount := randomAmount(r, info.TokensRewarded+1)
+		return amountAction("delegator stake rewarded tokens", delegator, templates.GenerateDelegatorStakeRewardedScript(env), amount,
+			func(table *model.IDTable) error { return table.DelegateRewardedTokens(nodeID, delegatorID, amount) })
+	case 3:
+		amount := randomAmount(r, info.TokensCommitted+info.TokensStaked+1)
+		return amountAction("delegator request unstaking", delegator, templates.GenerateDelegatorRequestUnstakeScript(env), amount,
+			func(table *model.IDTable) error { return table.RequestDelegatorUnstaking(nodeID, delegatorID, amount) })
+	case 4:
+		amount := randomAmount(r, info.TokensUnstaked+1)
+		return amountAction("delegator withdraw unstaked tokens", delegator, templates.GenerateDelegatorWithdrawUnstakedScript(env), amount,
+			func(table *model.IDTable) error {
+				return table.WithdrawDelegatorUnstakedTokens(nodeID, delegatorID, amount)
+			})
+	default:
+		amount := randomAmount(r, info.TokensRewarded+1)
+		return amountAction("delegator withdraw rewarded tokens", delegator, templates.GenerateDelegatorWithdrawRewardsScript(env), amount,
+			func(table *model.IDTable) error {
+				return table.WithdrawDelegatorRewardedTokens(nodeID, delegatorID, amount)
+			})
+	}
+}
+
+func randomAdminAction(env templates.Environment, r *rand.Rand, table *model.IDTable, admin *modelActor) modelAction {
+
+	switch r.Intn(3) {
+	case 0:
+		return endStakingAction(env, r, table, admin)
+	case 1:
+		return payRewardsAction(env, admin)
+	default:
+		return moveTokensAction(env, admin)
+	}
+}
+
+func endStakingAction(env templates.Environment, r *rand.Rand, table *model.IDTable, admin *modelActor) modelAction {
+
+	approved := make(map[string]bool)
+	var ids []cadence.Value
+
+	// Most of the time nodes are approved, so that they can actually stake
+	for _, nodeID := range table.NodeIDs() {
+		if r.Intn(5) > 0 {
+			approved[nodeID] = true
+			ids = append(ids, cadence.NewString(nodeID))
+		}
+	}
+
+	return modelAction{
+		name:  fmt.Sprintf("end staking auction with %d approved nodes", len(ids)),
+		actor: admin,
+		code:  templates.GenerateEndStakingScript(env),
+		args:  []cadence.Value{cadence.NewArray(ids)},
+		apply: func(table *model.IDTable) error { return table.EndStakingAuction(approved) },
+	}
+}
+
+func payRewardsAction(env templates.Environment, admin *modelActor) modelAction {
+	return modelAction{
+		name:  "pay rewards",
+		actor: admin,
+		code:  templates.GeneratePayRewardsScript(env),
+		apply: func(table *model.IDTable) error { return table.PayRewards() },
+	}
+}
+
+func moveTokensAction(env templates.Environment, admin *modelActor) modelAction {
+	return modelAction{
+		name:  "move tokens",
+		actor: admin,
+		code:  templates.GenerateMoveTokensScript(env),
+		apply: func(table *model.IDTable) error { return table.MoveTokens() },
+	}
+}
+
+func amountAction(
+	name string,
+	actor *modelActor,
+	code []byte,
+	amount ufix64.UFix64,
+	apply func(table *model.IDTable) error,
+) modelAction {
+	return modelAction{
+		name:  fmt.Sprintf("%s %s", name, amount),
+		actor: actor,
+		code:  code,
+		args:  []cadence.Value{cadence.UFix64(amount)},
+		apply: apply,
+	}
+}
+
+// randomAmount returns an amount below max, which is occasionally exceeded
+// so that the sequence also covers transactions that should revert.
+func randomAmount(r *rand.Rand, max ufix64.UFix64) ufix64.UFix64 {
+	if max == 0 || r.Intn(10) == 0 {
+		return ufix64.UFix64(r.Int63n(10000000000))
+	}
+
+	return ufix64.UFix64(r.Int63n(int64(max)))
+}
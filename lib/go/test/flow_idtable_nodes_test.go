@@ -1,6 +1,7 @@
 package test
 
 import (
+	"encoding/hex"
 	"fmt"
 	"testing"
 
@@ -51,10 +52,10 @@ func TestManyNodesIDTable(t *testing.T) {
 	}
 
 	cadencePublicKeys := cadence.NewArray(publicKeys)
-	cadenceCode := bytesToCadenceArray(IDTableCode)
+	cadenceCode := cadence.NewString(hex.EncodeToString(IDTableCode))
 
 	// Deploy the IDTableStaking contract
-	tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateTransferMinterAndDeployScript(env), b.ServiceKey().Address).
+	tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateTransferMinterAndDeployHexScript(env), b.ServiceKey().Address).
 		AddRawArgument(jsoncdc.MustEncode(cadencePublicKeys)).
 		AddRawArgument(jsoncdc.MustEncode(cadence.NewString("FlowIDTableStaking"))).
 		AddRawArgument(jsoncdc.MustEncode(cadenceCode))
@@ -228,7 +229,7 @@ func TestManyNodesIDTable(t *testing.T) {
 
 		tx := flow.NewTransaction().
 			SetScript(templates.GenerateEndStakingScript(env)).
-			SetGasLimit(35000).
+			SetGasLimit(45000).
 			SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 			SetPayer(b.ServiceKey().Address).
 			AddAuthorizer(idTableAddress)
@@ -308,7 +309,7 @@ func TestManyNodesIDTable(t *testing.T) {
 
 		tx := flow.NewTransaction().
 			SetScript(templates.GenerateEndStakingScript(env)).
-			SetGasLimit(150000).
+			SetGasLimit(225000).
 			SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 			SetPayer(b.ServiceKey().Address).
 			AddAuthorizer(idTableAddress)
@@ -362,7 +363,7 @@ func TestManyNodesIDTable(t *testing.T) {
 
 		tx := flow.NewTransaction().
 			SetScript(templates.GenerateEndEpochScript(env)).
-			SetGasLimit(350000).
+			SetGasLimit(500000).
 			SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 			SetPayer(b.ServiceKey().Address).
 			AddAuthorizer(idTableAddress)
@@ -402,10 +403,10 @@ func TestUnstakeAllManyDelegatorsIDTable(t *testing.T) {
 	publicKeys[0] = bytesToCadenceArray(IDTableAccountKey.Encode())
 
 	cadencePublicKeys := cadence.NewArray(publicKeys)
-	cadenceCode := bytesToCadenceArray(IDTableCode)
+	cadenceCode := cadence.NewString(hex.EncodeToString(IDTableCode))
 
 	// Deploy the IDTableStaking contract
-	tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateTransferMinterAndDeployScript(env), b.ServiceKey().Address).
+	tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateTransferMinterAndDeployHexScript(env), b.ServiceKey().Address).
 		AddRawArgument(jsoncdc.MustEncode(cadencePublicKeys)).
 		AddRawArgument(jsoncdc.MustEncode(cadence.NewString("FlowIDTableStaking"))).
 		AddRawArgument(jsoncdc.MustEncode(cadenceCode))
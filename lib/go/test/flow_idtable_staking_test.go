@@ -220,6 +220,10 @@ func TestIDTableStaking(t *testing.T) {
 	var totalPayout interpreter.UFix64Value = 125000000000000 // 1.25M
 	var cutPercentage interpreter.UFix64Value = 8000000       // 8.0 %
 
+	// Each node type is paid its share of the payout, using the reward ratios the contract is deployed with
+	collectionPayout := totalPayout.Mul(interpreter.UFix64Value(16800000)).(interpreter.UFix64Value)
+	consensusPayout := totalPayout.Mul(interpreter.UFix64Value(51800000)).(interpreter.UFix64Value)
+
 	// Create new keys for the ID table account
 	IDTableAccountKey, IDTableSigner := accountKeys.NewWithSigner()
 	var idTableAddress = deployStakingContract(t, b, IDTableAccountKey, env)
@@ -1118,6 +1122,9 @@ func TestIDTableStaking(t *testing.T) {
 
 		totalStaked = 165000000000000
 
+		// The admin node is the only staked collection node
+		var collectionStaked interpreter.UFix64Value = 25000000000000
+
 		result := executeScriptAndCheck(t, b, templates.GenerateGetUnstakedBalanceScript(env), [][]byte{jsoncdc.MustEncode(cadence.String(adminID))})
 		assertEqual(t, CadenceUFix64(unstaked[adminID].String()), result)
 
@@ -1130,18 +1137,13 @@ func TestIDTableStaking(t *testing.T) {
 		result = executeScriptAndCheck(t, b, templates.GenerateGetStakedBalanceScript(env), [][]byte{jsoncdc.MustEncode(cadence.String(adminID))})
 		assertEqual(t, CadenceUFix64(staked[adminID].String()), result)
 
-		rewardsResult, _ := payRewards(false, totalPayout, totalStaked, cutPercentage, staked[adminID])
+		rewardsResult, _ := payRewards(false, collectionPayout, collectionStaked, cutPercentage, staked[adminID])
 		rewards[adminID] = rewards[adminID].Plus(rewardsResult).(interpreter.UFix64Value)
 
 		result = executeScriptAndCheck(t, b, templates.GenerateGetRewardBalanceScript(env), [][]byte{jsoncdc.MustEncode(cadence.String(adminID))})
 		assertEqual(t, CadenceUFix64(rewards[adminID].String()), result)
 
-		rewardsResult, _ = payRewards(false, totalPayout, totalStaked, cutPercentage, 0)
-		rewards[joshID] = rewards[joshID].Plus(rewardsResult).(interpreter.UFix64Value)
-
-		rewardsResult, delegateeRewardsResult := payRewards(true, totalPayout, totalStaked, cutPercentage, 0)
-		rewards[joshID] = rewards[joshID].Plus(delegateeRewardsResult).(interpreter.UFix64Value)
-		rewards[joshID+firstDelegatorStringID] = rewards[joshID+firstDelegatorStringID].Plus(rewardsResult).(interpreter.UFix64Value)
+		// Josh and his delegator are not staked yet, so the consensus nodes are not paid
 
 		result = executeScriptAndCheck(t, b, templates.GenerateGetRewardBalanceScript(env), [][]byte{jsoncdc.MustEncode(cadence.String(joshID))})
 		assertEqual(t, CadenceUFix64(rewards[joshID].String()), result)
@@ -1162,7 +1164,7 @@ func TestIDTableStaking(t *testing.T) {
 		assertEqual(t, CadenceUFix64("0.0"), result)
 
 		result = executeScriptAndCheck(t, b, templates.GenerateGetRewardBalanceScript(env), [][]byte{jsoncdc.MustEncode(cadence.String(maxID))})
-		assertEqual(t, CadenceUFix64("1060606.05"), result)
+		assertEqual(t, CadenceUFix64("97499.99"), result)
 
 		result = executeScriptAndCheck(t, b, templates.GenerateGetDelegatorRewardsScript(env), [][]byte{jsoncdc.MustEncode(cadence.String(maxID)), jsoncdc.MustEncode(cadence.UInt32(firstDelegatorID))})
 		assertEqual(t, CadenceUFix64("0.0"), result)
@@ -1274,7 +1276,7 @@ func TestIDTableStaking(t *testing.T) {
 		assertEqual(t, CadenceUFix64("0.0"), result)
 
 		result = executeScriptAndCheck(t, b, templates.GenerateGetRewardBalanceScript(env), [][]byte{jsoncdc.MustEncode(cadence.String(maxID))})
-		assertEqual(t, CadenceUFix64("1060606.05"), result)
+		assertEqual(t, CadenceUFix64("97499.99"), result)
 
 		// Max Delegator Buckets
 
@@ -1575,6 +1577,9 @@ func TestIDTableStaking(t *testing.T) {
 
 		totalStaked = 372000000000000
 
+		// Josh and his delegator are the only consensus stakers
+		consensusStaked := staked[joshID].Plus(staked[joshID+firstDelegatorStringID]).(interpreter.UFix64Value)
+
 		tx := createTxWithTemplateAndAuthorizer(b, templates.GeneratePayRewardsScript(env), idTableAddress)
 
 		signAndSubmit(
@@ -1587,10 +1592,10 @@ func TestIDTableStaking(t *testing.T) {
 		result = executeScriptAndCheck(t, b, templates.GenerateGetRewardBalanceScript(env), [][]byte{jsoncdc.MustEncode(cadence.String(adminID))})
 		assertEqual(t, CadenceUFix64(rewards[adminID].String()), result)
 
-		rewardsResult, _ := payRewards(false, totalPayout, totalStaked, cutPercentage, staked[joshID])
+		rewardsResult, _ := payRewards(false, consensusPayout, consensusStaked, cutPercentage, staked[joshID])
 		rewards[joshID] = rewards[joshID].Plus(rewardsResult).(interpreter.UFix64Value)
 
-		rewardsResult, delegateeRewardsResult := payRewards(true, totalPayout, totalStaked, cutPercentage, staked[joshID+firstDelegatorStringID])
+		rewardsResult, delegateeRewardsResult := payRewards(true, consensusPayout, consensusStaked, cutPercentage, staked[joshID+firstDelegatorStringID])
 		rewards[joshID] = rewards[joshID].Plus(delegateeRewardsResult).(interpreter.UFix64Value)
 		rewards[joshID+firstDelegatorStringID] = rewards[joshID+firstDelegatorStringID].Plus(rewardsResult).(interpreter.UFix64Value)
 
@@ -1601,16 +1606,16 @@ func TestIDTableStaking(t *testing.T) {
 		assertEqual(t, CadenceUFix64(rewards[joshID+firstDelegatorStringID].String()), result)
 
 		result = executeScriptAndCheck(t, b, templates.GenerateGetRewardBalanceScript(env), [][]byte{jsoncdc.MustEncode(cadence.String(maxID))})
-		assertEqual(t, CadenceUFix64("1539100.666"), result)
+		assertEqual(t, CadenceUFix64("142287.08264"), result)
 
 		result = executeScriptAndCheck(t, b, templates.GenerateGetDelegatorRewardsScript(env), [][]byte{jsoncdc.MustEncode(cadence.String(maxID)), jsoncdc.MustEncode(cadence.UInt32(firstDelegatorID))})
-		assertEqual(t, CadenceUFix64("30913.978"), result)
+		assertEqual(t, CadenceUFix64("2893.54812"), result)
 
 		result = executeScriptAndCheck(t, b, templates.GenerateGetDelegatorRewardsScript(env), [][]byte{jsoncdc.MustEncode(cadence.String(maxID)), jsoncdc.MustEncode(cadence.UInt32(secondDelegatorID))})
-		assertEqual(t, CadenceUFix64("61827.956"), result)
+		assertEqual(t, CadenceUFix64("5787.09624"), result)
 
 		result = executeScriptAndCheck(t, b, templates.GenerateGetRewardBalanceScript(env), [][]byte{jsoncdc.MustEncode(cadence.String(bastianID))})
-		assertEqual(t, CadenceUFix64("470430.1"), result)
+		assertEqual(t, CadenceUFix64("44032.254"), result)
 
 	})
 
@@ -1651,7 +1656,7 @@ func TestIDTableStaking(t *testing.T) {
 		)
 
 		result := executeScriptAndCheck(t, b, templates.GenerateGetDelegatorRewardsScript(env), [][]byte{jsoncdc.MustEncode(cadence.String(maxID)), jsoncdc.MustEncode(cadence.UInt32(firstDelegatorID))})
-		assertEqual(t, CadenceUFix64("28913.978"), result)
+		assertEqual(t, CadenceUFix64("893.54812"), result)
 
 	})
 
@@ -0,0 +1,224 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	emulator "github.com/onflow/flow-emulator"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go-sdk/test"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/bootstrap"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+	"github.com/onflow/flow-core-contracts/lib/go/vesting"
+)
+
+// deployLockedTokensEnvironment deploys the staking, staking proxy and locked tokens contracts,
+// with the service account as the locked tokens admin.
+func deployLockedTokensEnvironment(t *testing.T, b *emulator.Blockchain) templates.Environment {
+	deployment, err := bootstrap.Deploy(bootstrap.NewEmulatorSender(b), bootstrap.EmulatorConfig())
+	require.NoError(t, err)
+
+	return deployment.Environment
+}
+
+// createLockedAccounts creates count shared accounts administered by the service account
+// and returns the addresses of the shared accounts and of their unlocked accounts.
+func createLockedAccounts(
+	t *testing.T,
+	b *emulator.Blockchain,
+	env templates.Environment,
+	accountKeys *test.AccountKeys,
+	count int,
+) (sharedAddresses, userAddresses []flow.Address) {
+
+	adminPublicKey := bytesToCadenceArray(accountKeys.New().Encode())
+
+	sharedAccountRegistered := fmt.Sprintf("A.%s.LockedTokens.SharedAccountRegistered", env.LockedTokensAddress)
+	unlockedAccountRegistered := fmt.Sprintf("A.%s.LockedTokens.UnlockedAccountRegistered", env.LockedTokensAddress)
+
+	for i := 0; i < count; i++ {
+		userPublicKey := bytesToCadenceArray(accountKeys.New().Encode())
+
+		tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateCreateSharedAccountScript(env), b.ServiceKey().Address).
+			AddRawArgument(jsoncdc.MustEncode(adminPublicKey)).
+			AddRawArgument(jsoncdc.MustEncode(userPublicKey)).
+			AddRawArgument(jsoncdc.MustEncode(userPublicKey))
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{b.ServiceKey().Address},
+			[]crypto.Signer{b.ServiceKey().Signer()},
+			false,
+		)
+
+		result, err := b.GetTransactionResult(tx.ID())
+		require.NoError(t, err)
+
+		for _, event := range result.Events {
+			switch event.Type {
+			case sharedAccountRegistered:
+				sharedAddresses = append(sharedAddresses, sharedAccountRegisteredEvent(event).Address())
+			case unlockedAccountRegistered:
+				userAddresses = append(userAddresses, unlockedAccountRegisteredEvent(event).Address())
+			}
+		}
+	}
+
+	require.Len(t, sharedAddresses, count)
+	require.Len(t, userAddresses, count)
+
+	return sharedAddresses, userAddresses
+}
+
+// submitVestingTransactions submits transactions generated by the vesting package,
+// signed by the service account.
+func submitVestingTransactions(t *testing.T, b *emulator.Blockchain, transactions []vesting.Transaction, shouldRevert bool) {
+	for _, transaction := range transactions {
+		tx := createTxWithTemplateAndAuthorizer(b, transaction.Script, b.ServiceKey().Address)
+
+		for _, argument := range transaction.Arguments {
+			require.NoError(t, tx.AddArgument(argument))
+		}
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{b.ServiceKey().Address},
+			[]crypto.Signer{b.ServiceKey().Signer()},
+			shouldRevert,
+		)
+	}
+}
+
+func TestLockedTokensBatch(t *testing.T) {
+	t.Parallel()
+
+	b := newBlockchain()
+
+	accountKeys := test.AccountKeyGenerator()
+
+	env := deployLockedTokensEnvironment(t, b)
+
+	const accounts = 5
+
+	sharedAddresses, userAddresses := createLockedAccounts(t, b, env, accountKeys, accounts)
+
+	amountFor := func(i int) ufix64.UFix64 {
+		return ufix64.UFix64(i+1) * 1000 * ufix64.One
+	}
+
+	t.Run("Should be able to deposit locked tokens to many shared accounts", func(t *testing.T) {
+
+		deposits := make([]vesting.Deposit, accounts)
+		for i, address := range sharedAddresses {
+			deposits[i] = vesting.Deposit{Account: cadence.Address(address), Amount: amountFor(i)}
+		}
+
+		transactions, err := vesting.DepositBatchTransactions(env, deposits, 2)
+		require.NoError(t, err)
+		require.Len(t, transactions, 3)
+
+		submitVestingTransactions(t, b, transactions, false)
+
+		for i, address := range userAddresses {
+			result := executeScriptAndCheck(t, b, templates.GenerateGetLockedAccountBalanceScript(env), [][]byte{jsoncdc.MustEncode(cadence.Address(address))})
+			assertEqual(t, cadence.UFix64(amountFor(i)), result)
+		}
+	})
+
+	t.Run("Should fail to deposit locked tokens to an account that is not locked", func(t *testing.T) {
+
+		deposits := []vesting.Deposit{
+			{Account: cadence.Address(sharedAddresses[0]), Amount: ufix64.One},
+			{Account: cadence.Address(userAddresses[0]), Amount: ufix64.One},
+		}
+
+		transactions, err := vesting.DepositBatchTransactions(env, deposits, vesting.DepositBatchSize)
+		require.NoError(t, err)
+		require.Len(t, transactions, 1)
+
+		submitVestingTransactions(t, b, transactions, true)
+
+		// Nothing was deposited to the shared account either
+		result := executeScriptAndCheck(t, b, templates.GenerateGetLockedAccountBalanceScript(env), [][]byte{jsoncdc.MustEncode(cadence.Address(userAddresses[0]))})
+		assertEqual(t, cadence.UFix64(amountFor(0)), result)
+	})
+
+	t.Run("Should be able to unlock tokens of many shared accounts", func(t *testing.T) {
+
+		unlocks := make([]vesting.Unlock, accounts)
+		for i, address := range sharedAddresses {
+			unlocks[i] = vesting.Unlock{Account: cadence.Address(address), Delta: amountFor(i) / 2}
+		}
+
+		transactions, err := vesting.UnlockBatchTransactions(env, unlocks, vesting.UnlockBatchSize)
+		require.NoError(t, err)
+		require.Len(t, transactions, 1)
+
+		submitVestingTransactions(t, b, transactions, false)
+
+		for i, address := range userAddresses {
+			result := executeScriptAndCheck(t, b, templates.GenerateGetUnlockLimitScript(env), [][]byte{jsoncdc.MustEncode(cadence.Address(address))})
+			assertEqual(t, cadence.UFix64(amountFor(i)/2), result)
+		}
+	})
+
+	t.Run("Should fail to unlock tokens of an account that is not locked", func(t *testing.T) {
+
+		unlocks := []vesting.Unlock{
+			{Account: cadence.Address(sharedAddresses[0]), Delta: ufix64.One},
+			{Account: cadence.Address(userAddresses[0]), Delta: ufix64.One},
+		}
+
+		transactions, err := vesting.UnlockBatchTransactions(env, unlocks, vesting.UnlockBatchSize)
+		require.NoError(t, err)
+
+		submitVestingTransactions(t, b, transactions, true)
+
+		result := executeScriptAndCheck(t, b, templates.GenerateGetUnlockLimitScript(env), [][]byte{jsoncdc.MustEncode(cadence.Address(userAddresses[0]))})
+		assertEqual(t, cadence.UFix64(amountFor(0)/2), result)
+	})
+
+	t.Run("Should stay within the estimated computation", func(t *testing.T) {
+
+		deposits := make([]vesting.Deposit, accounts)
+		unlocks := make([]vesting.Unlock, accounts)
+		for i, address := range sharedAddresses {
+			deposits[i] = vesting.Deposit{Account: cadence.Address(address), Amount: ufix64.One}
+			unlocks[i] = vesting.Unlock{Account: cadence.Address(address), Delta: ufix64.One}
+		}
+
+		depositTransactions, err := vesting.DepositBatchTransactions(env, deposits, accounts)
+		require.NoError(t, err)
+
+		unlockTransactions, err := vesting.UnlockBatchTransactions(env, unlocks, accounts)
+		require.NoError(t, err)
+
+		for _, batch := range []struct {
+			transaction vesting.Transaction
+			computation uint64
+		}{
+			{depositTransactions[0], vesting.DepositBatchComputation(accounts)},
+			{unlockTransactions[0], vesting.UnlockBatchComputation(accounts)},
+		} {
+			tx := createTxWithTemplateAndAuthorizer(b, batch.transaction.Script, b.ServiceKey().Address).
+				SetGasLimit(batch.computation)
+
+			for _, argument := range batch.transaction.Arguments {
+				require.NoError(t, tx.AddArgument(argument))
+			}
+
+			signAndSubmit(
+				t, b, tx,
+				[]flow.Address{b.ServiceKey().Address},
+				[]crypto.Signer{b.ServiceKey().Signer()},
+				false,
+			)
+		}
+	})
+}
@@ -127,10 +127,10 @@ func TestLockedTokensStaker(t *testing.T) {
 	publicKeys[0] = bytesToCadenceArray(IDTableAccountKey.Encode())
 
 	cadencePublicKeys := cadence.NewArray(publicKeys)
-	cadenceCode := bytesToCadenceArray(IDTableCode)
+	cadenceCode := cadence.NewString(hex.EncodeToString(IDTableCode))
 
 	// Deploy the IDTableStaking contract
-	tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateTransferMinterAndDeployScript(env), b.ServiceKey().Address).
+	tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateTransferMinterAndDeployHexScript(env), b.ServiceKey().Address).
 		AddRawArgument(jsoncdc.MustEncode(cadencePublicKeys)).
 		AddRawArgument(jsoncdc.MustEncode(cadence.NewString("FlowIDTableStaking"))).
 		AddRawArgument(jsoncdc.MustEncode(cadenceCode))
@@ -732,10 +732,10 @@ func TestLockedTokensDelegator(t *testing.T) {
 	publicKeys[0] = bytesToCadenceArray(IDTableAccountKey.Encode())
 
 	cadencePublicKeys := cadence.NewArray(publicKeys)
-	cadenceCode := bytesToCadenceArray(IDTableCode)
+	cadenceCode := cadence.NewString(hex.EncodeToString(IDTableCode))
 
 	// Deploy the IDTableStaking contract
-	tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateTransferMinterAndDeployScript(env), b.ServiceKey().Address).
+	tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateTransferMinterAndDeployHexScript(env), b.ServiceKey().Address).
 		AddRawArgument(jsoncdc.MustEncode(cadencePublicKeys)).
 		AddRawArgument(jsoncdc.MustEncode(cadence.NewString("FlowIDTableStaking"))).
 		AddRawArgument(jsoncdc.MustEncode(cadenceCode))
@@ -1185,10 +1185,10 @@ func TestCustodyProviderAccountCreation(t *testing.T) {
 	publicKeys[0] = bytesToCadenceArray(IDTableAccountKey.Encode())
 
 	cadencePublicKeys := cadence.NewArray(publicKeys)
-	cadenceCode := bytesToCadenceArray(IDTableCode)
+	cadenceCode := cadence.NewString(hex.EncodeToString(IDTableCode))
 
 	// Deploy the IDTableStaking contract
-	tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateTransferMinterAndDeployScript(env), b.ServiceKey().Address).
+	tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateTransferMinterAndDeployHexScript(env), b.ServiceKey().Address).
 		AddRawArgument(jsoncdc.MustEncode(cadencePublicKeys)).
 		AddRawArgument(jsoncdc.MustEncode(cadence.NewString("FlowIDTableStaking"))).
 		AddRawArgument(jsoncdc.MustEncode(cadenceCode))
@@ -1528,10 +1528,10 @@ func TestLockedTokensRealStaking(t *testing.T) {
 	publicKeys[0] = bytesToCadenceArray(IDTableAccountKey.Encode())
 
 	cadencePublicKeys := cadence.NewArray(publicKeys)
-	cadenceCode := bytesToCadenceArray(IDTableCode)
+	cadenceCode := cadence.NewString(hex.EncodeToString(IDTableCode))
 
 	// Deploy the IDTableStaking contract
-	tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateTransferMinterAndDeployScript(env), b.ServiceKey().Address).
+	tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateTransferMinterAndDeployHexScript(env), b.ServiceKey().Address).
 		AddRawArgument(jsoncdc.MustEncode(cadencePublicKeys)).
 		AddRawArgument(jsoncdc.MustEncode(cadence.NewString("FlowIDTableStaking"))).
 		AddRawArgument(jsoncdc.MustEncode(cadenceCode))
@@ -1870,10 +1870,10 @@ func TestLockedTokensRealDelegating(t *testing.T) {
 	publicKeys[0] = bytesToCadenceArray(IDTableAccountKey.Encode())
 
 	cadencePublicKeys := cadence.NewArray(publicKeys)
-	cadenceCode := bytesToCadenceArray(IDTableCode)
+	cadenceCode := cadence.NewString(hex.EncodeToString(IDTableCode))
 
 	// Deploy the IDTableStaking contract
-	tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateTransferMinterAndDeployScript(env), b.ServiceKey().Address).
+	tx := createTxWithTemplateAndAuthorizer(b, templates.GenerateTransferMinterAndDeployHexScript(env), b.ServiceKey().Address).
 		AddRawArgument(jsoncdc.MustEncode(cadencePublicKeys)).
 		AddRawArgument(jsoncdc.MustEncode(cadence.NewString("FlowIDTableStaking"))).
 		AddRawArgument(jsoncdc.MustEncode(cadenceCode))
@@ -0,0 +1,250 @@
+package test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	emulator "github.com/onflow/flow-emulator"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	sdktemplates "github.com/onflow/flow-go-sdk/templates"
+	"github.com/onflow/flow-go-sdk/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+func clusterNodeIDs(clusters ...[]string) cadence.Array {
+	values := make([]cadence.Value, len(clusters))
+
+	for i, nodeIDs := range clusters {
+		ids := make([]cadence.Value, len(nodeIDs))
+		for j, nodeID := range nodeIDs {
+			ids[j] = cadence.NewString(nodeID)
+		}
+		values[i] = cadence.NewArray(ids)
+	}
+
+	return cadence.NewArray(values)
+}
+
+func assertClusterComplete(t *testing.T, b *emulator.Blockchain, env templates.Environment, clusterIndex uint16, complete bool) {
+	result := executeScriptAndCheck(t, b, templates.GenerateGetClusterCompleteScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewUInt16(clusterIndex))})
+	assertEqual(t, cadence.NewBool(complete), result)
+}
+
+func TestQuorumCertificate(t *testing.T) {
+
+	t.Parallel()
+
+	b := newBlockchain()
+
+	env := templates.Environment{
+		FungibleTokenAddress: emulatorFTAddress,
+		FlowTokenAddress:     emulatorFlowTokenAddress,
+	}
+
+	accountKeys := test.AccountKeyGenerator()
+
+	IDTableAccountKey, _ := accountKeys.NewWithSigner()
+	idTableAddress := deployStakingContract(t, b, IDTableAccountKey, env)
+
+	env.IDTableAddress = idTableAddress.Hex()
+
+	QCAccountKey, QCSigner := accountKeys.NewWithSigner()
+	qcAddress, err := b.CreateAccount([]*flow.AccountKey{QCAccountKey}, []sdktemplates.Contract{
+		{
+			Name:   "FlowQuorumCertificate",
+			Source: string(contracts.FlowQC(idTableAddress.Hex())),
+		},
+	})
+	require.NoError(t, err)
+
+	env.QCAddress = qcAddress.Hex()
+
+	type node struct {
+		id      string
+		address flow.Address
+		signer  crypto.Signer
+	}
+
+	newNode := func(id string, number int, role uint8) node {
+		key, signer := accountKeys.NewWithSigner()
+		address, _ := b.CreateAccount([]*flow.AccountKey{key}, nil)
+		mintTokensForAccount(t, b, address)
+
+		registerNode(t, b, env, address, signer,
+			id, fmt.Sprintf("%0128d", number), fmt.Sprintf("%0128d", number), fmt.Sprintf("%0192d", number),
+			25000000000000, 0, role, false)
+
+		return node{id: id, address: address, signer: signer}
+	}
+
+	// josh, max and bastian are collection nodes, access is a consensus node
+	// and admin is a collection node that isn't in any cluster
+	joshNode := newNode(joshID, josh, 1)
+	maxNode := newNode(maxID, max, 1)
+	bastianNode := newNode(bastianID, bastian, 1)
+	accessNode := newNode(accessID, access, 2)
+	adminNode := newNode(adminID, admin, 1)
+
+	t.Run("Shouldn't be able to create a voter for a node that isn't a collection node", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateCreateVoterScript(env), accessNode.address, accessNode.signer, true)
+	})
+
+	t.Run("Should be able to create voters for collection nodes", func(t *testing.T) {
+		for _, n := range []node{joshNode, maxNode, bastianNode, adminNode} {
+			submitWithAuthorizer(t, b, templates.GenerateCreateVoterScript(env), n.address, n.signer, false)
+		}
+	})
+
+	t.Run("Shouldn't be able to create a second voter for the same node", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateCreateVoterScript(env), joshNode.address, joshNode.signer, true)
+
+		// the voter storage path of josh is already used, so also check
+		// that the contract refuses to create a second voter for the node
+		tx := createTxWithTemplateAndAuthorizer(b, []byte(fmt.Sprintf(`
+			import FlowIDTableStaking from 0x%s
+			import FlowQuorumCertificate from 0x%s
+
+			transaction {
+				prepare(acct: AuthAccount) {
+					let stakerRef = acct.borrow<&FlowIDTableStaking.NodeStaker>(from: FlowIDTableStaking.NodeStakerStoragePath)!
+					destroy FlowQuorumCertificate.createVoter(nodeStaker: stakerRef)
+				}
+			}`, idTableAddress.Hex(), qcAddress.Hex())), joshNode.address)
+
+		signAndSubmit(
+			t, b, tx,
+			[]flow.Address{b.ServiceKey().Address, joshNode.address},
+			[]crypto.Signer{b.ServiceKey().Signer(), joshNode.signer},
+			true,
+		)
+	})
+
+	t.Run("Shouldn't be able to vote before the voting starts", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateSubmitVoteScript(env), joshNode.address, joshNode.signer, true, cadence.NewString("vote"))
+	})
+
+	t.Run("Shouldn't be able to start the voting with nodes that aren't collection nodes", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateStartVotingScript(env), qcAddress, QCSigner, true,
+			clusterNodeIDs([]string{joshID, accessID}),
+		)
+		submitWithAuthorizer(t, b, templates.GenerateStartVotingScript(env), qcAddress, QCSigner, true,
+			clusterNodeIDs([]string{joshID, nonexistantID}),
+		)
+	})
+
+	t.Run("Shouldn't be able to start the voting with a node in two clusters", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateStartVotingScript(env), qcAddress, QCSigner, true,
+			clusterNodeIDs([]string{joshID, maxID}, []string{joshID}),
+		)
+	})
+
+	t.Run("Shouldn't be able to start the voting from another account", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateStartVotingScript(env), joshNode.address, joshNode.signer, true,
+			clusterNodeIDs([]string{joshID, maxID}),
+		)
+	})
+
+	t.Run("Should be able to start the voting", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateStartVotingScript(env), qcAddress, QCSigner, false,
+			clusterNodeIDs([]string{joshID, maxID}, []string{bastianID}),
+		)
+
+		result := executeScriptAndCheck(t, b, templates.GenerateGetVotingInProgressScript(env), nil)
+		assertEqual(t, cadence.NewBool(true), result)
+
+		assertClusterComplete(t, b, env, 0, false)
+		assertClusterComplete(t, b, env, 1, false)
+
+		result = executeScriptAndCheck(t, b, templates.GenerateGetVotingCompletedScript(env), nil)
+		assertEqual(t, cadence.NewBool(false), result)
+	})
+
+	t.Run("Shouldn't be able to vote for a node that isn't in a cluster", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateSubmitVoteScript(env), adminNode.address, adminNode.signer, true, cadence.NewString("vote"))
+	})
+
+	t.Run("Shouldn't be able to vote without a voter", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateSubmitVoteScript(env), accessNode.address, accessNode.signer, true, cadence.NewString("vote"))
+	})
+
+	t.Run("Shouldn't be able to submit an empty vote", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateSubmitVoteScript(env), joshNode.address, joshNode.signer, true, cadence.NewString(""))
+	})
+
+	t.Run("Should be able to vote", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateSubmitVoteScript(env), joshNode.address, joshNode.signer, false, cadence.NewString("joshVote"))
+
+		result := executeScriptAndCheck(t, b, templates.GenerateGetNodeHasVotedScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewString(joshID))})
+		assertEqual(t, cadence.NewBool(true), result)
+
+		result = executeScriptAndCheck(t, b, templates.GenerateGetNodeHasVotedScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewString(maxID))})
+		assertEqual(t, cadence.NewBool(false), result)
+
+		// one of two votes isn't more than two thirds of the cluster
+		assertClusterComplete(t, b, env, 0, false)
+	})
+
+	t.Run("Shouldn't be able to vote twice", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateSubmitVoteScript(env), joshNode.address, joshNode.signer, true, cadence.NewString("joshVote"))
+		submitWithAuthorizer(t, b, templates.GenerateSubmitVoteScript(env), joshNode.address, joshNode.signer, true, cadence.NewString("otherVote"))
+
+		result := executeScriptAndCheck(t, b, templates.GenerateGetClusterVotesScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewUInt16(0))})
+		votes := result.(cadence.Dictionary).Pairs
+		require.Len(t, votes, 1)
+		assertEqual(t, cadence.NewString(joshID), votes[0].Key)
+		assertEqual(t, cadence.NewString("joshVote"), votes[0].Value)
+	})
+
+	t.Run("Should complete the clusters when enough nodes voted", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateSubmitVoteScript(env), maxNode.address, maxNode.signer, false, cadence.NewString("maxVote"))
+
+		assertClusterComplete(t, b, env, 0, true)
+		assertClusterComplete(t, b, env, 1, false)
+
+		result := executeScriptAndCheck(t, b, templates.GenerateGetVotingCompletedScript(env), nil)
+		assertEqual(t, cadence.NewBool(false), result)
+
+		submitWithAuthorizer(t, b, templates.GenerateSubmitVoteScript(env), bastianNode.address, bastianNode.signer, false, cadence.NewString("bastianVote"))
+
+		assertClusterComplete(t, b, env, 1, true)
+
+		result = executeScriptAndCheck(t, b, templates.GenerateGetVotingCompletedScript(env), nil)
+		assertEqual(t, cadence.NewBool(true), result)
+	})
+
+	t.Run("Should be able to stop the voting", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateStopVotingScript(env), qcAddress, QCSigner, false)
+		submitWithAuthorizer(t, b, templates.GenerateStopVotingScript(env), qcAddress, QCSigner, true)
+
+		result := executeScriptAndCheck(t, b, templates.GenerateGetVotingInProgressScript(env), nil)
+		assertEqual(t, cadence.NewBool(false), result)
+
+		// the votes stay readable until the next voting
+		result = executeScriptAndCheck(t, b, templates.GenerateGetVotingCompletedScript(env), nil)
+		assertEqual(t, cadence.NewBool(true), result)
+	})
+
+	t.Run("Should discard the previous votes when a new voting starts", func(t *testing.T) {
+		submitWithAuthorizer(t, b, templates.GenerateStartVotingScript(env), qcAddress, QCSigner, false,
+			clusterNodeIDs([]string{joshID, maxID, bastianID, adminID}),
+		)
+
+		result := executeScriptAndCheck(t, b, templates.GenerateGetNodeHasVotedScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewString(joshID))})
+		assertEqual(t, cadence.NewBool(false), result)
+
+		// the same voter can vote again in the new voting
+		submitWithAuthorizer(t, b, templates.GenerateSubmitVoteScript(env), adminNode.address, adminNode.signer, false, cadence.NewString("adminVote"))
+
+		assertClusterComplete(t, b, env, 0, false)
+
+		result = executeScriptAndCheck(t, b, templates.GenerateGetClusterVotesScript(env), [][]byte{jsoncdc.MustEncode(cadence.NewUInt16(0))})
+		assert.Len(t, result.(cadence.Dictionary).Pairs, 1)
+	})
+}
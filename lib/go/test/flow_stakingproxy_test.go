@@ -1,6 +1,7 @@
 package test
 
 import (
+	"encoding/hex"
 	"fmt"
 	"testing"
 
@@ -40,11 +41,11 @@ func TestStakingProxy(t *testing.T) {
 	publicKeys[0] = bytesToCadenceArray(IDTableAccountKey.Encode())
 
 	cadencePublicKeys := cadence.NewArray(publicKeys)
-	cadenceCode := bytesToCadenceArray(IDTableCode)
+	cadenceCode := cadence.NewString(hex.EncodeToString(IDTableCode))
 
 	// Deploy the IDTableStaking contract
 	tx := flow.NewTransaction().
-		SetScript(templates.GenerateTransferMinterAndDeployScript(env)).
+		SetScript(templates.GenerateTransferMinterAndDeployHexScript(env)).
 		SetGasLimit(100).
 		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
 		SetPayer(b.ServiceKey().Address).
@@ -4,14 +4,34 @@ go 1.13
 
 require (
 	github.com/onflow/cadence v0.14.4
+	github.com/onflow/flow-core-contracts/lib/go/bootstrap v0.0.0-00010101000000-000000000000
 	github.com/onflow/flow-core-contracts/lib/go/contracts v0.7.1
+	github.com/onflow/flow-core-contracts/lib/go/model v0.0.0-00010101000000-000000000000
 	github.com/onflow/flow-core-contracts/lib/go/templates v0.0.0-00010101000000-000000000000
+	github.com/onflow/flow-core-contracts/lib/go/testkit v0.0.0-00010101000000-000000000000
+	github.com/onflow/flow-core-contracts/lib/go/ufix64 v0.0.0-00010101000000-000000000000
+	github.com/onflow/flow-core-contracts/lib/go/verify v0.0.0-00010101000000-000000000000
+	github.com/onflow/flow-core-contracts/lib/go/vesting v0.0.0-00010101000000-000000000000
 	github.com/onflow/flow-emulator v0.17.1
+	github.com/onflow/flow-ft/lib/go/contracts v0.5.0
 	github.com/onflow/flow-ft/lib/go/templates v0.2.0
 	github.com/onflow/flow-go-sdk v0.17.0
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/stretchr/testify v1.7.0
 )
 
 replace github.com/onflow/flow-core-contracts/lib/go/contracts => ../contracts
 
+replace github.com/onflow/flow-core-contracts/lib/go/model => ../model
+
 replace github.com/onflow/flow-core-contracts/lib/go/templates => ../templates
+
+replace github.com/onflow/flow-core-contracts/lib/go/ufix64 => ../ufix64
+
+replace github.com/onflow/flow-core-contracts/lib/go/vesting => ../vesting
+
+replace github.com/onflow/flow-core-contracts/lib/go/bootstrap => ../bootstrap
+
+replace github.com/onflow/flow-core-contracts/lib/go/testkit => ../testkit
+
+replace github.com/onflow/flow-core-contracts/lib/go/verify => ../verify
@@ -0,0 +1,413 @@
+package test
+
+import (
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/verify"
+)
+
+// The golden files are the snapshots of the code that the Generate* functions of the templates package
+// and the Flow* functions of the contracts package return for every network.
+// TestGolden fails with a diff if the code differs from its snapshot.
+//
+// To update the snapshots after changing a template or a contract, run
+//
+//	go test -run TestGolden -update
+var update = flag.Bool("update", false, "update the golden files")
+
+const goldenDir = "testdata/golden"
+
+// goldenNetwork is a network the snapshots are rendered for.
+type goldenNetwork struct {
+	env   templates.Environment
+	chain flow.ChainID
+
+	// flowFeesAddress is the address of FlowFees, which templates.Environment doesn't contain.
+	flowFeesAddress string
+}
+
+// goldenNetworks are the known networks. The addresses of mainnet and testnet are the ones
+// the verify package checks, and the emulator addresses are the ones that bootstrap.Deploy
+// deploys the contracts to on a new emulator. FlowEpoch, FlowQuorumCertificate and FlowDKG
+// are deployed to the account of FlowIDTableStaking.
+var goldenNetworks = []goldenNetwork{
+	verifiedNetwork(verify.Mainnet),
+	verifiedNetwork(verify.Testnet),
+	{
+		env: templates.Environment{
+			Network:              "emulator",
+			FungibleTokenAddress: "ee82856bf20e2aa6",
+			FlowTokenAddress:     "0ae53cb6e3f42a79",
+			IDTableAddress:       "01cf0e2f2f715450",
+			LockedTokensAddress:  "f3fcd2c1a78f5eee",
+			StakingProxyAddress:  "179b6b1cb6755e31",
+			StorageFeesAddress:   "f8d6e0586b0a20c7",
+			EpochAddress:         "01cf0e2f2f715450",
+			QCAddress:            "01cf0e2f2f715450",
+			DKGAddress:           "01cf0e2f2f715450",
+		},
+		chain:           flow.Emulator,
+		flowFeesAddress: "e5a8b7f23e8b548f",
+	},
+}
+
+// verifiedNetwork returns the network with the addresses of the verify package.
+func verifiedNetwork(name string) goldenNetwork {
+	network, err := verify.GetNetwork(name)
+	if err != nil {
+		panic(err)
+	}
+
+	addresses := network.Addresses
+
+	var lockedTokens contracts.Address
+	for _, contract := range network.Contracts {
+		if contract.Name == contracts.LockedTokensName {
+			lockedTokens = contract.Address
+		}
+	}
+
+	return goldenNetwork{
+		env: templates.Environment{
+			Network:              network.Name,
+			FungibleTokenAddress: addresses.FungibleToken.Hex(),
+			FlowTokenAddress:     addresses.FlowToken.Hex(),
+			IDTableAddress:       addresses.IDTable.Hex(),
+			LockedTokensAddress:  lockedTokens.Hex(),
+			StakingProxyAddress:  addresses.StakingProxy.Hex(),
+			StorageFeesAddress:   addresses.StorageFees.Hex(),
+			EpochAddress:         addresses.IDTable.Hex(),
+			QCAddress:            addresses.IDTable.Hex(),
+			DKGAddress:           addresses.IDTable.Hex(),
+		},
+		chain:           network.ChainID,
+		flowFeesAddress: addresses.FlowFees.Hex(),
+	}
+}
+
+func noEnvironment(generate func() []byte) func(templates.Environment) []byte {
+	return func(templates.Environment) []byte {
+		return generate()
+	}
+}
+
+// templateGenerators are all the Generate* functions of the templates package.
+// TestGoldenCoversAllTemplates fails if one is missing.
+var templateGenerators = map[string]func(templates.Environment) []byte{
+	"GenerateAddNodeInfoScript":                                          templates.GenerateAddNodeInfoScript,
+	"GenerateAddPublicDelegatorCapabilityScript":                         templates.GenerateAddPublicDelegatorCapabilityScript,
+	"GenerateAddPublicNodeCapabilityScript":                              templates.GenerateAddPublicNodeCapabilityScript,
+	"GenerateChangeCutScript":                                            templates.GenerateChangeCutScript,
+	"GenerateChangeMaximumDelegationRatiosScript":                        templates.GenerateChangeMaximumDelegationRatiosScript,
+	"GenerateChangeMaximumInitialWeightScript":                           templates.GenerateChangeMaximumInitialWeightScript,
+	"GenerateChangeMinimumsScript":                                       templates.GenerateChangeMinimumsScript,
+	"GenerateChangePayoutScript":                                         templates.GenerateChangePayoutScript,
+	"GenerateChangeRewardRatiosScript":                                   templates.GenerateChangeRewardRatiosScript,
+	"GenerateChangeStorageFeeParametersScript":                           templates.GenerateChangeStorageFeeParametersScript,
+	"GenerateCheckMainRegistrationScript":                                templates.GenerateCheckMainRegistrationScript,
+	"GenerateCheckSharedRegistrationScript":                              templates.GenerateCheckSharedRegistrationScript,
+	"GenerateCommitEpochScript":                                          templates.GenerateCommitEpochScript,
+	"GenerateCreateDelegationScript":                                     templates.GenerateCreateDelegationScript,
+	"GenerateCreateLockedDelegatorScript":                                templates.GenerateCreateLockedDelegatorScript,
+	"GenerateCreateParticipantScript":                                    templates.GenerateCreateParticipantScript,
+	"GenerateCreateSharedAccountScript":                                  templates.GenerateCreateSharedAccountScript,
+	"GenerateCreateVoterScript":                                          templates.GenerateCreateVoterScript,
+	"GenerateCustodyCreateAccountWithLeaseAccountScript":                 templates.GenerateCustodyCreateAccountWithLeaseAccountScript,
+	"GenerateCustodyCreateAccountsScript":                                templates.GenerateCustodyCreateAccountsScript,
+	"GenerateCustodyCreateOnlyLeaseAccountScript":                        templates.GenerateCustodyCreateOnlyLeaseAccountScript,
+	"GenerateCustodyCreateOnlySharedAccountScript":                       templates.GenerateCustodyCreateOnlySharedAccountScript,
+	"GenerateDelegateLockedRewardedTokensScript":                         templates.GenerateDelegateLockedRewardedTokensScript,
+	"GenerateDelegateLockedUnstakedTokensScript":                         templates.GenerateDelegateLockedUnstakedTokensScript,
+	"GenerateDelegateNewLockedTokensScript":                              templates.GenerateDelegateNewLockedTokensScript,
+	"GenerateDelegatorRequestUnstakeScript":                              templates.GenerateDelegatorRequestUnstakeScript,
+	"GenerateDelegatorStakeNewScript":                                    templates.GenerateDelegatorStakeNewScript,
+	"GenerateDelegatorStakeRewardedScript":                               templates.GenerateDelegatorStakeRewardedScript,
+	"GenerateDelegatorStakeUnstakedScript":                               templates.GenerateDelegatorStakeUnstakedScript,
+	"GenerateDelegatorWithdrawRewardsScript":                             templates.GenerateDelegatorWithdrawRewardsScript,
+	"GenerateDelegatorWithdrawUnstakedScript":                            templates.GenerateDelegatorWithdrawUnstakedScript,
+	"GenerateDeployEpochScript":                                          noEnvironment(templates.GenerateDeployEpochScript),
+	"GenerateDeployFlowFeesScript":                                       noEnvironment(templates.GenerateDeployFlowFeesScript),
+	"GenerateDeployLockedTokens":                                         noEnvironment(templates.GenerateDeployLockedTokens),
+	"GenerateDepositAccountCreatorScript":                                templates.GenerateDepositAccountCreatorScript,
+	"GenerateDepositLockedTokensBatchScript":                             templates.GenerateDepositLockedTokensBatchScript,
+	"GenerateDepositLockedTokensScript":                                  templates.GenerateDepositLockedTokensScript,
+	"GenerateDepositTokensScript":                                        templates.GenerateDepositTokensScript,
+	"GenerateEndCurrentEpochScript":                                      templates.GenerateEndCurrentEpochScript,
+	"GenerateEndDKGScript":                                               templates.GenerateEndDKGScript,
+	"GenerateEndEpochChangePayoutScript":                                 templates.GenerateEndEpochChangePayoutScript,
+	"GenerateEndEpochScript":                                             templates.GenerateEndEpochScript,
+	"GenerateEndStakingAuctionScript":                                    templates.GenerateEndStakingAuctionScript,
+	"GenerateEndStakingScript":                                           templates.GenerateEndStakingScript,
+	"GenerateGetAccountAvailableBalanceFilenameScript":                   templates.GenerateGetAccountAvailableBalanceFilenameScript,
+	"GenerateGetClusterCompleteScript":                                   templates.GenerateGetClusterCompleteScript,
+	"GenerateGetClusterVotesScript":                                      templates.GenerateGetClusterVotesScript,
+	"GenerateGetCommittedBalanceScript":                                  templates.GenerateGetCommittedBalanceScript,
+	"GenerateGetConsensusNodesScript":                                    templates.GenerateGetConsensusNodesScript,
+	"GenerateGetCutPercentageScript":                                     templates.GenerateGetCutPercentageScript,
+	"GenerateGetDKGCompletedScript":                                      templates.GenerateGetDKGCompletedScript,
+	"GenerateGetDKGEnabledScript":                                        templates.GenerateGetDKGEnabledScript,
+	"GenerateGetDelegationCapacityScript":                                templates.GenerateGetDelegationCapacityScript,
+	"GenerateGetDelegatorCommittedScript":                                templates.GenerateGetDelegatorCommittedScript,
+	"GenerateGetDelegatorIDScript":                                       templates.GenerateGetDelegatorIDScript,
+	"GenerateGetDelegatorInfoFromAddressScript":                          templates.GenerateGetDelegatorInfoFromAddressScript,
+	"GenerateGetDelegatorInfoScript":                                     templates.GenerateGetDelegatorInfoScript,
+	"GenerateGetDelegatorNodeIDScript":                                   templates.GenerateGetDelegatorNodeIDScript,
+	"GenerateGetDelegatorRequestScript":                                  templates.GenerateGetDelegatorRequestScript,
+	"GenerateGetDelegatorRewardsScript":                                  templates.GenerateGetDelegatorRewardsScript,
+	"GenerateGetDelegatorStakedScript":                                   templates.GenerateGetDelegatorStakedScript,
+	"GenerateGetDelegatorUnstakedScript":                                 templates.GenerateGetDelegatorUnstakedScript,
+	"GenerateGetDelegatorUnstakingRequestScript":                         templates.GenerateGetDelegatorUnstakingRequestScript,
+	"GenerateGetDelegatorUnstakingScript":                                templates.GenerateGetDelegatorUnstakingScript,
+	"GenerateGetEpochCounterScript":                                      templates.GenerateGetEpochCounterScript,
+	"GenerateGetEpochMetadataScript":                                     templates.GenerateGetEpochMetadataScript,
+	"GenerateGetEpochPhaseScript":                                        templates.GenerateGetEpochPhaseScript,
+	"GenerateGetFinalSubmissionsScript":                                  templates.GenerateGetFinalSubmissionsScript,
+	"GenerateGetInitialWeightScript":                                     templates.GenerateGetInitialWeightScript,
+	"GenerateGetLockedAccountAddressScript":                              templates.GenerateGetLockedAccountAddressScript,
+	"GenerateGetLockedAccountBalanceScript":                              templates.GenerateGetLockedAccountBalanceScript,
+	"GenerateGetLockedDelegatorInfoScript":                               templates.GenerateGetLockedDelegatorInfoScript,
+	"GenerateGetLockedStakerInfoScript":                                  templates.GenerateGetLockedStakerInfoScript,
+	"GenerateGetMaximumDelegationRatiosScript":                           templates.GenerateGetMaximumDelegationRatiosScript,
+	"GenerateGetMaximumInitialWeightScript":                              templates.GenerateGetMaximumInitialWeightScript,
+	"GenerateGetNetworkingAddressScript":                                 templates.GenerateGetNetworkingAddressScript,
+	"GenerateGetNetworkingKeyScript":                                     templates.GenerateGetNetworkingKeyScript,
+	"GenerateGetNodeHasVotedScript":                                      templates.GenerateGetNodeHasVotedScript,
+	"GenerateGetNodeIDScript":                                            templates.GenerateGetNodeIDScript,
+	"GenerateGetNodeInfoFromAddressScript":                               templates.GenerateGetNodeInfoFromAddressScript,
+	"GenerateGetNodeInfoScript":                                          templates.GenerateGetNodeInfoScript,
+	"GenerateGetRemoteNodeInfoScript":                                    templates.GenerateGetRemoteNodeInfoScript,
+	"GenerateGetRewardBalanceScript":                                     templates.GenerateGetRewardBalanceScript,
+	"GenerateGetRewardRatioScript":                                       templates.GenerateGetRewardRatioScript,
+	"GenerateGetRewardRatiosScript":                                      templates.GenerateGetRewardRatiosScript,
+	"GenerateGetRoleScript":                                              templates.GenerateGetRoleScript,
+	"GenerateGetStakeRequirementsScript":                                 templates.GenerateGetStakeRequirementsScript,
+	"GenerateGetStakedBalanceScript":                                     templates.GenerateGetStakedBalanceScript,
+	"GenerateGetStakingKeyScript":                                        templates.GenerateGetStakingKeyScript,
+	"GenerateGetStorageCapacityScript":                                   templates.GenerateGetStorageCapacityScript,
+	"GenerateGetStorageFeeConversionScript":                              templates.GenerateGetStorageFeeConversionScript,
+	"GenerateGetStorageFeeMinimumScript":                                 templates.GenerateGetStorageFeeMinimumScript,
+	"GenerateGetSubmissionThresholdScript":                               templates.GenerateGetSubmissionThresholdScript,
+	"GenerateGetSupplyTotalsScript":                                      templates.GenerateGetSupplyTotalsScript,
+	"GenerateGetTotalBalanceScript":                                      templates.GenerateGetTotalBalanceScript,
+	"GenerateGetTotalCommitmentBalanceScript":                            templates.GenerateGetTotalCommitmentBalanceScript,
+	"GenerateGetTotalCommitmentBalanceWithoutDelegatorsScript":           templates.GenerateGetTotalCommitmentBalanceWithoutDelegatorsScript,
+	"GenerateGetTotalTokensStakedByTypeScript":                           templates.GenerateGetTotalTokensStakedByTypeScript,
+	"GenerateGetTotalTokensStakedScript":                                 templates.GenerateGetTotalTokensStakedScript,
+	"GenerateGetUnlockLimitScript":                                       templates.GenerateGetUnlockLimitScript,
+	"GenerateGetUnstakedBalanceScript":                                   templates.GenerateGetUnstakedBalanceScript,
+	"GenerateGetUnstakingBalanceScript":                                  templates.GenerateGetUnstakingBalanceScript,
+	"GenerateGetUnstakingRequestScript":                                  templates.GenerateGetUnstakingRequestScript,
+	"GenerateGetVotingCompletedScript":                                   templates.GenerateGetVotingCompletedScript,
+	"GenerateGetVotingInProgressScript":                                  templates.GenerateGetVotingInProgressScript,
+	"GenerateGetWeeklyPayoutScript":                                      templates.GenerateGetWeeklyPayoutScript,
+	"GenerateGetWhiteboardMessageCountScript":                            templates.GenerateGetWhiteboardMessageCountScript,
+	"GenerateGetWhiteboardMessagesScript":                                templates.GenerateGetWhiteboardMessagesScript,
+	"GenerateIncreaseUnlockLimitBatchScript":                             templates.GenerateIncreaseUnlockLimitBatchScript,
+	"GenerateIncreaseUnlockLimitScript":                                  templates.GenerateIncreaseUnlockLimitScript,
+	"GenerateMoveTokensScript":                                           templates.GenerateMoveTokensScript,
+	"GeneratePayRewardsScript":                                           templates.GeneratePayRewardsScript,
+	"GeneratePostMessageScript":                                          templates.GeneratePostMessageScript,
+	"GenerateProxyRequestUnstakingScript":                                templates.GenerateProxyRequestUnstakingScript,
+	"GenerateProxyStakeNewTokensScript":                                  templates.GenerateProxyStakeNewTokensScript,
+	"GenerateProxyStakeUnstakedTokensScript":                             templates.GenerateProxyStakeUnstakedTokensScript,
+	"GenerateProxyUnstakeAllScript":                                      templates.GenerateProxyUnstakeAllScript,
+	"GenerateProxyWithdrawRewardsScript":                                 templates.GenerateProxyWithdrawRewardsScript,
+	"GenerateProxyWithdrawUnstakedScript":                                templates.GenerateProxyWithdrawUnstakedScript,
+	"GenerateRegisterDelegatorScript":                                    templates.GenerateRegisterDelegatorScript,
+	"GenerateRegisterLockedNodeScript":                                   templates.GenerateRegisterLockedNodeScript,
+	"GenerateRegisterManyDelegatorsScript":                               templates.GenerateRegisterManyDelegatorsScript,
+	"GenerateRegisterManyNodesScript":                                    templates.GenerateRegisterManyNodesScript,
+	"GenerateRegisterNodeScript":                                         templates.GenerateRegisterNodeScript,
+	"GenerateRegisterStakingProxyNodeScript":                             templates.GenerateRegisterStakingProxyNodeScript,
+	"GenerateRemoveDelegatorScript":                                      templates.GenerateRemoveDelegatorScript,
+	"GenerateRemoveNodeInfoScript":                                       templates.GenerateRemoveNodeInfoScript,
+	"GenerateRemoveNodeScript":                                           templates.GenerateRemoveNodeScript,
+	"GenerateRemoveStakingProxyScript":                                   templates.GenerateRemoveStakingProxyScript,
+	"GenerateReturnCurrentTableScript":                                   templates.GenerateReturnCurrentTableScript,
+	"GenerateReturnProposedTableScript":                                  templates.GenerateReturnProposedTableScript,
+	"GenerateReturnTableScript":                                          templates.GenerateReturnTableScript,
+	"GenerateSendFinalSubmissionScript":                                  templates.GenerateSendFinalSubmissionScript,
+	"GenerateSetNumViewsInEpochScript":                                   templates.GenerateSetNumViewsInEpochScript,
+	"GenerateSetupCustodyAccountScript":                                  templates.GenerateSetupCustodyAccountScript,
+	"GenerateSetupNodeAccountScript":                                     templates.GenerateSetupNodeAccountScript,
+	"GenerateSlashNodeScript":                                            templates.GenerateSlashNodeScript,
+	"GenerateStakeLockedRewardedTokensScript":                            templates.GenerateStakeLockedRewardedTokensScript,
+	"GenerateStakeLockedUnstakedTokensScript":                            templates.GenerateStakeLockedUnstakedTokensScript,
+	"GenerateStakeNewLockedTokensScript":                                 templates.GenerateStakeNewLockedTokensScript,
+	"GenerateStakeNewTokensScript":                                       templates.GenerateStakeNewTokensScript,
+	"GenerateStakeRewardedTokensScript":                                  templates.GenerateStakeRewardedTokensScript,
+	"GenerateStakeUnstakedTokensScript":                                  templates.GenerateStakeUnstakedTokensScript,
+	"GenerateStartDKGScript":                                             templates.GenerateStartDKGScript,
+	"GenerateStartVotingScript":                                          templates.GenerateStartVotingScript,
+	"GenerateStopVotingScript":                                           templates.GenerateStopVotingScript,
+	"GenerateSubmitVoteScript":                                           templates.GenerateSubmitVoteScript,
+	"GenerateTransferMinterAndDeployHexScript":                           templates.GenerateTransferMinterAndDeployHexScript,
+	"GenerateTransferMinterAndDeployScript":                              templates.GenerateTransferMinterAndDeployScript,
+	"GenerateUnDelegateLockedTokensScript":                               templates.GenerateUnDelegateLockedTokensScript,
+	"GenerateUnstakeAllLockedTokensScript":                               templates.GenerateUnstakeAllLockedTokensScript,
+	"GenerateUnstakeAllScript":                                           templates.GenerateUnstakeAllScript,
+	"GenerateUnstakeLockedTokensScript":                                  templates.GenerateUnstakeLockedTokensScript,
+	"GenerateUnstakeTokensScript":                                        templates.GenerateUnstakeTokensScript,
+	"GenerateWithdrawDelegatorLockedRewardedTokensScript":                templates.GenerateWithdrawDelegatorLockedRewardedTokensScript,
+	"GenerateWithdrawDelegatorLockedRewardedTokensToLockedAccountScript": templates.GenerateWithdrawDelegatorLockedRewardedTokensToLockedAccountScript,
+	"GenerateWithdrawDelegatorLockedUnstakedTokensScript":                templates.GenerateWithdrawDelegatorLockedUnstakedTokensScript,
+	"GenerateWithdrawLockedRewardedTokensScript":                         templates.GenerateWithdrawLockedRewardedTokensScript,
+	"GenerateWithdrawLockedRewardedTokensToLockedAccountScript":          templates.GenerateWithdrawLockedRewardedTokensToLockedAccountScript,
+	"GenerateWithdrawLockedUnstakedTokensScript":                         templates.GenerateWithdrawLockedUnstakedTokensScript,
+	"GenerateWithdrawRewardedTokensScript":                               templates.GenerateWithdrawRewardedTokensScript,
+	"GenerateWithdrawTokensScript":                                       templates.GenerateWithdrawTokensScript,
+	"GenerateWithdrawUnstakedTokensScript":                               templates.GenerateWithdrawUnstakedTokensScript,
+}
+
+// generateTemplate returns the code of a template, or an error with the panic
+// if the template can't be generated.
+func generateTemplate(generate func(templates.Environment) []byte, env templates.Environment) (code []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	return generate(env), nil
+}
+
+// contractGenerators returns the code of every contract of the contracts package
+// with the addresses of a network.
+func contractGenerators(network goldenNetwork) map[string][]byte {
+	env := network.env
+
+	return map[string][]byte{
+		"FungibleToken":          contracts.FungibleToken(),
+		"FlowToken":              contracts.FlowToken(env.FungibleTokenAddress),
+		"FlowFees":               contracts.FlowFees(env.FungibleTokenAddress, env.FlowTokenAddress),
+		"FlowStorageFees":        contracts.FlowStorageFees(env.FungibleTokenAddress, env.FlowTokenAddress),
+		"FlowServiceAccount":     contracts.FlowServiceAccount(env.FungibleTokenAddress, env.FlowTokenAddress, network.flowFeesAddress, env.StorageFeesAddress),
+		"FlowIDTableStaking":     contracts.FlowIDTableStaking(env.FungibleTokenAddress, env.FlowTokenAddress),
+		"TESTFlowIDTableStaking": contracts.TESTFlowIDTableStaking(env.FungibleTokenAddress, env.FlowTokenAddress),
+		"FlowStakingProxy":       contracts.FlowStakingProxy(),
+		"FlowLockedTokens":       contracts.FlowLockedTokens(env.FungibleTokenAddress, env.FlowTokenAddress, env.IDTableAddress, env.StakingProxyAddress, env.StorageFeesAddress),
+		"FlowEpoch":              contracts.FlowEpoch(env.IDTableAddress),
+		"FlowQC":                 contracts.FlowQC(env.IDTableAddress),
+		"FlowDKG":                contracts.FlowDKG(env.IDTableAddress),
+	}
+}
+
+func TestGolden(t *testing.T) {
+	for _, network := range goldenNetworks {
+		network := network
+
+		t.Run(network.env.Network, func(t *testing.T) {
+			t.Run("templates", func(t *testing.T) {
+				generated := make(map[string][]byte, len(templateGenerators))
+				for name, generate := range templateGenerators {
+					code, err := generateTemplate(generate, network.env)
+					require.NoError(t, err, name)
+
+					generated[name] = code
+				}
+
+				checkGolden(t, filepath.Join(goldenDir, network.env.Network, "templates"), generated)
+			})
+
+			t.Run("contracts", func(t *testing.T) {
+				checkGolden(t, filepath.Join(goldenDir, network.env.Network, "contracts"), contractGenerators(network))
+			})
+		})
+	}
+}
+
+// checkGolden compares the generated code with the snapshots in dir, which are named after
+// the functions that generate them. Snapshots of functions that no longer exist are reported too.
+// In update mode, the snapshots are rewritten instead.
+func checkGolden(t *testing.T, dir string, generated map[string][]byte) {
+	names := make([]string, 0, len(generated))
+	for name := range generated {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if *update {
+		require.NoError(t, os.RemoveAll(dir))
+		require.NoError(t, os.MkdirAll(dir, 0755))
+
+		for _, name := range names {
+			require.NoError(t, ioutil.WriteFile(goldenFilename(dir, name), generated[name], 0644))
+		}
+
+		return
+	}
+
+	for _, name := range names {
+		filename := goldenFilename(dir, name)
+
+		expected, err := ioutil.ReadFile(filename)
+		if os.IsNotExist(err) {
+			t.Errorf("%s has no snapshot, run the tests with -update to create %s", name, filename)
+			continue
+		}
+		require.NoError(t, err)
+
+		if string(expected) != string(generated[name]) {
+			diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+				A:        difflib.SplitLines(string(expected)),
+				B:        difflib.SplitLines(string(generated[name])),
+				FromFile: filename,
+				ToFile:   name,
+				Context:  3,
+			})
+
+			t.Errorf("%s differs from its snapshot, run the tests with -update if the change is intended:\n%s", name, diff)
+		}
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.cdc"))
+	require.NoError(t, err)
+
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".cdc")
+
+		if _, ok := generated[name]; !ok {
+			t.Errorf("%s is the snapshot of %s, which doesn't exist anymore, run the tests with -update to remove it", file, name)
+		}
+	}
+}
+
+func goldenFilename(dir, name string) string {
+	return filepath.Join(dir, name+".cdc")
+}
+
+// TestGoldenCoversAllTemplates checks that every Generate* function
+// of the templates package has a snapshot.
+func TestGoldenCoversAllTemplates(t *testing.T) {
+	packages, err := parser.ParseDir(token.NewFileSet(), "../templates", nil, 0)
+	require.NoError(t, err)
+
+	for _, file := range packages["templates"].Files {
+		for _, decl := range file.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Recv != nil || !strings.HasPrefix(function.Name.Name, "Generate") {
+				continue
+			}
+
+			_, ok = templateGenerators[function.Name.Name]
+			assert.True(t, ok, "templates.%s is missing from templateGenerators", function.Name.Name)
+		}
+	}
+}
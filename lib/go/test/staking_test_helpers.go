@@ -1,6 +1,7 @@
 package test
 
 import (
+	"encoding/hex"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -32,11 +33,11 @@ func deployStakingContract(t *testing.T, b *emulator.Blockchain, IDTableAccountK
 	publicKeys[0] = bytesToCadenceArray(IDTableAccountKey.Encode())
 
 	cadencePublicKeys := cadence.NewArray(publicKeys)
-	cadenceCode := bytesToCadenceArray(IDTableCode)
+	cadenceCode := cadence.NewString(hex.EncodeToString(IDTableCode))
 
 	// Deploy the IDTableStaking contract
 	tx := createTxWithTemplateAndAuthorizer(b,
-		templates.GenerateTransferMinterAndDeployScript(env),
+		templates.GenerateTransferMinterAndDeployHexScript(env),
 		b.ServiceKey().Address)
 
 	tx.AddRawArgument(jsoncdc.MustEncode(cadencePublicKeys))
@@ -53,20 +54,15 @@ func deployStakingContract(t *testing.T, b *emulator.Blockchain, IDTableAccountK
 		false,
 	)
 
-	var idTableAddress flow.Address
+	result, err := b.GetTransactionResult(tx.ID())
+	require.NoError(t, err)
 
-	var i uint64
-	i = 0
-	for i < 1000 {
-		results, _ := b.GetEventsByHeight(i, "flow.AccountCreated")
+	var idTableAddress flow.Address
 
-		for _, event := range results {
-			if event.Type == flow.EventAccountCreated {
-				idTableAddress = flow.Address(event.Value.Fields[0].(cadence.Address))
-			}
+	for _, event := range result.Events {
+		if event.Type == flow.EventAccountCreated {
+			idTableAddress = flow.AccountCreatedEvent(event).Address()
 		}
-
-		i = i + 1
 	}
 
 	return idTableAddress
@@ -278,11 +274,11 @@ func requestUnstaking(t *testing.T,
 
 func payRewards(
 	isDelegator bool,
-	totalPayout, totalStaked, cut, staked interpreter.UFix64Value,
+	rolePayout, roleStaked, cut, staked interpreter.UFix64Value,
 ) (
 	rewards, delegateeRewards interpreter.UFix64Value,
 ) {
-	calculatedRewards := totalPayout.Div(totalStaked).Mul(staked).(interpreter.UFix64Value)
+	calculatedRewards := rolePayout.Div(roleStaked).Mul(staked).(interpreter.UFix64Value)
 
 	if isDelegator {
 		delegateeRewards = calculatedRewards.Mul(cut).(interpreter.UFix64Value)
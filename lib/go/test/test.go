@@ -78,6 +78,31 @@ func signAndSubmit(
 	Submit(t, b, tx, shouldRevert)
 }
 
+// submitWithAuthorizer creates a transaction with the given script and arguments,
+// which is authorized by a single account, then signs and submits it.
+func submitWithAuthorizer(
+	t *testing.T,
+	b *emulator.Blockchain,
+	script []byte,
+	authorizer flow.Address,
+	signer crypto.Signer,
+	shouldRevert bool,
+	arguments ...cadence.Value,
+) {
+	tx := createTxWithTemplateAndAuthorizer(b, script, authorizer)
+
+	for _, argument := range arguments {
+		_ = tx.AddArgument(argument)
+	}
+
+	signAndSubmit(
+		t, b, tx,
+		[]flow.Address{b.ServiceKey().Address, authorizer},
+		[]crypto.Signer{b.ServiceKey().Signer(), signer},
+		shouldRevert,
+	)
+}
+
 // Submit submits a transaction and checks if it fails or not.
 func Submit(
 	t *testing.T,
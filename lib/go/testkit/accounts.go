@@ -0,0 +1,83 @@
+package testkit
+
+import (
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/stretchr/testify/require"
+
+	ft_templates "github.com/onflow/flow-ft/lib/go/templates"
+
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// Account is an emulator account with the signer of its only key.
+type Account struct {
+	Address flow.Address
+	Key     *flow.AccountKey
+	Signer  crypto.Signer
+}
+
+// ServiceAccount returns the service account of the emulator,
+// which can mint FLOW and stores the LockedTokens admin resources.
+func (f *Fixture) ServiceAccount() Account {
+	serviceKey := f.Blockchain.ServiceKey()
+
+	return Account{
+		Address: serviceKey.Address,
+		Key:     serviceKey.AccountKey(),
+		Signer:  serviceKey.Signer(),
+	}
+}
+
+// NewAccount creates an account with a new key and an empty FLOW vault.
+func (f *Fixture) NewAccount() Account {
+	f.t.Helper()
+
+	key, signer := f.accountKeys.NewWithSigner()
+
+	address, err := f.Blockchain.CreateAccount([]*flow.AccountKey{key}, nil)
+	require.NoError(f.t, err)
+
+	return Account{Address: address, Key: key, Signer: signer}
+}
+
+// NewFundedAccount creates an account and mints the amount of FLOW to it, if it is not zero.
+func (f *Fixture) NewFundedAccount(amount ufix64.UFix64) Account {
+	f.t.Helper()
+
+	account := f.NewAccount()
+	if amount > 0 {
+		f.Mint(account.Address, amount)
+	}
+
+	return account
+}
+
+// Mint mints FLOW to the vault of the recipient.
+func (f *Fixture) Mint(recipient flow.Address, amount ufix64.UFix64) {
+	f.t.Helper()
+
+	script := ft_templates.GenerateMintTokensScript(
+		flow.HexToAddress(f.Env.FungibleTokenAddress),
+		flow.HexToAddress(f.Env.FlowTokenAddress),
+		"FlowToken",
+	)
+
+	f.Send(script, f.ServiceAccount(), false, cadence.NewAddress(recipient), cadence.UFix64(amount))
+}
+
+// Balance returns the balance of the FLOW vault of an account.
+func (f *Fixture) Balance(address flow.Address) ufix64.UFix64 {
+	f.t.Helper()
+
+	script := ft_templates.GenerateInspectVaultScript(
+		flow.HexToAddress(f.Env.FungibleTokenAddress),
+		flow.HexToAddress(f.Env.FlowTokenAddress),
+		"FlowToken",
+	)
+
+	result := f.ExecuteScript(script, cadence.NewAddress(address))
+
+	return ufix64.UFix64(result.(cadence.UFix64))
+}
@@ -0,0 +1,165 @@
+package testkit
+
+import (
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/model"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// Buckets are the token buckets of a node or delegator.
+type Buckets struct {
+	Committed          ufix64.UFix64
+	Staked             ufix64.UFix64
+	RequestedToUnstake ufix64.UFix64
+	Unstaking          ufix64.UFix64
+	Unstaked           ufix64.UFix64
+	Rewarded           ufix64.UFix64
+}
+
+// NodeInfo returns the info of a node from the get_node_info.cdc script.
+func (f *Fixture) NodeInfo(nodeID string) model.NodeInfo {
+	f.t.Helper()
+
+	result := f.ExecuteScript(templates.GenerateGetNodeInfoScript(f.Env), cadence.NewString(nodeID))
+
+	info, err := model.DecodeNodeInfo(result)
+	require.NoError(f.t, err)
+
+	return info
+}
+
+// DelegatorInfo returns the info of a delegator from the get_delegator_info.cdc script.
+func (f *Fixture) DelegatorInfo(nodeID string, delegatorID uint32) model.DelegatorInfo {
+	f.t.Helper()
+
+	result := f.ExecuteScript(templates.GenerateGetDelegatorInfoScript(f.Env), cadence.NewString(nodeID), cadence.NewUInt32(delegatorID))
+
+	info, err := model.DecodeDelegatorInfo(result)
+	require.NoError(f.t, err)
+
+	return info
+}
+
+// NodeBuckets returns the token buckets of a node, without the tokens of its delegators.
+func (f *Fixture) NodeBuckets(nodeID string) Buckets {
+	f.t.Helper()
+
+	info := f.NodeInfo(nodeID)
+
+	return Buckets{
+		Committed:          info.TokensCommitted,
+		Staked:             info.TokensStaked,
+		RequestedToUnstake: info.TokensRequestedToUnstake,
+		Unstaking:          info.TokensUnstaking,
+		Unstaked:           info.TokensUnstaked,
+		Rewarded:           info.TokensRewarded,
+	}
+}
+
+// DelegatorBuckets returns the token buckets of a delegator.
+func (f *Fixture) DelegatorBuckets(nodeID string, delegatorID uint32) Buckets {
+	f.t.Helper()
+
+	info := f.DelegatorInfo(nodeID, delegatorID)
+
+	return Buckets{
+		Committed:          info.TokensCommitted,
+		Staked:             info.TokensStaked,
+		RequestedToUnstake: info.TokensRequestedToUnstake,
+		Unstaking:          info.TokensUnstaking,
+		Unstaked:           info.TokensUnstaked,
+		Rewarded:           info.TokensRewarded,
+	}
+}
+
+// AssertNodeBuckets asserts that the token buckets of a node are the expected ones.
+func (f *Fixture) AssertNodeBuckets(nodeID string, expected Buckets) bool {
+	f.t.Helper()
+
+	return assert.Equal(f.t, expected, f.NodeBuckets(nodeID), "buckets of node %s", nodeID)
+}
+
+// AssertDelegatorBuckets asserts that the token buckets of a delegator are the expected ones.
+func (f *Fixture) AssertDelegatorBuckets(nodeID string, delegatorID uint32, expected Buckets) bool {
+	f.t.Helper()
+
+	return assert.Equal(f.t, expected, f.DelegatorBuckets(nodeID, delegatorID), "buckets of delegator %d of node %s", delegatorID, nodeID)
+}
+
+// MoveTokens returns the buckets after the tokens are moved at the end of an epoch:
+// committed tokens are staked, requested tokens start unstaking
+// and the tokens that were unstaking are unstaked.
+func MoveTokens(b Buckets) (Buckets, error) {
+	staked, err := b.Staked.Add(b.Committed)
+	if err != nil {
+		return Buckets{}, err
+	}
+
+	staked, err = staked.Sub(b.RequestedToUnstake)
+	if err != nil {
+		return Buckets{}, err
+	}
+
+	unstaked, err := b.Unstaked.Add(b.Unstaking)
+	if err != nil {
+		return Buckets{}, err
+	}
+
+	return Buckets{
+		Staked:    staked,
+		Unstaking: b.RequestedToUnstake,
+		Unstaked:  unstaked,
+		Rewarded:  b.Rewarded,
+	}, nil
+}
+
+// RolePayout returns the part of the epoch payout that payRewards pays
+// to the nodes of a role and their delegators.
+func (f *Fixture) RolePayout(role uint8) ufix64.UFix64 {
+	f.t.Helper()
+
+	payout := f.ExecuteScript(templates.GenerateGetWeeklyPayoutScript(f.Env)).(cadence.UFix64)
+	ratio := f.ExecuteScript(templates.GenerateGetRewardRatioScript(f.Env), cadence.NewUInt8(role)).(cadence.UFix64)
+
+	rolePayout, err := ufix64.UFix64(payout).Mul(ufix64.UFix64(ratio))
+	require.NoError(f.t, err)
+
+	return rolePayout
+}
+
+// Rewards returns the rewards of tokens staked for an epoch, computed like payRewards:
+// the payout of the role, as returned by RolePayout, is divided by the total staked tokens
+// of the role before it is multiplied by the staked tokens.
+func Rewards(rolePayout, roleStaked, staked ufix64.UFix64) (ufix64.UFix64, error) {
+	perToken, err := rolePayout.Div(roleStaked)
+	if err != nil {
+		return 0, err
+	}
+
+	return perToken.Mul(staked)
+}
+
+// DelegatorRewards returns the rewards of tokens a delegator staked for an epoch,
+// and the cut of the rewards that is paid to the node operator instead.
+func DelegatorRewards(rolePayout, roleStaked, staked, cut ufix64.UFix64) (rewards, nodeCut ufix64.UFix64, err error) {
+	total, err := Rewards(rolePayout, roleStaked, staked)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	nodeCut, err = total.Mul(cut)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	rewards, err = total.Sub(nodeCut)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return rewards, nodeCut, nil
+}
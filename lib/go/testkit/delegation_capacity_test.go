@@ -0,0 +1,155 @@
+package testkit_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/model"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/testkit"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// delegationRatiosArgument returns the ratios argument of the
+// change_maximum_delegation_ratios transaction.
+func delegationRatiosArgument(ratios map[uint8]string) cadence.Value {
+	pairs := make([]cadence.KeyValuePair, 0, len(ratios))
+	for role, ratio := range ratios {
+		pairs = append(pairs, cadence.KeyValuePair{Key: cadence.NewUInt8(role), Value: cadence.UFix64(ufix64.MustParse(ratio))})
+	}
+
+	return cadence.NewDictionary(pairs)
+}
+
+func TestIDTableDelegationCapacity(t *testing.T) {
+
+	f := testkit.New(t)
+	changeScript := templates.GenerateChangeMaximumDelegationRatiosScript(f.Env)
+
+	table := testkit.EmulatorModel()
+
+	collection := f.NewModelNode(table, testkit.NodeID(1), testkit.RoleCollection, ufix64.MustParse("250000.0"))
+	consensus := f.NewModelNode(table, testkit.NodeID(2), testkit.RoleConsensus, ufix64.MustParse("500000.0"))
+	delegator := f.NewModelDelegator(table, collection.ID, ufix64.MustParse("100000.0"))
+
+	// The delegator has more tokens than the node can accept
+	f.Mint(delegator.Owner.Address, ufix64.MustParse("50000.0"))
+
+	// assertCapacity checks the remaining capacity of the node against the model
+	assertCapacity := func(t *testing.T, nodeID string, expected string) {
+		capacity, limited, err := model.DecodeDelegationCapacity(f.ExecuteScript(templates.GenerateGetDelegationCapacityScript(f.Env), cadence.NewString(nodeID)))
+		require.NoError(t, err)
+
+		expectedCapacity, expectedLimited, err := table.RemainingDelegationCapacity(nodeID)
+		require.NoError(t, err)
+
+		assert.Equal(t, expectedLimited, limited)
+		assert.Equal(t, expectedCapacity, capacity)
+
+		if expected == "" {
+			assert.False(t, limited)
+		} else {
+			assert.True(t, limited)
+			assert.Equal(t, ufix64.MustParse(expected), capacity)
+		}
+	}
+
+	t.Run("Should not limit delegation without maximum ratios", func(t *testing.T) {
+		assertCapacity(t, collection.ID, "")
+		assertCapacity(t, consensus.ID, "")
+	})
+
+	t.Run("Should not be able to set invalid maximum ratios", func(t *testing.T) {
+		f.Send(changeScript, f.StakingAdmin, true, delegationRatiosArgument(map[uint8]string{6: "1.0"}))
+		f.Send(changeScript, collection.Owner, true, delegationRatiosArgument(map[uint8]string{1: "1.0"}))
+
+		ratios, err := model.DecodeRoleAmounts(f.ExecuteScript(templates.GenerateGetMaximumDelegationRatiosScript(f.Env)))
+		require.NoError(t, err)
+		assert.Empty(t, ratios)
+	})
+
+	t.Run("Should set the maximum ratios", func(t *testing.T) {
+		result := f.Send(changeScript, f.StakingAdmin, false, delegationRatiosArgument(map[uint8]string{1: "0.5"}))
+
+		require.NoError(t, table.SetMaximumDelegationRatios(map[uint8]ufix64.UFix64{
+			testkit.RoleCollection: ufix64.MustParse("0.5"),
+		}))
+
+		eventType := testkit.EventType(f.StakingAdmin.Address, "FlowIDTableStaking", "NewMaximumDelegationRatios")
+
+		var emitted bool
+		for _, event := range result.Events {
+			if event.Type != eventType {
+				continue
+			}
+
+			emitted = true
+
+			ratios, err := model.DecodeRoleAmounts(event.Value.Fields[0])
+			require.NoError(t, err)
+			assert.Equal(t, table.MaximumDelegationRatios(), ratios)
+		}
+		assert.True(t, emitted)
+
+		ratios, err := model.DecodeRoleAmounts(f.ExecuteScript(templates.GenerateGetMaximumDelegationRatiosScript(f.Env)))
+		require.NoError(t, err)
+		assert.Equal(t, table.MaximumDelegationRatios(), ratios)
+
+		// Half of the 250000 tokens of the node, minus the 100000 tokens already delegated
+		assertCapacity(t, collection.ID, "25000.0")
+		assertCapacity(t, consensus.ID, "")
+	})
+
+	t.Run("Should limit new delegated tokens", func(t *testing.T) {
+		stakeNewScript := templates.GenerateDelegatorStakeNewScript(f.Env)
+
+		f.Send(stakeNewScript, delegator.Owner, true, cadence.UFix64(ufix64.MustParse("25000.1")))
+		f.Send(stakeNewScript, delegator.Owner, false, cadence.UFix64(ufix64.MustParse("15000.0")))
+		require.NoError(t, table.DelegateNewTokens(collection.ID, delegator.ID, ufix64.MustParse("15000.0")))
+
+		assertCapacity(t, collection.ID, "10000.0")
+	})
+
+	t.Run("Should limit delegated unstaked tokens", func(t *testing.T) {
+		stakeUnstakedScript := templates.GenerateDelegatorStakeUnstakedScript(f.Env)
+
+		f.RequestDelegatorUnstaking(delegator, ufix64.MustParse("20000.0"))
+		require.NoError(t, table.RequestDelegatorUnstaking(collection.ID, delegator.ID, ufix64.MustParse("20000.0")))
+		assertCapacity(t, collection.ID, "30000.0")
+
+		f.Send(stakeUnstakedScript, delegator.Owner, true, cadence.UFix64(ufix64.MustParse("30000.1")))
+		f.Send(stakeUnstakedScript, delegator.Owner, false, cadence.UFix64(ufix64.MustParse("20000.0")))
+		require.NoError(t, table.DelegateUnstakedTokens(collection.ID, delegator.ID, ufix64.MustParse("20000.0")))
+
+		assertCapacity(t, collection.ID, "10000.0")
+	})
+
+	t.Run("Should not limit the delegation to nodes of other roles", func(t *testing.T) {
+		f.NewModelDelegator(table, consensus.ID, ufix64.MustParse("1000000.0"))
+
+		assertCapacity(t, consensus.ID, "")
+	})
+
+	t.Run("Should limit delegated rewarded tokens", func(t *testing.T) {
+		stakeRewardedScript := templates.GenerateDelegatorStakeRewardedScript(f.Env)
+
+		// The tokens are staked in the first epoch and rewarded in the second one
+		for epoch := 0; epoch < 2; epoch++ {
+			f.AdvanceModelEpoch(table, []string{collection.ID, consensus.ID})
+		}
+
+		rewarded := f.DelegatorInfo(collection.ID, delegator.ID).TokensRewarded
+		require.True(t, rewarded > ufix64.MustParse("10000.0"))
+
+		f.Send(stakeRewardedScript, delegator.Owner, true, cadence.UFix64(ufix64.MustParse("10000.1")))
+		f.Send(stakeRewardedScript, delegator.Owner, false, cadence.UFix64(ufix64.MustParse("10000.0")))
+		require.NoError(t, table.DelegateRewardedTokens(collection.ID, delegator.ID, ufix64.MustParse("10000.0")))
+
+		assertCapacity(t, collection.ID, "0.0")
+
+		f.AssertSupplyInvariants()
+	})
+}
@@ -0,0 +1,112 @@
+package testkit_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/model"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/testkit"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+func TestIDTableInitialWeight(t *testing.T) {
+
+	f := testkit.New(t)
+	changeScript := templates.GenerateChangeMaximumInitialWeightScript(f.Env)
+
+	table := testkit.EmulatorModel()
+
+	collection := f.NewModelNode(table, testkit.NodeID(1), testkit.RoleCollection, ufix64.MustParse("300000.0"))
+	f.NewModelDelegator(table, collection.ID, ufix64.MustParse("50000.5"))
+	consensus := f.NewModelNode(table, testkit.NodeID(2), testkit.RoleConsensus, ufix64.MustParse("600000.0"))
+	access := f.NewModelNode(table, testkit.NodeID(3), testkit.RoleAccess, ufix64.MustParse("0.5"))
+	rejected := f.NewModelNode(table, testkit.NodeID(4), testkit.RoleCollection, ufix64.MustParse("250000.0"))
+
+	nodeIDs := []string{collection.ID, consensus.ID, access.ID, rejected.ID}
+
+	// The rejected node is never approved, so it is refunded at the end of the first epoch
+	approved := []string{collection.ID, consensus.ID, access.ID}
+
+	readWeight := func(t *testing.T, nodeID string) uint64 {
+		weight, err := model.DecodeInitialWeight(f.ExecuteScript(templates.GenerateGetInitialWeightScript(f.Env), cadence.NewString(nodeID)))
+		require.NoError(t, err)
+
+		return weight
+	}
+
+	readMaximum := func(t *testing.T) uint64 {
+		maximum, err := model.DecodeInitialWeight(f.ExecuteScript(templates.GenerateGetMaximumInitialWeightScript(f.Env)))
+		require.NoError(t, err)
+
+		return maximum
+	}
+
+	assertWeights := func(t *testing.T, expected map[string]uint64) {
+		for _, nodeID := range nodeIDs {
+			node, err := table.NodeInfo(nodeID)
+			require.NoError(t, err)
+
+			assert.Equal(t, expected[nodeID], node.InitialWeight, "model weight of node %s", nodeID)
+			assert.Equal(t, expected[nodeID], readWeight(t, nodeID), "weight of node %s", nodeID)
+		}
+	}
+
+	t.Run("Should weigh the approved nodes by the stake committed to them", func(t *testing.T) {
+		assertWeights(t, map[string]uint64{})
+
+		f.AdvanceModelEpoch(table, approved)
+
+		// The delegated tokens count towards the weight of the node, fractions
+		// of a token do not, but every approved node has some weight
+		assertWeights(t, map[string]uint64{
+			collection.ID: 350000,
+			consensus.ID:  600000,
+			access.ID:     1,
+		})
+	})
+
+	t.Run("Should not be able to set an invalid maximum weight", func(t *testing.T) {
+		f.Send(changeScript, f.StakingAdmin, true, cadence.NewUInt64(0))
+		f.Send(changeScript, collection.Owner, true, cadence.NewUInt64(400000))
+
+		assert.Equal(t, table.MaximumInitialWeight(), readMaximum(t))
+	})
+
+	t.Run("Should set the maximum weight", func(t *testing.T) {
+		result := f.Send(changeScript, f.StakingAdmin, false, cadence.NewUInt64(400000))
+		require.NoError(t, table.SetMaximumInitialWeight(400000))
+
+		eventType := testkit.EventType(f.StakingAdmin.Address, "FlowIDTableStaking", "NewMaximumInitialWeight")
+
+		var emitted bool
+		for _, event := range result.Events {
+			if event.Type == eventType {
+				emitted = true
+				assert.Equal(t, cadence.NewUInt64(400000), event.Value.Fields[0])
+			}
+		}
+		assert.True(t, emitted)
+
+		assert.Equal(t, uint64(400000), readMaximum(t))
+	})
+
+	t.Run("Should cap the weights at the end of the next staking auction", func(t *testing.T) {
+		f.Mint(collection.Owner.Address, ufix64.MustParse("100000.0"))
+		f.CommitNewTokens(collection, ufix64.MustParse("100000.0"))
+		require.NoError(t, table.StakeNewTokens(collection.ID, ufix64.MustParse("100000.0")))
+
+		f.AdvanceModelEpoch(table, approved)
+
+		assertWeights(t, map[string]uint64{
+			collection.ID: 400000,
+			consensus.ID:  400000,
+			access.ID:     1,
+		})
+
+		f.AssertSupplyInvariants()
+	})
+}
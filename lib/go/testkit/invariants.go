@@ -0,0 +1,76 @@
+package testkit
+
+import (
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/model"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// Accounts returns the addresses of all the accounts of the emulator,
+// in the order they were created.
+func (f *Fixture) Accounts() []flow.Address {
+	// Accounts can't be removed, so only the ones created since the last call are looked up
+	generator := flow.NewAddressGenerator(flow.Emulator).SetIndex(uint(len(f.accounts)))
+
+	for {
+		address := generator.NextAddress()
+
+		if _, err := f.Blockchain.GetAccount(address); err != nil {
+			break
+		}
+
+		f.accounts = append(f.accounts, address)
+	}
+
+	return append([]flow.Address(nil), f.accounts...)
+}
+
+// SupplyTotals returns the sums of the buckets of every role, the totals staked
+// the staking contract records and the FLOW held by the staking account
+// from the get_supply_totals.cdc script.
+//
+// The FLOW held by the staking account is the total supply minus the balances
+// of the vaults of all the other accounts of the emulator. Scripts can't read the vault
+// of FlowFees, so this only holds while transaction fees are disabled, like by default.
+func (f *Fixture) SupplyTotals() model.SupplyTotals {
+	f.t.Helper()
+
+	addresses := f.Accounts()
+
+	accounts := make([]cadence.Value, len(addresses))
+	for i, address := range addresses {
+		accounts[i] = cadence.NewAddress(address)
+	}
+
+	result := f.ExecuteScript(templates.GenerateGetSupplyTotalsScript(f.Env), cadence.NewArray(accounts))
+
+	totals, err := model.DecodeSupplyTotals(result)
+	require.NoError(f.t, err)
+
+	return totals
+}
+
+// AssertSupplyInvariants asserts that the buckets of all nodes and delegators add up
+// to the totals the staking contract records and, with the vault of the staking account,
+// to the FLOW it holds.
+func (f *Fixture) AssertSupplyInvariants() bool {
+	f.t.Helper()
+
+	ok := true
+
+	for _, violation := range model.CheckSupply(f.SupplyTotals()) {
+		ok = assert.Fail(f.t, "supply invariant does not hold", violation.String())
+	}
+
+	return ok
+}
+
+// CheckInvariantsAfterEachBlock makes the fixture assert the supply invariants
+// after every transaction it sends.
+func (f *Fixture) CheckInvariantsAfterEachBlock() {
+	f.checkInvariants = true
+}
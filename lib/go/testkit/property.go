@@ -0,0 +1,686 @@
+package testkit
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/flow-core-contracts/lib/go/bootstrap"
+	"github.com/onflow/flow-core-contracts/lib/go/model"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// ActionKind is a kind of staking action of a property-based test.
+type ActionKind int
+
+const (
+	ActionRegisterNode ActionKind = iota
+	ActionStakeNewTokens
+	ActionStakeUnstakedTokens
+	ActionStakeRewardedTokens
+	ActionRequestUnstaking
+	ActionWithdrawUnstakedTokens
+	ActionWithdrawRewardedTokens
+	ActionRegisterDelegator
+	ActionDelegateNewTokens
+	ActionDelegateUnstakedTokens
+	ActionDelegateRewardedTokens
+	ActionRequestDelegatorUnstaking
+	ActionWithdrawDelegatorUnstakedTokens
+	ActionWithdrawDelegatorRewardedTokens
+	ActionEndStakingAuction
+	ActionPayRewards
+	ActionMoveTokens
+)
+
+var actionNames = map[ActionKind]string{
+	ActionRegisterNode:                    "register node",
+	ActionStakeNewTokens:                  "stake new tokens",
+	ActionStakeUnstakedTokens:             "stake unstaked tokens",
+	ActionStakeRewardedTokens:             "stake rewarded tokens",
+	ActionRequestUnstaking:                "request unstaking",
+	ActionWithdrawUnstakedTokens:          "withdraw unstaked tokens",
+	ActionWithdrawRewardedTokens:          "withdraw rewarded tokens",
+	ActionRegisterDelegator:               "register delegator",
+	ActionDelegateNewTokens:               "delegate new tokens",
+	ActionDelegateUnstakedTokens:          "delegate unstaked tokens",
+	ActionDelegateRewardedTokens:          "delegate rewarded tokens",
+	ActionRequestDelegatorUnstaking:       "request delegator unstaking",
+	ActionWithdrawDelegatorUnstakedTokens: "withdraw delegator unstaked tokens",
+	ActionWithdrawDelegatorRewardedTokens: "withdraw delegator rewarded tokens",
+	ActionEndStakingAuction:               "end staking auction",
+	ActionPayRewards:                      "pay rewards",
+	ActionMoveTokens:                      "move tokens",
+}
+
+func (k ActionKind) String() string {
+	if name, ok := actionNames[k]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("ActionKind(%d)", int(k))
+}
+
+func (k ActionKind) isDelegatorAction() bool {
+	return k >= ActionRegisterDelegator && k <= ActionWithdrawDelegatorRewardedTokens
+}
+
+func (k ActionKind) isAdminAction() bool {
+	return k >= ActionEndStakingAuction
+}
+
+// Action is a transaction of a property-based test.
+//
+// Actions refer to nodes and delegators by their index, so that
+// a sequence can be replayed on a new fixture and shrunk.
+type Action struct {
+	Kind ActionKind
+
+	// Actor is the index of the node or delegator that sends the transaction.
+	// It is ignored for the actions of the staking admin.
+	Actor int
+
+	// Amount is the amount of FLOW of the action, if it has one.
+	// For ActionRegisterNode it is the amount committed.
+	Amount ufix64.UFix64
+
+	// Approved are the indexes of the nodes approved by ActionEndStakingAuction.
+	Approved []int
+}
+
+func (a Action) String() string {
+	switch {
+	case a.Kind == ActionEndStakingAuction:
+		return fmt.Sprintf("%s approving nodes %v", a.Kind, a.Approved)
+	case a.Kind.isAdminAction():
+		return a.Kind.String()
+	case a.Kind == ActionRegisterDelegator:
+		return fmt.Sprintf("delegator %d: %s", a.Actor, a.Kind)
+	case a.Kind.isDelegatorAction():
+		return fmt.Sprintf("delegator %d: %s %s", a.Actor, a.Kind, a.Amount)
+	default:
+		return fmt.Sprintf("node %d: %s %s", a.Actor, a.Kind, a.Amount)
+	}
+}
+
+// Sequence is a sequence of actions.
+type Sequence []Action
+
+func (s Sequence) String() string {
+	lines := make([]string, len(s))
+	for i, action := range s {
+		lines[i] = fmt.Sprintf("%3d. %s", i, action)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// PropertyConfig configures a property-based test of the staking contract.
+//
+// Node i has the role i%5+1 and delegator j delegates to node j%Nodes.
+// There has to be at least one node.
+type PropertyConfig struct {
+	// Seed is the seed of the first sequence. If it is zero, the current time is used.
+	Seed int64
+
+	Sequences  int
+	Actions    int
+	Nodes      int
+	Delegators int
+}
+
+// actorFunds is the FLOW minted to every node and delegator account.
+const actorFunds = 10000000 * ufix64.UFix64(100000000)
+
+// PropertyError is the first property that does not hold for a sequence.
+type PropertyError struct {
+	// Index is the index of the action after which the property does not hold.
+	Index  int
+	Action Action
+	Err    error
+}
+
+func (e *PropertyError) Error() string {
+	return fmt.Sprintf("action %d (%s): %s", e.Index, e.Action, e.Err)
+}
+
+func (e *PropertyError) Unwrap() error {
+	return e.Err
+}
+
+// CheckStakingProperties runs random sequences of staking actions on new fixtures
+// and checks after every action that:
+//
+//   - the transaction reverts if and only if the operation of the model fails
+//   - the buckets of every node and delegator are the ones of the model
+//   - the supply invariants of CheckSupply hold
+//
+// A failing sequence is shrunk and reported with its seed.
+func CheckStakingProperties(t *testing.T, config PropertyConfig) {
+	t.Helper()
+
+	seed := config.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	for i := 0; i < config.Sequences; i++ {
+		sequenceSeed := seed + int64(i)
+
+		t.Run(fmt.Sprintf("Seed %d", sequenceSeed), func(t *testing.T) {
+			sequence := GenerateSequence(rand.New(rand.NewSource(sequenceSeed)), config)
+
+			err := RunSequence(t, config, sequence)
+			if err == nil {
+				return
+			}
+
+			minimal := Shrink(sequence, func(candidate Sequence) error {
+				return RunSequence(t, config, candidate)
+			})
+
+			t.Fatalf("seed %d: %s\n\nminimal sequence of %d actions:\n%s\n\nerror: %s",
+				sequenceSeed, err, len(minimal), minimal, RunSequence(t, config, minimal))
+		})
+	}
+}
+
+// GenerateSequence returns a random sequence of actions.
+//
+// The amounts are chosen from the state of a model the actions are applied to,
+// so that most of the transactions succeed. Some exceed it on purpose,
+// so that the sequence also covers transactions that should revert.
+func GenerateSequence(r *rand.Rand, config PropertyConfig) Sequence {
+	s := newPropertyState(config)
+
+	sequence := make(Sequence, 0, config.Actions)
+
+	for len(sequence) < config.Actions {
+		var action Action
+
+		switch n := r.Intn(10); {
+		case n < 4:
+			action = s.randomNodeAction(r, r.Intn(config.Nodes))
+		case n < 8 && config.Delegators > 0:
+			action = s.randomDelegatorAction(r, r.Intn(config.Delegators))
+		default:
+			action = s.randomAdminAction(r)
+		}
+
+		// Errors are expected, the transaction then reverts and leaves the contract untouched
+		_ = s.apply(action)
+
+		sequence = append(sequence, action)
+	}
+
+	return sequence
+}
+
+// EmulatorModel returns a model of the FlowIDTableStaking contract deployed by New.
+func EmulatorModel() *model.IDTable {
+	conf := bootstrap.EmulatorConfig()
+
+	return model.NewIDTable(ufix64.UFix64(conf.EpochTokenPayout), ufix64.UFix64(conf.RewardCut))
+}
+
+// RunSequence runs the actions on a new fixture and returns a *PropertyError
+// for the first action after which a property does not hold.
+// The test only fails if the fixture can't be created or a script fails.
+func RunSequence(t testing.TB, config PropertyConfig, sequence Sequence) error {
+	t.Helper()
+
+	f := New(t)
+
+	s := newPropertyState(config)
+	s.fixture = f
+
+	for i := 0; i < config.Nodes; i++ {
+		s.nodes = append(s.nodes, f.NewFundedAccount(actorFunds))
+	}
+
+	for i := 0; i < config.Delegators; i++ {
+		s.delegators = append(s.delegators, f.NewFundedAccount(actorFunds))
+	}
+
+	for i, action := range sequence {
+		if err := s.run(action); err != nil {
+			return &PropertyError{Index: i, Action: action, Err: err}
+		}
+	}
+
+	return nil
+}
+
+// Shrink returns the shortest subsequence it finds for which fails still returns an error,
+// with amounts rounded down to whole FLOW where that keeps it failing.
+func Shrink(sequence Sequence, fails func(Sequence) error) Sequence {
+	err := fails(sequence)
+	if err == nil {
+		return sequence
+	}
+
+	// The actions after the failing one are not needed to reproduce it
+	var propertyErr *PropertyError
+	if errors.As(err, &propertyErr) && propertyErr.Index < len(sequence) {
+		sequence = sequence[:propertyErr.Index+1]
+	}
+
+	for chunk := len(sequence) / 2; chunk >= 1; chunk /= 2 {
+		for start := 0; start+chunk <= len(sequence); {
+			candidate := make(Sequence, 0, len(sequence)-chunk)
+			candidate = append(candidate, sequence[:start]...)
+			candidate = append(candidate, sequence[start+chunk:]...)
+
+			if fails(candidate) != nil {
+				sequence = candidate
+			} else {
+				start += chunk
+			}
+		}
+	}
+
+	for i, action := range sequence {
+		rounded := action.Amount - action.Amount%100000000
+		if rounded == action.Amount {
+			continue
+		}
+
+		candidate := append(Sequence{}, sequence...)
+		candidate[i].Amount = rounded
+
+		if fails(candidate) != nil {
+			sequence = candidate
+		}
+	}
+
+	return sequence
+}
+
+// propertyState is the state of a sequence, which is either only applied
+// to the model while it is generated or also run on a fixture.
+type propertyState struct {
+	config PropertyConfig
+	table  *model.IDTable
+
+	// registeredNodes are the indexes of the nodes that store a NodeStaker.
+	registeredNodes map[int]bool
+
+	// delegatorIDs are the IDs of the delegators that store a NodeDelegator, by their index.
+	delegatorIDs map[int]uint32
+
+	fixture    *Fixture
+	nodes      []Account
+	delegators []Account
+}
+
+var (
+	errAlreadyRegistered = errors.New("the account already stores a NodeStaker or NodeDelegator")
+	errNotRegistered     = errors.New("the account does not store a NodeStaker or NodeDelegator")
+)
+
+func newPropertyState(config PropertyConfig) *propertyState {
+	return &propertyState{
+		config:          config,
+		table:           EmulatorModel(),
+		registeredNodes: make(map[int]bool),
+		delegatorIDs:    make(map[int]uint32),
+	}
+}
+
+func (s *propertyState) nodeID(node int) string {
+	return NodeID(node + 1)
+}
+
+func (s *propertyState) nodeRole(node int) uint8 {
+	return uint8(node%5) + RoleCollection
+}
+
+func (s *propertyState) delegatorNodeID(delegator int) string {
+	return s.nodeID(delegator % s.config.Nodes)
+}
+
+func (s *propertyState) randomNodeAction(r *rand.Rand, node int) Action {
+	nodeID := s.nodeID(node)
+
+	info, err := s.table.NodeInfo(nodeID)
+	if !s.registeredNodes[node] || err != nil {
+		// Some nodes start below the minimum, so that they get refunded
+		minimum := s.table.MinimumStakeRequirements()[s.nodeRole(node)]
+		if minimum == 0 {
+			minimum = 10000000000
+		}
+
+		return Action{Kind: ActionRegisterNode, Actor: node, Amount: randomAmount(r, minimum+minimum/2)}
+	}
+
+	action := Action{Actor: node}
+
+	switch r.Intn(6) {
+	case 0:
+		action.Kind = ActionStakeNewTokens
+		action.Amount = randomAmount(r, 5000000000000)
+	case 1:
+		action.Kind = ActionStakeUnstakedTokens
+		action.Amount = randomAmount(r, info.TokensUnstaked+info.TokensRequestedToUnstake+1)
+	case 2:
+		action.Kind = ActionStakeRewardedTokens
+		action.Amount = randomAmount(r, info.TokensRewarded+1)
+	case 3:
+		action.Kind = ActionRequestUnstaking
+		action.Amount = randomAmount(r, info.TokensCommitted+info.TokensStaked+1)
+	case 4:
+		action.Kind = ActionWithdrawUnstakedTokens
+		action.Amount = randomAmount(r, info.TokensUnstaked+1)
+	default:
+		action.Kind = ActionWithdrawRewardedTokens
+		action.Amount = randomAmount(r, info.TokensRewarded+1)
+	}
+
+	return action
+}
+
+func (s *propertyState) randomDelegatorAction(r *rand.Rand, delegator int) Action {
+	delegatorID, registered := s.delegatorIDs[delegator]
+	if !registered {
+		return Action{Kind: ActionRegisterDelegator, Actor: delegator}
+	}
+
+	info, _ := s.table.DelegatorInfo(s.delegatorNodeID(delegator), delegatorID)
+
+	action := Action{Actor: delegator}
+
+	switch r.Intn(6) {
+	case 0:
+		action.Kind = ActionDelegateNewTokens
+		action.Amount = randomAmount(r, 5000000000000)
+	case 1:
+		action.Kind = ActionDelegateUnstakedTokens
+		action.Amount = randomAmount(r, info.TokensUnstaked+info.TokensRequestedToUnstake+1)
+	case 2:
+		action.Kind = ActionDelegateRewardedTokens
+		action.Amount = randomAmount(r, info.TokensRewarded+1)
+	case 3:
+		action.Kind = ActionRequestDelegatorUnstaking
+		action.Amount = randomAmount(r, info.TokensCommitted+info.TokensStaked+1)
+	case 4:
+		action.Kind = ActionWithdrawDelegatorUnstakedTokens
+		action.Amount = randomAmount(r, info.TokensUnstaked+1)
+	default:
+		action.Kind = ActionWithdrawDelegatorRewardedTokens
+		action.Amount = randomAmount(r, info.TokensRewarded+1)
+	}
+
+	return action
+}
+
+func (s *propertyState) randomAdminAction(r *rand.Rand) Action {
+	switch r.Intn(3) {
+	case 0:
+		action := Action{Kind: ActionEndStakingAuction}
+
+		// Most of the time nodes are approved, so that they can actually stake
+		for node := 0; node < s.config.Nodes; node++ {
+			if r.Intn(5) > 0 {
+				action.Approved = append(action.Approved, node)
+			}
+		}
+
+		return action
+	case 1:
+		return Action{Kind: ActionPayRewards}
+	default:
+		return Action{Kind: ActionMoveTokens}
+	}
+}
+
+// apply applies an action to the model.
+//
+// The transactions of an actor revert whenever its account does not store the resource
+// they borrow. This happens when shrinking removes the registration of a node or delegator.
+func (s *propertyState) apply(action Action) error {
+	amount := action.Amount
+
+	_, delegatorRegistered := s.delegatorIDs[action.Actor]
+
+	switch {
+	case action.Kind == ActionRegisterNode && s.registeredNodes[action.Actor],
+		action.Kind == ActionRegisterDelegator && delegatorRegistered:
+		return errAlreadyRegistered
+	case action.Kind.isAdminAction(),
+		action.Kind == ActionRegisterNode,
+		action.Kind == ActionRegisterDelegator:
+	case action.Kind.isDelegatorAction() && !delegatorRegistered,
+		!action.Kind.isDelegatorAction() && !s.registeredNodes[action.Actor]:
+		return errNotRegistered
+	}
+
+	if !action.Kind.isDelegatorAction() {
+		nodeID := s.nodeID(action.Actor)
+
+		switch action.Kind {
+		case ActionRegisterNode:
+			if err := s.table.AddNodeRecord(nodeID, s.nodeRole(action.Actor), amount); err != nil {
+				return err
+			}
+
+			s.registeredNodes[action.Actor] = true
+
+			return nil
+		case ActionStakeNewTokens:
+			return s.table.StakeNewTokens(nodeID, amount)
+		case ActionStakeUnstakedTokens:
+			return s.table.StakeUnstakedTokens(nodeID, amount)
+		case ActionStakeRewardedTokens:
+			return s.table.StakeRewardedTokens(nodeID, amount)
+		case ActionRequestUnstaking:
+			return s.table.RequestUnstaking(nodeID, amount)
+		case ActionWithdrawUnstakedTokens:
+			return s.table.WithdrawUnstakedTokens(nodeID, amount)
+		case ActionWithdrawRewardedTokens:
+			return s.table.WithdrawRewardedTokens(nodeID, amount)
+		case ActionEndStakingAuction:
+			return s.table.EndStakingAuction(s.approvedNodeIDs(action))
+		case ActionPayRewards:
+			return s.table.PayRewards()
+		default:
+			return s.table.MoveTokens()
+		}
+	}
+
+	nodeID := s.delegatorNodeID(action.Actor)
+
+	if action.Kind == ActionRegisterDelegator {
+		delegatorID, err := s.table.RegisterNewDelegator(nodeID)
+		if err != nil {
+			return err
+		}
+
+		s.delegatorIDs[action.Actor] = delegatorID
+
+		return nil
+	}
+
+	delegatorID := s.delegatorIDs[action.Actor]
+
+	switch action.Kind {
+	case ActionDelegateNewTokens:
+		return s.table.DelegateNewTokens(nodeID, delegatorID, amount)
+	case ActionDelegateUnstakedTokens:
+		return s.table.DelegateUnstakedTokens(nodeID, delegatorID, amount)
+	case ActionDelegateRewardedTokens:
+		return s.table.DelegateRewardedTokens(nodeID, delegatorID, amount)
+	case ActionRequestDelegatorUnstaking:
+		return s.table.RequestDelegatorUnstaking(nodeID, delegatorID, amount)
+	case ActionWithdrawDelegatorUnstakedTokens:
+		return s.table.WithdrawDelegatorUnstakedTokens(nodeID, delegatorID, amount)
+	default:
+		return s.table.WithdrawDelegatorRewardedTokens(nodeID, delegatorID, amount)
+	}
+}
+
+func (s *propertyState) approvedNodeIDs(action Action) map[string]bool {
+	approved := make(map[string]bool, len(action.Approved))
+	for _, node := range action.Approved {
+		approved[s.nodeID(node)] = true
+	}
+
+	return approved
+}
+
+// transaction returns the script, authorizer and arguments of the transaction of an action.
+func (s *propertyState) transaction(action Action) ([]byte, Account, []cadence.Value) {
+	env := s.fixture.Env
+	amount := []cadence.Value{cadence.UFix64(action.Amount)}
+
+	switch action.Kind {
+	case ActionRegisterNode:
+		return templates.GenerateRegisterNodeScript(env), s.nodes[action.Actor],
+			registerNodeArguments(s.nodeID(action.Actor), s.nodeRole(action.Actor), action.Amount)
+	case ActionStakeNewTokens:
+		return templates.GenerateStakeNewTokensScript(env), s.nodes[action.Actor], amount
+	case ActionStakeUnstakedTokens:
+		return templates.GenerateStakeUnstakedTokensScript(env), s.nodes[action.Actor], amount
+	case ActionStakeRewardedTokens:
+		return templates.GenerateStakeRewardedTokensScript(env), s.nodes[action.Actor], amount
+	case ActionRequestUnstaking:
+		return templates.GenerateUnstakeTokensScript(env), s.nodes[action.Actor], amount
+	case ActionWithdrawUnstakedTokens:
+		return templates.GenerateWithdrawUnstakedTokensScript(env), s.nodes[action.Actor], amount
+	case ActionWithdrawRewardedTokens:
+		return templates.GenerateWithdrawRewardedTokensScript(env), s.nodes[action.Actor], amount
+	case ActionRegisterDelegator:
+		return templates.GenerateRegisterDelegatorScript(env), s.delegators[action.Actor],
+			[]cadence.Value{cadence.NewString(s.delegatorNodeID(action.Actor))}
+	case ActionDelegateNewTokens:
+		return templates.GenerateDelegatorStakeNewScript(env), s.delegators[action.Actor], amount
+	case ActionDelegateUnstakedTokens:
+		return templates.GenerateDelegatorStakeUnstakedScript(env), s.delegators[action.Actor], amount
+	case ActionDelegateRewardedTokens:
+		return templates.GenerateDelegatorStakeRewardedScript(env), s.delegators[action.Actor], amount
+	case ActionRequestDelegatorUnstaking:
+		return templates.GenerateDelegatorRequestUnstakeScript(env), s.delegators[action.Actor], amount
+	case ActionWithdrawDelegatorUnstakedTokens:
+		return templates.GenerateDelegatorWithdrawUnstakedScript(env), s.delegators[action.Actor], amount
+	case ActionWithdrawDelegatorRewardedTokens:
+		return templates.GenerateDelegatorWithdrawRewardsScript(env), s.delegators[action.Actor], amount
+	case ActionEndStakingAuction:
+		ids := make([]cadence.Value, len(action.Approved))
+		for i, node := range action.Approved {
+			ids[i] = cadence.NewString(s.nodeID(node))
+		}
+
+		return templates.GenerateEndStakingScript(env), s.fixture.StakingAdmin, []cadence.Value{cadence.NewArray(ids)}
+	case ActionPayRewards:
+		return templates.GeneratePayRewardsScript(env), s.fixture.StakingAdmin, nil
+	default:
+		return templates.GenerateMoveTokensScript(env), s.fixture.StakingAdmin, nil
+	}
+}
+
+// run applies an action to the model, sends its transaction and checks the properties.
+func (s *propertyState) run(action Action) error {
+	if action.Actor < 0 ||
+		(action.Kind.isDelegatorAction() && action.Actor >= s.config.Delegators) ||
+		(!action.Kind.isDelegatorAction() && !action.Kind.isAdminAction() && action.Actor >= s.config.Nodes) {
+		return fmt.Errorf("actor %d does not exist", action.Actor)
+	}
+
+	modelErr := s.apply(action)
+
+	f := s.fixture
+	script, authorizer, arguments := s.transaction(action)
+
+	tx, err := f.newSignedTransaction(script, authorizer, arguments)
+	if err != nil {
+		return err
+	}
+
+	if err := f.Blockchain.AddTransaction(*tx); err != nil {
+		return err
+	}
+
+	result, err := f.Blockchain.ExecuteNextTransaction()
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.Blockchain.CommitBlock(); err != nil {
+		return err
+	}
+
+	if modelErr != nil && !result.Reverted() {
+		return fmt.Errorf("expected the transaction to revert with: %s", modelErr)
+	}
+
+	if modelErr == nil && result.Reverted() {
+		return fmt.Errorf("unexpected revert: %s", result.Error)
+	}
+
+	return s.check()
+}
+
+// check compares the buckets of all nodes and delegators with the model and checks the supply invariants.
+func (s *propertyState) check() error {
+	f := s.fixture
+
+	for node := 0; node < s.config.Nodes; node++ {
+		expected, err := s.table.NodeInfo(s.nodeID(node))
+		if err != nil {
+			continue
+		}
+
+		actual := f.NodeInfo(s.nodeID(node))
+
+		// The networking fields are not part of the model
+		actual.NetworkingAddress = ""
+		actual.NetworkingKey = ""
+		actual.StakingKey = ""
+
+		if !assert.ObjectsAreEqual(expected, actual) {
+			return fmt.Errorf("node %d is %+v, but the model expects %+v", node, actual, expected)
+		}
+	}
+
+	for delegator, delegatorID := range s.delegatorIDs {
+		nodeID := s.delegatorNodeID(delegator)
+
+		expected, err := s.table.DelegatorInfo(nodeID, delegatorID)
+		if err != nil {
+			return err
+		}
+
+		actual := f.DelegatorInfo(nodeID, delegatorID)
+
+		if !assert.ObjectsAreEqual(expected, actual) {
+			return fmt.Errorf("delegator %d is %+v, but the model expects %+v", delegator, actual, expected)
+		}
+	}
+
+	violations := model.CheckSupply(f.SupplyTotals())
+	if len(violations) > 0 {
+		messages := make([]string, len(violations))
+		for i, violation := range violations {
+			messages[i] = violation.String()
+		}
+
+		return fmt.Errorf("supply invariants do not hold: %s", strings.Join(messages, "; "))
+	}
+
+	return nil
+}
+
+// randomAmount returns an amount below max, which is occasionally exceeded.
+func randomAmount(r *rand.Rand, max ufix64.UFix64) ufix64.UFix64 {
+	if max == 0 || r.Intn(10) == 0 {
+		return ufix64.UFix64(r.Int63n(10000000000))
+	}
+
+	return ufix64.UFix64(r.Int63n(int64(max)))
+}
@@ -0,0 +1,141 @@
+package testkit_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/model"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/testkit"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// rewardRatiosArgument returns the ratios argument of the change_reward_ratios transaction,
+// ordered by node role.
+func rewardRatiosArgument(ratios ...string) cadence.Value {
+	values := make([]cadence.Value, len(ratios))
+	for i, ratio := range ratios {
+		values[i] = cadence.UFix64(ufix64.MustParse(ratio))
+	}
+
+	return cadence.NewArray(values)
+}
+
+func TestIDTableRewardRatios(t *testing.T) {
+
+	f := testkit.New(t)
+	changeScript := templates.GenerateChangeRewardRatiosScript(f.Env)
+
+	table := testkit.EmulatorModel()
+
+	collection := f.NewModelNode(table, testkit.NodeID(1), testkit.RoleCollection, ufix64.MustParse("500000.0"))
+	delegator := f.NewModelDelegator(table, collection.ID, ufix64.MustParse("100000.0"))
+	consensus := f.NewModelNode(table, testkit.NodeID(2), testkit.RoleConsensus, ufix64.MustParse("1000000.0"))
+	access := f.NewModelNode(table, testkit.NodeID(3), testkit.RoleAccess, ufix64.MustParse("100.0"))
+
+	approved := []string{collection.ID, consensus.ID, access.ID}
+
+	// The first epoch only stakes the committed tokens, nothing is paid yet
+	f.AdvanceModelEpoch(table, approved)
+
+	readRatios := func(t *testing.T) map[uint8]ufix64.UFix64 {
+		ratios, err := model.DecodeRoleAmounts(f.ExecuteScript(templates.GenerateGetRewardRatiosScript(f.Env)))
+		require.NoError(t, err)
+
+		return ratios
+	}
+
+	t.Run("Should start with the default reward ratios", func(t *testing.T) {
+		assert.Equal(t, table.RewardRatios(), readRatios(t))
+	})
+
+	t.Run("Should not be able to set invalid reward ratios", func(t *testing.T) {
+		// The ratios don't add up to 1
+		f.Send(changeScript, f.StakingAdmin, true, rewardRatiosArgument("0.25", "0.25", "0.2", "0.2", "0.2"))
+		// A node type is missing
+		f.Send(changeScript, f.StakingAdmin, true, rewardRatiosArgument("0.25", "0.25", "0.25", "0.25"))
+
+		assert.Equal(t, table.RewardRatios(), readRatios(t))
+	})
+
+	t.Run("Should not be able to set the reward ratios without the admin resource", func(t *testing.T) {
+		f.Send(changeScript, collection.Owner, true, rewardRatiosArgument("0.25", "0.25", "0.2", "0.2", "0.1"))
+	})
+
+	t.Run("Should set the reward ratios", func(t *testing.T) {
+		result := f.Send(changeScript, f.StakingAdmin, false, rewardRatiosArgument("0.25", "0.25", "0.2", "0.2", "0.1"))
+
+		require.NoError(t, table.SetRewardRatios(map[uint8]ufix64.UFix64{
+			testkit.RoleCollection:   ufix64.MustParse("0.25"),
+			testkit.RoleConsensus:    ufix64.MustParse("0.25"),
+			testkit.RoleExecution:    ufix64.MustParse("0.2"),
+			testkit.RoleVerification: ufix64.MustParse("0.2"),
+			testkit.RoleAccess:       ufix64.MustParse("0.1"),
+		}))
+
+		eventType := testkit.EventType(f.StakingAdmin.Address, "FlowIDTableStaking", "NewRewardRatios")
+
+		var emitted bool
+		for _, event := range result.Events {
+			if event.Type != eventType {
+				continue
+			}
+
+			emitted = true
+
+			ratios, err := model.DecodeRoleAmounts(event.Value.Fields[0])
+			require.NoError(t, err)
+			assert.Equal(t, table.RewardRatios(), ratios)
+		}
+		assert.True(t, emitted)
+
+		assert.Equal(t, table.RewardRatios(), readRatios(t))
+	})
+
+	t.Run("Should pay each node type its share of the payout", func(t *testing.T) {
+		result := f.AdvanceModelEpoch(table, approved)
+
+		for _, nodeID := range approved {
+			expected, err := table.NodeInfo(nodeID)
+			require.NoError(t, err)
+
+			assert.Equal(t, expected.TokensRewarded, f.NodeInfo(nodeID).TokensRewarded)
+		}
+
+		expected, err := table.DelegatorInfo(collection.ID, delegator.ID)
+		require.NoError(t, err)
+		assert.Equal(t, expected.TokensRewarded, f.DelegatorInfo(collection.ID, delegator.ID).TokensRewarded)
+
+		// The collection node and its delegator share the same payout as the
+		// consensus node, which has more stake, and the single access node gets
+		// the whole access payout
+		assert.Equal(t, ufix64.MustParse("312500.0"), f.NodeInfo(consensus.ID).TokensRewarded)
+		assert.Equal(t, ufix64.MustParse("125000.0"), f.NodeInfo(access.ID).TokensRewarded)
+
+		// Nothing was rewarded before, so the rewarded tokens are the rewards of this epoch.
+		// They are less than the payout, since the execution and verification nodes
+		// have no staked tokens and their ratios are not minted
+		var paid ufix64.UFix64
+		for _, nodeID := range approved {
+			paid += f.NodeInfo(nodeID).TokensRewarded
+		}
+		paid += f.DelegatorInfo(collection.ID, delegator.ID).TokensRewarded
+
+		eventType := testkit.EventType(f.StakingAdmin.Address, "FlowIDTableStaking", "TotalRewardsPaid")
+
+		var emitted bool
+		for _, event := range result.Events {
+			if event.Type == eventType {
+				emitted = true
+				assert.Equal(t, cadence.UFix64(paid), event.Value.Fields[0])
+			}
+		}
+		assert.True(t, emitted)
+		assert.True(t, paid < table.EpochTokenPayout())
+
+		f.AssertSupplyInvariants()
+	})
+}
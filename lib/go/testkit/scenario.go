@@ -0,0 +1,561 @@
+package testkit
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// Scenario is a staking test described by a YAML or JSON file,
+// so that test cases can be written without Go.
+// The files in the scenarios directory are examples.
+type Scenario struct {
+	Name string `yaml:"name"`
+
+	// Accounts are the accounts of the scenario by name, with the FLOW minted to them.
+	// The staking admin is always available as "admin".
+	Accounts map[string]ScenarioAccount `yaml:"accounts"`
+
+	Steps []ScenarioStep `yaml:"steps"`
+}
+
+// ScenarioAccount is an account of a scenario.
+type ScenarioAccount struct {
+	Balance string `yaml:"balance"`
+}
+
+// ScenarioStep is a step of a scenario. It does exactly one of:
+//
+//   - registerNode: registers a node, with a node ID and keys generated for it
+//   - registerDelegator: registers a delegator and delegates new tokens to the node
+//   - transaction: sends a template, by its path in the transactions directory,
+//     signed by an account with JSON-Cadence arguments
+//   - epoch: ends the staking auction, pays rewards and moves tokens
+//
+// and then checks the expected balances.
+type ScenarioStep struct {
+	Name string `yaml:"name"`
+
+	RegisterNode      *ScenarioNode      `yaml:"registerNode"`
+	RegisterDelegator *ScenarioDelegator `yaml:"registerDelegator"`
+
+	Transaction string `yaml:"transaction"`
+	Signer      string `yaml:"signer"`
+
+	// Arguments are JSON-Cadence values, e.g. {type: UFix64, value: "10.0"}.
+	// {type: Address, account: josh} is the address of an account
+	// and {type: String, node: joshNode} is the ID of a node.
+	Arguments []interface{} `yaml:"arguments"`
+
+	Epoch *ScenarioEpoch `yaml:"epoch"`
+
+	// Revert is whether the transaction of the step is expected to revert.
+	Revert bool `yaml:"revert"`
+
+	// Expect are the expected buckets of nodes and delegators by their name.
+	// Buckets that are not listed are not checked.
+	Expect map[string]ScenarioBuckets `yaml:"expect"`
+
+	// Balances are the expected FLOW balances of accounts by their name.
+	Balances map[string]string `yaml:"balances"`
+}
+
+// ScenarioNode registers a node.
+type ScenarioNode struct {
+	// Name is the name the node is referred to with in the scenario.
+	Name    string `yaml:"name"`
+	Account string `yaml:"account"`
+	Role    uint8  `yaml:"role"`
+	Amount  string `yaml:"amount"`
+}
+
+// ScenarioDelegator registers a delegator.
+type ScenarioDelegator struct {
+	// Name is the name the delegator is referred to with in the scenario.
+	Name    string `yaml:"name"`
+	Account string `yaml:"account"`
+	Node    string `yaml:"node"`
+
+	// Amount is delegated right after the delegator is registered, unless it is empty.
+	Amount string `yaml:"amount"`
+}
+
+// ScenarioEpoch advances to the next epoch.
+type ScenarioEpoch struct {
+	// Approve are the names of the approved nodes. If it is omitted, all proposed nodes are approved.
+	Approve []string `yaml:"approve"`
+}
+
+// ScenarioBuckets are the expected buckets of a node or delegator,
+// named like the fields of NodeInfo and DelegatorInfo.
+type ScenarioBuckets struct {
+	TokensCommitted          *string `yaml:"tokensCommitted"`
+	TokensStaked             *string `yaml:"tokensStaked"`
+	TokensRequestedToUnstake *string `yaml:"tokensRequestedToUnstake"`
+	TokensUnstaking          *string `yaml:"tokensUnstaking"`
+	TokensUnstaked           *string `yaml:"tokensUnstaked"`
+	TokensRewarded           *string `yaml:"tokensRewarded"`
+}
+
+// LoadScenario reads a scenario from a YAML or JSON file.
+// Unknown fields are errors, so that typos don't silently skip checks.
+func LoadScenario(path string) (Scenario, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return Scenario{}, err
+	}
+	defer file.Close()
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+
+	var scenario Scenario
+	if err := decoder.Decode(&scenario); err != nil {
+		return Scenario{}, fmt.Errorf("could not read scenario %s: %w", path, err)
+	}
+
+	if scenario.Name == "" {
+		scenario.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	return scenario, nil
+}
+
+// RunScenarios runs every .yaml, .yml and .json scenario in the directory as a subtest.
+func RunScenarios(t *testing.T, dir string) {
+	t.Helper()
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range files {
+		switch filepath.Ext(file.Name()) {
+		case ".yaml", ".yml", ".json":
+		default:
+			continue
+		}
+
+		path := filepath.Join(dir, file.Name())
+
+		t.Run(file.Name(), func(t *testing.T) {
+			scenario, err := LoadScenario(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			RunScenario(t, scenario)
+		})
+	}
+}
+
+// RunScenario runs a scenario on a new fixture, which checks the supply invariants after every block.
+// Every step is a subtest and the scenario stops at the first step that fails.
+func RunScenario(t *testing.T, scenario Scenario) {
+	t.Helper()
+
+	r := &scenarioRunner{
+		f:          New(t),
+		accounts:   make(map[string]Account),
+		nodes:      make(map[string]string),
+		delegators: make(map[string]Delegator),
+	}
+
+	r.accounts["admin"] = r.f.StakingAdmin
+	r.f.CheckInvariantsAfterEachBlock()
+
+	names := make([]string, 0, len(scenario.Accounts))
+	for name := range scenario.Accounts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if _, exists := r.accounts[name]; exists {
+			t.Fatalf("account %s: the name is reserved", name)
+		}
+
+		balance, err := parseAmount(scenario.Accounts[name].Balance)
+		if err != nil {
+			t.Fatalf("account %s: %s", name, err)
+		}
+
+		r.accounts[name] = r.f.NewFundedAccount(balance)
+	}
+
+	for i, step := range scenario.Steps {
+		name := fmt.Sprintf("%d %s", i+1, step.description())
+
+		ok := t.Run(name, func(t *testing.T) {
+			// The helpers of the fixture report failures of the step to its subtest
+			parent := r.f.t
+			r.f.t = t
+			defer func() { r.f.t = parent }()
+
+			if err := r.run(step); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		if !ok {
+			t.Fatalf("step %s failed", name)
+		}
+	}
+}
+
+// description returns the name of a step, or describes what it does.
+func (step ScenarioStep) description() string {
+	switch {
+	case step.Name != "":
+		return step.Name
+	case step.RegisterNode != nil:
+		return fmt.Sprintf("register node %s", step.RegisterNode.Name)
+	case step.RegisterDelegator != nil:
+		return fmt.Sprintf("register delegator %s", step.RegisterDelegator.Name)
+	case step.Transaction != "":
+		return fmt.Sprintf("%s sends %s", step.Signer, step.Transaction)
+	case step.Epoch != nil:
+		return "advance epoch"
+	default:
+		return "check"
+	}
+}
+
+type scenarioRunner struct {
+	f *Fixture
+
+	accounts   map[string]Account
+	nodes      map[string]string
+	delegators map[string]Delegator
+}
+
+func (r *scenarioRunner) run(step ScenarioStep) error {
+	actions := 0
+	for _, set := range []bool{step.RegisterNode != nil, step.RegisterDelegator != nil, step.Transaction != "", step.Epoch != nil} {
+		if set {
+			actions++
+		}
+	}
+
+	if actions > 1 {
+		return errors.New("a step can only do one of registerNode, registerDelegator, transaction and epoch")
+	}
+
+	var err error
+
+	switch {
+	case step.RegisterNode != nil:
+		err = r.registerNode(*step.RegisterNode, step.Revert)
+	case step.RegisterDelegator != nil:
+		err = r.registerDelegator(*step.RegisterDelegator, step.Revert)
+	case step.Transaction != "":
+		err = r.sendTransaction(step)
+	case step.Epoch != nil:
+		err = r.advanceEpoch(*step.Epoch)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return r.check(step)
+}
+
+func (r *scenarioRunner) account(name string) (Account, error) {
+	account, ok := r.accounts[name]
+	if !ok {
+		return Account{}, fmt.Errorf("unknown account %q", name)
+	}
+
+	return account, nil
+}
+
+func (r *scenarioRunner) node(name string) (string, error) {
+	nodeID, ok := r.nodes[name]
+	if !ok {
+		return "", fmt.Errorf("unknown node %q", name)
+	}
+
+	return nodeID, nil
+}
+
+func (r *scenarioRunner) registerNode(node ScenarioNode, revert bool) error {
+	if _, exists := r.nodes[node.Name]; exists || node.Name == "" {
+		return fmt.Errorf("node name %q is empty or already used", node.Name)
+	}
+
+	owner, err := r.account(node.Account)
+	if err != nil {
+		return err
+	}
+
+	amount, err := parseAmount(node.Amount)
+	if err != nil {
+		return err
+	}
+
+	nodeID := NodeID(len(r.nodes) + 1)
+
+	r.f.Send(templates.GenerateRegisterNodeScript(r.f.Env), owner, revert, registerNodeArguments(nodeID, node.Role, amount)...)
+
+	if !revert {
+		r.nodes[node.Name] = nodeID
+	}
+
+	return nil
+}
+
+func (r *scenarioRunner) registerDelegator(delegator ScenarioDelegator, revert bool) error {
+	if _, exists := r.delegators[delegator.Name]; exists || delegator.Name == "" {
+		return fmt.Errorf("delegator name %q is empty or already used", delegator.Name)
+	}
+
+	owner, err := r.account(delegator.Account)
+	if err != nil {
+		return err
+	}
+
+	nodeID, ok := r.nodes[delegator.Node]
+	if !ok {
+		// Delegating to a node that doesn't exist is expected to revert
+		nodeID = delegator.Node
+	}
+
+	if revert {
+		r.f.Send(templates.GenerateRegisterDelegatorScript(r.f.Env), owner, true, cadence.NewString(nodeID))
+		return nil
+	}
+
+	registered := r.f.RegisterDelegator(owner, nodeID)
+	r.delegators[delegator.Name] = registered
+
+	if delegator.Amount != "" {
+		amount, err := parseAmount(delegator.Amount)
+		if err != nil {
+			return err
+		}
+
+		r.f.DelegateNewTokens(registered, amount)
+	}
+
+	return nil
+}
+
+func (r *scenarioRunner) sendTransaction(step ScenarioStep) error {
+	script, err := templates.Get(step.Transaction, r.f.Env)
+	if err != nil {
+		return err
+	}
+
+	signer, err := r.account(step.Signer)
+	if err != nil {
+		return err
+	}
+
+	arguments := make([]cadence.Value, len(step.Arguments))
+	for i, argument := range step.Arguments {
+		arguments[i], err = r.argument(argument)
+		if err != nil {
+			return fmt.Errorf("argument %d: %w", i+1, err)
+		}
+	}
+
+	r.f.Send(script, signer, step.Revert, arguments...)
+
+	return nil
+}
+
+func (r *scenarioRunner) advanceEpoch(epoch ScenarioEpoch) error {
+	if epoch.Approve == nil {
+		r.f.AdvanceEpoch()
+		return nil
+	}
+
+	nodeIDs := make([]string, len(epoch.Approve))
+	for i, name := range epoch.Approve {
+		nodeID, err := r.node(name)
+		if err != nil {
+			return err
+		}
+
+		nodeIDs[i] = nodeID
+	}
+
+	r.f.AdvanceEpochWithApprovedNodes(nodeIDs)
+
+	return nil
+}
+
+// check compares the buckets and balances with the expected ones.
+func (r *scenarioRunner) check(step ScenarioStep) error {
+	names := make([]string, 0, len(step.Expect))
+	for name := range step.Expect {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		var actual Buckets
+
+		if nodeID, ok := r.nodes[name]; ok {
+			actual = r.f.NodeBuckets(nodeID)
+		} else if delegator, ok := r.delegators[name]; ok {
+			actual = r.f.DelegatorBuckets(delegator.NodeID, delegator.ID)
+		} else {
+			return fmt.Errorf("unknown node or delegator %q", name)
+		}
+
+		expected := step.Expect[name]
+
+		for _, bucket := range []struct {
+			name     string
+			expected *string
+			actual   ufix64.UFix64
+		}{
+			{"tokensCommitted", expected.TokensCommitted, actual.Committed},
+			{"tokensStaked", expected.TokensStaked, actual.Staked},
+			{"tokensRequestedToUnstake", expected.TokensRequestedToUnstake, actual.RequestedToUnstake},
+			{"tokensUnstaking", expected.TokensUnstaking, actual.Unstaking},
+			{"tokensUnstaked", expected.TokensUnstaked, actual.Unstaked},
+			{"tokensRewarded", expected.TokensRewarded, actual.Rewarded},
+		} {
+			if bucket.expected == nil {
+				continue
+			}
+
+			amount, err := parseAmount(*bucket.expected)
+			if err != nil {
+				return fmt.Errorf("%s of %s: %w", bucket.name, name, err)
+			}
+
+			assert.Equal(r.f.t, amount.String(), bucket.actual.String(), "%s of %s", bucket.name, name)
+		}
+	}
+
+	names = names[:0]
+	for name := range step.Balances {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		account, err := r.account(name)
+		if err != nil {
+			return err
+		}
+
+		amount, err := parseAmount(step.Balances[name])
+		if err != nil {
+			return fmt.Errorf("balance of %s: %w", name, err)
+		}
+
+		assert.Equal(r.f.t, amount.String(), r.f.Balance(account.Address).String(), "balance of %s", name)
+	}
+
+	return nil
+}
+
+// argument decodes a JSON-Cadence argument, after replacing
+// the references to accounts and nodes with their addresses and IDs.
+func (r *scenarioRunner) argument(value interface{}) (cadence.Value, error) {
+	resolved, err := r.resolve(value)
+	if err != nil {
+		return nil, err
+	}
+
+	encoded, err := json.Marshal(resolved)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := jsoncdc.Decode(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, encoded)
+	}
+
+	return decoded, nil
+}
+
+func (r *scenarioRunner) resolve(value interface{}) (interface{}, error) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		resolved := make(map[string]interface{}, len(value))
+
+		for key, field := range value {
+			name, _ := field.(string)
+
+			switch key {
+			case "account":
+				account, err := r.account(name)
+				if err != nil {
+					return nil, err
+				}
+
+				resolved["value"] = "0x" + account.Address.Hex()
+			case "node":
+				nodeID, err := r.node(name)
+				if err != nil {
+					return nil, err
+				}
+
+				resolved["value"] = nodeID
+			default:
+				field, err := r.resolve(field)
+				if err != nil {
+					return nil, err
+				}
+
+				resolved[key] = field
+			}
+		}
+
+		return resolved, nil
+	case []interface{}:
+		resolved := make([]interface{}, len(value))
+
+		for i, element := range value {
+			var err error
+			resolved[i], err = r.resolve(element)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return resolved, nil
+	case int:
+		// JSON-Cadence encodes all numbers as strings
+		return fmt.Sprint(value), nil
+	case float64:
+		return nil, fmt.Errorf("%v must be quoted, so that it keeps its decimal places", value)
+	default:
+		return value, nil
+	}
+}
+
+// parseAmount parses an amount of FLOW, which may also be written without decimal places.
+func parseAmount(s string) (ufix64.UFix64, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+
+	return ufix64.Parse(s)
+}
@@ -0,0 +1,193 @@
+package testkit_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-emulator/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/model"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/testkit"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// slashEvents decodes the TokensSlashed and DelegatorTokensSlashed events of a transaction.
+func slashEvents(t *testing.T, f *testkit.Fixture, result *types.TransactionResult) []model.SlashEvent {
+	nodeEvent := testkit.EventType(f.StakingAdmin.Address, "FlowIDTableStaking", "TokensSlashed")
+	delegatorEvent := testkit.EventType(f.StakingAdmin.Address, "FlowIDTableStaking", "DelegatorTokensSlashed")
+
+	var slashes []model.SlashEvent
+	for _, event := range result.Events {
+		if event.Type != nodeEvent && event.Type != delegatorEvent {
+			continue
+		}
+
+		slash, err := model.DecodeSlashEvent(event.Value)
+		require.NoError(t, err)
+
+		slashes = append(slashes, slash)
+	}
+
+	return slashes
+}
+
+func slashArguments(nodeID, percentage string, slashDelegators bool, recipient *cadence.Address) []cadence.Value {
+	var optionalRecipient cadence.Optional
+	if recipient != nil {
+		optionalRecipient = cadence.NewOptional(*recipient)
+	} else {
+		optionalRecipient = cadence.NewOptional(nil)
+	}
+
+	return []cadence.Value{
+		cadence.NewString(nodeID),
+		cadence.UFix64(ufix64.MustParse(percentage)),
+		cadence.NewBool(slashDelegators),
+		optionalRecipient,
+	}
+}
+
+func TestIDTableSlashing(t *testing.T) {
+
+	f := testkit.New(t)
+	slashScript := templates.GenerateSlashNodeScript(f.Env)
+
+	// The model mirrors the staking table, except for the rewards,
+	// which are not slashed
+	table := model.NewIDTable(0, ufix64.MustParse("0.08"))
+
+	node := f.NewModelNode(table, testkit.NodeID(1), testkit.RoleCollection, ufix64.MustParse("500000.0"))
+	delegator := f.NewModelDelegator(table, node.ID, ufix64.MustParse("100000.0"))
+	other := f.NewModelNode(table, testkit.NodeID(2), testkit.RoleConsensus, ufix64.MustParse("1000000.0"))
+
+	f.AdvanceEpoch()
+	require.NoError(t, table.EndStakingAuction(map[string]bool{node.ID: true, other.ID: true}))
+	require.NoError(t, table.MoveTokens())
+
+	f.RequestUnstaking(node, ufix64.MustParse("100000.0"))
+	f.Mint(node.Owner.Address, ufix64.MustParse("10000.0"))
+	f.CommitNewTokens(node, ufix64.MustParse("10000.0"))
+
+	require.NoError(t, table.RequestUnstaking(node.ID, ufix64.MustParse("100000.0")))
+	require.NoError(t, table.StakeNewTokens(node.ID, ufix64.MustParse("10000.0")))
+
+	t.Run("Should not be able to slash an invalid percentage", func(t *testing.T) {
+		f.Send(slashScript, f.StakingAdmin, true, slashArguments(node.ID, "0.0", true, nil)...)
+		f.Send(slashScript, f.StakingAdmin, true, slashArguments(node.ID, "1.1", true, nil)...)
+		f.Send(slashScript, f.StakingAdmin, true, slashArguments(testkit.NodeID(3), "0.1", true, nil)...)
+	})
+
+	t.Run("Should not be able to slash without the admin resource", func(t *testing.T) {
+		f.Send(slashScript, node.Owner, true, slashArguments(node.ID, "0.1", true, nil)...)
+	})
+
+	t.Run("Should burn the slashed tokens of the node and its delegators", func(t *testing.T) {
+		inBuckets := f.SupplyTotals().TokensInBuckets()
+		supply := flowTokenSupply(f)
+
+		result := f.Send(slashScript, f.StakingAdmin, false, slashArguments(node.ID, "0.1", true, nil)...)
+
+		expected, err := table.SlashNode(node.ID, ufix64.MustParse("0.1"), true)
+		require.NoError(t, err)
+
+		assert.Equal(t, []model.Slash{
+			{NodeID: node.ID, Amount: ufix64.MustParse("51000.0")},
+			{NodeID: node.ID, DelegatorID: delegator.ID, Amount: ufix64.MustParse("10000.0")},
+		}, expected)
+
+		assert.Equal(t, []model.SlashEvent{
+			{Slash: expected[0]},
+			{Slash: expected[1]},
+		}, slashEvents(t, f, result))
+
+		// The unstaking request is slashed too, so that it can still be filled
+		f.AssertNodeBuckets(node.ID, testkit.Buckets{
+			Staked:             ufix64.MustParse("450000.0"),
+			Committed:          ufix64.MustParse("9000.0"),
+			RequestedToUnstake: ufix64.MustParse("90000.0"),
+		})
+		f.AssertDelegatorBuckets(node.ID, delegator.ID, testkit.Buckets{
+			Staked: ufix64.MustParse("90000.0"),
+		})
+		f.AssertNodeBuckets(other.ID, testkit.Buckets{
+			Staked: ufix64.MustParse("1000000.0"),
+		})
+
+		// No account received the slashed tokens, so they were burned
+		slashed := ufix64.MustParse("61000.0")
+		assert.Equal(t, inBuckets-slashed, f.SupplyTotals().TokensInBuckets())
+		assert.Equal(t, supply-slashed, flowTokenSupply(f))
+		f.AssertSupplyInvariants()
+	})
+
+	t.Run("Should fill the slashed unstaking request at the end of the epoch", func(t *testing.T) {
+		f.AdvanceEpoch()
+		require.NoError(t, table.EndStakingAuction(map[string]bool{node.ID: true, other.ID: true}))
+		require.NoError(t, table.MoveTokens())
+
+		info := f.NodeInfo(node.ID)
+		assert.Equal(t, ufix64.MustParse("369000.0"), info.TokensStaked)
+		assert.Equal(t, ufix64.MustParse("90000.0"), info.TokensUnstaking)
+		assert.Equal(t, ufix64.UFix64(0), info.TokensRequestedToUnstake)
+
+		f.AssertSupplyInvariants()
+	})
+
+	t.Run("Should redirect the slashed tokens and slash unstaking tokens", func(t *testing.T) {
+		recipient := f.NewAccount()
+		address := cadence.NewAddress(recipient.Address)
+
+		result := f.Send(slashScript, f.StakingAdmin, false, slashArguments(node.ID, "0.5", false, &address)...)
+
+		expected, err := table.SlashNode(node.ID, ufix64.MustParse("0.5"), false)
+		require.NoError(t, err)
+		require.Len(t, expected, 1)
+
+		assert.Equal(t, []model.SlashEvent{
+			{Slash: expected[0], Recipient: &address},
+		}, slashEvents(t, f, result))
+
+		assert.Equal(t, ufix64.MustParse("229500.0"), f.Balance(recipient.Address))
+
+		info := f.NodeInfo(node.ID)
+		assert.Equal(t, ufix64.MustParse("184500.0"), info.TokensStaked)
+		assert.Equal(t, ufix64.MustParse("45000.0"), info.TokensUnstaking)
+
+		// The delegators are not slashed
+		assert.Equal(t, ufix64.MustParse("90000.0"), f.DelegatorInfo(node.ID, delegator.ID).TokensStaked)
+
+		f.AssertSupplyInvariants()
+	})
+
+	t.Run("Should not emit events when slashing a node without tokens", func(t *testing.T) {
+		empty := f.NewModelNode(table, testkit.NodeID(4), testkit.RoleExecution, 0)
+
+		result := f.Send(slashScript, f.StakingAdmin, false, slashArguments(empty.ID, "0.5", true, nil)...)
+
+		expected, err := table.SlashNode(empty.ID, ufix64.MustParse("0.5"), true)
+		require.NoError(t, err)
+		assert.Empty(t, expected)
+
+		assert.Empty(t, slashEvents(t, f, result))
+
+		f.AssertNodeBuckets(empty.ID, testkit.Buckets{})
+		f.AssertSupplyInvariants()
+	})
+}
+
+// flowTokenSupply returns the total supply of the FlowToken contract.
+func flowTokenSupply(f *testkit.Fixture) ufix64.UFix64 {
+	script := fmt.Sprintf(`
+		import FlowToken from 0x%s
+
+		pub fun main(): UFix64 {
+			return FlowToken.totalSupply
+		}
+	`, f.Env.FlowTokenAddress)
+
+	return ufix64.UFix64(f.ExecuteScript([]byte(script)).(cadence.UFix64))
+}
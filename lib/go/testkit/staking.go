@@ -0,0 +1,232 @@
+package testkit
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-emulator/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/model"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// The node roles of FlowIDTableStaking.
+const (
+	RoleCollection uint8 = iota + 1
+	RoleConsensus
+	RoleExecution
+	RoleVerification
+	RoleAccess
+)
+
+// Node is a node registered by an account.
+type Node struct {
+	ID   string
+	Role uint8
+
+	// Owner is the account that stores the NodeStaker resource.
+	Owner Account
+}
+
+// Delegator is a delegator registered by an account.
+type Delegator struct {
+	NodeID string
+	ID     uint32
+
+	// Owner is the account that stores the NodeDelegator resource.
+	Owner Account
+}
+
+// NodeID returns a valid node ID, a 64 character hex string, for a number.
+func NodeID(n int) string {
+	return fmt.Sprintf("%064x", n)
+}
+
+// NewNode creates an account with the amount of FLOW and registers a node with all of it committed.
+func (f *Fixture) NewNode(nodeID string, role uint8, amount ufix64.UFix64) Node {
+	f.t.Helper()
+
+	owner := f.NewFundedAccount(amount)
+
+	return f.RegisterNode(owner, nodeID, role, amount)
+}
+
+// RegisterNode registers a node for the owner with the amount committed.
+// The networking address and keys are derived from the node ID, so that they are unique.
+func (f *Fixture) RegisterNode(owner Account, nodeID string, role uint8, amount ufix64.UFix64) Node {
+	f.t.Helper()
+
+	f.Send(templates.GenerateRegisterNodeScript(f.Env), owner, false, registerNodeArguments(nodeID, role, amount)...)
+
+	return Node{ID: nodeID, Role: role, Owner: owner}
+}
+
+// registerNodeArguments returns the arguments of register_node.cdc,
+// with a networking address and keys derived from the node ID.
+func registerNodeArguments(nodeID string, role uint8, amount ufix64.UFix64) []cadence.Value {
+	return []cadence.Value{
+		cadence.NewString(nodeID),
+		cadence.NewUInt8(role),
+		cadence.NewString(fmt.Sprintf("%s.nodes.test:3569", nodeID)),
+		cadence.NewString(fmt.Sprintf("%0128s", nodeID)),
+		cadence.NewString(fmt.Sprintf("%0192s", nodeID)),
+		cadence.UFix64(amount),
+	}
+}
+
+// CommitNewTokens commits new tokens from the vault of the node owner.
+func (f *Fixture) CommitNewTokens(node Node, amount ufix64.UFix64) {
+	f.t.Helper()
+
+	f.Send(templates.GenerateStakeNewTokensScript(f.Env), node.Owner, false, cadence.UFix64(amount))
+}
+
+// RequestUnstaking requests to unstake tokens of a node.
+func (f *Fixture) RequestUnstaking(node Node, amount ufix64.UFix64) {
+	f.t.Helper()
+
+	f.Send(templates.GenerateUnstakeTokensScript(f.Env), node.Owner, false, cadence.UFix64(amount))
+}
+
+// NewDelegator creates an account with the amount of FLOW and registers a delegator
+// of the node with all of it committed.
+func (f *Fixture) NewDelegator(nodeID string, amount ufix64.UFix64) Delegator {
+	f.t.Helper()
+
+	owner := f.NewFundedAccount(amount)
+
+	delegator := f.RegisterDelegator(owner, nodeID)
+
+	f.DelegateNewTokens(delegator, amount)
+
+	return delegator
+}
+
+// NewModelNode registers a node like NewNode and adds the same node record to the model,
+// so that tests can compare the contract with the model.
+func (f *Fixture) NewModelNode(table *model.IDTable, nodeID string, role uint8, amount ufix64.UFix64) Node {
+	f.t.Helper()
+
+	node := f.NewNode(nodeID, role, amount)
+
+	require.NoError(f.t, table.AddNodeRecord(nodeID, role, amount))
+
+	return node
+}
+
+// NewModelDelegator registers a delegator like NewDelegator and registers the same
+// delegator in the model. It fails the test if the model assigns a different delegator ID.
+func (f *Fixture) NewModelDelegator(table *model.IDTable, nodeID string, amount ufix64.UFix64) Delegator {
+	f.t.Helper()
+
+	delegator := f.NewDelegator(nodeID, amount)
+
+	delegatorID, err := table.RegisterNewDelegator(nodeID)
+	require.NoError(f.t, err)
+	require.Equal(f.t, delegator.ID, delegatorID, "delegator ID of the model")
+
+	require.NoError(f.t, table.DelegateNewTokens(nodeID, delegatorID, amount))
+
+	return delegator
+}
+
+// RegisterDelegator registers a delegator of the node for the owner.
+func (f *Fixture) RegisterDelegator(owner Account, nodeID string) Delegator {
+	f.t.Helper()
+
+	result := f.Send(templates.GenerateRegisterDelegatorScript(f.Env), owner, false, cadence.NewString(nodeID))
+
+	eventType := EventType(f.StakingAdmin.Address, "FlowIDTableStaking", "NewDelegatorCreated")
+
+	for _, event := range result.Events {
+		if event.Type == eventType {
+			return Delegator{
+				NodeID: nodeID,
+				ID:     uint32(event.Value.Fields[1].(cadence.UInt32)),
+				Owner:  owner,
+			}
+		}
+	}
+
+	require.FailNow(f.t, "no NewDelegatorCreated event")
+
+	return Delegator{}
+}
+
+// DelegateNewTokens commits new tokens from the vault of the delegator owner.
+func (f *Fixture) DelegateNewTokens(delegator Delegator, amount ufix64.UFix64) {
+	f.t.Helper()
+
+	f.Send(templates.GenerateDelegatorStakeNewScript(f.Env), delegator.Owner, false, cadence.UFix64(amount))
+}
+
+// RequestDelegatorUnstaking requests to unstake tokens of a delegator.
+func (f *Fixture) RequestDelegatorUnstaking(delegator Delegator, amount ufix64.UFix64) {
+	f.t.Helper()
+
+	f.Send(templates.GenerateDelegatorRequestUnstakeScript(f.Env), delegator.Owner, false, cadence.UFix64(amount))
+}
+
+// ProposedNodeIDs returns the IDs of the nodes that have enough tokens committed for the next epoch.
+func (f *Fixture) ProposedNodeIDs() []string {
+	f.t.Helper()
+
+	result := f.ExecuteScript(templates.GenerateReturnProposedTableScript(f.Env))
+
+	values := result.(cadence.Array).Values
+	nodeIDs := make([]string, len(values))
+
+	for i, value := range values {
+		nodeIDs[i] = string(value.(cadence.String))
+	}
+
+	return nodeIDs
+}
+
+// AdvanceEpoch ends the staking auction approving every proposed node,
+// pays the rewards of the epoch and moves the tokens to the buckets of the next epoch,
+// like the FlowEpoch contract does at the end of an epoch.
+// It returns the result of the pay rewards transaction, which emits the reward events.
+func (f *Fixture) AdvanceEpoch() *types.TransactionResult {
+	f.t.Helper()
+
+	return f.AdvanceEpochWithApprovedNodes(f.ProposedNodeIDs())
+}
+
+// AdvanceEpochWithApprovedNodes advances the epoch like AdvanceEpoch,
+// but only approves the given nodes. The other nodes are refunded their committed tokens.
+func (f *Fixture) AdvanceEpochWithApprovedNodes(nodeIDs []string) *types.TransactionResult {
+	f.t.Helper()
+
+	ids := make([]cadence.Value, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		ids[i] = cadence.NewString(nodeID)
+	}
+
+	f.Send(templates.GenerateEndStakingScript(f.Env), f.StakingAdmin, false, cadence.NewArray(ids))
+	result := f.Send(templates.GeneratePayRewardsScript(f.Env), f.StakingAdmin, false)
+	f.Send(templates.GenerateMoveTokensScript(f.Env), f.StakingAdmin, false)
+
+	return result
+}
+
+// AdvanceModelEpoch advances the epoch like AdvanceEpochWithApprovedNodes
+// and ends the same epoch in the model, so that tests can compare the contract with the model.
+func (f *Fixture) AdvanceModelEpoch(table *model.IDTable, nodeIDs []string) *types.TransactionResult {
+	f.t.Helper()
+
+	result := f.AdvanceEpochWithApprovedNodes(nodeIDs)
+
+	approved := make(map[string]bool, len(nodeIDs))
+	for _, nodeID := range nodeIDs {
+		approved[nodeID] = true
+	}
+
+	require.NoError(f.t, table.EndStakingAuction(approved))
+	require.NoError(f.t, table.PayRewards())
+	require.NoError(f.t, table.MoveTokens())
+
+	return result
+}
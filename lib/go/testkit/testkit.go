@@ -0,0 +1,242 @@
+// Package testkit runs the core contracts on an emulator for tests of projects that build on them.
+//
+//	func TestStaking(t *testing.T) {
+//	    f := testkit.New(t)
+//
+//	    node := f.NewNode(nodeID, testkit.RoleCollection, ufix64.MustParse("250000.0"))
+//
+//	    f.AdvanceEpoch()
+//
+//	    f.AssertNodeBuckets(node.ID, testkit.Buckets{Staked: ufix64.MustParse("250000.0")})
+//	}
+//
+// The fixture deploys the core contracts with the bootstrap package, so the addresses of
+// the contracts are only known at runtime and are available in Fixture.Env.
+// The helpers fail the test when a transaction or script unexpectedly fails.
+package testkit
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	emulator "github.com/onflow/flow-emulator"
+	"github.com/onflow/flow-emulator/types"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go-sdk/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/bootstrap"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// GasLimit is the gas limit of the transactions created by the test kit.
+const GasLimit = 9999
+
+// Fixture is an emulator blockchain with the core contracts deployed.
+type Fixture struct {
+	t testing.TB
+
+	Blockchain *emulator.Blockchain
+
+	// Env contains the addresses of the deployed contracts.
+	Env templates.Environment
+
+	// Deployment contains the accounts the contracts are deployed to.
+	Deployment *bootstrap.Deployment
+
+	// StakingAdmin is the account that stores the FlowIDTableStaking Admin resource.
+	StakingAdmin Account
+
+	accountKeys *test.AccountKeys
+
+	checkInvariants bool
+
+	// accounts are the addresses of the accounts found by Accounts.
+	accounts []flow.Address
+}
+
+// New returns a fixture with the core contracts deployed to a new emulator blockchain.
+// The storage limit is disabled, unless it is enabled again with the options.
+func New(t testing.TB, opts ...emulator.Option) *Fixture {
+	return NewWithConfig(t, bootstrap.EmulatorConfig(), opts...)
+}
+
+// NewWithConfig returns a fixture with the core contracts deployed with the given configuration.
+func NewWithConfig(t testing.TB, conf bootstrap.Config, opts ...emulator.Option) *Fixture {
+	t.Helper()
+
+	b := NewBlockchain(t, opts...)
+
+	deployment, err := bootstrap.Deploy(bootstrap.NewEmulatorSender(b), conf)
+	require.NoError(t, err)
+
+	return &Fixture{
+		t:          t,
+		Blockchain: b,
+		Env:        deployment.Environment,
+		Deployment: deployment,
+		StakingAdmin: Account{
+			Address: deployment.IDTable.Address,
+			Key:     deployment.IDTable.Key,
+			Signer:  deployment.IDTable.Signer,
+		},
+		accountKeys: test.AccountKeyGenerator(),
+	}
+}
+
+// NewBlockchain returns an emulator blockchain without the storage limit.
+func NewBlockchain(t testing.TB, opts ...emulator.Option) *emulator.Blockchain {
+	t.Helper()
+
+	b, err := emulator.NewBlockchain(
+		append(
+			[]emulator.Option{
+				emulator.WithStorageLimitEnabled(false),
+			},
+			opts...,
+		)...,
+	)
+	require.NoError(t, err)
+
+	return b
+}
+
+// NewTransaction returns a transaction with the script and authorizer,
+// which the service account proposes and pays for.
+func NewTransaction(b *emulator.Blockchain, script []byte, authorizer flow.Address) *flow.Transaction {
+	return flow.NewTransaction().
+		SetScript(script).
+		SetGasLimit(GasLimit).
+		SetProposalKey(b.ServiceKey().Address, b.ServiceKey().Index, b.ServiceKey().SequenceNumber).
+		SetPayer(b.ServiceKey().Address).
+		AddAuthorizer(authorizer)
+}
+
+// SignAndSubmit signs a transaction with the signers, the first of which signs the envelope,
+// and submits it with Submit.
+func SignAndSubmit(
+	t testing.TB,
+	b *emulator.Blockchain,
+	tx *flow.Transaction,
+	signerAddresses []flow.Address,
+	signers []crypto.Signer,
+	shouldRevert bool,
+) *types.TransactionResult {
+	t.Helper()
+
+	for i := len(signerAddresses) - 1; i >= 0; i-- {
+		var err error
+
+		if i == 0 {
+			err = tx.SignEnvelope(signerAddresses[i], 0, signers[i])
+		} else {
+			err = tx.SignPayload(signerAddresses[i], 0, signers[i])
+		}
+
+		require.NoError(t, err)
+	}
+
+	return Submit(t, b, tx, shouldRevert)
+}
+
+// Submit submits a signed transaction, checks that it reverted or succeeded as expected
+// and commits the block.
+func Submit(t testing.TB, b *emulator.Blockchain, tx *flow.Transaction, shouldRevert bool) *types.TransactionResult {
+	t.Helper()
+
+	err := b.AddTransaction(*tx)
+	require.NoError(t, err)
+
+	result, err := b.ExecuteNextTransaction()
+	require.NoError(t, err)
+
+	if shouldRevert {
+		assert.True(t, result.Reverted(), "expected the transaction to revert")
+	} else if !assert.True(t, result.Succeeded()) {
+		t.Log(result.Error.Error())
+	}
+
+	_, err = b.CommitBlock()
+	require.NoError(t, err)
+
+	return result
+}
+
+// ExecuteScriptAndCheck executes a script with JSON-Cadence encoded arguments
+// and checks that it succeeded.
+func ExecuteScriptAndCheck(t testing.TB, b *emulator.Blockchain, script []byte, arguments [][]byte) cadence.Value {
+	t.Helper()
+
+	result, err := b.ExecuteScript(script, arguments)
+	require.NoError(t, err)
+
+	if !assert.True(t, result.Succeeded()) {
+		t.Log(result.Error.Error())
+	}
+
+	return result.Value
+}
+
+// Send submits a transaction authorized by a single account with the arguments.
+// If CheckInvariantsAfterEachBlock was called, it also asserts the supply invariants.
+func (f *Fixture) Send(script []byte, authorizer Account, shouldRevert bool, arguments ...cadence.Value) *types.TransactionResult {
+	f.t.Helper()
+
+	tx, err := f.newSignedTransaction(script, authorizer, arguments)
+	require.NoError(f.t, err)
+
+	result := Submit(f.t, f.Blockchain, tx, shouldRevert)
+
+	if f.checkInvariants {
+		f.AssertSupplyInvariants()
+	}
+
+	return result
+}
+
+// newSignedTransaction returns a transaction with the arguments, signed by the authorizer
+// and by the service account, which pays for it.
+func (f *Fixture) newSignedTransaction(script []byte, authorizer Account, arguments []cadence.Value) (*flow.Transaction, error) {
+	tx := NewTransaction(f.Blockchain, script, authorizer.Address)
+
+	for _, argument := range arguments {
+		if err := tx.AddArgument(argument); err != nil {
+			return nil, err
+		}
+	}
+
+	serviceKey := f.Blockchain.ServiceKey()
+
+	if authorizer.Address != serviceKey.Address {
+		if err := tx.SignPayload(authorizer.Address, 0, authorizer.Signer); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.SignEnvelope(serviceKey.Address, serviceKey.Index, serviceKey.Signer()); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// ExecuteScript executes a script with the arguments and checks that it succeeded.
+func (f *Fixture) ExecuteScript(script []byte, arguments ...cadence.Value) cadence.Value {
+	f.t.Helper()
+
+	encoded := make([][]byte, len(arguments))
+	for i, argument := range arguments {
+		encoded[i] = jsoncdc.MustEncode(argument)
+	}
+
+	return ExecuteScriptAndCheck(f.t, f.Blockchain, script, encoded)
+}
+
+// EventType returns the type of an event of a contract deployed to the address.
+func EventType(address flow.Address, contract, event string) string {
+	return fmt.Sprintf("A.%s.%s.%s", address.Hex(), contract, event)
+}
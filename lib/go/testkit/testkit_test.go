@@ -0,0 +1,187 @@
+package testkit_test
+
+import (
+	"errors"
+	"flag"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/testkit"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+func TestFixture(t *testing.T) {
+
+	f := testkit.New(t)
+	f.CheckInvariantsAfterEachBlock()
+
+	t.Run("Should create funded accounts", func(t *testing.T) {
+		account := f.NewFundedAccount(ufix64.MustParse("100.0"))
+
+		assert.Equal(t, ufix64.MustParse("100.0"), f.Balance(account.Address))
+
+		f.Mint(account.Address, ufix64.MustParse("0.5"))
+		assert.Equal(t, ufix64.MustParse("100.5"), f.Balance(account.Address))
+	})
+
+	collector := f.NewNode(testkit.NodeID(1), testkit.RoleCollection, ufix64.MustParse("260000.0"))
+	consensus := f.NewNode(testkit.NodeID(2), testkit.RoleConsensus, ufix64.MustParse("100000.0"))
+	delegator := f.NewDelegator(collector.ID, ufix64.MustParse("50000.0"))
+
+	t.Run("Should register nodes and delegators", func(t *testing.T) {
+		f.AssertNodeBuckets(collector.ID, testkit.Buckets{Committed: ufix64.MustParse("260000.0")})
+		f.AssertDelegatorBuckets(collector.ID, delegator.ID, testkit.Buckets{Committed: ufix64.MustParse("50000.0")})
+
+		assert.Equal(t, uint32(1), delegator.ID)
+		assert.Equal(t, testkit.RoleConsensus, f.NodeInfo(consensus.ID).Role)
+
+		// the consensus node doesn't have enough tokens committed to be proposed
+		assert.Equal(t, []string{collector.ID}, f.ProposedNodeIDs())
+	})
+
+	t.Run("Should advance the epoch and refund nodes that aren't proposed", func(t *testing.T) {
+		f.AdvanceEpoch()
+
+		f.AssertNodeBuckets(collector.ID, testkit.Buckets{Staked: ufix64.MustParse("260000.0")})
+		f.AssertDelegatorBuckets(collector.ID, delegator.ID, testkit.Buckets{Staked: ufix64.MustParse("50000.0")})
+		f.AssertNodeBuckets(consensus.ID, testkit.Buckets{Unstaked: ufix64.MustParse("100000.0")})
+	})
+
+	t.Run("Should pay the expected rewards and move tokens", func(t *testing.T) {
+		f.RequestUnstaking(collector, ufix64.MustParse("10000.0"))
+
+		expected := f.NodeBuckets(collector.ID)
+		assert.Equal(t, ufix64.MustParse("10000.0"), expected.RequestedToUnstake)
+
+		payout := f.RolePayout(testkit.RoleCollection)
+		cut := ufix64.UFix64(f.ExecuteScript(templates.GenerateGetCutPercentageScript(f.Env)).(cadence.UFix64))
+		totalStaked := ufix64.MustParse("310000.0")
+
+		nodeRewards, err := testkit.Rewards(payout, totalStaked, expected.Staked)
+		require.NoError(t, err)
+
+		delegatorRewards, nodeCut, err := testkit.DelegatorRewards(payout, totalStaked, ufix64.MustParse("50000.0"), cut)
+		require.NoError(t, err)
+
+		f.AdvanceEpoch()
+
+		expected, err = testkit.MoveTokens(expected)
+		require.NoError(t, err)
+		expected.Rewarded, err = nodeRewards.Add(nodeCut)
+		require.NoError(t, err)
+
+		f.AssertNodeBuckets(collector.ID, expected)
+		f.AssertDelegatorBuckets(collector.ID, delegator.ID, testkit.Buckets{
+			Staked:   ufix64.MustParse("50000.0"),
+			Rewarded: delegatorRewards,
+		})
+	})
+
+	t.Run("Should report reverted transactions", func(t *testing.T) {
+		f.Send(templates.GenerateStakeNewTokensScript(f.Env), collector.Owner, true, cadence.UFix64(ufix64.MustParse("1.0")))
+	})
+}
+
+func TestSupplyInvariants(t *testing.T) {
+
+	f := testkit.New(t)
+
+	node := f.NewNode(testkit.NodeID(1), testkit.RoleConsensus, ufix64.MustParse("500000.0"))
+	f.NewDelegator(node.ID, ufix64.MustParse("1000.0"))
+
+	f.AdvanceEpoch()
+	f.AdvanceEpoch()
+
+	totals := f.SupplyTotals()
+	consensus := totals.Roles[testkit.RoleConsensus]
+
+	assert.Equal(t, ufix64.MustParse("501000.0"), consensus.TokensStaked)
+	assert.NotZero(t, consensus.TokensRewarded)
+	assert.Equal(t, consensus.Sum(), totals.TokensInBuckets())
+	assert.Equal(t, totals.TokensHeld, totals.StakingAccountBalance+totals.TokensInBuckets())
+
+	assert.True(t, f.AssertSupplyInvariants())
+}
+
+var propertySeed = flag.Int64("testkit.seed", 1, "seed of the first random action sequence of the staking property test")
+
+func TestStakingProperties(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping the property test in short mode")
+	}
+
+	testkit.CheckStakingProperties(t, testkit.PropertyConfig{
+		Seed:       *propertySeed,
+		Sequences:  2,
+		Actions:    50,
+		Nodes:      5,
+		Delegators: 10,
+	})
+}
+
+func TestShrink(t *testing.T) {
+
+	// The sequence fails if it moves tokens after paying rewards
+	fails := func(sequence testkit.Sequence) error {
+		paid := false
+		for i, action := range sequence {
+			switch action.Kind {
+			case testkit.ActionPayRewards:
+				paid = true
+			case testkit.ActionMoveTokens:
+				if paid {
+					return &testkit.PropertyError{Index: i, Action: action, Err: errors.New("moved tokens")}
+				}
+			}
+		}
+
+		return nil
+	}
+
+	sequence := testkit.Sequence{
+		{Kind: testkit.ActionRegisterNode, Actor: 0, Amount: ufix64.MustParse("250000.5")},
+		{Kind: testkit.ActionMoveTokens},
+		{Kind: testkit.ActionStakeNewTokens, Actor: 0, Amount: ufix64.MustParse("1.5")},
+		{Kind: testkit.ActionPayRewards},
+		{Kind: testkit.ActionRequestUnstaking, Actor: 0, Amount: ufix64.MustParse("1.5")},
+		{Kind: testkit.ActionMoveTokens},
+		{Kind: testkit.ActionPayRewards},
+	}
+
+	assert.Equal(t, testkit.Sequence{
+		{Kind: testkit.ActionPayRewards},
+		{Kind: testkit.ActionMoveTokens},
+	}, testkit.Shrink(sequence, fails))
+
+	assert.Equal(t, sequence[:2], testkit.Shrink(sequence[:2], fails))
+}
+
+func TestScenarios(t *testing.T) {
+	testkit.RunScenarios(t, "scenarios")
+}
+
+func TestMoveTokens(t *testing.T) {
+	moved, err := testkit.MoveTokens(testkit.Buckets{
+		Committed:          ufix64.MustParse("10.0"),
+		Staked:             ufix64.MustParse("100.0"),
+		RequestedToUnstake: ufix64.MustParse("30.0"),
+		Unstaking:          ufix64.MustParse("5.0"),
+		Unstaked:           ufix64.MustParse("1.0"),
+		Rewarded:           ufix64.MustParse("2.0"),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, testkit.Buckets{
+		Staked:    ufix64.MustParse("80.0"),
+		Unstaking: ufix64.MustParse("30.0"),
+		Unstaked:  ufix64.MustParse("6.0"),
+		Rewarded:  ufix64.MustParse("2.0"),
+	}, moved)
+
+	_, err = testkit.MoveTokens(testkit.Buckets{RequestedToUnstake: ufix64.MustParse("1.0")})
+	assert.Error(t, err)
+}
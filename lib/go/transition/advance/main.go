@@ -0,0 +1,444 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	emulator "github.com/onflow/flow-emulator"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/client"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/onflow/flow-core-contracts/lib/go/bootstrap"
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/transition"
+	"github.com/onflow/flow-core-contracts/lib/go/verify"
+)
+
+type Config struct {
+	Network        string
+	AccessNode     string
+	IDTableAddress string
+	Epoch          uint64
+	Approved       string
+	Journal        string
+	AdminAddress   string
+	AdminKeyFile   string
+	AdminKeyIndex  int
+	PlanOnly       bool
+	EmulatorDB     string
+
+	Allowlist  string
+	RoleCaps   map[string]int
+	Out        string
+	Dictionary bool
+}
+
+const emulatorNetwork = "emulator"
+
+var accessNodes = map[string]string{
+	verify.Mainnet: "access.mainnet.nodes.onflow.org:9000",
+	verify.Testnet: "access.devnet.nodes.onflow.org:9000",
+}
+
+var conf Config
+
+var cmd = &cobra.Command{
+	Use:   "advance",
+	Short: "End a staking epoch and start the next one",
+	Long: `End a staking epoch and start the next one with the FlowIDTableStaking admin transactions.
+
+The end_staking, pay_rewards and move_tokens transactions are sent in order and signed
+by the admin key. Before the first one, the supply invariants must hold and all the
+approved nodes must be proposed. After every transaction, its events and the state of the
+contract are compared with what the transition was expected to do. The command stops at
+the first check that fails.
+
+The progress is saved in the --journal file, including every signed transaction before it
+is sent. Running the command again resumes an interrupted transition without sending a
+transaction twice, and does nothing if the transition of --epoch already finished.
+
+With --plan, only the checks before the transition run and nothing is sent.
+With --emulator-db, the transition runs on a copy of the database of a stopped emulator
+and the journal is not written, to try it out before running it on a network.
+
+The approved nodes are read from a JSON array of node IDs.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := advance(conf); err != nil {
+			exit(err)
+		}
+	},
+}
+
+var approveCmd = &cobra.Command{
+	Use:   "approve",
+	Short: "Build the list of approved nodes for the end of the staking auction",
+	Long: `Build the list of approved nodes for the end of the staking auction.
+
+Every registered node is approved if it is proposed, in the --allowlist file and within
+the --role-cap of its role. Nodes that aren't proposed have committed less than the minimum
+stake of their role. When a role has more nodes than its cap, the nodes with the most tokens
+committed, including their delegators', are approved.
+
+The allowlist file has one node ID per line, and lines starting with # are ignored.
+Without an allowlist, every proposed node can be approved.
+
+The argument of the end_staking.cdc transaction is written to stdout in the JSON-Cadence format,
+or the approvedNodeIDs dictionary of Admin.endStakingAuction with --dictionary. The dropped nodes
+and the reasons are written to stderr. With --out, the approved node IDs are also written to a
+JSON file that can be passed to --approved.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := approve(conf); err != nil {
+			exit(err)
+		}
+	},
+}
+
+func approve(conf Config) error {
+	env, err := getEnv(conf)
+	if err != nil {
+		return err
+	}
+
+	approvalConf := transition.ApprovalConfig{
+		Env:      env,
+		RoleCaps: map[uint8]int{},
+	}
+
+	if conf.Allowlist != "" {
+		approvalConf.Allowlist, err = readAllowlist(conf.Allowlist)
+		if err != nil {
+			return fmt.Errorf("could not read the allowlist: %w", err)
+		}
+	}
+
+	for role, limit := range conf.RoleCaps {
+		r, err := strconv.ParseUint(role, 10, 8)
+		if err != nil {
+			return fmt.Errorf("invalid role %s", role)
+		}
+
+		if limit < 0 {
+			return fmt.Errorf("invalid cap %d of role %s: the cap can't be negative", limit, role)
+		}
+
+		approvalConf.RoleCaps[uint8(r)] = limit
+	}
+
+	chain, closeChain, err := newChain(conf, transition.Admin{})
+	if err != nil {
+		return err
+	}
+	defer closeChain()
+
+	approval, err := transition.BuildApproval(context.Background(), chain, approvalConf)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stderr, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NODE\tROLE\tCOMMITTED\tWITH DELEGATORS\tDROPPED\tDETAILS")
+
+	for _, node := range approval.Dropped {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\n", node.NodeID, node.Role, node.Committed, node.TotalCommitted, node.Reason, node.Details)
+	}
+
+	w.Flush()
+
+	for _, id := range approval.UnknownAllowed {
+		fmt.Fprintf(os.Stderr, "node %s of the allowlist is not registered\n", id)
+	}
+
+	fmt.Fprintf(os.Stderr, "%d nodes approved, %d dropped\n", len(approval.Approved), len(approval.Dropped))
+
+	var argument cadence.Value = approval.Argument()
+	if conf.Dictionary {
+		argument = approval.Dictionary()
+	}
+
+	encoded, err := jsoncdc.Encode(argument)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(encoded))
+
+	if conf.Out != "" {
+		data, err := json.MarshalIndent(approval.NodeIDs(), "", "  ")
+		if err != nil {
+			return err
+		}
+
+		if err := ioutil.WriteFile(conf.Out, data, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readAllowlist(path string) ([]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		ids = append(ids, line)
+	}
+
+	return ids, nil
+}
+
+func advance(conf Config) error {
+	ctx := context.Background()
+
+	env, err := getEnv(conf)
+	if err != nil {
+		return err
+	}
+
+	var approved []string
+	if err := readJSON(conf.Approved, &approved); err != nil {
+		return fmt.Errorf("could not read the approved nodes: %w", err)
+	}
+
+	transitionConf := transition.Config{
+		Env:             env,
+		Epoch:           conf.Epoch,
+		ApprovedNodeIDs: approved,
+	}
+
+	admin, err := getAdmin(conf)
+	if err != nil {
+		return err
+	}
+
+	chain, closeChain, err := newChain(conf, admin)
+	if err != nil {
+		return err
+	}
+	defer closeChain()
+
+	var journal transition.Journal = transition.NewFileJournal(conf.Journal)
+	if conf.EmulatorDB != "" {
+		journal = &transition.MemoryJournal{}
+	}
+
+	orchestrator := transition.New(chain, journal, transitionConf)
+
+	var report transition.Report
+	if conf.PlanOnly {
+		report, err = orchestrator.Plan(ctx)
+	} else {
+		report, err = orchestrator.Run(ctx)
+	}
+
+	printReport(report)
+
+	return err
+}
+
+// newChain returns a chain on the access node of the network,
+// or on a copy of the emulator database if one is given.
+func newChain(conf Config, admin transition.Admin) (transition.Chain, func() error, error) {
+	if conf.EmulatorDB != "" {
+		b, closeCopy, err := transition.CopyEmulator(conf.EmulatorDB, emulator.WithStorageLimitEnabled(false))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return transition.NewEmulatorChain(b, admin), closeCopy, nil
+	}
+
+	accessNode := conf.AccessNode
+	if accessNode == "" {
+		accessNode = accessNodes[conf.Network]
+	}
+
+	flowClient, err := client.New(accessNode, grpc.WithInsecure())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return transition.NewClientChain(flowClient, admin), flowClient.Close, nil
+}
+
+func getEnv(conf Config) (templates.Environment, error) {
+	if conf.Network == emulatorNetwork {
+		if conf.IDTableAddress == "" {
+			return templates.Environment{}, fmt.Errorf("the address of FlowIDTableStaking on the emulator is required")
+		}
+
+		emulatorConf := bootstrap.EmulatorConfig()
+
+		return templates.Environment{
+			Network:              emulatorNetwork,
+			FungibleTokenAddress: emulatorConf.FungibleTokenAddress.Hex(),
+			FlowTokenAddress:     emulatorConf.FlowTokenAddress.Hex(),
+			IDTableAddress:       conf.IDTableAddress,
+		}, nil
+	}
+
+	network, err := verify.GetNetwork(conf.Network)
+	if err != nil {
+		return templates.Environment{}, err
+	}
+
+	env := templates.Environment{
+		Network:              network.Name,
+		FungibleTokenAddress: network.Addresses.FungibleToken.Hex(),
+		FlowTokenAddress:     network.Addresses.FlowToken.Hex(),
+		IDTableAddress:       network.Addresses.IDTable.Hex(),
+		StakingProxyAddress:  network.Addresses.StakingProxy.Hex(),
+	}
+
+	if conf.IDTableAddress != "" {
+		env.IDTableAddress = conf.IDTableAddress
+	}
+
+	return env, nil
+}
+
+// getChainID returns the chain of the network, whose addresses the admin address is checked against.
+func getChainID(conf Config) (flow.ChainID, error) {
+	if conf.Network == emulatorNetwork {
+		return flow.Emulator, nil
+	}
+
+	network, err := verify.GetNetwork(conf.Network)
+	if err != nil {
+		return "", err
+	}
+
+	return network.ChainID, nil
+}
+
+func getAdmin(conf Config) (transition.Admin, error) {
+	chain, err := getChainID(conf)
+	if err != nil {
+		return transition.Admin{}, err
+	}
+
+	address, err := contracts.ParseAddress(conf.AdminAddress, chain)
+	if err != nil {
+		return transition.Admin{}, fmt.Errorf("invalid admin address: %w", err)
+	}
+
+	data, err := ioutil.ReadFile(conf.AdminKeyFile)
+	if err != nil {
+		return transition.Admin{}, fmt.Errorf("could not read the admin key: %w", err)
+	}
+
+	privateKey, err := crypto.DecodePrivateKeyHex(crypto.ECDSA_P256, strings.TrimSpace(string(data)))
+	if err != nil {
+		return transition.Admin{}, fmt.Errorf("invalid admin key: %w", err)
+	}
+
+	return transition.Admin{
+		Address:  flow.Address(address),
+		KeyIndex: conf.AdminKeyIndex,
+		Signer:   crypto.NewInMemorySigner(privateKey, crypto.SHA3_256),
+	}, nil
+}
+
+func readJSON(path string, v interface{}) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+func printReport(report transition.Report) {
+	if report.AlreadyDone {
+		fmt.Printf("the transition of epoch %d already finished\n", report.Plan.Epoch)
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "STEP\tCHECK\tRESULT\tDETAILS")
+
+	for _, check := range report.Checks {
+		result := "passed"
+		if !check.Passed {
+			result = "FAILED"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", check.Step, check.Name, result, strings.Join(check.Details, "; "))
+	}
+
+	w.Flush()
+
+	for _, tx := range report.Transactions {
+		fmt.Printf("%s: transaction %s\n", tx.Step, tx.ID)
+	}
+}
+
+func init() {
+	initConfig()
+}
+
+func initConfig() {
+	chainFlags := cmd.PersistentFlags()
+
+	chainFlags.StringVar(&conf.Network, "network", verify.Mainnet, "Flow network to advance, or emulator")
+	chainFlags.StringVar(&conf.AccessNode, "access-node", "", "Access node to send the transactions to, defaults to the public access node of the network")
+	chainFlags.StringVar(&conf.IDTableAddress, "idtable-address", "", "Address of FlowIDTableStaking, required on the emulator")
+	chainFlags.StringVar(&conf.EmulatorDB, "emulator-db", "", "Run on a copy of the database of a stopped emulator, without writing the journal")
+
+	flags := cmd.Flags()
+
+	flags.Uint64Var(&conf.Epoch, "epoch", 0, "Counter of the epoch that ends")
+	flags.StringVar(&conf.Approved, "approved", "", "JSON file with the IDs of the nodes approved for the next epoch")
+	flags.StringVar(&conf.Journal, "journal", "transition.json", "File the progress of the transition is saved in")
+	flags.StringVar(&conf.AdminAddress, "admin-address", "", "Account that stores the FlowIDTableStaking Admin resource")
+	flags.StringVar(&conf.AdminKeyFile, "admin-key-file", "", "File with the hex encoded ECDSA_P256 private key of the admin account")
+	flags.IntVar(&conf.AdminKeyIndex, "admin-key-index", 0, "Index of the admin key on the account")
+	flags.BoolVar(&conf.PlanOnly, "plan", false, "Only run the checks before the transition")
+
+	cmd.MarkFlagRequired("epoch")
+	cmd.MarkFlagRequired("approved")
+	cmd.MarkFlagRequired("admin-address")
+	cmd.MarkFlagRequired("admin-key-file")
+
+	approveFlags := approveCmd.Flags()
+
+	approveFlags.StringVar(&conf.Allowlist, "allowlist", "", "File with the IDs of the nodes that can be approved, one per line")
+	approveFlags.StringToIntVar(&conf.RoleCaps, "role-cap", nil, "Maximum number of approved nodes of a role, like 1=100, can be repeated")
+	approveFlags.StringVar(&conf.Out, "out", "", "JSON file to write the approved node IDs to")
+	approveFlags.BoolVar(&conf.Dictionary, "dictionary", false, "Write the approvedNodeIDs dictionary of Admin.endStakingAuction instead of the end_staking.cdc argument")
+
+	cmd.AddCommand(approveCmd)
+}
+
+func main() {
+	if err := cmd.Execute(); err != nil {
+		exit(err)
+	}
+}
+
+func exit(err error) {
+	fmt.Println(err)
+	os.Exit(1)
+}
@@ -0,0 +1,252 @@
+package transition
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-core-contracts/lib/go/model"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// DropReason is the reason a node is not approved.
+type DropReason string
+
+const (
+	// DropBelowMinimum is the reason of a node that is not proposed, because the tokens
+	// it committed for the next epoch are below the minimum stake of its role.
+	DropBelowMinimum DropReason = "below minimum"
+
+	// DropNotAllowed is the reason of a node that is not in the allowlist.
+	DropNotAllowed DropReason = "not allowed"
+
+	// DropRoleCap is the reason of a node that is allowed, but has less tokens committed
+	// with its delegators than the nodes of its role that fill the cap of the role.
+	DropRoleCap DropReason = "role cap"
+)
+
+// ApprovalConfig contains the rules that select the approved nodes among the proposed ones.
+type ApprovalConfig struct {
+	// Env contains the addresses of the core contracts.
+	Env templates.Environment
+
+	// Allowlist are the IDs of the nodes that can be approved.
+	// If it is nil, every proposed node can be approved.
+	Allowlist []string
+
+	// RoleCaps are the maximum numbers of approved nodes of some roles, which can't be negative.
+	// The nodes with the most tokens committed, including their delegators', are approved first,
+	// and nodes with the same tokens committed are approved in the order of their IDs.
+	RoleCaps map[uint8]int
+}
+
+// ApprovalNode is a node that was considered for approval.
+type ApprovalNode struct {
+	NodeID string
+	Role   uint8
+
+	// Committed are the tokens of the node operator for the next epoch,
+	// which must meet the minimum stake of the role.
+	Committed ufix64.UFix64
+
+	// TotalCommitted are the tokens of the node operator and its delegators for the next epoch.
+	TotalCommitted ufix64.UFix64
+}
+
+// DroppedNode is a node that is not approved.
+type DroppedNode struct {
+	ApprovalNode
+	Reason  DropReason
+	Details string
+}
+
+// Approval is the list of approved nodes for endStakingAuction.
+type Approval struct {
+	// Approved are the approved nodes, sorted by node ID.
+	Approved []ApprovalNode
+
+	// Dropped are the nodes that are not approved, sorted by node ID.
+	Dropped []DroppedNode
+
+	// UnknownAllowed are the node IDs of the allowlist that are not registered.
+	UnknownAllowed []string
+}
+
+// NodeIDs returns the IDs of the approved nodes.
+func (a Approval) NodeIDs() []string {
+	ids := make([]string, len(a.Approved))
+	for i, node := range a.Approved {
+		ids[i] = node.NodeID
+	}
+
+	return ids
+}
+
+// Argument returns the argument of the end_staking.cdc transaction,
+// which builds the approval dictionary from the array of node IDs.
+func (a Approval) Argument() cadence.Array {
+	ids := make([]cadence.Value, len(a.Approved))
+	for i, node := range a.Approved {
+		ids[i] = cadence.NewString(node.NodeID)
+	}
+
+	return cadence.NewArray(ids)
+}
+
+// Dictionary returns the approvedNodeIDs argument of Admin.endStakingAuction.
+func (a Approval) Dictionary() cadence.Dictionary {
+	pairs := make([]cadence.KeyValuePair, len(a.Approved))
+	for i, node := range a.Approved {
+		pairs[i] = cadence.KeyValuePair{
+			Key:   cadence.NewString(node.NodeID),
+			Value: cadence.NewBool(true),
+		}
+	}
+
+	return cadence.NewDictionary(pairs)
+}
+
+// BuildApproval selects the approved nodes for the next epoch.
+//
+// A node is approved if it is proposed, in the allowlist and within the cap of its role,
+// in that order. Nodes that aren't proposed are dropped with the tokens they committed
+// and the minimum stake of their role, as returned by getMinimumStakeRequirements.
+func BuildApproval(ctx context.Context, scripts ScriptExecutor, conf ApprovalConfig) (Approval, error) {
+	env := conf.Env
+
+	for role, limit := range conf.RoleCaps {
+		if limit < 0 {
+			return Approval{}, fmt.Errorf("invalid cap %d of role %d: the cap can't be negative", limit, role)
+		}
+	}
+
+	allNodeIDs, err := nodeIDs(ctx, scripts, templates.GenerateReturnTableScript(env))
+	if err != nil {
+		return Approval{}, err
+	}
+
+	proposedNodeIDs, err := nodeIDs(ctx, scripts, templates.GenerateReturnProposedTableScript(env))
+	if err != nil {
+		return Approval{}, err
+	}
+
+	proposed := set(proposedNodeIDs)
+	registered := set(allNodeIDs)
+
+	var allowed map[string]bool
+	if conf.Allowlist != nil {
+		allowed = set(conf.Allowlist)
+	}
+
+	var approval Approval
+	for _, id := range sortedCopy(conf.Allowlist) {
+		if !registered[id] {
+			approval.UnknownAllowed = append(approval.UnknownAllowed, id)
+		}
+	}
+
+	minimums := map[uint8]ufix64.UFix64{}
+	candidates := map[uint8][]ApprovalNode{}
+
+	for _, id := range allNodeIDs {
+		node, err := approvalNode(ctx, scripts, env, id)
+		if err != nil {
+			return Approval{}, err
+		}
+
+		minimum, ok := minimums[node.Role]
+		if !ok {
+			minimum, err = amount(ctx, scripts, templates.GenerateGetStakeRequirementsScript(env), cadence.NewUInt8(node.Role))
+			if err != nil {
+				return Approval{}, err
+			}
+
+			minimums[node.Role] = minimum
+		}
+
+		switch {
+		case !proposed[id]:
+			approval.Dropped = append(approval.Dropped, DroppedNode{
+				ApprovalNode: node,
+				Reason:       DropBelowMinimum,
+				Details:      fmt.Sprintf("%s FLOW committed, the minimum of role %d is %s", node.Committed, node.Role, minimum),
+			})
+
+		case allowed != nil && !allowed[id]:
+			approval.Dropped = append(approval.Dropped, DroppedNode{
+				ApprovalNode: node,
+				Reason:       DropNotAllowed,
+				Details:      "the node is not in the allowlist",
+			})
+
+		default:
+			candidates[node.Role] = append(candidates[node.Role], node)
+		}
+	}
+
+	for role, nodes := range candidates {
+		limit, capped := conf.RoleCaps[role]
+		if !capped || len(nodes) <= limit {
+			approval.Approved = append(approval.Approved, nodes...)
+			continue
+		}
+
+		sort.SliceStable(nodes, func(i, j int) bool {
+			return nodes[i].TotalCommitted > nodes[j].TotalCommitted
+		})
+
+		approval.Approved = append(approval.Approved, nodes[:limit]...)
+
+		for i, node := range nodes[limit:] {
+			approval.Dropped = append(approval.Dropped, DroppedNode{
+				ApprovalNode: node,
+				Reason:       DropRoleCap,
+				Details:      fmt.Sprintf("ranked %d by tokens committed with delegators, the cap of role %d is %d", limit+i+1, role, limit),
+			})
+		}
+	}
+
+	sort.Slice(approval.Approved, func(i, j int) bool {
+		return approval.Approved[i].NodeID < approval.Approved[j].NodeID
+	})
+
+	sort.Slice(approval.Dropped, func(i, j int) bool {
+		return approval.Dropped[i].NodeID < approval.Dropped[j].NodeID
+	})
+
+	return approval, nil
+}
+
+// approvalNode returns a node with the tokens committed for the next epoch,
+// like getNodeCommittedBalanceWithoutDelegators and getNodeCommittedBalanceWithDelegators.
+func approvalNode(ctx context.Context, scripts ScriptExecutor, env templates.Environment, nodeID string) (ApprovalNode, error) {
+	value, err := executeScript(ctx, scripts, templates.GenerateGetNodeInfoScript(env), cadence.NewString(nodeID))
+	if err != nil {
+		return ApprovalNode{}, err
+	}
+
+	info, err := model.DecodeNodeInfo(value)
+	if err != nil {
+		return ApprovalNode{}, err
+	}
+
+	var committed ufix64.UFix64
+	if info.TokensCommitted+info.TokensStaked > info.TokensRequestedToUnstake {
+		committed = info.TokensCommitted + info.TokensStaked - info.TokensRequestedToUnstake
+	}
+
+	total, err := amount(ctx, scripts, templates.GenerateGetTotalCommitmentBalanceScript(env), cadence.NewString(nodeID))
+	if err != nil {
+		return ApprovalNode{}, err
+	}
+
+	return ApprovalNode{
+		NodeID:         nodeID,
+		Role:           info.Role,
+		Committed:      committed,
+		TotalCommitted: total,
+	}, nil
+}
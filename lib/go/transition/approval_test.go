@@ -0,0 +1,120 @@
+package transition_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/testkit"
+	"github.com/onflow/flow-core-contracts/lib/go/transition"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+func TestBuildApproval(t *testing.T) {
+	ctx := context.Background()
+
+	f := testkit.New(t)
+
+	f.NewNode(testkit.NodeID(1), testkit.RoleCollection, ufix64.MustParse("500000.0"))
+	f.NewDelegator(testkit.NodeID(1), ufix64.MustParse("100000.0"))
+	f.NewNode(testkit.NodeID(2), testkit.RoleCollection, ufix64.MustParse("400000.0"))
+	f.NewNode(testkit.NodeID(3), testkit.RoleCollection, ufix64.MustParse("300000.0"))
+	f.NewNode(testkit.NodeID(4), testkit.RoleConsensus, ufix64.MustParse("400000.0"))
+	f.NewNode(testkit.NodeID(5), testkit.RoleConsensus, ufix64.MustParse("600000.0"))
+	f.NewNode(testkit.NodeID(6), testkit.RoleExecution, ufix64.MustParse("1250000.0"))
+
+	scripts := chain(f)
+
+	t.Run("Proposed", func(t *testing.T) {
+		approval, err := transition.BuildApproval(ctx, scripts, transition.ApprovalConfig{Env: f.Env})
+		require.NoError(t, err)
+
+		assert.Equal(t, f.ProposedNodeIDs(), approval.NodeIDs())
+		require.Len(t, approval.Dropped, 1)
+		assert.Equal(t, testkit.NodeID(4), approval.Dropped[0].NodeID)
+		assert.Equal(t, transition.DropBelowMinimum, approval.Dropped[0].Reason)
+		assert.Equal(t, "400000.00000000 FLOW committed, the minimum of role 2 is 500000.00000000", approval.Dropped[0].Details)
+	})
+
+	t.Run("Negative cap", func(t *testing.T) {
+		_, err := transition.BuildApproval(ctx, scripts, transition.ApprovalConfig{
+			Env: f.Env,
+			RoleCaps: map[uint8]int{
+				testkit.RoleCollection: -1,
+			},
+		})
+		assert.EqualError(t, err, "invalid cap -1 of role 1: the cap can't be negative")
+	})
+
+	t.Run("Allowlist and caps", func(t *testing.T) {
+		approval, err := transition.BuildApproval(ctx, scripts, transition.ApprovalConfig{
+			Env: f.Env,
+			Allowlist: []string{
+				testkit.NodeID(9),
+				testkit.NodeID(1),
+				testkit.NodeID(2),
+				testkit.NodeID(3),
+				testkit.NodeID(4),
+				testkit.NodeID(6),
+			},
+			RoleCaps: map[uint8]int{
+				testkit.RoleCollection: 2,
+				testkit.RoleExecution:  2,
+			},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []transition.ApprovalNode{
+			{
+				NodeID:         testkit.NodeID(1),
+				Role:           testkit.RoleCollection,
+				Committed:      ufix64.MustParse("500000.0"),
+				TotalCommitted: ufix64.MustParse("600000.0"),
+			},
+			{
+				NodeID:         testkit.NodeID(2),
+				Role:           testkit.RoleCollection,
+				Committed:      ufix64.MustParse("400000.0"),
+				TotalCommitted: ufix64.MustParse("400000.0"),
+			},
+			{
+				NodeID:         testkit.NodeID(6),
+				Role:           testkit.RoleExecution,
+				Committed:      ufix64.MustParse("1250000.0"),
+				TotalCommitted: ufix64.MustParse("1250000.0"),
+			},
+		}, approval.Approved)
+
+		require.Len(t, approval.Dropped, 3)
+
+		assert.Equal(t, testkit.NodeID(3), approval.Dropped[0].NodeID)
+		assert.Equal(t, transition.DropRoleCap, approval.Dropped[0].Reason)
+		assert.Equal(t, "ranked 3 by tokens committed with delegators, the cap of role 1 is 2", approval.Dropped[0].Details)
+
+		assert.Equal(t, testkit.NodeID(4), approval.Dropped[1].NodeID)
+		assert.Equal(t, transition.DropBelowMinimum, approval.Dropped[1].Reason)
+
+		assert.Equal(t, testkit.NodeID(5), approval.Dropped[2].NodeID)
+		assert.Equal(t, transition.DropNotAllowed, approval.Dropped[2].Reason)
+
+		assert.Equal(t, []string{testkit.NodeID(9)}, approval.UnknownAllowed)
+
+		assert.Equal(t, cadence.NewArray([]cadence.Value{
+			cadence.NewString(testkit.NodeID(1)),
+			cadence.NewString(testkit.NodeID(2)),
+			cadence.NewString(testkit.NodeID(6)),
+		}), approval.Argument())
+		assert.Len(t, approval.Dictionary().Pairs, 3)
+
+		f.Send(templates.GenerateEndStakingScript(f.Env), f.StakingAdmin, false, approval.Argument())
+		f.Send(templates.GeneratePayRewardsScript(f.Env), f.StakingAdmin, false)
+		f.Send(templates.GenerateMoveTokensScript(f.Env), f.StakingAdmin, false)
+
+		staked := f.ExecuteScript(templates.GenerateReturnCurrentTableScript(f.Env)).(cadence.Array)
+		assert.ElementsMatch(t, approval.Argument().Values, staked.Values)
+	})
+}
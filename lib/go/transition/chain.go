@@ -0,0 +1,288 @@
+package transition
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	emulator "github.com/onflow/flow-emulator"
+	"github.com/onflow/flow-emulator/storage/badger"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/client"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// gasLimit is the gas limit of the admin transactions.
+const gasLimit = 9999
+
+// Admin is the account that stores the FlowIDTableStaking Admin resource,
+// and the key that signs the transactions of the transition.
+// The account proposes, pays for and authorizes the transactions.
+type Admin struct {
+	Address  flow.Address
+	KeyIndex int
+	Signer   crypto.Signer
+}
+
+// ScriptExecutor executes scripts on a network.
+type ScriptExecutor interface {
+	// ExecuteScript executes a script at the latest sealed block.
+	ExecuteScript(ctx context.Context, script []byte, arguments []cadence.Value) (cadence.Value, error)
+}
+
+// Chain is the network that the transition runs on.
+type Chain interface {
+	ScriptExecutor
+
+	// PrepareTransaction returns a transaction with the script and arguments,
+	// signed by the admin with the current sequence number of its key.
+	PrepareTransaction(ctx context.Context, script []byte, arguments []cadence.Value) (*flow.Transaction, error)
+
+	// SendTransaction sends a signed transaction without waiting for its result.
+	SendTransaction(ctx context.Context, tx *flow.Transaction) error
+
+	// GetTransactionResult returns the result of a transaction.
+	// The status of a transaction that the network doesn't know is TransactionStatusUnknown.
+	GetTransactionResult(ctx context.Context, id flow.Identifier) (*flow.TransactionResult, error)
+}
+
+// newAdminTransaction returns a transaction that the admin proposes, pays for and authorizes.
+func newAdminTransaction(
+	admin Admin,
+	referenceBlockID flow.Identifier,
+	sequenceNumber uint64,
+	script []byte,
+	arguments []cadence.Value,
+) (*flow.Transaction, error) {
+	tx := flow.NewTransaction().
+		SetScript(script).
+		SetGasLimit(gasLimit).
+		SetReferenceBlockID(referenceBlockID).
+		SetProposalKey(admin.Address, admin.KeyIndex, sequenceNumber).
+		SetPayer(admin.Address).
+		AddAuthorizer(admin.Address)
+
+	for _, argument := range arguments {
+		if err := tx.AddArgument(argument); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.SignEnvelope(admin.Address, admin.KeyIndex, admin.Signer); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// sequenceNumber returns the sequence number of the key of the admin.
+func sequenceNumber(account *flow.Account, admin Admin) (uint64, error) {
+	for _, key := range account.Keys {
+		if key.Index == admin.KeyIndex {
+			return key.SequenceNumber, nil
+		}
+	}
+
+	return 0, fmt.Errorf("admin account %s has no key %d", admin.Address, admin.KeyIndex)
+}
+
+// ClientChain runs the transition on a network through an access node.
+type ClientChain struct {
+	client *client.Client
+	admin  Admin
+}
+
+var _ Chain = &ClientChain{}
+
+// NewClientChain returns a chain that sends the transactions of the admin to an access node.
+func NewClientChain(flowClient *client.Client, admin Admin) *ClientChain {
+	return &ClientChain{client: flowClient, admin: admin}
+}
+
+func (c *ClientChain) ExecuteScript(ctx context.Context, script []byte, arguments []cadence.Value) (cadence.Value, error) {
+	return c.client.ExecuteScriptAtLatestBlock(ctx, script, arguments)
+}
+
+func (c *ClientChain) PrepareTransaction(ctx context.Context, script []byte, arguments []cadence.Value) (*flow.Transaction, error) {
+	account, err := c.client.GetAccountAtLatestBlock(ctx, c.admin.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := sequenceNumber(account, c.admin)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := c.client.GetLatestBlockHeader(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return newAdminTransaction(c.admin, block.ID, sequence, script, arguments)
+}
+
+func (c *ClientChain) SendTransaction(ctx context.Context, tx *flow.Transaction) error {
+	return c.client.SendTransaction(ctx, *tx)
+}
+
+func (c *ClientChain) GetTransactionResult(ctx context.Context, id flow.Identifier) (*flow.TransactionResult, error) {
+	result, err := c.client.GetTransactionResult(ctx, id)
+	if status.Code(err) == codes.NotFound {
+		return &flow.TransactionResult{Status: flow.TransactionStatusUnknown}, nil
+	}
+
+	return result, err
+}
+
+// EmulatorChain runs the transition on an emulator blockchain,
+// which executes every transaction in its own block.
+type EmulatorChain struct {
+	blockchain *emulator.Blockchain
+	admin      Admin
+}
+
+var _ Chain = &EmulatorChain{}
+
+// NewEmulatorChain returns a chain that sends the transactions of the admin to an emulator blockchain.
+func NewEmulatorChain(b *emulator.Blockchain, admin Admin) *EmulatorChain {
+	return &EmulatorChain{blockchain: b, admin: admin}
+}
+
+func (c *EmulatorChain) ExecuteScript(_ context.Context, script []byte, arguments []cadence.Value) (cadence.Value, error) {
+	encoded := make([][]byte, len(arguments))
+	for i, argument := range arguments {
+		var err error
+		encoded[i], err = jsoncdc.Encode(argument)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result, err := c.blockchain.ExecuteScript(script, encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Succeeded() {
+		return nil, result.Error
+	}
+
+	return result.Value, nil
+}
+
+func (c *EmulatorChain) PrepareTransaction(_ context.Context, script []byte, arguments []cadence.Value) (*flow.Transaction, error) {
+	account, err := c.blockchain.GetAccount(c.admin.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := sequenceNumber(account, c.admin)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := c.blockchain.GetLatestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return newAdminTransaction(c.admin, flow.Identifier(block.ID()), sequence, script, arguments)
+}
+
+func (c *EmulatorChain) SendTransaction(_ context.Context, tx *flow.Transaction) error {
+	if err := c.blockchain.AddTransaction(*tx); err != nil {
+		return err
+	}
+
+	_, _, err := c.blockchain.ExecuteAndCommitBlock()
+	return err
+}
+
+func (c *EmulatorChain) GetTransactionResult(_ context.Context, id flow.Identifier) (*flow.TransactionResult, error) {
+	return c.blockchain.GetTransactionResult(id)
+}
+
+// CopyEmulator copies the database of an emulator that was started with persistence,
+// like `flow emulator --persist`, and returns a blockchain that runs on the copy,
+// so a transition can be tried out without changing the original database.
+// The emulator should be stopped, so the database isn't copied while it is written.
+//
+// close closes the blockchain and removes the copy.
+func CopyEmulator(dbPath string, opts ...emulator.Option) (b *emulator.Blockchain, close func() error, err error) {
+	dir, err := ioutil.TempDir("", "transition")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := copyDir(dbPath, dir); err != nil {
+		os.RemoveAll(dir)
+		return nil, nil, fmt.Errorf("could not copy the emulator database: %w", err)
+	}
+
+	store, err := badger.New(badger.WithPath(dir), badger.WithTruncate(true))
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, nil, err
+	}
+
+	close = func() error {
+		defer os.RemoveAll(dir)
+		return store.Close()
+	}
+
+	b, err = emulator.NewBlockchain(append([]emulator.Option{emulator.WithStore(store)}, opts...)...)
+	if err != nil {
+		close()
+		return nil, nil, err
+	}
+
+	return b, close, nil
+}
+
+// copyDir copies the files of a directory, except the lock file of the database.
+func copyDir(src, dst string) error {
+	files, err := ioutil.ReadDir(src)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if file.IsDir() || file.Name() == "LOCK" {
+			continue
+		}
+
+		if err := copyFile(filepath.Join(src, file.Name()), filepath.Join(dst, file.Name()), file.Mode()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func copyFile(src, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+
+	return out.Close()
+}
@@ -0,0 +1,654 @@
+package transition
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/onflow/flow-core-contracts/lib/go/model"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// Plan is what a transition is expected to do, computed from the state before it starts.
+type Plan struct {
+	Epoch           uint64   `json:"epoch"`
+	ApprovedNodeIDs []string `json:"approvedNodeIDs"`
+
+	// Roles are the roles of all the nodes.
+	Roles map[string]uint8 `json:"roles"`
+
+	// StakedNodeIDs are the nodes that are staked before the transition.
+	StakedNodeIDs []string `json:"stakedNodeIDs"`
+
+	// ProposedNodeIDs are the nodes that have enough tokens committed for the next epoch.
+	ProposedNodeIDs []string `json:"proposedNodeIDs"`
+
+	// RemovedNodeIDs are the nodes that end_staking removes and refunds,
+	// because they are not approved or not proposed.
+	RemovedNodeIDs []string `json:"removedNodeIDs"`
+
+	// NextStakedNodeIDs are the nodes that are staked after the transition,
+	// the nodes that are approved and proposed.
+	NextStakedNodeIDs []string `json:"nextStakedNodeIDs"`
+
+	// Payout is the epoch token payout.
+	Payout ufix64.UFix64 `json:"payout"`
+
+	// Rewards are the rewards that pay_rewards is expected to pay.
+	Rewards []Reward `json:"rewards"`
+
+	// TotalStakedByRole are the total staked tokens of every role before the transition.
+	TotalStakedByRole map[uint8]ufix64.UFix64 `json:"totalStakedByRole"`
+
+	// RewardRatios are the ratios of the payout that the nodes of every role are paid.
+	RewardRatios map[uint8]ufix64.UFix64 `json:"rewardRatios"`
+}
+
+// TotalRewards returns the sum of the expected rewards, which is the amount
+// pay_rewards is expected to mint.
+func (p Plan) TotalRewards() (ufix64.UFix64, error) {
+	var total ufix64.UFix64
+	for _, reward := range p.Rewards {
+		var err error
+		total, err = total.Add(reward.Amount)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return total, nil
+}
+
+// Reward is the reward of a node operator, including the cut of its delegators' rewards,
+// or the reward of a delegator after the cut.
+type Reward struct {
+	NodeID      string        `json:"nodeID"`
+	DelegatorID uint32        `json:"delegatorID,omitempty"`
+	Amount      ufix64.UFix64 `json:"amount"`
+}
+
+func (r Reward) String() string {
+	if r.DelegatorID == 0 {
+		return fmt.Sprintf("node %s: %s", r.NodeID, r.Amount)
+	}
+
+	return fmt.Sprintf("delegator %d of node %s: %s", r.DelegatorID, r.NodeID, r.Amount)
+}
+
+func executeScript(ctx context.Context, scripts ScriptExecutor, script []byte, arguments ...cadence.Value) (cadence.Value, error) {
+	value, err := scripts.ExecuteScript(ctx, script, arguments)
+	if err != nil {
+		return nil, fmt.Errorf("could not execute script: %w", err)
+	}
+
+	return value, nil
+}
+
+func nodeIDs(ctx context.Context, scripts ScriptExecutor, script []byte) ([]string, error) {
+	value, err := executeScript(ctx, scripts, script)
+	if err != nil {
+		return nil, err
+	}
+
+	array, ok := value.(cadence.Array)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T for the node IDs", value)
+	}
+
+	ids := make([]string, len(array.Values))
+	for i, element := range array.Values {
+		id, ok := element.(cadence.String)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type %T for a node ID", element)
+		}
+
+		ids[i] = string(id)
+	}
+
+	sort.Strings(ids)
+
+	return ids, nil
+}
+
+func amount(ctx context.Context, scripts ScriptExecutor, script []byte, arguments ...cadence.Value) (ufix64.UFix64, error) {
+	value, err := executeScript(ctx, scripts, script, arguments...)
+	if err != nil {
+		return 0, err
+	}
+
+	amount, ok := value.(cadence.UFix64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected type %T for an amount", value)
+	}
+
+	return ufix64.UFix64(amount), nil
+}
+
+func (o *Orchestrator) supplyTotals(ctx context.Context) (model.SupplyTotals, error) {
+	value, err := executeScript(ctx, o.chain, templates.GenerateGetSupplyTotalsScript(o.conf.Env), cadence.NewArray([]cadence.Value{}))
+	if err != nil {
+		return model.SupplyTotals{}, err
+	}
+
+	return model.DecodeSupplyTotals(value)
+}
+
+// supplyCheck checks the supply invariants. The FLOW held by the staking account
+// is not checked, because it is only known if the balances of all the other accounts
+// are known, and the vault of FlowFees can't be read by a script.
+func (o *Orchestrator) supplyCheck(ctx context.Context, step string) (Check, error) {
+	totals, err := o.supplyTotals(ctx)
+	if err != nil {
+		return Check{}, err
+	}
+
+	check := Check{Step: step, Name: "supply invariants", Passed: true}
+
+	for _, violation := range model.CheckSupply(totals) {
+		if violation.Role == 0 {
+			continue
+		}
+
+		check.Passed = false
+		check.Details = append(check.Details, violation.String())
+	}
+
+	return check, nil
+}
+
+// plan reads the state of the contract and checks the preconditions of the transition.
+func (o *Orchestrator) plan(ctx context.Context) (Plan, []Check, error) {
+	env := o.conf.Env
+
+	plan := Plan{
+		Epoch:             o.conf.Epoch,
+		ApprovedNodeIDs:   o.conf.ApprovedNodeIDs,
+		Roles:             map[string]uint8{},
+		TotalStakedByRole: map[uint8]ufix64.UFix64{},
+		RewardRatios:      map[uint8]ufix64.UFix64{},
+	}
+
+	allNodeIDs, err := nodeIDs(ctx, o.chain, templates.GenerateReturnTableScript(env))
+	if err != nil {
+		return Plan{}, nil, err
+	}
+
+	plan.StakedNodeIDs, err = nodeIDs(ctx, o.chain, templates.GenerateReturnCurrentTableScript(env))
+	if err != nil {
+		return Plan{}, nil, err
+	}
+
+	plan.ProposedNodeIDs, err = nodeIDs(ctx, o.chain, templates.GenerateReturnProposedTableScript(env))
+	if err != nil {
+		return Plan{}, nil, err
+	}
+
+	approved := set(plan.ApprovedNodeIDs)
+	proposed := set(plan.ProposedNodeIDs)
+
+	for _, id := range allNodeIDs {
+		if approved[id] && proposed[id] {
+			plan.NextStakedNodeIDs = append(plan.NextStakedNodeIDs, id)
+		} else {
+			plan.RemovedNodeIDs = append(plan.RemovedNodeIDs, id)
+		}
+	}
+
+	payout, err := amount(ctx, o.chain, templates.GenerateGetWeeklyPayoutScript(env))
+	if err != nil {
+		return Plan{}, nil, err
+	}
+
+	plan.Payout = payout
+
+	cut, err := amount(ctx, o.chain, templates.GenerateGetCutPercentageScript(env))
+	if err != nil {
+		return Plan{}, nil, err
+	}
+
+	value, err := executeScript(ctx, o.chain, templates.GenerateGetRewardRatiosScript(env))
+	if err != nil {
+		return Plan{}, nil, err
+	}
+
+	ratios, err := model.DecodeRoleAmounts(value)
+	if err != nil {
+		return Plan{}, nil, err
+	}
+
+	for role, ratio := range ratios {
+		plan.RewardRatios[role] = ratio
+	}
+
+	totals, err := o.supplyTotals(ctx)
+	if err != nil {
+		return Plan{}, nil, err
+	}
+
+	for role, roleTotals := range totals.Roles {
+		plan.TotalStakedByRole[role] = roleTotals.TotalTokensStakedByNodeType
+	}
+
+	for _, id := range allNodeIDs {
+		rewards, role, err := o.nodeRewards(ctx, id, plan, payout, cut)
+		if err != nil {
+			return Plan{}, nil, err
+		}
+
+		plan.Roles[id] = role
+		plan.Rewards = append(plan.Rewards, rewards...)
+	}
+
+	supply, err := o.supplyCheck(ctx, StepPreconditions)
+	if err != nil {
+		return Plan{}, nil, err
+	}
+
+	checks := []Check{
+		supply,
+		approvedCheck(plan, proposed),
+		tableCheck(plan),
+		rewardsCheck(plan),
+	}
+
+	return plan, checks, nil
+}
+
+// nodeRewards returns the rewards that pay_rewards pays to a node and its delegators,
+// given the reward ratios and total staked tokens of the roles in the plan, and the role of the node.
+func (o *Orchestrator) nodeRewards(
+	ctx context.Context,
+	nodeID string,
+	plan Plan,
+	payout, cut ufix64.UFix64,
+) ([]Reward, uint8, error) {
+	env := o.conf.Env
+
+	value, err := executeScript(ctx, o.chain, templates.GenerateGetNodeInfoScript(env), cadence.NewString(nodeID))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	node, err := model.DecodeNodeInfo(value)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ratio := plan.RewardRatios[node.Role]
+	totalStaked := plan.TotalStakedByRole[node.Role]
+
+	if ratio == 0 || node.TokensStaked == 0 || totalStaked == 0 {
+		return nil, node.Role, nil
+	}
+
+	delegatorsStaked := make([]ufix64.UFix64, len(node.Delegators))
+	for i, delegatorID := range node.Delegators {
+		value, err := executeScript(ctx, o.chain, templates.GenerateGetDelegatorInfoScript(env),
+			cadence.NewString(nodeID), cadence.NewUInt32(delegatorID))
+		if err != nil {
+			return nil, 0, err
+		}
+
+		delegator, err := model.DecodeDelegatorInfo(value)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		delegatorsStaked[i] = delegator.TokensStaked
+	}
+
+	projected, err := model.ProjectRewards(node.TokensStaked, delegatorsStaked, totalStaked, model.RolePayout(payout, ratio), cut)
+	if err != nil {
+		return nil, 0, fmt.Errorf("could not compute the rewards of node %s: %w", nodeID, err)
+	}
+
+	var rewards []Reward
+
+	for i, amount := range projected.Delegators {
+		if amount > 0 {
+			rewards = append(rewards, Reward{NodeID: nodeID, DelegatorID: node.Delegators[i], Amount: ufix64.UFix64(amount)})
+		}
+	}
+
+	if projected.Node > 0 {
+		rewards = append(rewards, Reward{NodeID: nodeID, Amount: projected.Node})
+	}
+
+	return rewards, node.Role, nil
+}
+
+func approvedCheck(plan Plan, proposed map[string]bool) Check {
+	check := Check{Step: StepPreconditions, Name: "approved nodes are proposed", Passed: true}
+
+	if len(plan.ApprovedNodeIDs) == 0 {
+		check.Passed = false
+		check.Details = append(check.Details, "no node is approved")
+	}
+
+	for _, id := range plan.ApprovedNodeIDs {
+		if !proposed[id] {
+			check.Passed = false
+			check.Details = append(check.Details, fmt.Sprintf("node %s is approved but not proposed", id))
+		}
+	}
+
+	return check
+}
+
+// tableCheck compares the staked table with the proposed table. It always passes.
+func tableCheck(plan Plan) Check {
+	staked := set(plan.StakedNodeIDs)
+	next := set(plan.NextStakedNodeIDs)
+
+	check := Check{Step: StepPreconditions, Name: "proposed and staked tables", Passed: true}
+
+	for _, id := range plan.NextStakedNodeIDs {
+		if !staked[id] {
+			check.Details = append(check.Details, fmt.Sprintf("node %s joins", id))
+		}
+	}
+
+	for _, id := range plan.StakedNodeIDs {
+		if !next[id] {
+			check.Details = append(check.Details, fmt.Sprintf("node %s leaves", id))
+		}
+	}
+
+	check.Details = append(check.Details, fmt.Sprintf(
+		"%d nodes staked, %d proposed, %d staked next epoch, %d removed",
+		len(plan.StakedNodeIDs), len(plan.ProposedNodeIDs), len(plan.NextStakedNodeIDs), len(plan.RemovedNodeIDs),
+	))
+
+	return check
+}
+
+// rewardsCheck checks that the expected rewards don't exceed the payout.
+func rewardsCheck(plan Plan) Check {
+	check := Check{Step: StepPreconditions, Name: "expected rewards", Passed: true}
+
+	total, err := plan.TotalRewards()
+	if err != nil {
+		check.Passed = false
+		check.Details = append(check.Details, err.Error())
+		return check
+	}
+
+	if total > plan.Payout {
+		check.Passed = false
+	}
+
+	check.Details = append(check.Details, fmt.Sprintf("%d rewards of %s in total, the payout is %s", len(plan.Rewards), total, plan.Payout))
+
+	return check
+}
+
+// events returns the fields of the FlowIDTableStaking events of a type in a transaction result.
+func (o *Orchestrator) events(result *flow.TransactionResult, name string) []map[string]cadence.Value {
+	eventType := fmt.Sprintf("A.%s.FlowIDTableStaking.%s", flow.HexToAddress(o.conf.Env.IDTableAddress).Hex(), name)
+
+	var events []map[string]cadence.Value
+
+	for _, event := range result.Events {
+		if event.Type != eventType {
+			continue
+		}
+
+		fields := make(map[string]cadence.Value, len(event.Value.Fields))
+		for i, field := range event.Value.EventType.Fields {
+			if i < len(event.Value.Fields) {
+				fields[field.Identifier] = event.Value.Fields[i]
+			}
+		}
+
+		events = append(events, fields)
+	}
+
+	return events
+}
+
+func (o *Orchestrator) checkEndStaking(_ context.Context, plan Plan, result *flow.TransactionResult) ([]Check, error) {
+	var removed []string
+	for _, fields := range o.events(result, "NodeRemovedAndRefunded") {
+		id, _ := fields["nodeID"].(cadence.String)
+		removed = append(removed, string(id))
+	}
+
+	return []Check{setCheck(StepEndStaking, "removed nodes", "NodeRemovedAndRefunded event", plan.RemovedNodeIDs, removed)}, nil
+}
+
+func (o *Orchestrator) checkPayRewards(ctx context.Context, plan Plan, result *flow.TransactionResult) ([]Check, error) {
+	check := Check{Step: StepPayRewards, Name: "rewards", Passed: true}
+
+	expected := map[Reward]bool{}
+	for _, reward := range plan.Rewards {
+		expected[reward] = true
+	}
+
+	paid := map[Reward]bool{}
+	for _, name := range []string{"RewardsPaid", "DelegatorRewardsPaid"} {
+		for _, fields := range o.events(result, name) {
+			nodeID, _ := fields["nodeID"].(cadence.String)
+			delegatorID, _ := fields["delegatorID"].(cadence.UInt32)
+			amount, _ := fields["amount"].(cadence.UFix64)
+
+			paid[Reward{NodeID: string(nodeID), DelegatorID: uint32(delegatorID), Amount: ufix64.UFix64(amount)}] = true
+		}
+	}
+
+	for _, reward := range plan.Rewards {
+		if !paid[reward] {
+			check.Passed = false
+			check.Details = append(check.Details, fmt.Sprintf("expected reward was not paid: %s", reward))
+		}
+	}
+
+	var unexpected []string
+	for reward := range paid {
+		if !expected[reward] {
+			unexpected = append(unexpected, fmt.Sprintf("unexpected reward was paid: %s", reward))
+		}
+	}
+
+	sort.Strings(unexpected)
+	if len(unexpected) > 0 {
+		check.Passed = false
+		check.Details = append(check.Details, unexpected...)
+	}
+
+	total, err := totalRewardsCheck(plan, o.events(result, "TotalRewardsPaid"))
+	if err != nil {
+		return nil, err
+	}
+
+	supply, err := o.supplyCheck(ctx, StepPayRewards)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Check{check, total, supply}, nil
+}
+
+// totalRewardsCheck checks that the TotalRewardsPaid event has the sum of the expected rewards,
+// which is what pay_rewards mints, and not the payout.
+func totalRewardsCheck(plan Plan, events []map[string]cadence.Value) (Check, error) {
+	check := Check{Step: StepPayRewards, Name: "TotalRewardsPaid event", Passed: true}
+
+	if len(events) != 1 {
+		check.Passed = false
+		check.Details = append(check.Details, fmt.Sprintf("expected one TotalRewardsPaid event, got %d", len(events)))
+		return check, nil
+	}
+
+	expected, err := plan.TotalRewards()
+	if err != nil {
+		return Check{}, err
+	}
+
+	paid, _ := events[0]["amount"].(cadence.UFix64)
+
+	if ufix64.UFix64(paid) != expected {
+		check.Passed = false
+		check.Details = append(check.Details, fmt.Sprintf("the event has %s FLOW of rewards paid, expected %s", ufix64.UFix64(paid), expected))
+	}
+
+	return check, nil
+}
+
+func (o *Orchestrator) checkMoveTokens(ctx context.Context, plan Plan, result *flow.TransactionResult) ([]Check, error) {
+	env := o.conf.Env
+
+	totalStaked, err := amount(ctx, o.chain, templates.GenerateGetTotalTokensStakedScript(env))
+	if err != nil {
+		return nil, err
+	}
+
+	epoch := Check{Step: StepMoveTokens, Name: "NewEpoch event", Passed: true}
+
+	newEpochs := o.events(result, "NewEpoch")
+	if len(newEpochs) != 1 {
+		epoch.Passed = false
+		epoch.Details = append(epoch.Details, fmt.Sprintf("expected one NewEpoch event, got %d", len(newEpochs)))
+	} else {
+		staked, _ := newEpochs[0]["totalStaked"].(cadence.UFix64)
+		payout, _ := newEpochs[0]["totalRewardPayout"].(cadence.UFix64)
+
+		if ufix64.UFix64(staked) != totalStaked {
+			epoch.Passed = false
+			epoch.Details = append(epoch.Details, fmt.Sprintf("the event has %s FLOW staked, the contract %s", ufix64.UFix64(staked), totalStaked))
+		}
+
+		if ufix64.UFix64(payout) != plan.Payout {
+			epoch.Passed = false
+			epoch.Details = append(epoch.Details, fmt.Sprintf("the event has a payout of %s, expected %s", ufix64.UFix64(payout), plan.Payout))
+		}
+	}
+
+	totals, err := o.stakedTotalsCheck(ctx, plan, result)
+	if err != nil {
+		return nil, err
+	}
+
+	staked, err := nodeIDs(ctx, o.chain, templates.GenerateReturnCurrentTableScript(env))
+	if err != nil {
+		return nil, err
+	}
+
+	table := setCheck(StepMoveTokens, "staked table", "staked node", plan.NextStakedNodeIDs, staked)
+
+	supply, err := o.supplyCheck(ctx, StepMoveTokens)
+	if err != nil {
+		return nil, err
+	}
+
+	return []Check{epoch, totals, table, supply}, nil
+}
+
+// stakedTotalsCheck checks that the total staked tokens of every role changed by the
+// amounts of the tokens staked and unstaking events of the nodes and delegators of the role.
+func (o *Orchestrator) stakedTotalsCheck(ctx context.Context, plan Plan, result *flow.TransactionResult) (Check, error) {
+	check := Check{Step: StepMoveTokens, Name: "staked totals", Passed: true}
+
+	type change struct{ added, removed ufix64.UFix64 }
+	changes := map[uint8]*change{}
+
+	for role := range plan.TotalStakedByRole {
+		changes[role] = &change{}
+	}
+
+	events := map[string]bool{
+		"TokensStaked":             true,
+		"DelegatorTokensStaked":    true,
+		"TokensUnstaking":          false,
+		"DelegatorTokensUnstaking": false,
+	}
+
+	for name, added := range events {
+		for _, fields := range o.events(result, name) {
+			nodeID, _ := fields["nodeID"].(cadence.String)
+			amount, _ := fields["amount"].(cadence.UFix64)
+
+			role := plan.Roles[string(nodeID)]
+			if changes[role] == nil {
+				changes[role] = &change{}
+			}
+
+			var err error
+			if added {
+				changes[role].added, err = changes[role].added.Add(ufix64.UFix64(amount))
+			} else {
+				changes[role].removed, err = changes[role].removed.Add(ufix64.UFix64(amount))
+			}
+			if err != nil {
+				return Check{}, err
+			}
+		}
+	}
+
+	totals, err := o.supplyTotals(ctx)
+	if err != nil {
+		return Check{}, err
+	}
+
+	roles := make([]int, 0, len(changes))
+	for role := range changes {
+		roles = append(roles, int(role))
+	}
+	sort.Ints(roles)
+
+	for _, r := range roles {
+		role := uint8(r)
+		after := totals.Roles[role].TotalTokensStakedByNodeType
+
+		expected, err := plan.TotalStakedByRole[role].Add(changes[role].added)
+		if err == nil {
+			expected, err = expected.Sub(changes[role].removed)
+		}
+
+		if err != nil || expected != after {
+			check.Passed = false
+			check.Details = append(check.Details, fmt.Sprintf(
+				"role %d has %s FLOW staked, expected %s plus %s staked minus %s unstaking",
+				role, after, plan.TotalStakedByRole[role], changes[role].added, changes[role].removed,
+			))
+		}
+	}
+
+	return check, nil
+}
+
+// setCheck checks that the actual node IDs are the expected ones.
+func setCheck(step, name, what string, expected, actual []string) Check {
+	check := Check{Step: step, Name: name, Passed: true}
+
+	expectedSet := set(expected)
+	actualSet := set(actual)
+
+	for _, id := range sortedCopy(expected) {
+		if !actualSet[id] {
+			check.Passed = false
+			check.Details = append(check.Details, fmt.Sprintf("missing %s for node %s", what, id))
+		}
+	}
+
+	for _, id := range sortedCopy(actual) {
+		if !expectedSet[id] {
+			check.Passed = false
+			check.Details = append(check.Details, fmt.Sprintf("unexpected %s for node %s", what, id))
+		}
+	}
+
+	return check
+}
+
+func set(ids []string) map[string]bool {
+	s := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		s[id] = true
+	}
+
+	return s
+}
@@ -0,0 +1,141 @@
+package transition
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// State is the progress of a transition, which is saved in a journal after every change,
+// so an interrupted transition can be resumed.
+type State struct {
+	Plan  Plan        `json:"plan"`
+	Steps []StepState `json:"steps"`
+}
+
+// StepState is the progress of one step of a transition.
+type StepState struct {
+	Name string `json:"name"`
+
+	// Transaction is the encoded signed transaction of the step. It is saved before it is sent,
+	// so a resumed transition sends the same transaction again instead of a new one,
+	// which the network rejects if the first one was executed.
+	Transaction []byte `json:"transaction,omitempty"`
+
+	// Sealed is true once the transaction is sealed and succeeded.
+	Sealed bool `json:"sealed"`
+
+	// Checked is true once the post-conditions of the step hold.
+	Checked bool `json:"checked"`
+}
+
+// Done returns true if all the steps of the transition are sealed and checked.
+func (s *State) Done() bool {
+	for _, step := range s.Steps {
+		if !step.Checked {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Journal stores the state of the last transition.
+type Journal interface {
+	// Load returns the saved state, or nil if no state was saved yet.
+	Load() (*State, error)
+
+	// Save replaces the saved state.
+	Save(state *State) error
+}
+
+// FileJournal is a journal in a JSON file.
+type FileJournal struct {
+	path string
+}
+
+var _ Journal = &FileJournal{}
+
+// NewFileJournal returns a journal stored in the file at the path.
+func NewFileJournal(path string) *FileJournal {
+	return &FileJournal{path: path}
+}
+
+func (j *FileJournal) Load() (*State, error) {
+	data, err := ioutil.ReadFile(j.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+// Save writes the state to a temporary file and renames it,
+// so the journal is never left half written.
+func (j *FileJournal) Save(state *State) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(j.path), filepath.Base(j.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), j.path)
+}
+
+// MemoryJournal is a journal that is lost when the process exits, for dry runs.
+type MemoryJournal struct {
+	state []byte
+}
+
+var _ Journal = &MemoryJournal{}
+
+func (j *MemoryJournal) Load() (*State, error) {
+	if j.state == nil {
+		return nil, nil
+	}
+
+	var state State
+	if err := json.Unmarshal(j.state, &state); err != nil {
+		return nil, err
+	}
+
+	return &state, nil
+}
+
+func (j *MemoryJournal) Save(state *State) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	j.state = data
+	return nil
+}
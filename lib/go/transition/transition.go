@@ -0,0 +1,395 @@
+// Package transition ends a staking epoch and starts the next one with the FlowIDTableStaking
+// admin transactions, and checks the state of the contract before and after every step.
+//
+// The transition runs end_staking.cdc, pay_rewards.cdc and move_tokens.cdc in order:
+//
+//   - before it starts, the supply invariants must hold and all the approved nodes must be proposed.
+//     The expected rewards, removed nodes and next staked table are computed from the current state
+//   - after end_staking, the removed nodes must match the NodeRemovedAndRefunded events
+//   - after pay_rewards, the RewardsPaid and DelegatorRewardsPaid events must match the expected
+//     rewards, the TotalRewardsPaid event must have their sum and the supply invariants must hold
+//   - after move_tokens, the NewEpoch event must match the payout and the total staked, the
+//     staked totals must have changed by the amounts of the events, the staked table must be
+//     the expected one and the supply invariants must hold
+//
+// The progress is saved in a Journal, including every signed transaction before it is sent,
+// so an interrupted transition resumes where it stopped and a finished transition isn't run twice.
+//
+//	orchestrator := transition.New(
+//	    transition.NewClientChain(flowClient, admin),
+//	    transition.NewFileJournal("transition.json"),
+//	    transition.Config{Env: env, Epoch: 42, ApprovedNodeIDs: approved},
+//	)
+//
+//	report, err := orchestrator.Run(ctx)
+//
+// A dry run runs the transition on a copy of an emulator database, see CopyEmulator,
+// with a MemoryJournal.
+package transition
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+)
+
+// The steps of a transition.
+const (
+	StepPreconditions = "preconditions"
+	StepEndStaking    = "end_staking"
+	StepPayRewards    = "pay_rewards"
+	StepMoveTokens    = "move_tokens"
+)
+
+// DefaultPollInterval is the interval at which the result of a sent transaction is requested.
+const DefaultPollInterval = time.Second
+
+// ErrCheckFailed is returned when a check of the transition fails.
+var ErrCheckFailed = errors.New("check failed")
+
+// Config contains the transition to run.
+type Config struct {
+	// Env contains the addresses of the core contracts.
+	Env templates.Environment
+
+	// Epoch is the counter of the epoch that the transition ends.
+	// The journal uses it to recognize a transition that already ran.
+	Epoch uint64
+
+	// ApprovedNodeIDs are the nodes that are approved for the next epoch.
+	ApprovedNodeIDs []string
+
+	// PollInterval is the interval at which the result of a sent transaction is requested.
+	// If it is zero, DefaultPollInterval is used.
+	PollInterval time.Duration
+}
+
+// Check is the result of a check of the transition.
+type Check struct {
+	Step    string
+	Name    string
+	Passed  bool
+	Details []string
+}
+
+// Transaction is the transaction that ran a step.
+type Transaction struct {
+	Step string
+	ID   flow.Identifier
+}
+
+// Report is what a transition did.
+type Report struct {
+	Plan         Plan
+	Checks       []Check
+	Transactions []Transaction
+
+	// AlreadyDone is true if the journal shows that the transition of the epoch finished,
+	// in which case nothing was sent.
+	AlreadyDone bool
+}
+
+// Failed returns the checks that failed.
+func (r Report) Failed() []Check {
+	var failed []Check
+	for _, check := range r.Checks {
+		if !check.Passed {
+			failed = append(failed, check)
+		}
+	}
+
+	return failed
+}
+
+// Orchestrator runs a transition on a chain.
+type Orchestrator struct {
+	chain   Chain
+	journal Journal
+	conf    Config
+}
+
+// New returns an orchestrator that runs the transition of the configuration on the chain
+// and saves its progress in the journal.
+func New(chain Chain, journal Journal, conf Config) *Orchestrator {
+	if conf.PollInterval == 0 {
+		conf.PollInterval = DefaultPollInterval
+	}
+
+	conf.ApprovedNodeIDs = sortedCopy(conf.ApprovedNodeIDs)
+
+	return &Orchestrator{
+		chain:   chain,
+		journal: journal,
+		conf:    conf,
+	}
+}
+
+// step is a transaction of the transition and the checks of its result.
+type step struct {
+	name      string
+	script    func(env templates.Environment) []byte
+	arguments func(plan Plan) []cadence.Value
+	check     func(ctx context.Context, plan Plan, result *flow.TransactionResult) ([]Check, error)
+}
+
+func (o *Orchestrator) steps() []step {
+	noArguments := func(Plan) []cadence.Value { return nil }
+
+	return []step{
+		{
+			name:   StepEndStaking,
+			script: templates.GenerateEndStakingScript,
+			arguments: func(plan Plan) []cadence.Value {
+				ids := make([]cadence.Value, len(plan.ApprovedNodeIDs))
+				for i, id := range plan.ApprovedNodeIDs {
+					ids[i] = cadence.NewString(id)
+				}
+
+				return []cadence.Value{cadence.NewArray(ids)}
+			},
+			check: o.checkEndStaking,
+		},
+		{
+			name:      StepPayRewards,
+			script:    templates.GeneratePayRewardsScript,
+			arguments: noArguments,
+			check:     o.checkPayRewards,
+		},
+		{
+			name:      StepMoveTokens,
+			script:    templates.GenerateMoveTokensScript,
+			arguments: noArguments,
+			check:     o.checkMoveTokens,
+		},
+	}
+}
+
+// Plan checks the preconditions of the transition and returns what it is expected to do,
+// without sending any transaction.
+func (o *Orchestrator) Plan(ctx context.Context) (Report, error) {
+	plan, checks, err := o.plan(ctx)
+	if err != nil {
+		return Report{}, err
+	}
+
+	report := Report{Plan: plan, Checks: checks}
+
+	if len(report.Failed()) > 0 {
+		return report, fmt.Errorf("%s: %w", StepPreconditions, ErrCheckFailed)
+	}
+
+	return report, nil
+}
+
+// Run runs the transition, or resumes it if the journal contains an unfinished transition
+// of the same epoch. Nothing is sent if the journal contains the finished transition of the epoch.
+//
+// Run stops at the first check that fails and returns an error that wraps ErrCheckFailed.
+// Running it again repeats the failed check.
+func (o *Orchestrator) Run(ctx context.Context) (Report, error) {
+	state, err := o.journal.Load()
+	if err != nil {
+		return Report{}, fmt.Errorf("could not load the journal: %w", err)
+	}
+
+	if state != nil {
+		switch {
+		case state.Plan.Epoch == o.conf.Epoch && state.Done():
+			return Report{Plan: state.Plan, AlreadyDone: true}, nil
+
+		case state.Plan.Epoch != o.conf.Epoch && !state.Done():
+			return Report{}, fmt.Errorf("the transition of epoch %d is not finished", state.Plan.Epoch)
+
+		case state.Plan.Epoch > o.conf.Epoch:
+			return Report{}, fmt.Errorf("the transition of epoch %d already ran", state.Plan.Epoch)
+
+		case state.Plan.Epoch == o.conf.Epoch && !equal(state.Plan.ApprovedNodeIDs, o.conf.ApprovedNodeIDs):
+			return Report{}, fmt.Errorf("the approved nodes differ from the unfinished transition of epoch %d", state.Plan.Epoch)
+		}
+	}
+
+	var report Report
+
+	if state == nil || state.Done() {
+		report, err = o.Plan(ctx)
+		if err != nil {
+			return report, err
+		}
+
+		state = &State{Plan: report.Plan}
+		for _, s := range o.steps() {
+			state.Steps = append(state.Steps, StepState{Name: s.name})
+		}
+
+		if err := o.journal.Save(state); err != nil {
+			return report, fmt.Errorf("could not save the journal: %w", err)
+		}
+	}
+
+	report.Plan = state.Plan
+
+	for i, s := range o.steps() {
+		progress := &state.Steps[i]
+
+		if progress.Checked {
+			id, err := transactionID(progress)
+			if err != nil {
+				return report, err
+			}
+
+			report.Transactions = append(report.Transactions, Transaction{Step: s.name, ID: id})
+			continue
+		}
+
+		result, id, err := o.execute(ctx, state, progress, s)
+		if err != nil {
+			return report, fmt.Errorf("%s: %w", s.name, err)
+		}
+
+		report.Transactions = append(report.Transactions, Transaction{Step: s.name, ID: id})
+
+		checks, err := s.check(ctx, state.Plan, result)
+		report.Checks = append(report.Checks, checks...)
+		if err != nil {
+			return report, fmt.Errorf("%s: %w", s.name, err)
+		}
+
+		for _, check := range checks {
+			if !check.Passed {
+				return report, fmt.Errorf("%s: %w", s.name, ErrCheckFailed)
+			}
+		}
+
+		progress.Checked = true
+		if err := o.journal.Save(state); err != nil {
+			return report, fmt.Errorf("could not save the journal: %w", err)
+		}
+	}
+
+	return report, nil
+}
+
+func transactionID(progress *StepState) (flow.Identifier, error) {
+	tx, err := flow.DecodeTransaction(progress.Transaction)
+	if err != nil {
+		return flow.EmptyID, fmt.Errorf("could not decode the %s transaction in the journal: %w", progress.Name, err)
+	}
+
+	return tx.ID(), nil
+}
+
+// execute sends the transaction of a step, or the one in the journal if it was prepared before,
+// and waits until it is sealed.
+//
+// A transaction that expired or failed is removed from the journal, so the step is retried
+// with a new transaction, since neither changed the state of the contract.
+func (o *Orchestrator) execute(ctx context.Context, state *State, progress *StepState, s step) (*flow.TransactionResult, flow.Identifier, error) {
+	for {
+		if progress.Transaction == nil {
+			tx, err := o.chain.PrepareTransaction(ctx, s.script(o.conf.Env), s.arguments(state.Plan))
+			if err != nil {
+				return nil, flow.EmptyID, fmt.Errorf("could not prepare the transaction: %w", err)
+			}
+
+			progress.Transaction = tx.Encode()
+			if err := o.journal.Save(state); err != nil {
+				return nil, flow.EmptyID, fmt.Errorf("could not save the journal: %w", err)
+			}
+		}
+
+		tx, err := flow.DecodeTransaction(progress.Transaction)
+		if err != nil {
+			return nil, flow.EmptyID, fmt.Errorf("could not decode the transaction in the journal: %w", err)
+		}
+
+		result, err := o.wait(ctx, tx)
+		if err != nil {
+			return nil, tx.ID(), err
+		}
+
+		if result.Status == flow.TransactionStatusExpired {
+			progress.Transaction = nil
+			if err := o.journal.Save(state); err != nil {
+				return nil, tx.ID(), fmt.Errorf("could not save the journal: %w", err)
+			}
+
+			continue
+		}
+
+		if result.Error != nil {
+			progress.Transaction = nil
+			if err := o.journal.Save(state); err != nil {
+				return nil, tx.ID(), fmt.Errorf("could not save the journal: %w", err)
+			}
+
+			return nil, tx.ID(), fmt.Errorf("transaction %s failed: %w", tx.ID(), result.Error)
+		}
+
+		progress.Sealed = true
+		if err := o.journal.Save(state); err != nil {
+			return nil, tx.ID(), fmt.Errorf("could not save the journal: %w", err)
+		}
+
+		return result, tx.ID(), nil
+	}
+}
+
+// wait sends the transaction if the chain doesn't know it and waits until it is sealed or expired.
+func (o *Orchestrator) wait(ctx context.Context, tx *flow.Transaction) (*flow.TransactionResult, error) {
+	sent := false
+
+	for {
+		result, err := o.chain.GetTransactionResult(ctx, tx.ID())
+		if err != nil {
+			return nil, fmt.Errorf("could not get the result of transaction %s: %w", tx.ID(), err)
+		}
+
+		switch result.Status {
+		case flow.TransactionStatusSealed, flow.TransactionStatusExpired:
+			return result, nil
+
+		case flow.TransactionStatusUnknown:
+			if !sent {
+				if err := o.chain.SendTransaction(ctx, tx); err != nil {
+					return nil, fmt.Errorf("could not send transaction %s: %w", tx.ID(), err)
+				}
+
+				sent = true
+				continue
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(o.conf.PollInterval):
+		}
+	}
+}
+
+func sortedCopy(ids []string) []string {
+	sorted := append([]string{}, ids...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
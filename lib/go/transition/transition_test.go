@@ -0,0 +1,283 @@
+package transition_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	emulator "github.com/onflow/flow-emulator"
+	"github.com/onflow/flow-emulator/storage/badger"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/testkit"
+	"github.com/onflow/flow-core-contracts/lib/go/transition"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// setup stakes three nodes and a delegator, and requests to unstake some tokens of the first node.
+// Only the first two nodes are approved for the next epoch.
+func setup(t *testing.T, opts ...emulator.Option) (*testkit.Fixture, transition.Config) {
+	f := testkit.New(t, opts...)
+
+	node1 := f.NewNode(testkit.NodeID(1), testkit.RoleCollection, ufix64.MustParse("500000.0"))
+	node2 := f.NewNode(testkit.NodeID(2), testkit.RoleConsensus, ufix64.MustParse("1000000.0"))
+	f.NewNode(testkit.NodeID(3), testkit.RoleCollection, ufix64.MustParse("300000.0"))
+	f.NewDelegator(node1.ID, ufix64.MustParse("100000.0"))
+
+	f.AdvanceEpoch()
+
+	f.RequestUnstaking(node1, ufix64.MustParse("50000.0"))
+	f.Mint(node2.Owner.Address, ufix64.MustParse("10000.0"))
+	f.CommitNewTokens(node2, ufix64.MustParse("10000.0"))
+
+	return f, transition.Config{
+		Env:             f.Env,
+		Epoch:           1,
+		ApprovedNodeIDs: []string{node2.ID, node1.ID},
+	}
+}
+
+func chain(f *testkit.Fixture) *transition.EmulatorChain {
+	return transition.NewEmulatorChain(f.Blockchain, admin(f))
+}
+
+func admin(f *testkit.Fixture) transition.Admin {
+	return transition.Admin{
+		Address:  f.StakingAdmin.Address,
+		KeyIndex: f.StakingAdmin.Key.Index,
+		Signer:   f.StakingAdmin.Signer,
+	}
+}
+
+func height(t *testing.T, b *emulator.Blockchain) uint64 {
+	block, err := b.GetLatestBlock()
+	require.NoError(t, err)
+
+	return block.Header.Height
+}
+
+func assertPassed(t *testing.T, report transition.Report) {
+	for _, check := range report.Checks {
+		assert.True(t, check.Passed, "%s: %s: %v", check.Step, check.Name, check.Details)
+	}
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+
+	f, conf := setup(t)
+
+	journal := &transition.MemoryJournal{}
+	orchestrator := transition.New(chain(f), journal, conf)
+
+	report, err := orchestrator.Run(ctx)
+	require.NoError(t, err)
+	assertPassed(t, report)
+
+	assert.False(t, report.AlreadyDone)
+	assert.Len(t, report.Checks, 12)
+	require.Len(t, report.Transactions, 3)
+	assert.Equal(t, transition.StepEndStaking, report.Transactions[0].Step)
+	assert.Equal(t, transition.StepPayRewards, report.Transactions[1].Step)
+	assert.Equal(t, transition.StepMoveTokens, report.Transactions[2].Step)
+
+	plan := report.Plan
+	assert.Equal(t, []string{testkit.NodeID(1), testkit.NodeID(2)}, plan.NextStakedNodeIDs)
+	assert.Equal(t, []string{testkit.NodeID(3)}, plan.RemovedNodeIDs)
+	assert.Len(t, plan.Rewards, 4)
+
+	f.AssertNodeBuckets(testkit.NodeID(3), testkit.Buckets{
+		Unstaking: ufix64.MustParse("300000.0"),
+		Rewarded:  rewardOf(plan, testkit.NodeID(3)),
+	})
+	f.AssertNodeBuckets(testkit.NodeID(1), testkit.Buckets{
+		Staked:    ufix64.MustParse("450000.0"),
+		Unstaking: ufix64.MustParse("50000.0"),
+		Rewarded:  rewardOf(plan, testkit.NodeID(1)),
+	})
+	f.AssertSupplyInvariants()
+
+	t.Run("Already done", func(t *testing.T) {
+		before := height(t, f.Blockchain)
+
+		report, err := orchestrator.Run(ctx)
+		require.NoError(t, err)
+
+		assert.True(t, report.AlreadyDone)
+		assert.Equal(t, plan, report.Plan)
+		assert.Equal(t, before, height(t, f.Blockchain))
+	})
+
+	t.Run("Earlier epoch", func(t *testing.T) {
+		conf := conf
+		conf.Epoch = 0
+
+		_, err := transition.New(chain(f), journal, conf).Run(ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("Next epoch", func(t *testing.T) {
+		conf := conf
+		conf.Epoch = 2
+		conf.ApprovedNodeIDs = f.ProposedNodeIDs()
+
+		report, err := transition.New(chain(f), journal, conf).Run(ctx)
+		require.NoError(t, err)
+		assertPassed(t, report)
+
+		assert.False(t, report.AlreadyDone)
+		assert.Len(t, report.Transactions, 3)
+	})
+}
+
+func rewardOf(plan transition.Plan, nodeID string) ufix64.UFix64 {
+	for _, reward := range plan.Rewards {
+		if reward.NodeID == nodeID && reward.DelegatorID == 0 {
+			return reward.Amount
+		}
+	}
+
+	return 0
+}
+
+// interruptedChain fails after a transaction with the script is sent,
+// like a process that stops before it sees the result.
+type interruptedChain struct {
+	transition.Chain
+	script []byte
+}
+
+var errInterrupted = errors.New("interrupted")
+
+func (c *interruptedChain) SendTransaction(ctx context.Context, tx *flow.Transaction) error {
+	if err := c.Chain.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+
+	if bytes.Equal(tx.Script, c.script) {
+		return errInterrupted
+	}
+
+	return nil
+}
+
+func TestResume(t *testing.T) {
+	ctx := context.Background()
+
+	f, conf := setup(t)
+
+	dir, err := ioutil.TempDir("", "transition")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "transition.json")
+
+	interrupted := &interruptedChain{
+		Chain:  chain(f),
+		script: templates.GeneratePayRewardsScript(f.Env),
+	}
+
+	_, err = transition.New(interrupted, transition.NewFileJournal(path), conf).Run(ctx)
+	require.True(t, errors.Is(err, errInterrupted), err)
+
+	state, err := transition.NewFileJournal(path).Load()
+	require.NoError(t, err)
+	require.Len(t, state.Steps, 3)
+	assert.True(t, state.Steps[0].Checked)
+	assert.NotNil(t, state.Steps[1].Transaction)
+	assert.False(t, state.Steps[1].Sealed)
+
+	// The approved nodes must not change while the transition is not finished
+	changed := conf
+	changed.ApprovedNodeIDs = []string{testkit.NodeID(1)}
+
+	_, err = transition.New(chain(f), transition.NewFileJournal(path), changed).Run(ctx)
+	assert.Error(t, err)
+
+	// The pay_rewards transaction was executed, so it is not sent again
+	report, err := transition.New(chain(f), transition.NewFileJournal(path), conf).Run(ctx)
+	require.NoError(t, err)
+	assertPassed(t, report)
+
+	// The checks of end_staking already passed and are not repeated
+	assert.Len(t, report.Checks, 7)
+	require.Len(t, report.Transactions, 3)
+
+	payRewards, err := flow.DecodeTransaction(state.Steps[1].Transaction)
+	require.NoError(t, err)
+	assert.Equal(t, payRewards.ID(), report.Transactions[1].ID)
+
+	assert.Equal(t, rewardOf(report.Plan, testkit.NodeID(2)), f.NodeBuckets(testkit.NodeID(2)).Rewarded)
+}
+
+func TestPreconditions(t *testing.T) {
+	ctx := context.Background()
+
+	f, conf := setup(t)
+	conf.ApprovedNodeIDs = append(conf.ApprovedNodeIDs, testkit.NodeID(4))
+
+	before := height(t, f.Blockchain)
+	journal := &transition.MemoryJournal{}
+
+	report, err := transition.New(chain(f), journal, conf).Run(ctx)
+	require.True(t, errors.Is(err, transition.ErrCheckFailed), err)
+
+	failed := report.Failed()
+	require.Len(t, failed, 1)
+	assert.Equal(t, transition.StepPreconditions, failed[0].Step)
+	assert.Equal(t, []string{"node " + testkit.NodeID(4) + " is approved but not proposed"}, failed[0].Details)
+
+	assert.Empty(t, report.Transactions)
+	assert.Equal(t, before, height(t, f.Blockchain))
+
+	state, err := journal.Load()
+	require.NoError(t, err)
+	assert.Nil(t, state)
+}
+
+func TestDryRun(t *testing.T) {
+	ctx := context.Background()
+
+	dir, err := ioutil.TempDir("", "emulator")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store, err := badger.New(badger.WithPath(dir))
+	require.NoError(t, err)
+
+	f, conf := setup(t, emulator.WithStore(store))
+	before := height(t, f.Blockchain)
+	nodeInfo := f.NodeInfo(testkit.NodeID(1))
+
+	require.NoError(t, store.Close())
+
+	b, closeCopy, err := transition.CopyEmulator(dir, emulator.WithStorageLimitEnabled(false))
+	require.NoError(t, err)
+
+	report, err := transition.New(transition.NewEmulatorChain(b, admin(f)), &transition.MemoryJournal{}, conf).Run(ctx)
+	require.NoError(t, err)
+	assertPassed(t, report)
+
+	assert.Greater(t, height(t, b), before)
+	require.NoError(t, closeCopy())
+
+	// The original database is unchanged
+	store, err = badger.New(badger.WithPath(dir))
+	require.NoError(t, err)
+	defer store.Close()
+
+	original, err := emulator.NewBlockchain(emulator.WithStore(store), emulator.WithStorageLimitEnabled(false))
+	require.NoError(t, err)
+
+	assert.Equal(t, before, height(t, original))
+
+	f.Blockchain = original
+	assert.Equal(t, nodeInfo, f.NodeInfo(testkit.NodeID(1)))
+}
@@ -0,0 +1,101 @@
+//go:build go1.18
+// +build go1.18
+
+package ufix64_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/interpreter"
+
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// canonical matches the strings that Parse accepts, apart from the range check.
+// Cadence additionally accepts signs in the integer part.
+var canonical = regexp.MustCompile(`^[0-9]+\.[0-9]{1,8}$`)
+
+func FuzzParse(f *testing.F) {
+	for _, s := range []string{"0.0", "1.5", "0.00000001", "184467440737.09551615", "184467440737.09551616", "-0.5", "+1.0", "1.000000001"} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		value, err := ufix64.Parse(s)
+
+		expected, cadenceErr := cadence.NewUFix64(s)
+
+		if err == nil {
+			require.NoError(t, cadenceErr, "Cadence rejects %q", s)
+			require.Equal(t, uint64(expected), uint64(value), s)
+			require.Equal(t, interpreter.UFix64Value(value).String(), value.String())
+			return
+		}
+
+		if canonical.MatchString(s) {
+			require.Error(t, cadenceErr, "Cadence accepts %q", s)
+		}
+	})
+}
+
+func FuzzArithmetic(f *testing.F) {
+	for _, values := range [][2]uint64{
+		{0, 0},
+		{1, 1},
+		{125000000000000, 165000000000000},
+		{uint64(ufix64.Max), 1},
+		{uint64(ufix64.Max), uint64(ufix64.Max)},
+		{100000000, 99999999},
+	} {
+		f.Add(values[0], values[1])
+	}
+
+	f.Fuzz(func(t *testing.T, a, b uint64) {
+		x, y := ufix64.UFix64(a), ufix64.UFix64(b)
+		cx, cy := interpreter.UFix64Value(a), interpreter.UFix64Value(b)
+
+		compare(t, "add", func() (ufix64.UFix64, error) { return x.Add(y) }, func() interpreter.NumberValue { return cx.Plus(cy) })
+		compare(t, "sub", func() (ufix64.UFix64, error) { return x.Sub(y) }, func() interpreter.NumberValue { return cx.Minus(cy) })
+		compare(t, "mul", func() (ufix64.UFix64, error) { return x.Mul(y) }, func() interpreter.NumberValue { return cx.Mul(cy) })
+
+		if b != 0 {
+			compare(t, "div", func() (ufix64.UFix64, error) { return x.Div(y) }, func() interpreter.NumberValue { return cx.Div(cy) })
+		}
+
+		assert.Equal(t, bool(cx.Less(cy)), x.Cmp(y) < 0)
+		assert.Equal(t, bool(cx.Greater(cy)), x.Cmp(y) > 0)
+	})
+}
+
+// compare runs an operation with both implementations and checks that
+// they return the same value, or that both fail with the same error.
+func compare(t *testing.T, name string, operation func() (ufix64.UFix64, error), cadenceOperation func() interpreter.NumberValue) {
+	value, err := operation()
+
+	expected, cadenceErr := recoverCadence(cadenceOperation)
+
+	switch cadenceErr.(type) {
+	case nil:
+		require.NoError(t, err, name)
+		require.Equal(t, uint64(expected.(interpreter.UFix64Value)), uint64(value), name)
+	case interpreter.OverflowError:
+		require.Equal(t, ufix64.ErrOverflow, err, name)
+	case interpreter.UnderflowError:
+		require.Equal(t, ufix64.ErrUnderflow, err, name)
+	default:
+		require.FailNow(t, "unexpected Cadence error", "%s: %v", name, cadenceErr)
+	}
+}
+
+func recoverCadence(operation func() interpreter.NumberValue) (value interpreter.NumberValue, err interface{}) {
+	defer func() {
+		err = recover()
+	}()
+
+	return operation(), nil
+}
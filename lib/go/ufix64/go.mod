@@ -0,0 +1,8 @@
+module github.com/onflow/flow-core-contracts/lib/go/ufix64
+
+go 1.13
+
+require (
+	github.com/onflow/cadence v0.14.4
+	github.com/stretchr/testify v1.7.0
+)
@@ -0,0 +1,188 @@
+// Package ufix64 implements the Cadence UFix64 type in Go.
+//
+// A UFix64 is an unsigned 64-bit fixed-point number with 8 decimal places.
+// Multiplication and division truncate to 8 decimal places and every
+// operation that does not fit into 64 bits returns an error, the same way
+// the Cadence interpreter aborts the transaction.
+package ufix64
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// UFix64 is a Cadence UFix64 value, stored as the number of 10^-8 units.
+type UFix64 uint64
+
+const (
+	// Scale is the number of decimal places of a UFix64.
+	Scale = 8
+
+	// Factor is the raw value of 1.0.
+	Factor = 100000000
+
+	// Zero is 0.0.
+	Zero UFix64 = 0
+
+	// One is 1.0.
+	One UFix64 = Factor
+
+	// Max is the largest UFix64, 184467440737.09551615.
+	Max UFix64 = math.MaxUint64
+)
+
+var (
+	ErrOverflow       = errors.New("UFix64 overflow")
+	ErrUnderflow      = errors.New("UFix64 underflow")
+	ErrDivisionByZero = errors.New("UFix64 division by zero")
+)
+
+var factorBig = new(big.Int).SetUint64(Factor)
+
+// Parse parses a decimal string like "1250000.0" or "0.00000001".
+//
+// Like a Cadence fixed-point literal, the string must have digits on both
+// sides of the decimal point and at most 8 fractional digits.
+func Parse(s string) (UFix64, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("invalid UFix64 %q: missing decimal point", s)
+	}
+
+	integerPart, fractionalPart := parts[0], parts[1]
+
+	if !isDigits(integerPart) {
+		return 0, fmt.Errorf("invalid UFix64 %q: invalid integer part", s)
+	}
+
+	if !isDigits(fractionalPart) {
+		return 0, fmt.Errorf("invalid UFix64 %q: invalid fractional part", s)
+	}
+
+	if len(fractionalPart) > Scale {
+		return 0, fmt.Errorf("invalid UFix64 %q: more than %d fractional digits", s, Scale)
+	}
+
+	integer, err := strconv.ParseUint(integerPart, 10, 64)
+	if err != nil || integer > uint64(Max)/Factor {
+		return 0, fmt.Errorf("invalid UFix64 %q: %w", s, ErrOverflow)
+	}
+
+	fractional, _ := strconv.ParseUint(fractionalPart+strings.Repeat("0", Scale-len(fractionalPart)), 10, 64)
+
+	value, err := UFix64(integer * Factor).Add(UFix64(fractional))
+	if err != nil {
+		return 0, fmt.Errorf("invalid UFix64 %q: %w", s, err)
+	}
+
+	return value, nil
+}
+
+// MustParse is like Parse but panics if the string cannot be parsed.
+func MustParse(s string) UFix64 {
+	value, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+func isDigits(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String formats the value with all 8 decimal places, like Cadence does.
+func (v UFix64) String() string {
+	return fmt.Sprintf("%d.%08d", uint64(v)/Factor, uint64(v)%Factor)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (v UFix64) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *UFix64) UnmarshalText(text []byte) error {
+	value, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*v = value
+
+	return nil
+}
+
+// Add returns v + other.
+func (v UFix64) Add(other UFix64) (UFix64, error) {
+	sum := v + other
+	if sum < v {
+		return 0, ErrOverflow
+	}
+
+	return sum, nil
+}
+
+// Sub returns v - other.
+func (v UFix64) Sub(other UFix64) (UFix64, error) {
+	if other > v {
+		return 0, ErrUnderflow
+	}
+
+	return v - other, nil
+}
+
+// Mul returns v * other, truncated to 8 decimal places.
+func (v UFix64) Mul(other UFix64) (UFix64, error) {
+	result := new(big.Int).Mul(new(big.Int).SetUint64(uint64(v)), new(big.Int).SetUint64(uint64(other)))
+	result.Quo(result, factorBig)
+
+	if !result.IsUint64() {
+		return 0, ErrOverflow
+	}
+
+	return UFix64(result.Uint64()), nil
+}
+
+// Div returns v / other, truncated to 8 decimal places.
+func (v UFix64) Div(other UFix64) (UFix64, error) {
+	if other == 0 {
+		return 0, ErrDivisionByZero
+	}
+
+	result := new(big.Int).Mul(new(big.Int).SetUint64(uint64(v)), factorBig)
+	result.Quo(result, new(big.Int).SetUint64(uint64(other)))
+
+	if !result.IsUint64() {
+		return 0, ErrOverflow
+	}
+
+	return UFix64(result.Uint64()), nil
+}
+
+// Cmp compares v and other and returns -1, 0 or +1.
+func (v UFix64) Cmp(other UFix64) int {
+	switch {
+	case v < other:
+		return -1
+	case v > other:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,111 @@
+package ufix64_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+func TestParse(t *testing.T) {
+
+	t.Run("Should parse valid values", func(t *testing.T) {
+		for s, expected := range map[string]ufix64.UFix64{
+			"0.0":                   0,
+			"1.0":                   ufix64.One,
+			"0.00000001":            1,
+			"1250000.0":             125000000000000,
+			"0.08":                  8000000,
+			"007.5":                 750000000,
+			"184467440737.09551615": ufix64.Max,
+		} {
+			value, err := ufix64.Parse(s)
+			require.NoError(t, err, s)
+			assert.Equal(t, expected, value, s)
+		}
+	})
+
+	t.Run("Shouldn't parse invalid values", func(t *testing.T) {
+		for _, s := range []string{
+			"",
+			"1",
+			"1.",
+			".1",
+			"1.0.0",
+			"-1.0",
+			"+1.0",
+			"1.-0",
+			"1_000.0",
+			"0.000000001",
+			"184467440737.09551616",
+			"99999999999999999999.0",
+		} {
+			_, err := ufix64.Parse(s)
+			assert.Error(t, err, s)
+		}
+	})
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "0.00000000", ufix64.Zero.String())
+	assert.Equal(t, "1250000.00000000", ufix64.MustParse("1250000.0").String())
+	assert.Equal(t, "184467440737.09551615", ufix64.Max.String())
+}
+
+func TestText(t *testing.T) {
+	var values map[string]ufix64.UFix64
+
+	err := json.Unmarshal([]byte(`{"0x01": "10.5"}`), &values)
+	require.NoError(t, err)
+	assert.Equal(t, ufix64.MustParse("10.5"), values["0x01"])
+
+	b, err := json.Marshal(values)
+	require.NoError(t, err)
+	assert.Equal(t, `{"0x01":"10.50000000"}`, string(b))
+
+	err = json.Unmarshal([]byte(`{"0x01": "10"}`), &values)
+	assert.Error(t, err)
+}
+
+func TestArithmetic(t *testing.T) {
+
+	t.Run("Should truncate multiplication and division", func(t *testing.T) {
+		scale, err := ufix64.MustParse("1250000.0").Div(ufix64.MustParse("1650000.0"))
+		require.NoError(t, err)
+		assert.Equal(t, ufix64.MustParse("0.75757575"), scale)
+
+		reward, err := ufix64.MustParse("900000.0").Mul(scale)
+		require.NoError(t, err)
+		assert.Equal(t, ufix64.MustParse("681818.175"), reward)
+
+		cut, err := ufix64.MustParse("0.00000002").Mul(ufix64.MustParse("0.08"))
+		require.NoError(t, err)
+		assert.Equal(t, ufix64.Zero, cut)
+	})
+
+	t.Run("Should return errors instead of wrapping around", func(t *testing.T) {
+		_, err := ufix64.Max.Add(1)
+		assert.Equal(t, ufix64.ErrOverflow, err)
+
+		_, err = ufix64.Zero.Sub(1)
+		assert.Equal(t, ufix64.ErrUnderflow, err)
+
+		_, err = ufix64.Max.Mul(ufix64.MustParse("1.00000001"))
+		assert.Equal(t, ufix64.ErrOverflow, err)
+
+		_, err = ufix64.Max.Div(ufix64.MustParse("0.99999999"))
+		assert.Equal(t, ufix64.ErrOverflow, err)
+
+		_, err = ufix64.One.Div(0)
+		assert.Equal(t, ufix64.ErrDivisionByZero, err)
+	})
+
+	t.Run("Should compare values", func(t *testing.T) {
+		assert.Equal(t, -1, ufix64.Zero.Cmp(ufix64.One))
+		assert.Equal(t, 0, ufix64.One.Cmp(ufix64.MustParse("1.0")))
+		assert.Equal(t, 1, ufix64.Max.Cmp(ufix64.One))
+	})
+}
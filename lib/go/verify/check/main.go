@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/onflow/flow-go-sdk/client"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/onflow/flow-core-contracts/lib/go/verify"
+)
+
+type Config struct {
+	Network    string
+	AccessNode string
+	Deployed   string
+	Contracts  []string
+}
+
+var accessNodes = map[string]string{
+	verify.Mainnet: "access.mainnet.nodes.onflow.org:9000",
+	verify.Testnet: "access.devnet.nodes.onflow.org:9000",
+}
+
+var conf Config
+
+var cmd = &cobra.Command{
+	Use:   "check",
+	Short: "Verify the code of the deployed core contracts",
+	Long: `Verify the code of the deployed core contracts.
+
+The code deployed to the core contract accounts of the network is compared
+with the code this library generates for the network. Line endings, trailing
+whitespace and the formatting of import addresses are ignored.
+
+The code is fetched from an access node, or read from the directory given
+with --deployed, which contains a <ContractName>.cdc file for every contract.
+
+A unified diff is shown for every contract that doesn't match, and the command
+exits with status 1 if a contract doesn't match or isn't deployed.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		results, err := check(conf)
+		if err != nil {
+			exit(err)
+		}
+
+		ok := true
+
+		for _, result := range results {
+			fmt.Printf("%-22s %s  %s\n", result.Name, result.Address, result.Status)
+
+			if result.Status != verify.Match {
+				ok = false
+			}
+		}
+
+		for _, result := range results {
+			if result.Diff != "" {
+				fmt.Printf("\n%s", result.Diff)
+			}
+		}
+
+		if !ok {
+			os.Exit(1)
+		}
+	},
+}
+
+func check(conf Config) ([]verify.Result, error) {
+	network, err := verify.GetNetwork(conf.Network)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(conf.Contracts) > 0 {
+		network.Contracts, err = selectContracts(network.Contracts, conf.Contracts)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if conf.Deployed != "" {
+		fetcher, err := readDeployed(conf.Deployed, network.Contracts)
+		if err != nil {
+			return nil, err
+		}
+
+		return verify.Verify(context.Background(), fetcher, network)
+	}
+
+	accessNode := conf.AccessNode
+	if accessNode == "" {
+		accessNode = accessNodes[network.Name]
+	}
+
+	flowClient, err := client.New(accessNode, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer flowClient.Close()
+
+	return verify.Verify(context.Background(), verify.NewClientFetcher(flowClient), network)
+}
+
+func selectContracts(contracts []verify.Contract, names []string) ([]verify.Contract, error) {
+	var selected []verify.Contract
+
+	for _, name := range names {
+		found := false
+
+		for _, contract := range contracts {
+			if contract.Name == name {
+				selected = append(selected, contract)
+				found = true
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("unknown contract %s", name)
+		}
+	}
+
+	return selected, nil
+}
+
+// readDeployed reads the <ContractName>.cdc files of the contracts, a missing file is a missing contract
+func readDeployed(dir string, contracts []verify.Contract) (verify.StaticFetcher, error) {
+	fetcher := verify.StaticFetcher{}
+
+	for _, contract := range contracts {
+		if fetcher[contract.Address] == nil {
+			fetcher[contract.Address] = map[string][]byte{}
+		}
+
+		code, err := ioutil.ReadFile(filepath.Join(dir, contract.Name+".cdc"))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		fetcher[contract.Address][contract.Name] = code
+	}
+
+	return fetcher, nil
+}
+
+func init() {
+	initConfig()
+}
+
+func initConfig() {
+	flags := cmd.PersistentFlags()
+
+	flags.StringVar(&conf.Network, "network", verify.Mainnet, "Flow network to verify")
+	flags.StringVar(&conf.AccessNode, "access-node", "", "Access node to fetch the contracts from, defaults to the public access node of the network")
+	flags.StringVar(&conf.Deployed, "deployed", "", "Directory with the deployed code of the contracts, instead of fetching it")
+	flags.StringSliceVar(&conf.Contracts, "contract", nil, "Only verify the given contracts")
+}
+
+func main() {
+	if err := cmd.Execute(); err != nil {
+		exit(err)
+	}
+}
+
+func exit(err error) {
+	fmt.Println(err)
+	os.Exit(1)
+}
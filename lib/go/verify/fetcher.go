@@ -0,0 +1,40 @@
+package verify
+
+import (
+	"context"
+
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/client"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+)
+
+// ClientFetcher fetches accounts from an access node.
+type ClientFetcher struct {
+	client *client.Client
+}
+
+var _ AccountFetcher = &ClientFetcher{}
+
+// NewClientFetcher returns a fetcher that fetches accounts at the latest block.
+func NewClientFetcher(flowClient *client.Client) *ClientFetcher {
+	return &ClientFetcher{client: flowClient}
+}
+
+func (f *ClientFetcher) GetContracts(ctx context.Context, address contracts.Address) (map[string][]byte, error) {
+	account, err := f.client.GetAccountAtLatestBlock(ctx, flow.Address(address))
+	if err != nil {
+		return nil, err
+	}
+
+	return account.Contracts, nil
+}
+
+// StaticFetcher returns contracts that the caller already fetched, by account.
+type StaticFetcher map[contracts.Address]map[string][]byte
+
+var _ AccountFetcher = StaticFetcher{}
+
+func (f StaticFetcher) GetContracts(_ context.Context, address contracts.Address) (map[string][]byte, error) {
+	return f[address], nil
+}
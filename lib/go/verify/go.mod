@@ -0,0 +1,12 @@
+module github.com/onflow/flow-core-contracts/lib/go/verify
+
+go 1.13
+
+require (
+	github.com/onflow/flow-core-contracts/lib/go/contracts v0.7.1
+	github.com/onflow/flow-go-sdk v0.17.0
+	github.com/pmezard/go-difflib v1.0.0
+	github.com/stretchr/testify v1.7.0
+)
+
+replace github.com/onflow/flow-core-contracts/lib/go/contracts => ../contracts
@@ -0,0 +1,106 @@
+package verify
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+)
+
+// Contract is a core contract deployed to an account.
+type Contract struct {
+	Name    string
+	Address contracts.Address
+}
+
+// Network contains the core contracts deployed to a network
+// and the addresses their imports are replaced with.
+type Network struct {
+	Name      string
+	ChainID   flow.ChainID
+	Addresses contracts.Addresses
+	Contracts []Contract
+}
+
+const (
+	Mainnet = "mainnet"
+	Testnet = "testnet"
+)
+
+// GetNetwork returns the core contracts of mainnet or testnet.
+func GetNetwork(name string) (Network, error) {
+	switch name {
+	case Mainnet:
+		return newNetwork(Mainnet, flow.Mainnet, addresses{
+			fungibleToken: "f233dcee88fe0abe",
+			flowToken:     "1654653399040a61",
+			flowFees:      "f919ee77447b7497",
+			storageFees:   "e467b9dd11fa00df",
+			idTable:       "8624b52f9ddcd04a",
+			stakingProxy:  "62430cf28c26d095",
+			lockedTokens:  "8d0e87b65159ae63",
+		}), nil
+	case Testnet:
+		return newNetwork(Testnet, flow.Testnet, addresses{
+			fungibleToken: "9a0766d93b6608b7",
+			flowToken:     "7e60df042a9c0868",
+			flowFees:      "912d5440f7e3769e",
+			storageFees:   "8c5303eaa26202d6",
+			idTable:       "9eca2b38b18b5dfe",
+			stakingProxy:  "7aad92e5a0715d21",
+			lockedTokens:  "95e019a17d0e23d7",
+		}), nil
+	}
+
+	return Network{}, fmt.Errorf("invalid network %s", name)
+}
+
+type addresses struct {
+	fungibleToken string
+	flowToken     string
+	flowFees      string
+	storageFees   string
+	idTable       string
+	stakingProxy  string
+	lockedTokens  string
+}
+
+// newNetwork returns the contracts of a network. FlowServiceAccount
+// is deployed to the service account, which also stores FlowStorageFees.
+func newNetwork(name string, chain flow.ChainID, a addresses) Network {
+	address := func(hex string) contracts.Address {
+		address, err := contracts.ParseAddress(hex, chain)
+		if err != nil {
+			panic(err)
+		}
+
+		return address
+	}
+
+	network := Network{
+		Name:    name,
+		ChainID: chain,
+		Addresses: contracts.Addresses{
+			FungibleToken: address(a.fungibleToken),
+			FlowToken:     address(a.flowToken),
+			FlowFees:      address(a.flowFees),
+			StorageFees:   address(a.storageFees),
+			IDTable:       address(a.idTable),
+			StakingProxy:  address(a.stakingProxy),
+		},
+	}
+
+	network.Contracts = []Contract{
+		{contracts.FungibleTokenName, network.Addresses.FungibleToken},
+		{contracts.FlowTokenName, network.Addresses.FlowToken},
+		{contracts.FlowFeesName, network.Addresses.FlowFees},
+		{contracts.FlowStorageFeesName, network.Addresses.StorageFees},
+		{contracts.FlowServiceAccountName, network.Addresses.StorageFees},
+		{contracts.FlowIDTableStakingName, network.Addresses.IDTable},
+		{contracts.StakingProxyName, network.Addresses.StakingProxy},
+		{contracts.LockedTokensName, address(a.lockedTokens)},
+	}
+
+	return network
+}
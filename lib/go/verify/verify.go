@@ -0,0 +1,160 @@
+// Package verify checks that the code deployed to the core contract accounts
+// of a network matches the code that the contracts package generates for it.
+//
+//    network, _ := verify.GetNetwork(verify.Mainnet)
+//
+//    results, err := verify.Verify(ctx, verify.NewClientFetcher(flowClient), network)
+//
+//    for _, result := range results {
+//        if result.Status != verify.Match {
+//            fmt.Println(result.Diff)
+//        }
+//    }
+//
+// The code is normalized before it is compared, so that line endings, trailing whitespace
+// and the formatting of import addresses don't cause differences.
+package verify
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+)
+
+// Status is the result of comparing the deployed code of a contract with the expected code.
+type Status int
+
+const (
+	// Match means that the deployed code is the expected code.
+	Match Status = iota
+
+	// Mismatch means that the deployed code differs from the expected code.
+	Mismatch
+
+	// Missing means that the contract isn't deployed to its account.
+	Missing
+)
+
+func (s Status) String() string {
+	switch s {
+	case Match:
+		return "match"
+	case Mismatch:
+		return "mismatch"
+	case Missing:
+		return "missing"
+	}
+
+	return fmt.Sprintf("Status(%d)", int(s))
+}
+
+// Result is the result of verifying a contract.
+type Result struct {
+	Contract
+	Status Status
+
+	// Diff is a unified diff from the expected to the deployed code, if they don't match.
+	Diff string
+}
+
+// AccountFetcher fetches the contracts deployed to an account.
+type AccountFetcher interface {
+	// GetContracts returns the code of the contracts deployed to the account by contract name.
+	GetContracts(ctx context.Context, address contracts.Address) (map[string][]byte, error)
+}
+
+// Verify fetches the code of every contract of the network and compares it with the expected code.
+// An error is only returned if the code couldn't be fetched or generated, differences are reported in the results.
+func Verify(ctx context.Context, fetcher AccountFetcher, network Network) ([]Result, error) {
+	deployed := make(map[contracts.Address]map[string][]byte)
+
+	for _, contract := range network.Contracts {
+		if _, ok := deployed[contract.Address]; ok {
+			continue
+		}
+
+		accountContracts, err := fetcher.GetContracts(ctx, contract.Address)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch account %s: %w", contract.Address, err)
+		}
+
+		deployed[contract.Address] = accountContracts
+	}
+
+	results := make([]Result, 0, len(network.Contracts))
+
+	for _, contract := range network.Contracts {
+		code, ok := deployed[contract.Address][contract.Name]
+		if !ok {
+			results = append(results, Result{Contract: contract, Status: Missing})
+			continue
+		}
+
+		result, err := VerifyCode(network, contract, code)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// VerifyCode compares code that the caller fetched with the expected code of a contract of the network.
+func VerifyCode(network Network, contract Contract, deployed []byte) (Result, error) {
+	expected, err := contracts.Get(contract.Name, network.Addresses)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return Compare(contract, expected, deployed), nil
+}
+
+// Compare compares the normalized deployed code of a contract with its normalized expected code.
+func Compare(contract Contract, expected, deployed []byte) Result {
+	normalizedExpected := Normalize(expected)
+	normalizedDeployed := Normalize(deployed)
+
+	if normalizedExpected == normalizedDeployed {
+		return Result{Contract: contract, Status: Match}
+	}
+
+	diff, _ := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(normalizedExpected),
+		B:        difflib.SplitLines(normalizedDeployed),
+		FromFile: fmt.Sprintf("expected/%s.cdc", contract.Name),
+		ToFile:   fmt.Sprintf("%s/%s.cdc", contract.Address, contract.Name),
+		Context:  3,
+	})
+
+	return Result{Contract: contract, Status: Mismatch, Diff: diff}
+}
+
+var importAddress = regexp.MustCompile(`^(\s*import\s+.+\s+from\s+)0x([0-9a-fA-F]{1,16})\s*$`)
+
+// Normalize returns the code with Unix line endings, without trailing whitespace
+// or leading and trailing blank lines, and with the addresses of imports
+// written as 16 lowercase hex digits.
+func Normalize(code []byte) string {
+	text := strings.ReplaceAll(string(code), "\r\n", "\n")
+
+	lines := strings.Split(text, "\n")
+
+	for i, line := range lines {
+		line = strings.TrimRight(line, " \t\r")
+
+		if match := importAddress.FindStringSubmatch(line); match != nil {
+			line = match[1] + "0x" + strings.Repeat("0", 16-len(match[2])) + strings.ToLower(match[2])
+		}
+
+		lines[i] = line
+	}
+
+	return strings.Trim(strings.Join(lines, "\n"), "\n") + "\n"
+}
@@ -0,0 +1,126 @@
+package verify_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+	"github.com/onflow/flow-core-contracts/lib/go/verify"
+)
+
+type failingFetcher struct{}
+
+func (failingFetcher) GetContracts(context.Context, contracts.Address) (map[string][]byte, error) {
+	return nil, errors.New("access node unavailable")
+}
+
+// deployedContracts returns the expected code of every contract of the network by account
+func deployedContracts(t *testing.T, network verify.Network) verify.StaticFetcher {
+	fetcher := verify.StaticFetcher{}
+
+	for _, contract := range network.Contracts {
+		code, err := contracts.Get(contract.Name, network.Addresses)
+		require.NoError(t, err)
+
+		if fetcher[contract.Address] == nil {
+			fetcher[contract.Address] = map[string][]byte{}
+		}
+		fetcher[contract.Address][contract.Name] = code
+	}
+
+	return fetcher
+}
+
+func TestVerify(t *testing.T) {
+
+	network, err := verify.GetNetwork(verify.Mainnet)
+	require.NoError(t, err)
+
+	idTable := network.Contracts[5]
+	require.Equal(t, contracts.FlowIDTableStakingName, idTable.Name)
+
+	t.Run("Should match the generated code", func(t *testing.T) {
+		results, err := verify.Verify(context.Background(), deployedContracts(t, network), network)
+		require.NoError(t, err)
+
+		require.Len(t, results, len(network.Contracts))
+		for _, result := range results {
+			assert.Equal(t, verify.Match, result.Status, result.Name)
+			assert.Empty(t, result.Diff)
+		}
+	})
+
+	t.Run("Should ignore line endings, trailing whitespace and the formatting of import addresses", func(t *testing.T) {
+		fetcher := deployedContracts(t, network)
+
+		code := string(fetcher[idTable.Address][idTable.Name])
+		code = strings.ReplaceAll(code, "0xf233dcee88fe0abe", "0xF233DCEE88FE0ABE")
+		code = strings.ReplaceAll(code, "\n", "  \r\n")
+		fetcher[idTable.Address][idTable.Name] = []byte("\n" + code + "\n\n")
+
+		results, err := verify.Verify(context.Background(), fetcher, network)
+		require.NoError(t, err)
+		assert.Equal(t, verify.Match, results[5].Status)
+	})
+
+	t.Run("Should show a unified diff when the code differs", func(t *testing.T) {
+		fetcher := deployedContracts(t, network)
+
+		code := string(fetcher[idTable.Address][idTable.Name])
+		fetcher[idTable.Address][idTable.Name] = []byte(strings.Replace(code, "pub let id: String", "pub var id: String", 1))
+
+		results, err := verify.Verify(context.Background(), fetcher, network)
+		require.NoError(t, err)
+
+		for i, result := range results {
+			if i == 5 {
+				continue
+			}
+			assert.Equal(t, verify.Match, result.Status, result.Name)
+		}
+
+		result := results[5]
+		assert.Equal(t, verify.Mismatch, result.Status)
+		assert.Contains(t, result.Diff, "--- expected/FlowIDTableStaking.cdc")
+		assert.Contains(t, result.Diff, "+++ 0x8624b52f9ddcd04a/FlowIDTableStaking.cdc")
+		assert.Contains(t, result.Diff, "-        pub let id: String")
+		assert.Contains(t, result.Diff, "+        pub var id: String")
+	})
+
+	t.Run("Should detect imports of the wrong network", func(t *testing.T) {
+		testnet, err := verify.GetNetwork(verify.Testnet)
+		require.NoError(t, err)
+
+		code, err := contracts.Get(contracts.FlowTokenName, testnet.Addresses)
+		require.NoError(t, err)
+
+		result, err := verify.VerifyCode(network, network.Contracts[1], code)
+		require.NoError(t, err)
+		assert.Equal(t, verify.Mismatch, result.Status)
+		assert.Contains(t, result.Diff, "+import FungibleToken from 0x9a0766d93b6608b7")
+	})
+
+	t.Run("Should report missing contracts", func(t *testing.T) {
+		fetcher := deployedContracts(t, network)
+		delete(fetcher[idTable.Address], idTable.Name)
+
+		results, err := verify.Verify(context.Background(), fetcher, network)
+		require.NoError(t, err)
+		assert.Equal(t, verify.Missing, results[5].Status)
+	})
+
+	t.Run("Should return fetch errors", func(t *testing.T) {
+		_, err := verify.Verify(context.Background(), failingFetcher{}, network)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should reject unknown networks", func(t *testing.T) {
+		_, err := verify.GetNetwork("canarynet")
+		assert.Error(t, err)
+	})
+}
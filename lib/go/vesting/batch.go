@@ -0,0 +1,124 @@
+package vesting
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// MaxComputationLimit is the largest computation limit a transaction can have.
+const MaxComputationLimit = 9999
+
+// UnlockBatchComputation returns the computation used by an unlock_tokens_batch.cdc
+// transaction that unlocks the given number of accounts, as measured on the emulator.
+func UnlockBatchComputation(accounts int) uint64 {
+	return 3 + 11*uint64(accounts)
+}
+
+// DepositBatchComputation returns the computation used by a deposit_locked_tokens_batch.cdc
+// transaction that deposits into the given number of accounts, as measured on the emulator.
+func DepositBatchComputation(accounts int) uint64 {
+	return 5 + 36*uint64(accounts)
+}
+
+var (
+	// UnlockBatchSize is the largest number of accounts an unlock_tokens_batch.cdc
+	// transaction can unlock with half of the maximum computation limit.
+	UnlockBatchSize = batchSize(UnlockBatchComputation)
+
+	// DepositBatchSize is the largest number of accounts a deposit_locked_tokens_batch.cdc
+	// transaction can deposit into with half of the maximum computation limit.
+	DepositBatchSize = batchSize(DepositBatchComputation)
+)
+
+// batchSize leaves half of the computation limit as a safety margin,
+// the computation used by the contract functions changes when they are upgraded.
+func batchSize(computation func(accounts int) uint64) int {
+	size := 0
+	for computation(size+1) <= MaxComputationLimit/2 {
+		size++
+	}
+
+	return size
+}
+
+// Deposit is an amount of locked tokens deposited into a locked account.
+type Deposit struct {
+	Account cadence.Address
+	Amount  ufix64.UFix64
+}
+
+// UnlockBatchTransactions returns unlock_tokens_batch.cdc transactions
+// that unlock at most batchSize accounts each.
+func UnlockBatchTransactions(env templates.Environment, unlocks []Unlock, batchSize int) ([]Transaction, error) {
+	amounts := make([]amount, len(unlocks))
+
+	for i, unlock := range unlocks {
+		amounts[i] = amount{unlock.Account, unlock.Delta}
+	}
+
+	return batchTransactions(templates.GenerateIncreaseUnlockLimitBatchScript(env), amounts, batchSize)
+}
+
+// DepositBatchTransactions returns deposit_locked_tokens_batch.cdc transactions
+// that deposit into at most batchSize accounts each.
+func DepositBatchTransactions(env templates.Environment, deposits []Deposit, batchSize int) ([]Transaction, error) {
+	amounts := make([]amount, len(deposits))
+
+	for i, deposit := range deposits {
+		amounts[i] = amount{deposit.Account, deposit.Amount}
+	}
+
+	return batchTransactions(templates.GenerateDepositLockedTokensBatchScript(env), amounts, batchSize)
+}
+
+type amount struct {
+	account cadence.Address
+	value   ufix64.UFix64
+}
+
+// batchTransactions splits the amounts into {Address: UFix64} dictionaries of at most batchSize entries.
+// An account can only be a key once in a dictionary, so it is an error if it appears twice.
+func batchTransactions(script []byte, amounts []amount, batchSize int) ([]Transaction, error) {
+	if batchSize <= 0 {
+		return nil, fmt.Errorf("invalid batch size %d", batchSize)
+	}
+
+	seen := make(map[cadence.Address]bool, len(amounts))
+
+	for _, amount := range amounts {
+		if seen[amount.account] {
+			return nil, fmt.Errorf("account %s appears more than once", FormatAddress(amount.account))
+		}
+
+		seen[amount.account] = true
+	}
+
+	var transactions []Transaction
+
+	for start := 0; start < len(amounts); start += batchSize {
+		end := start + batchSize
+		if end > len(amounts) {
+			end = len(amounts)
+		}
+
+		pairs := make([]cadence.KeyValuePair, 0, end-start)
+
+		for _, amount := range amounts[start:end] {
+			pairs = append(pairs, cadence.KeyValuePair{
+				Key:   amount.account,
+				Value: cadence.UFix64(amount.value),
+			})
+		}
+
+		transactions = append(transactions, Transaction{
+			Script:    script,
+			Arguments: []cadence.Value{cadence.NewDictionary(pairs)},
+		})
+	}
+
+	return transactions, nil
+}
@@ -0,0 +1,275 @@
+// Package vesting computes the unlocks of locked FLOW accounts.
+//
+// Every time a vesting release happens, the LockedTokens admin increases
+// the unlock limit of each locked account by the amount that vested since
+// the previous release. This package computes those amounts from vesting
+// schedules and generates the unlock_tokens.cdc transactions for them, or
+// batches of unlock_tokens_batch.cdc and deposit_locked_tokens_batch.cdc
+// transactions that stay under the computation limit.
+package vesting
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// DateFormat is the format of the dates in a schedule.
+const DateFormat = "2006-01-02"
+
+// Kind is the way tokens of a schedule vest.
+type Kind string
+
+const (
+	// Cliff schedules unlock all their tokens at the start date.
+	Cliff Kind = "cliff"
+	// Linear schedules unlock their tokens day by day between the start and the end date.
+	Linear Kind = "linear"
+	// Monthly schedules unlock their tokens in equal tranches,
+	// one every month after the start date.
+	Monthly Kind = "monthly"
+)
+
+// Schedule is the vesting schedule of some of the tokens of a locked account.
+//
+// An account can have several schedules, their unlocks are added up.
+type Schedule struct {
+	Account cadence.Address
+	Total   ufix64.UFix64
+	Kind    Kind
+	Start   time.Time
+	// End is the date linear schedules are fully vested.
+	End time.Time
+	// Cliff is the optional date before which nothing of a linear schedule unlocks.
+	// Tokens that vested before the cliff unlock at the cliff.
+	Cliff time.Time
+	// Months is the number of tranches of a monthly schedule.
+	Months int
+}
+
+type scheduleJSON struct {
+	Account string        `json:"account"`
+	Total   ufix64.UFix64 `json:"total"`
+	Kind    Kind          `json:"type"`
+	Start   string        `json:"start"`
+	End     string        `json:"end,omitempty"`
+	Cliff   string        `json:"cliff,omitempty"`
+	Months  int           `json:"months,omitempty"`
+}
+
+// ParseSchedules decodes a JSON array of schedules like
+//
+//	[{"account": "0x01cf0e2f2f715450", "total": "1200.0", "type": "monthly", "start": "2021-01-01", "months": 12}]
+//
+// and validates them, including that every account is an address of the chain.
+func ParseSchedules(data []byte, chain flow.ChainID) ([]Schedule, error) {
+	var raws []scheduleJSON
+
+	err := json.Unmarshal(data, &raws)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]Schedule, len(raws))
+
+	for i, raw := range raws {
+		schedules[i], err = parseSchedule(raw, chain)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return schedules, nil
+}
+
+func parseSchedule(raw scheduleJSON, chain flow.ChainID) (Schedule, error) {
+	account, err := contracts.ParseAddress(raw.Account, chain)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	schedule := Schedule{
+		Account: cadence.Address(account),
+		Total:   raw.Total,
+		Kind:    raw.Kind,
+		Months:  raw.Months,
+	}
+
+	schedule.Start, err = parseDate("start", raw.Start)
+	if err != nil {
+		return Schedule{}, err
+	}
+
+	if raw.End != "" {
+		schedule.End, err = parseDate("end", raw.End)
+		if err != nil {
+			return Schedule{}, err
+		}
+	}
+
+	if raw.Cliff != "" {
+		schedule.Cliff, err = parseDate("cliff", raw.Cliff)
+		if err != nil {
+			return Schedule{}, err
+		}
+	}
+
+	err = schedule.Validate()
+	if err != nil {
+		return Schedule{}, fmt.Errorf("invalid schedule for account %s: %w", FormatAddress(schedule.Account), err)
+	}
+
+	return schedule, nil
+}
+
+// MarshalJSON encodes a schedule in the format accepted by ParseSchedules.
+func (s Schedule) MarshalJSON() ([]byte, error) {
+	raw := scheduleJSON{
+		Account: FormatAddress(s.Account),
+		Total:   s.Total,
+		Kind:    s.Kind,
+		Start:   s.Start.Format(DateFormat),
+		Months:  s.Months,
+	}
+
+	if !s.End.IsZero() {
+		raw.End = s.End.Format(DateFormat)
+	}
+
+	if !s.Cliff.IsZero() {
+		raw.Cliff = s.Cliff.Format(DateFormat)
+	}
+
+	return json.Marshal(raw)
+}
+
+// Validate checks that the fields required by the kind of the schedule are set.
+func (s Schedule) Validate() error {
+	switch s.Kind {
+	case Cliff:
+		return nil
+
+	case Linear:
+		if !s.End.After(s.Start) {
+			return errors.New("the end of a linear schedule must be after its start")
+		}
+
+		if !s.Cliff.IsZero() && (s.Cliff.Before(s.Start) || s.Cliff.After(s.End)) {
+			return errors.New("the cliff of a linear schedule must be between its start and end")
+		}
+
+		return nil
+
+	case Monthly:
+		if s.Months <= 0 {
+			return errors.New("a monthly schedule must have at least one month")
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unknown schedule type %q", s.Kind)
+	}
+}
+
+// Vested returns the tokens of the schedule that are vested at the end of the given date.
+func (s Schedule) Vested(date time.Time) ufix64.UFix64 {
+	date = truncateToDate(date)
+
+	switch s.Kind {
+	case Cliff:
+		if date.Before(s.Start) {
+			return 0
+		}
+
+		return s.Total
+
+	case Linear:
+		if date.Before(s.Start) || date.Before(s.Cliff) {
+			return 0
+		}
+
+		if !date.Before(s.End) {
+			return s.Total
+		}
+
+		return fraction(s.Total, days(s.Start, date), days(s.Start, s.End))
+
+	case Monthly:
+		tranches := 0
+		for tranches < s.Months && !date.Before(addMonths(s.Start, tranches+1)) {
+			tranches++
+		}
+
+		// The last tranche gets the remainder, so that the schedule
+		// always adds up to its total.
+		if tranches == s.Months {
+			return s.Total
+		}
+
+		return fraction(s.Total, int64(tranches), int64(s.Months))
+
+	default:
+		return 0
+	}
+}
+
+// fraction returns total * numerator / denominator, truncated to UFix64 precision.
+func fraction(total ufix64.UFix64, numerator, denominator int64) ufix64.UFix64 {
+	result := new(big.Int).Mul(new(big.Int).SetUint64(uint64(total)), big.NewInt(numerator))
+	result.Quo(result, big.NewInt(denominator))
+
+	return ufix64.UFix64(result.Uint64())
+}
+
+func days(from, to time.Time) int64 {
+	return int64(to.Sub(from).Hours() / 24)
+}
+
+// addMonths adds months to a date, using the last day of the month
+// if the day does not exist, so that a schedule starting on January 31st
+// has its first tranche on February 28th or 29th.
+func addMonths(date time.Time, months int) time.Time {
+	year, month, day := date.Date()
+
+	firstOfMonth := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(firstOfMonth.Year(), firstOfMonth.Month(), day, 0, 0, 0, 0, time.UTC)
+}
+
+func truncateToDate(date time.Time) time.Time {
+	year, month, day := date.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// ParseDate parses a date in the DateFormat.
+func ParseDate(value string) (time.Time, error) {
+	return time.ParseInLocation(DateFormat, value, time.UTC)
+}
+
+func parseDate(name, value string) (time.Time, error) {
+	date, err := ParseDate(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid %s date %q: %w", name, value, err)
+	}
+
+	return date, nil
+}
+
+// FormatAddress formats an address as 16 hex characters with the 0x prefix.
+func FormatAddress(address cadence.Address) string {
+	return "0x" + address.Hex()
+}
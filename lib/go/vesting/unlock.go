@@ -0,0 +1,156 @@
+package vesting
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+)
+
+// Account is the state of a locked account, as returned by the
+// get_locked_account_balance.cdc and get_unlock_limit.cdc scripts.
+type Account struct {
+	LockedBalance ufix64.UFix64 `json:"lockedBalance"`
+	UnlockLimit   ufix64.UFix64 `json:"unlockLimit"`
+}
+
+// Unlock is the amount the unlock limit of a locked account is increased by.
+type Unlock struct {
+	Account cadence.Address
+	Delta   ufix64.UFix64
+}
+
+// Unlocks returns the tokens of every account that vested after the previous
+// release date, up to and including the given date, sorted by account.
+// Accounts with nothing to unlock are left out. If previous is the zero time,
+// everything that vested up to the given date is unlocked.
+//
+// An error is returned if the unlock limit of any account would exceed its locked
+// balance, or if the state of an account with something to unlock is missing.
+func Unlocks(schedules []Schedule, previous, date time.Time, accounts map[cadence.Address]Account) ([]Unlock, error) {
+
+	if !previous.IsZero() && !date.After(previous) {
+		return nil, fmt.Errorf("the release date %s must be after the previous release date %s",
+			date.Format(DateFormat), previous.Format(DateFormat))
+	}
+
+	deltas := make(map[cadence.Address]ufix64.UFix64)
+
+	for _, schedule := range schedules {
+		delta := schedule.Vested(date)
+
+		if !previous.IsZero() {
+			// Vested never decreases, so this cannot underflow
+			delta -= schedule.Vested(previous)
+		}
+
+		sum, err := deltas[schedule.Account].Add(delta)
+		if err != nil {
+			return nil, fmt.Errorf("unlock for account %s: %w", FormatAddress(schedule.Account), err)
+		}
+
+		deltas[schedule.Account] = sum
+	}
+
+	var unlocks []Unlock
+	var problems []string
+
+	for address, delta := range deltas {
+		if delta == 0 {
+			continue
+		}
+
+		account, ok := accounts[address]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s: missing locked balance and unlock limit", FormatAddress(address)))
+			continue
+		}
+
+		newLimit, err := account.UnlockLimit.Add(delta)
+		if err != nil || newLimit > account.LockedBalance {
+			problems = append(problems, fmt.Sprintf("%s: unlocking %s would raise the unlock limit from %s above the locked balance of %s",
+				FormatAddress(address), delta, account.UnlockLimit, account.LockedBalance))
+			continue
+		}
+
+		unlocks = append(unlocks, Unlock{Account: address, Delta: delta})
+	}
+
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return nil, fmt.Errorf("refusing to unlock more than the locked balance:\n%s", strings.Join(problems, "\n"))
+	}
+
+	sort.Slice(unlocks, func(i, j int) bool {
+		return bytes.Compare(unlocks[i].Account[:], unlocks[j].Account[:]) < 0
+	})
+
+	return unlocks, nil
+}
+
+// Transaction is a transaction script with its arguments.
+type Transaction struct {
+	Script    []byte
+	Arguments []cadence.Value
+}
+
+// MarshalJSON encodes the transaction as its script
+// and its arguments in the JSON-Cadence format.
+func (t Transaction) MarshalJSON() ([]byte, error) {
+	arguments := make([]json.RawMessage, len(t.Arguments))
+
+	for i, argument := range t.Arguments {
+		encoded, err := jsoncdc.Encode(argument)
+		if err != nil {
+			return nil, err
+		}
+
+		arguments[i] = bytes.TrimSpace(encoded)
+	}
+
+	var b bytes.Buffer
+
+	// Keep the script readable, it is reviewed before it is signed
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+
+	err := encoder.Encode(struct {
+		Script    string            `json:"script"`
+		Arguments []json.RawMessage `json:"arguments"`
+	}{
+		Script:    string(t.Script),
+		Arguments: arguments,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSpace(b.Bytes()), nil
+}
+
+// UnlockTransactions returns one unlock_tokens.cdc transaction for every unlock.
+func UnlockTransactions(env templates.Environment, unlocks []Unlock) []Transaction {
+	script := templates.GenerateIncreaseUnlockLimitScript(env)
+
+	transactions := make([]Transaction, len(unlocks))
+
+	for i, unlock := range unlocks {
+		transactions[i] = Transaction{
+			Script: script,
+			Arguments: []cadence.Value{
+				unlock.Account,
+				cadence.UFix64(unlock.Delta),
+			},
+		}
+	}
+
+	return transactions
+}
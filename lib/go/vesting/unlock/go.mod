@@ -0,0 +1,22 @@
+module github.com/onflow/flow-core-contracts/lib/go/vesting/unlock
+
+go 1.13
+
+require (
+	github.com/onflow/cadence v0.14.4
+	github.com/onflow/flow-core-contracts/lib/go/contracts v0.0.0-00010101000000-000000000000
+	github.com/onflow/flow-core-contracts/lib/go/templates v0.0.0-00010101000000-000000000000
+	github.com/onflow/flow-core-contracts/lib/go/ufix64 v0.0.0-00010101000000-000000000000
+	github.com/onflow/flow-core-contracts/lib/go/vesting v0.0.0-00010101000000-000000000000
+	github.com/onflow/flow-go-sdk v0.17.0
+	github.com/spf13/cobra v1.1.1
+	google.golang.org/grpc v1.31.1
+)
+
+replace github.com/onflow/flow-core-contracts/lib/go/contracts => ../../contracts
+
+replace github.com/onflow/flow-core-contracts/lib/go/templates => ../../templates
+
+replace github.com/onflow/flow-core-contracts/lib/go/ufix64 => ../../ufix64
+
+replace github.com/onflow/flow-core-contracts/lib/go/vesting => ../
@@ -0,0 +1,296 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/client"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+	"github.com/onflow/flow-core-contracts/lib/go/vesting"
+)
+
+type Config struct {
+	Network    string
+	Schedules  string
+	Accounts   string
+	AccessNode string
+	Date       string
+	Previous   string
+	BatchSize  int
+}
+
+const (
+	testnet = "testnet"
+	mainnet = "mainnet"
+)
+
+const (
+	testnetFungibleTokenAddress = "9a0766d93b6608b7"
+	testnetFlowTokenAddress     = "7e60df042a9c0868"
+	testnetLockedTokensAddress  = "95e019a17d0e23d7"
+)
+
+const (
+	mainnetFungibleTokenAddress = "f233dcee88fe0abe"
+	mainnetFlowTokenAddress     = "1654653399040a61"
+	mainnetLockedTokensAddress  = "8d0e87b65159ae63"
+)
+
+// chainIDs are the chains whose addresses the accounts of a network are checked against.
+var chainIDs = map[string]flow.ChainID{
+	testnet: flow.Testnet,
+	mainnet: flow.Mainnet,
+}
+
+var conf Config
+
+var cmd = &cobra.Command{
+	Use:   "unlock <outfile>",
+	Short: "Generate the unlock transactions of a vesting release",
+	Long: `Generate the unlock transactions of a vesting release.
+
+The tokens that vested after the previous release date, up to and including
+the release date, are computed from the vesting schedules. The command refuses
+to unlock more than the locked balance of an account, which is read from the
+accounts file or queried from an access node.
+
+Accounts are unlocked in batches of unlock_tokens_batch.cdc transactions
+that stay well under the computation limit. With a batch size of 0,
+one unlock_tokens.cdc transaction is generated for every account.
+
+The transactions are written to <outfile> as JSON, with the arguments
+in the JSON-Cadence format.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		env, err := getEnv(conf)
+		if err != nil {
+			exit(err)
+		}
+
+		transactions, err := generateUnlocks(env, conf)
+		if err != nil {
+			exit(err)
+		}
+
+		outfile := args[0]
+
+		err = writeTransactions(outfile, transactions)
+		if err != nil {
+			exit(err)
+		}
+	},
+}
+
+func generateUnlocks(env templates.Environment, conf Config) ([]vesting.Transaction, error) {
+
+	if conf.Schedules == "" {
+		return nil, fmt.Errorf("missing --schedules")
+	}
+
+	b, err := ioutil.ReadFile(conf.Schedules)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := chainIDs[env.Network]
+
+	schedules, err := vesting.ParseSchedules(b, chain)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedules file: %w", err)
+	}
+
+	date, err := vesting.ParseDate(conf.Date)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --date: %w", err)
+	}
+
+	var previous time.Time
+	if conf.Previous != "" {
+		previous, err = vesting.ParseDate(conf.Previous)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --previous: %w", err)
+		}
+	}
+
+	var accounts map[cadence.Address]vesting.Account
+
+	switch {
+	case conf.Accounts != "" && conf.AccessNode != "":
+		return nil, fmt.Errorf("--accounts and --access-node cannot be used together")
+	case conf.Accounts != "":
+		accounts, err = readAccounts(conf.Accounts, chain)
+	case conf.AccessNode != "":
+		accounts, err = fetchAccounts(env, conf.AccessNode, schedules)
+	default:
+		return nil, fmt.Errorf("missing --accounts or --access-node")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	unlocks, err := vesting.Unlocks(schedules, previous, date, accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.BatchSize == 0 {
+		return vesting.UnlockTransactions(env, unlocks), nil
+	}
+
+	return vesting.UnlockBatchTransactions(env, unlocks, conf.BatchSize)
+}
+
+// writeTransactions writes the transactions without escaping the scripts,
+// so that they can be reviewed before they are signed
+func writeTransactions(path string, transactions []vesting.Transaction) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	encoder := json.NewEncoder(f)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(transactions)
+}
+
+// readAccounts reads a file like {"0x01cf0e2f2f715450": {"lockedBalance": "1000.0", "unlockLimit": "0.0"}}
+func readAccounts(path string, chain flow.ChainID) (map[cadence.Address]vesting.Account, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]vesting.Account
+
+	err = json.Unmarshal(b, &raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid accounts file: %w", err)
+	}
+
+	accounts := make(map[cadence.Address]vesting.Account, len(raw))
+
+	for value, account := range raw {
+		address, err := contracts.ParseAddress(value, chain)
+		if err != nil {
+			return nil, err
+		}
+
+		accounts[cadence.Address(address)] = account
+	}
+
+	return accounts, nil
+}
+
+// fetchAccounts queries the locked balance and unlock limit of every scheduled account
+func fetchAccounts(env templates.Environment, accessNode string, schedules []vesting.Schedule) (map[cadence.Address]vesting.Account, error) {
+	flowClient, err := client.New(accessNode, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer flowClient.Close()
+
+	ctx := context.Background()
+
+	accounts := make(map[cadence.Address]vesting.Account)
+
+	for _, schedule := range schedules {
+		if _, ok := accounts[schedule.Account]; ok {
+			continue
+		}
+
+		lockedBalance, err := executeUFix64Script(ctx, flowClient, templates.GenerateGetLockedAccountBalanceScript(env), schedule.Account)
+		if err != nil {
+			return nil, err
+		}
+
+		unlockLimit, err := executeUFix64Script(ctx, flowClient, templates.GenerateGetUnlockLimitScript(env), schedule.Account)
+		if err != nil {
+			return nil, err
+		}
+
+		accounts[schedule.Account] = vesting.Account{
+			LockedBalance: lockedBalance,
+			UnlockLimit:   unlockLimit,
+		}
+	}
+
+	return accounts, nil
+}
+
+func executeUFix64Script(ctx context.Context, flowClient *client.Client, script []byte, address cadence.Address) (ufix64.UFix64, error) {
+	result, err := flowClient.ExecuteScriptAtLatestBlock(ctx, script, []cadence.Value{address})
+	if err != nil {
+		return 0, fmt.Errorf("could not query account %s: %w", vesting.FormatAddress(address), err)
+	}
+
+	value, ok := result.(cadence.UFix64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected result %s for account %s", result, vesting.FormatAddress(address))
+	}
+
+	return ufix64.UFix64(value), nil
+}
+
+func getEnv(conf Config) (templates.Environment, error) {
+
+	if conf.Network == testnet {
+		return templates.Environment{
+			Network:              testnet,
+			FungibleTokenAddress: testnetFungibleTokenAddress,
+			FlowTokenAddress:     testnetFlowTokenAddress,
+			LockedTokensAddress:  testnetLockedTokensAddress,
+		}, nil
+	}
+
+	if conf.Network == mainnet {
+		return templates.Environment{
+			Network:              mainnet,
+			FungibleTokenAddress: mainnetFungibleTokenAddress,
+			FlowTokenAddress:     mainnetFlowTokenAddress,
+			LockedTokensAddress:  mainnetLockedTokensAddress,
+		}, nil
+	}
+
+	return templates.Environment{}, fmt.Errorf("invalid network %s", conf.Network)
+}
+
+func init() {
+	initConfig()
+}
+
+func initConfig() {
+	flags := cmd.PersistentFlags()
+
+	flags.StringVar(&conf.Network, "network", mainnet, "Flow network to generate for")
+	flags.StringVar(&conf.Schedules, "schedules", "", "JSON file with the vesting schedules")
+	flags.StringVar(&conf.Accounts, "accounts", "", "JSON file with the locked balance and unlock limit of every account")
+	flags.StringVar(&conf.AccessNode, "access-node", "", "Access node to query the locked balance and unlock limit of every account from")
+	flags.StringVar(&conf.Date, "date", time.Now().UTC().Format(vesting.DateFormat), "Release date")
+	flags.StringVar(&conf.Previous, "previous", "", "Date of the previous release, if any")
+	flags.IntVar(&conf.BatchSize, "batch-size", vesting.UnlockBatchSize, "Number of accounts unlocked per transaction, 0 for one unlock_tokens.cdc transaction per account")
+}
+
+func main() {
+	if err := cmd.Execute(); err != nil {
+		exit(err)
+	}
+}
+
+func exit(err error) {
+	fmt.Println(err)
+	os.Exit(1)
+}
@@ -0,0 +1,247 @@
+package vesting_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/onflow/flow-core-contracts/lib/go/contracts"
+	"github.com/onflow/flow-core-contracts/lib/go/templates"
+	"github.com/onflow/flow-core-contracts/lib/go/ufix64"
+	"github.com/onflow/flow-core-contracts/lib/go/vesting"
+)
+
+func date(t *testing.T, value string) time.Time {
+	d, err := vesting.ParseDate(value)
+	require.NoError(t, err)
+	return d
+}
+
+func address(t *testing.T, value string) cadence.Address {
+	a, err := contracts.ParseAddress(value, flow.Emulator)
+	require.NoError(t, err)
+	return cadence.Address(a)
+}
+
+// Account addresses of the emulator
+const (
+	firstAddress  = "0x01cf0e2f2f715450"
+	secondAddress = "0x179b6b1cb6755e31"
+	thirdAddress  = "0xf3fcd2c1a78f5eee"
+)
+
+func TestSchedules(t *testing.T) {
+
+	t.Run("Should decode and validate schedules", func(t *testing.T) {
+		schedules, err := vesting.ParseSchedules([]byte(`[
+			{"account": "0x01cf0e2f2f715450", "total": "100.0", "type": "cliff", "start": "2021-01-01"},
+			{"account": "0x179b6b1cb6755e31", "total": "100.0", "type": "linear", "start": "2021-01-01", "end": "2021-01-11", "cliff": "2021-01-05"},
+			{"account": "0xf3fcd2c1a78f5eee", "total": "100.0", "type": "monthly", "start": "2021-01-31", "months": 3}
+		]`), flow.Emulator)
+		require.NoError(t, err)
+		require.Len(t, schedules, 3)
+
+		b, err := json.Marshal(schedules[1])
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"account": "0x179b6b1cb6755e31", "total": "100.00000000", "type": "linear", "start": "2021-01-01", "end": "2021-01-11", "cliff": "2021-01-05"}`, string(b))
+
+		for _, invalid := range []string{
+			`{"account": "0x01cf0e2f2f715450", "total": "100.0", "type": "weekly", "start": "2021-01-01"}`,
+			`{"account": "0x01cf0e2f2f715450", "total": "100.0", "type": "linear", "start": "2021-01-01", "end": "2020-01-01"}`,
+			`{"account": "0x01cf0e2f2f715450", "total": "100.0", "type": "monthly", "start": "2021-01-01"}`,
+			`{"account": "0x01cf0e2f2f715450", "total": "100", "type": "cliff", "start": "2021-01-01"}`,
+			`{"account": "0xzz", "total": "100.0", "type": "cliff", "start": "2021-01-01"}`,
+			`{"account": "0x01cf0e2f2f715451", "total": "100.0", "type": "cliff", "start": "2021-01-01"}`,
+			`{"account": "0x01cf0e2f2f715450", "total": "100.0", "type": "cliff", "start": "01/01/2021"}`,
+		} {
+			_, err := vesting.ParseSchedules([]byte("["+invalid+"]"), flow.Emulator)
+			assert.Error(t, err, invalid)
+		}
+
+		_, err = vesting.ParseSchedules([]byte(`[{"account": "0x01cf0e2f2f715450", "total": "100.0", "type": "cliff", "start": "2021-01-01"}]`), flow.Mainnet)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should vest cliff schedules at once", func(t *testing.T) {
+		schedule := vesting.Schedule{Total: ufix64.MustParse("100.0"), Kind: vesting.Cliff, Start: date(t, "2021-01-01")}
+
+		assert.Equal(t, ufix64.Zero, schedule.Vested(date(t, "2020-12-31")))
+		assert.Equal(t, ufix64.MustParse("100.0"), schedule.Vested(date(t, "2021-01-01")))
+	})
+
+	t.Run("Should vest linear schedules day by day after the cliff", func(t *testing.T) {
+		schedule := vesting.Schedule{
+			Total: ufix64.MustParse("100.0"),
+			Kind:  vesting.Linear,
+			Start: date(t, "2021-01-01"),
+			End:   date(t, "2021-01-04"),
+			Cliff: date(t, "2021-01-02"),
+		}
+
+		assert.Equal(t, ufix64.Zero, schedule.Vested(date(t, "2021-01-01")))
+		assert.Equal(t, ufix64.MustParse("33.33333333"), schedule.Vested(date(t, "2021-01-02")))
+		assert.Equal(t, ufix64.MustParse("66.66666666"), schedule.Vested(date(t, "2021-01-03")))
+		assert.Equal(t, ufix64.MustParse("100.0"), schedule.Vested(date(t, "2021-01-04")))
+	})
+
+	t.Run("Should vest monthly tranches and clamp to the end of the month", func(t *testing.T) {
+		schedule := vesting.Schedule{Total: ufix64.MustParse("100.0"), Kind: vesting.Monthly, Start: date(t, "2021-01-31"), Months: 3}
+
+		assert.Equal(t, ufix64.Zero, schedule.Vested(date(t, "2021-02-27")))
+		assert.Equal(t, ufix64.MustParse("33.33333333"), schedule.Vested(date(t, "2021-02-28")))
+		assert.Equal(t, ufix64.MustParse("66.66666666"), schedule.Vested(date(t, "2021-03-31")))
+		assert.Equal(t, ufix64.MustParse("66.66666666"), schedule.Vested(date(t, "2021-04-29")))
+		assert.Equal(t, ufix64.MustParse("100.0"), schedule.Vested(date(t, "2021-04-30")))
+	})
+}
+
+func TestUnlocks(t *testing.T) {
+
+	first := address(t, firstAddress)
+	second := address(t, secondAddress)
+
+	schedules := []vesting.Schedule{
+		{Account: second, Total: ufix64.MustParse("300.0"), Kind: vesting.Monthly, Start: date(t, "2021-01-01"), Months: 3},
+		{Account: first, Total: ufix64.MustParse("100.0"), Kind: vesting.Cliff, Start: date(t, "2021-02-01")},
+		{Account: first, Total: ufix64.MustParse("50.0"), Kind: vesting.Monthly, Start: date(t, "2021-01-01"), Months: 2},
+	}
+
+	accounts := map[cadence.Address]vesting.Account{
+		first:  {LockedBalance: ufix64.MustParse("150.0")},
+		second: {LockedBalance: ufix64.MustParse("300.0"), UnlockLimit: ufix64.MustParse("100.0")},
+	}
+
+	t.Run("Should add up the deltas of every schedule of an account", func(t *testing.T) {
+		unlocks, err := vesting.Unlocks(schedules, time.Time{}, date(t, "2021-02-01"), accounts)
+		require.NoError(t, err)
+
+		assert.Equal(t, []vesting.Unlock{
+			{Account: first, Delta: ufix64.MustParse("125.0")},
+			{Account: second, Delta: ufix64.MustParse("100.0")},
+		}, unlocks)
+	})
+
+	t.Run("Should only unlock what vested since the previous release", func(t *testing.T) {
+		unlocks, err := vesting.Unlocks(schedules, date(t, "2021-02-01"), date(t, "2021-03-01"), accounts)
+		require.NoError(t, err)
+
+		assert.Equal(t, []vesting.Unlock{
+			{Account: first, Delta: ufix64.MustParse("25.0")},
+			{Account: second, Delta: ufix64.MustParse("100.0")},
+		}, unlocks)
+
+		_, err = vesting.Unlocks(schedules, date(t, "2021-03-01"), date(t, "2021-03-01"), accounts)
+		assert.Error(t, err)
+	})
+
+	t.Run("Shouldn't unlock more than the locked balance", func(t *testing.T) {
+		_, err := vesting.Unlocks(schedules, date(t, "2021-03-01"), date(t, "2021-04-01"), map[cadence.Address]vesting.Account{
+			second: {LockedBalance: ufix64.MustParse("300.0"), UnlockLimit: ufix64.MustParse("200.00000001")},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), vesting.FormatAddress(second))
+
+		_, err = vesting.Unlocks(schedules, time.Time{}, date(t, "2021-02-01"), map[cadence.Address]vesting.Account{first: accounts[first]})
+		assert.Error(t, err)
+	})
+
+	t.Run("Should generate unlock transactions", func(t *testing.T) {
+		env := templates.Environment{LockedTokensAddress: "0x0000000000000003"}
+
+		transactions := vesting.UnlockTransactions(env, []vesting.Unlock{{Account: first, Delta: ufix64.MustParse("25.0")}})
+		require.Len(t, transactions, 1)
+
+		assert.Equal(t, templates.GenerateIncreaseUnlockLimitScript(env), transactions[0].Script)
+
+		b, err := json.Marshal(transactions[0].Arguments)
+		require.NoError(t, err)
+		assert.NotEmpty(t, b)
+
+		b, err = json.Marshal(transactions[0])
+		require.NoError(t, err)
+
+		var decoded struct {
+			Arguments []json.RawMessage `json:"arguments"`
+		}
+		require.NoError(t, json.Unmarshal(b, &decoded))
+		require.Len(t, decoded.Arguments, 2)
+		assert.JSONEq(t, `{"type": "Address", "value": "0x01cf0e2f2f715450"}`, string(decoded.Arguments[0]))
+		assert.JSONEq(t, `{"type": "UFix64", "value": "25.00000000"}`, string(decoded.Arguments[1]))
+	})
+}
+
+func TestBatchTransactions(t *testing.T) {
+
+	env := templates.Environment{LockedTokensAddress: "0x0000000000000003"}
+
+	unlocks := []vesting.Unlock{
+		{Account: address(t, firstAddress), Delta: ufix64.MustParse("1.0")},
+		{Account: address(t, secondAddress), Delta: ufix64.MustParse("2.0")},
+		{Account: address(t, thirdAddress), Delta: ufix64.MustParse("3.0")},
+	}
+
+	t.Run("Should split batches by size", func(t *testing.T) {
+		transactions, err := vesting.UnlockBatchTransactions(env, unlocks, 2)
+		require.NoError(t, err)
+		require.Len(t, transactions, 2)
+
+		assert.Equal(t, templates.GenerateIncreaseUnlockLimitBatchScript(env), transactions[0].Script)
+		assert.Equal(t, []cadence.Value{
+			cadence.NewDictionary([]cadence.KeyValuePair{
+				{Key: unlocks[0].Account, Value: cadence.UFix64(unlocks[0].Delta)},
+				{Key: unlocks[1].Account, Value: cadence.UFix64(unlocks[1].Delta)},
+			}),
+		}, transactions[0].Arguments)
+		assert.Equal(t, []cadence.Value{
+			cadence.NewDictionary([]cadence.KeyValuePair{
+				{Key: unlocks[2].Account, Value: cadence.UFix64(unlocks[2].Delta)},
+			}),
+		}, transactions[1].Arguments)
+
+		b, err := json.Marshal(transactions[1])
+		require.NoError(t, err)
+
+		var decoded struct {
+			Arguments []json.RawMessage `json:"arguments"`
+		}
+		require.NoError(t, json.Unmarshal(b, &decoded))
+		require.Len(t, decoded.Arguments, 1)
+		assert.JSONEq(t,
+			`{"type": "Dictionary", "value": [{"key": {"type": "Address", "value": "0xf3fcd2c1a78f5eee"}, "value": {"type": "UFix64", "value": "3.00000000"}}]}`,
+			string(decoded.Arguments[0]),
+		)
+	})
+
+	t.Run("Should generate deposit batches", func(t *testing.T) {
+		deposits := []vesting.Deposit{
+			{Account: address(t, firstAddress), Amount: ufix64.MustParse("10.0")},
+		}
+
+		transactions, err := vesting.DepositBatchTransactions(env, deposits, vesting.DepositBatchSize)
+		require.NoError(t, err)
+		require.Len(t, transactions, 1)
+		assert.Equal(t, templates.GenerateDepositLockedTokensBatchScript(env), transactions[0].Script)
+	})
+
+	t.Run("Shouldn't generate invalid batches", func(t *testing.T) {
+		_, err := vesting.UnlockBatchTransactions(env, unlocks, 0)
+		assert.Error(t, err)
+
+		_, err = vesting.UnlockBatchTransactions(env, append(unlocks, unlocks[0]), 2)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should keep batches within half of the computation limit", func(t *testing.T) {
+		assert.LessOrEqual(t, vesting.UnlockBatchComputation(vesting.UnlockBatchSize), uint64(vesting.MaxComputationLimit/2))
+		assert.Greater(t, vesting.UnlockBatchComputation(vesting.UnlockBatchSize+1), uint64(vesting.MaxComputationLimit/2))
+
+		assert.LessOrEqual(t, vesting.DepositBatchComputation(vesting.DepositBatchSize), uint64(vesting.MaxComputationLimit/2))
+		assert.Greater(t, vesting.DepositBatchComputation(vesting.DepositBatchSize+1), uint64(vesting.MaxComputationLimit/2))
+	})
+}